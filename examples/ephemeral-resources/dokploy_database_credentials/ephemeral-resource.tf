@@ -0,0 +1,13 @@
+# Fetch connection details for a PostgreSQL instance without storing them in state
+ephemeral "dokploy_database_credentials" "db" {
+  database_id = dokploy_postgres.db.id
+  type        = "postgres"
+}
+
+# Configure another provider with the external connection details
+provider "postgresql" {
+  host     = ephemeral.dokploy_database_credentials.db.external_host
+  port     = ephemeral.dokploy_database_credentials.db.external_port
+  username = ephemeral.dokploy_database_credentials.db.username
+  password = ephemeral.dokploy_database_credentials.db.password
+}
@@ -0,0 +1,11 @@
+# Deploy the shared base stack with per-environment overrides
+resource "dokploy_compose" "app" {
+  name           = "app-${var.environment}"
+  environment_id = dokploy_environment.this.id
+  source_type    = "raw"
+
+  compose_file_content = provider::dokploy::compose_merge([
+    file("${path.module}/compose/base.yml"),
+    file("${path.module}/compose/${var.environment}.yml"),
+  ])
+}
@@ -0,0 +1,6 @@
+# Fail at plan time with a readable error if the schedule is malformed
+resource "dokploy_scheduled_task" "cleanup" {
+  name            = "cleanup"
+  cron_expression = provider::dokploy::validate_cron(var.cleanup_schedule)
+  script          = "docker system prune -f"
+}
@@ -0,0 +1,16 @@
+# A preset fills in the provider's API URL and checks the model name
+resource "dokploy_ai" "openai" {
+  name    = "OpenAI"
+  preset  = "openai"
+  api_key = var.openai_api_key
+  model   = "gpt-4o"
+}
+
+# Azure endpoints are per resource, so api_url stays required
+resource "dokploy_ai" "azure" {
+  name    = "Azure OpenAI"
+  preset  = "azure"
+  api_url = "https://my-resource.openai.azure.com/openai"
+  api_key = var.azure_openai_api_key
+  model   = "my-gpt-4o-deployment"
+}
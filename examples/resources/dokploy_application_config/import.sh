@@ -0,0 +1,3 @@
+# Application config can be imported using the application ID. Nothing is
+# overridden after import until attributes are added to the configuration.
+terraform import dokploy_application_config.api "application-id-123"
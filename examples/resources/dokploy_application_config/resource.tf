@@ -0,0 +1,24 @@
+# The application is defined once, leaving env, replicas and domains to the
+# per-environment configuration.
+resource "dokploy_application" "api" {
+  name           = "api"
+  environment_id = var.environment_id
+  source_type    = "docker"
+  docker_image   = "ghcr.io/example/api:1.4.0"
+}
+
+resource "dokploy_application_config" "api" {
+  application_id = dokploy_application.api.id
+
+  env = {
+    LOG_LEVEL    = "debug"
+    DATABASE_URL = var.staging_database_url
+  }
+  replicas = 2
+
+  domains = [{
+    host  = "api.staging.example.com"
+    port  = 3000
+    https = true
+  }]
+}
@@ -0,0 +1,2 @@
+# Compose backups can be imported using their ID
+terraform import dokploy_compose_backup.db "backup-id-123"
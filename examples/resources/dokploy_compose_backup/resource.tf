@@ -0,0 +1,11 @@
+# Nightly dump of the "db" service of a compose stack
+resource "dokploy_compose_backup" "db" {
+  compose_id     = dokploy_compose.stack.id
+  service_name   = "db"
+  database_type  = "postgres"
+  database       = "app"
+  database_user  = "postgres"
+  destination_id = dokploy_destination.s3.id
+  schedule       = "0 2 * * *"
+  prefix         = "stack-db"
+}
@@ -0,0 +1,3 @@
+# An environment backup policy can be imported using the environment ID. The
+# first existing backup of each database in the environment is adopted.
+terraform import dokploy_environment_backup_policy.production "environment-id-123"
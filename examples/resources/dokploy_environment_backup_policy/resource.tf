@@ -0,0 +1,14 @@
+# Back up every database in the environment nightly, including databases
+# added later.
+resource "dokploy_environment_backup_policy" "production" {
+  environment_id    = dokploy_environment.production.id
+  destination_id    = dokploy_destination.s3.id
+  schedule          = "0 2 * * *"
+  prefix            = "nightly"
+  keep_latest_count = 14
+
+  # MongoDB databases need the name of the database to dump.
+  database_names = {
+    (dokploy_mongo.events.id) = "events"
+  }
+}
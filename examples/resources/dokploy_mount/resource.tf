@@ -29,6 +29,16 @@ resource "dokploy_mount" "env_file" {
   EOT
 }
 
+# File mount with content hidden from plan output
+resource "dokploy_mount" "secrets_file" {
+  service_id        = dokploy_application.myapp.id
+  service_type      = "application"
+  type              = "file"
+  file_path         = "/app/secrets.env"
+  mount_path        = "/app/secrets.env"
+  sensitive_content = var.secrets_env
+}
+
 # Database volume mount example
 resource "dokploy_mount" "postgres_data" {
   service_id   = dokploy_database.postgres.id
@@ -0,0 +1,19 @@
+# Grant project access to many members at once. Roles list project names and
+# members are given roles by email, so no IDs need to be looked up.
+resource "dokploy_permissions_policy" "org" {
+  policy = <<-EOT
+    roles:
+      developers: [Shop, Blog]
+      support: [Shop]
+    members:
+      alice@example.com: developers
+      bob@example.com: [developers, support]
+      carol@example.com: support
+  EOT
+}
+
+# The policy can also be kept in a file, as YAML or JSON.
+resource "dokploy_permissions_policy" "contractors" {
+  policy           = file("${path.module}/contractors.yml")
+  include_services = true
+}
@@ -0,0 +1,2 @@
+# Project permissions can be imported using "member_id/project_id"
+terraform import dokploy_project_permissions.developer "member-id-123/project-id-123"
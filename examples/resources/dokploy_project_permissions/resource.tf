@@ -0,0 +1,13 @@
+data "dokploy_users" "all" {}
+
+locals {
+  developer = one([for u in data.dokploy_users.all.users : u if u.email == "dev@example.com"])
+}
+
+# Grant access to the project, all of its environments and every service,
+# including services added later.
+resource "dokploy_project_permissions" "developer" {
+  member_id        = local.developer.member_id
+  project_id       = dokploy_project.example.id
+  include_services = true
+}
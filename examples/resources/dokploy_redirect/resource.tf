@@ -20,6 +20,9 @@ resource "dokploy_redirect" "old_blog" {
   regex          = "^https://example\\.com/old-blog/(.*)"
   replacement    = "https://example.com/blog/$1"
   permanent      = false
+
+  # Redirects apply in the order they were added
+  depends_on = [dokploy_redirect.http_to_https]
 }
 
 # Redirect legacy API endpoint
@@ -58,3 +58,14 @@ resource "dokploy_registry" "harbor" {
   password      = var.harbor_robot_token
   image_prefix  = "harbor.example.com/library"
 }
+
+# Write-only password, kept out of state. Bump password_wo_version to rotate:
+# the new token is tested against the registry before it is saved.
+resource "dokploy_registry" "ghcr_rotated" {
+  registry_name       = "GitHub Container Registry (rotated)"
+  registry_url        = "ghcr.io"
+  username            = "myorg"
+  password_wo         = var.github_token
+  password_wo_version = 2
+  image_prefix        = "ghcr.io/myorg"
+}
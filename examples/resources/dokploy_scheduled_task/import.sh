@@ -0,0 +1,2 @@
+# Scheduled tasks can be imported using their ID
+terraform import dokploy_scheduled_task.docker_cleanup "schedule-id-123"
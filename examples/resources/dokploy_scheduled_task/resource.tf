@@ -0,0 +1,18 @@
+# Weekly docker cleanup on a remote server
+resource "dokploy_scheduled_task" "docker_cleanup" {
+  name            = "docker-cleanup"
+  server_id       = dokploy_server.worker.id
+  cron_expression = "0 4 * * 0"
+  script          = "docker system prune --all --force --volumes"
+}
+
+# Nightly script on the Dokploy host
+resource "dokploy_scheduled_task" "rotate_logs" {
+  name            = "rotate-logs"
+  cron_expression = "30 2 * * *"
+  timezone        = "Europe/Lisbon"
+  shell_type      = "sh"
+  script          = <<-EOT
+    find /var/log/app -name '*.log' -mtime +7 -delete
+  EOT
+}
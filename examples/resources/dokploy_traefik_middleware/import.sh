@@ -0,0 +1,8 @@
+# Global middlewares on the Dokploy host
+terraform import dokploy_traefik_middleware.api_rate_limit "dokploy/api-rate-limit"
+
+# Global middlewares on a remote server
+terraform import dokploy_traefik_middleware.api_rate_limit "server:server-id-123/api-rate-limit"
+
+# Middlewares in an application's Traefik config
+terraform import dokploy_traefik_middleware.internal_only "application:app-id-123/internal-only"
@@ -0,0 +1,42 @@
+# Global rate limit, attach with "api-rate-limit@file"
+resource "dokploy_traefik_middleware" "api_rate_limit" {
+  name = "api-rate-limit"
+
+  rate_limit = {
+    average = 100
+    burst   = 50
+    period  = "1s"
+  }
+}
+
+# Restrict an application to internal networks
+resource "dokploy_traefik_middleware" "internal_only" {
+  name           = "internal-only"
+  application_id = dokploy_application.admin.id
+
+  ip_allow_list = {
+    source_range = ["10.0.0.0/8", "192.168.0.0/16"]
+  }
+}
+
+# Compression with Traefik defaults
+resource "dokploy_traefik_middleware" "compress" {
+  name     = "compress"
+  compress = {}
+}
+
+# Security headers
+resource "dokploy_traefik_middleware" "security_headers" {
+  name = "security-headers"
+
+  headers = {
+    sts_seconds            = 31536000
+    sts_include_subdomains = true
+    frame_deny             = true
+    content_type_nosniff   = true
+
+    custom_response_headers = {
+      "X-Powered-By" = ""
+    }
+  }
+}
@@ -2,24 +2,98 @@ package client
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/waiter"
 )
 
 // ErrNotFound is returned when a resource is not found (404).
 var ErrNotFound = errors.New("resource not found")
 
+// APIError is returned when the API answers with an error status other
+// than 404.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Body       string
+	// RequestID is the X-Request-Id sent with the request, for finding it in
+	// the Dokploy server logs.
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("API error: %s - %s (request ID %s)", e.Status, e.Body, e.RequestID)
+	}
+	return fmt.Sprintf("API error: %s - %s", e.Status, e.Body)
+}
+
+// requestIDHeader carries an ID generated for every request, so that
+// provider logs and errors can be matched with the Dokploy server logs.
+const requestIDHeader = "X-Request-Id"
+
+// newRequestID returns a random 128-bit request ID in hex.
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// IsServerError reports whether err is a 5xx response from the API.
+func IsServerError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= 500
+}
+
 // DokployClient holds connection details.
 type DokployClient struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+
+	versionMu sync.Mutex
+	version   string
+
+	membersMu      sync.Mutex
+	members        []OrganizationMember
+	membersFetched time.Time
+
+	descriptionSuffix string
+
+	requestHooks []func(RequestInfo)
+}
+
+// Transports shared by every client, so connections to the Dokploy API stay
+// open across the hundreds of small requests a large apply makes.
+var (
+	sharedTransport             = newTransport(false)
+	sharedUncompressedTransport = newTransport(true)
+)
+
+// newTransport returns a transport tuned for many small JSON requests to a
+// single host. Unless compression is disabled, responses are requested with
+// gzip and decompressed transparently.
+func newTransport(disableCompression bool) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConns = 100
+	t.MaxIdleConnsPerHost = 32
+	t.IdleConnTimeout = 90 * time.Second
+	t.ForceAttemptHTTP2 = true
+	t.DisableCompression = disableCompression
+	return t
 }
 
 func NewDokployClient(baseURL, apiKey string) *DokployClient {
@@ -27,12 +101,69 @@ func NewDokployClient(baseURL, apiKey string) *DokployClient {
 		BaseURL: baseURL,
 		APIKey:  apiKey,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: sharedTransport,
 		},
 	}
 }
 
-func (c *DokployClient) doRequest(method, endpoint string, body interface{}) ([]byte, error) {
+// SetCompression controls whether responses are requested gzip-compressed.
+// Compression is enabled by default.
+func (c *DokployClient) SetCompression(enabled bool) {
+	if enabled {
+		c.HTTPClient.Transport = sharedTransport
+	} else {
+		c.HTTPClient.Transport = sharedUncompressedTransport
+	}
+}
+
+// SetDescriptionSuffix sets text appended, after a space, to the description
+// of the applications and compose stacks the client creates or updates. The
+// suffix is removed again when they are fetched, so callers see the
+// description they set.
+func (c *DokployClient) SetDescriptionSuffix(suffix string) {
+	c.descriptionSuffix = suffix
+}
+
+// describe sets the description in payload to desc followed by the
+// description suffix. An empty desc only gets the bare suffix when always is
+// set or the description is being cleared, so updates that leave the
+// description alone do not touch it.
+func (c *DokployClient) describe(payload map[string]interface{}, desc string, always bool) {
+	value, present := payload["description"]
+	cleared := present && value == nil
+	switch {
+	case desc != "" && c.descriptionSuffix != "":
+		payload["description"] = desc + " " + c.descriptionSuffix
+	case desc != "":
+		payload["description"] = desc
+	case c.descriptionSuffix != "" && (always || cleared):
+		payload["description"] = c.descriptionSuffix
+	}
+}
+
+// undescribe removes the description suffix from desc.
+func (c *DokployClient) undescribe(desc string) string {
+	switch {
+	case c.descriptionSuffix == "":
+		return desc
+	case desc == c.descriptionSuffix:
+		return ""
+	}
+	return strings.TrimSuffix(desc, " "+c.descriptionSuffix)
+}
+
+// Endpoint returns the base URL of the Dokploy API.
+func (c *DokployClient) Endpoint() string {
+	return c.BaseURL
+}
+
+func (c *DokployClient) doRequest(method, endpoint string, body interface{}) (respBytes []byte, err error) {
+	start := time.Now()
+	statusCode := 0
+	requestID := newRequestID()
+	defer func() { c.observe(method, endpoint, requestID, statusCode, time.Since(start), err) }()
+
 	var reqBody io.Reader
 	if body != nil {
 		jsonBytes, err := json.Marshal(body)
@@ -49,16 +180,20 @@ func (c *DokployClient) doRequest(method, endpoint string, body interface{}) ([]
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.Header.Set("x-api-key", c.APIKey)
+	req.Header.Set(requestIDHeader, requestID)
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
+	statusCode = resp.StatusCode
 
-	respBytes, err := io.ReadAll(resp.Body)
+	respBytes, err = io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -69,12 +204,102 @@ func (c *DokployClient) doRequest(method, endpoint string, body interface{}) ([]
 		return nil, fmt.Errorf("%w: %s", ErrNotFound, string(respBytes))
 	}
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(respBytes))
+		return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: string(respBytes), RequestID: requestID}
 	}
 
 	return respBytes, nil
 }
 
+// childCreateRetry bounds how often createChild repeats a create whose
+// request failed before a response was received.
+var childCreateRetry = waiter.Config{
+	Interval:    500 * time.Millisecond,
+	MaxInterval: 4 * time.Second,
+	Multiplier:  2,
+	Jitter:      0.2,
+	MaxAttempts: 3,
+}
+
+// isTransportError reports whether err occurred before a response was
+// received, so the server may or may not have applied the request.
+func isTransportError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// createChild creates a child of a service, such as a port, redirect or mount,
+// at most once. Dokploy has no idempotency keys, so the children that exist
+// before the write are recorded and the created child is identified as the
+// new one that matches the request. When the request fails without a
+// response, the children are listed again before retrying, so a create that
+// reached the server is never sent twice.
+func createChild[T any](list func() ([]T, error), id func(T) string, match func(T) bool, create func() ([]byte, error)) (*T, error) {
+	existing, err := list()
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(existing))
+	for _, child := range existing {
+		known[id(child)] = true
+	}
+
+	// created returns the new child matching the request, if there is one.
+	created := func() (*T, error) {
+		children, err := list()
+		if err != nil {
+			return nil, err
+		}
+		for i := range children {
+			if !known[id(children[i])] && match(children[i]) {
+				return &children[i], nil
+			}
+		}
+		return nil, nil
+	}
+
+	// unsure is set when a create failed without a response, so the next
+	// attempt checks whether it was applied before sending it again.
+	unsure := false
+	return waiter.Wait(context.Background(), childCreateRetry, func(context.Context) (*T, error) {
+		if unsure {
+			child, err := created()
+			if err != nil {
+				return nil, waiter.Retryable(err)
+			}
+			if child != nil {
+				return child, nil
+			}
+		}
+
+		resp, err := create()
+		if err != nil {
+			if isTransportError(err) {
+				unsure = true
+				return nil, waiter.Retryable(err)
+			}
+			return nil, err
+		}
+
+		var result T
+		if err := json.Unmarshal(resp, &result); err == nil && id(result) != "" {
+			return &result, nil
+		}
+
+		// The API returns true on success, so look the child up.
+		if string(resp) != "true" {
+			return nil, fmt.Errorf("unexpected API response format: %s", string(resp))
+		}
+		child, err := created()
+		if err != nil {
+			return nil, fmt.Errorf("created but failed to fetch details: %w", err)
+		}
+		if child == nil {
+			return nil, fmt.Errorf("created but could not find it in the service")
+		}
+		return child, nil
+	}, func(child *T) bool { return child != nil })
+}
+
 // --- User ---
 
 // UserDetails represents the nested user object in OrganizationMember.
@@ -163,7 +388,7 @@ func (c *DokployClient) GetUser() (*User, error) {
 
 // GetCurrentMember returns the full organization member info for the current user.
 func (c *DokployClient) GetCurrentMember() (*OrganizationMember, error) {
-	resp, err := c.doRequest("GET", "user.get", nil)
+	resp, err := c.call("user.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -175,20 +400,47 @@ func (c *DokployClient) GetCurrentMember() (*OrganizationMember, error) {
 	return &member, nil
 }
 
-// ListMembers returns all organization members.
+// membersTTL bounds how long ListMembers serves a cached member list, so
+// changes made outside the provider, such as accepted invitations or role
+// changes in the UI, show up during long applies.
+var membersTTL = 30 * time.Second
+
+// ListMembers returns all organization members. The list is cached for
+// membersTTL so that member and permission lookups across many resources
+// share one user.all call; writes that may change members invalidate it.
 func (c *DokployClient) ListMembers() ([]OrganizationMember, error) {
-	resp, err := c.doRequest("GET", "user.all", nil)
-	if err != nil {
-		return nil, err
-	}
+	c.membersMu.Lock()
+	defer c.membersMu.Unlock()
+
+	if c.members == nil || time.Since(c.membersFetched) > membersTTL {
+		resp, err := c.call("user.all", nil)
+		if err != nil {
+			return nil, err
+		}
 
-	var members []OrganizationMember
-	if err := json.Unmarshal(resp, &members); err != nil {
-		return nil, fmt.Errorf("failed to parse users response: %w", err)
+		var members []OrganizationMember
+		if err := json.Unmarshal(resp, &members); err != nil {
+			return nil, fmt.Errorf("failed to parse users response: %w", err)
+		}
+		if members == nil {
+			members = []OrganizationMember{}
+		}
+		c.members = members
+		c.membersFetched = time.Now()
 	}
+
+	members := make([]OrganizationMember, len(c.members))
+	copy(members, c.members)
 	return members, nil
 }
 
+// invalidateMembers drops the cached member list so the next lookup refetches it.
+func (c *DokployClient) invalidateMembers() {
+	c.membersMu.Lock()
+	defer c.membersMu.Unlock()
+	c.members = nil
+}
+
 // GetMemberByUserID finds a member by their user ID.
 func (c *DokployClient) GetMemberByUserID(userID string) (*OrganizationMember, error) {
 	members, err := c.ListMembers()
@@ -216,7 +468,7 @@ func (c *DokployClient) GetMemberByID(memberID string) (*OrganizationMember, err
 			return &m, nil
 		}
 	}
-	return nil, fmt.Errorf("member with ID %s not found", memberID)
+	return nil, fmt.Errorf("%w: member with ID %s", ErrNotFound, memberID)
 }
 
 // UserPermissionsInput represents the input for assigning permissions.
@@ -258,7 +510,7 @@ func (c *DokployClient) AssignUserPermissions(input UserPermissionsInput) error
 		"canCreateEnvironments":   input.CanCreateEnvironments,
 	}
 
-	_, err := c.doRequest("POST", "user.assignPermissions", payload)
+	_, err := c.call("user.assignPermissions", payload)
 	return err
 }
 
@@ -292,7 +544,7 @@ func (c *DokployClient) CreateApiKey(input ApiKeyCreateInput) (*ApiKey, error) {
 		payload["rateLimitTimeWindow"] = *input.RateLimitTimeWindow
 	}
 
-	resp, err := c.doRequest("POST", "user.createApiKey", payload)
+	resp, err := c.call("user.createApiKey", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -309,7 +561,7 @@ func (c *DokployClient) DeleteApiKey(apiKeyID string) error {
 	payload := map[string]string{
 		"apiKeyId": apiKeyID,
 	}
-	_, err := c.doRequest("POST", "user.deleteApiKey", payload)
+	_, err := c.call("user.deleteApiKey", payload)
 	return err
 }
 
@@ -363,7 +615,7 @@ func (c *DokployClient) CreateAI(name, apiURL, apiKey, model string, isEnabled b
 	// Record time before creation to help identify the new resource
 	creationTime := time.Now().Add(-1 * time.Second)
 
-	_, err := c.doRequest("POST", "ai.create", payload)
+	_, err := c.call("ai.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -400,8 +652,7 @@ func (c *DokployClient) CreateAI(name, apiURL, apiKey, model string, isEnabled b
 
 // GetAI retrieves an AI configuration by ID.
 func (c *DokployClient) GetAI(aiID string) (*AI, error) {
-	endpoint := fmt.Sprintf("ai.get?aiId=%s", aiID)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("ai.get", map[string]interface{}{"aiId": aiID})
 	if err != nil {
 		return nil, err
 	}
@@ -415,7 +666,7 @@ func (c *DokployClient) GetAI(aiID string) (*AI, error) {
 
 // ListAIs returns all AI configurations.
 func (c *DokployClient) ListAIs() ([]AI, error) {
-	resp, err := c.doRequest("GET", "ai.getAll", nil)
+	resp, err := c.call("ai.getAll", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -438,7 +689,7 @@ func (c *DokployClient) UpdateAI(ai AI) error {
 		"isEnabled": ai.IsEnabled,
 	}
 
-	_, err := c.doRequest("POST", "ai.update", payload)
+	_, err := c.call("ai.update", payload)
 	return err
 }
 
@@ -447,15 +698,14 @@ func (c *DokployClient) DeleteAI(aiID string) error {
 	payload := map[string]string{
 		"aiId": aiID,
 	}
-	_, err := c.doRequest("POST", "ai.delete", payload)
+	_, err := c.call("ai.delete", payload)
 	return err
 }
 
 // GetAIModels retrieves available models from an AI provider.
 func (c *DokployClient) GetAIModels(apiURL, apiKey string) ([]AIModel, error) {
 	// URL encode the parameters to handle special characters safely
-	endpoint := fmt.Sprintf("ai.getModels?apiUrl=%s&apiKey=%s", url.QueryEscape(apiURL), url.QueryEscape(apiKey))
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("ai.getModels", map[string]interface{}{"apiUrl": apiURL, "apiKey": apiKey})
 	if err != nil {
 		return nil, err
 	}
@@ -500,7 +750,7 @@ func (c *DokployClient) CreateCertificate(cert Certificate) (*Certificate, error
 		payload["serverId"] = *cert.ServerID
 	}
 
-	resp, err := c.doRequest("POST", "certificates.create", payload)
+	resp, err := c.call("certificates.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -514,8 +764,7 @@ func (c *DokployClient) CreateCertificate(cert Certificate) (*Certificate, error
 
 // GetCertificate retrieves a certificate by ID.
 func (c *DokployClient) GetCertificate(id string) (*Certificate, error) {
-	endpoint := fmt.Sprintf("certificates.one?certificateId=%s", url.QueryEscape(id))
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("certificates.one", map[string]interface{}{"certificateId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -529,7 +778,7 @@ func (c *DokployClient) GetCertificate(id string) (*Certificate, error) {
 
 // ListCertificates returns all certificates.
 func (c *DokployClient) ListCertificates() ([]Certificate, error) {
-	resp, err := c.doRequest("GET", "certificates.all", nil)
+	resp, err := c.call("certificates.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -546,13 +795,13 @@ func (c *DokployClient) DeleteCertificate(id string) error {
 	payload := map[string]string{
 		"certificateId": id,
 	}
-	_, err := c.doRequest("POST", "certificates.remove", payload)
+	_, err := c.call("certificates.remove", payload)
 	return err
 }
 
 // GetCurrentOrganizationID retrieves the organization ID for the current user.
 func (c *DokployClient) GetCurrentOrganizationID() (string, error) {
-	resp, err := c.doRequest("GET", "user.get", nil)
+	resp, err := c.call("user.get", nil)
 	if err != nil {
 		return "", err
 	}
@@ -569,10 +818,26 @@ func (c *DokployClient) GetCurrentOrganizationID() (string, error) {
 // --- Project ---
 
 type Project struct {
-	ID           string        `json:"projectId"`
-	Name         string        `json:"name"`
-	Description  string        `json:"description"`
-	Environments []Environment `json:"environments"`
+	ID             string        `json:"projectId"`
+	Name           string        `json:"name"`
+	Description    string        `json:"description"`
+	CreatedAt      string        `json:"createdAt"`
+	OrganizationID string        `json:"organizationId"`
+	Environments   []Environment `json:"environments"`
+}
+
+// ListProjects returns all projects with their environments and services.
+func (c *DokployClient) ListProjects() ([]Project, error) {
+	resp, err := c.call("project.all", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []Project
+	if err := json.Unmarshal(resp, &projects); err != nil {
+		return nil, fmt.Errorf("failed to parse projects response: %w", err)
+	}
+	return projects, nil
 }
 
 type projectResponse struct {
@@ -584,7 +849,7 @@ func (c *DokployClient) CreateProject(name, description string) (*Project, error
 		"name":        name,
 		"description": description,
 	}
-	resp, err := c.doRequest("POST", "project.create", payload)
+	resp, err := c.call("project.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -597,8 +862,7 @@ func (c *DokployClient) CreateProject(name, description string) (*Project, error
 }
 
 func (c *DokployClient) GetProject(id string) (*Project, error) {
-	endpoint := fmt.Sprintf("project.one?projectId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("project.one", map[string]interface{}{"projectId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -614,7 +878,7 @@ func (c *DokployClient) DeleteProject(id string) error {
 	payload := map[string]string{
 		"projectId": id,
 	}
-	_, err := c.doRequest("POST", "project.remove", payload)
+	_, err := c.call("project.remove", payload)
 	return err
 }
 
@@ -624,7 +888,7 @@ func (c *DokployClient) UpdateProject(id, name, description string) (*Project, e
 		"name":        name,
 		"description": description,
 	}
-	resp, err := c.doRequest("POST", "project.update", payload)
+	resp, err := c.call("project.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -639,15 +903,18 @@ func (c *DokployClient) UpdateProject(id, name, description string) (*Project, e
 // --- Environment ---
 
 type Environment struct {
-	ID          string     `json:"environmentId"`
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	ProjectID   string     `json:"projectId"`
-	Postgres    []Database `json:"postgres"`
-	Mysql       []Database `json:"mysql"`
-	Mariadb     []Database `json:"mariadb"`
-	Mongo       []Database `json:"mongo"`
-	Redis       []Database `json:"redis"`
+	ID           string        `json:"environmentId"`
+	Name         string        `json:"name"`
+	Description  string        `json:"description"`
+	ProjectID    string        `json:"projectId"`
+	Env          string        `json:"env"`
+	Applications []Application `json:"applications"`
+	Compose      []Compose     `json:"compose"`
+	Postgres     []Database    `json:"postgres"`
+	Mysql        []Database    `json:"mysql"`
+	Mariadb      []Database    `json:"mariadb"`
+	Mongo        []Database    `json:"mongo"`
+	Redis        []Database    `json:"redis"`
 }
 
 func (c *DokployClient) CreateEnvironment(projectID, name, description string) (*Environment, error) {
@@ -656,7 +923,7 @@ func (c *DokployClient) CreateEnvironment(projectID, name, description string) (
 		"name":        name,
 		"description": description,
 	}
-	resp, err := c.doRequest("POST", "environment.create", payload)
+	resp, err := c.call("environment.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -682,7 +949,7 @@ func (c *DokployClient) UpdateEnvironment(env Environment) (*Environment, error)
 		"description":   env.Description,
 		"projectId":     env.ProjectID,
 	}
-	resp, err := c.doRequest("POST", "environment.update", payload)
+	resp, err := c.call("environment.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -701,11 +968,56 @@ func (c *DokployClient) UpdateEnvironment(env Environment) (*Environment, error)
 	return &result, nil
 }
 
+// GetEnvironment retrieves a single environment by ID.
+func (c *DokployClient) GetEnvironment(id string) (*Environment, error) {
+	resp, err := c.call("environment.one", map[string]interface{}{"environmentId": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var result Environment
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse environment response: %w", err)
+	}
+	return &result, nil
+}
+
+// SaveEnvironmentEnv replaces the shared env of an environment. Variables set
+// here are available to every service in the environment.
+func (c *DokployClient) SaveEnvironmentEnv(id, env string) error {
+	payload := map[string]interface{}{
+		"environmentId": id,
+		"env":           env,
+	}
+	_, err := c.call("environment.update", payload)
+	return err
+}
+
+// UpdateEnvironmentEnv applies updateFn to the parsed env of an environment
+// and saves the result if anything changed.
+func (c *DokployClient) UpdateEnvironmentEnv(id string, updateFn func(envMap map[string]string)) error {
+	env, err := c.GetEnvironment(id)
+	if err != nil {
+		return err
+	}
+
+	f, _ := ParseEnvFile(env.Env)
+	envMap := f.Map()
+	updateFn(envMap)
+	f.Apply(envMap)
+
+	newEnvStr := f.String()
+	if newEnvStr == env.Env {
+		return nil
+	}
+	return c.SaveEnvironmentEnv(id, newEnvStr)
+}
+
 func (c *DokployClient) DeleteEnvironment(id string) error {
 	payload := map[string]string{
 		"environmentId": id,
 	}
-	_, err := c.doRequest("POST", "environment.remove", payload)
+	_, err := c.call("environment.remove", payload)
 	return err
 }
 
@@ -725,13 +1037,13 @@ type Application struct {
 	SourceType string `json:"sourceType"` // github, gitlab, bitbucket, git, docker, drop
 
 	// Git provider settings (application.saveGitProvider)
-	CustomGitUrl       string `json:"customGitUrl"`
-	CustomGitBranch    string `json:"customGitBranch"`
-	CustomGitSSHKeyId  string `json:"customGitSSHKeyId"`
-	CustomGitBuildPath string `json:"customGitBuildPath"`
-	EnableSubmodules   bool   `json:"enableSubmodules"`
-	WatchPaths         string `json:"watchPaths"` // Stored as JSON array string
-	CleanCache         bool   `json:"cleanCache"`
+	CustomGitUrl       string     `json:"customGitUrl"`
+	CustomGitBranch    string     `json:"customGitBranch"`
+	CustomGitSSHKeyId  string     `json:"customGitSSHKeyId"`
+	CustomGitBuildPath string     `json:"customGitBuildPath"`
+	EnableSubmodules   bool       `json:"enableSubmodules"`
+	WatchPaths         WatchPaths `json:"watchPaths"`
+	CleanCache         bool       `json:"cleanCache"`
 
 	// GitHub provider settings (application.saveGithubProvider)
 	Repository  string `json:"repository"`
@@ -812,6 +1124,7 @@ type Application struct {
 	NetworkSwarm         []map[string]interface{} `json:"networkSwarm"`
 	StopGracePeriodSwarm *int64                   `json:"stopGracePeriodSwarm"`
 	EndpointSpecSwarm    map[string]interface{}   `json:"endpointSpecSwarm"`
+	UlimitsSwarm         []Ulimit                 `json:"ulimitsSwarm"`
 
 	// Preview deployments (application.update)
 	IsPreviewDeploymentsActive            bool   `json:"isPreviewDeploymentsActive"`
@@ -843,12 +1156,78 @@ type Application struct {
 
 	// Application status
 	ApplicationStatus string `json:"applicationStatus"` // idle, running, done, error
+	RefreshToken      string `json:"refreshToken"`
 
 	// Domains
 	Domains []Domain `json:"domains"`
 
+	// Child resources
+	Ports     []Port     `json:"ports"`
+	Redirects []Redirect `json:"redirects"`
+	Mounts    []Mount    `json:"mounts"`
+
 	// Timestamps
 	CreatedAt string `json:"createdAt"`
+
+	// Clear lists fields to reset on update.
+	Clear []ApplicationField `json:"-"`
+}
+
+// WatchPaths decodes the watch paths of a service, which Dokploy returns
+// either as a JSON array or, depending on the version and database driver,
+// as a string holding a JSON array.
+type WatchPaths []string
+
+func (w *WatchPaths) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		if strings.TrimSpace(s) == "" {
+			*w = nil
+			return nil
+		}
+		data = []byte(s)
+	}
+	var paths []string
+	if err := json.Unmarshal(data, &paths); err != nil {
+		return fmt.Errorf("invalid watch paths %s: %w", data, err)
+	}
+	*w = paths
+	return nil
+}
+
+// ApplicationField names an optional application setting that can be
+// cleared on update.
+type ApplicationField string
+
+const (
+	ApplicationFieldDescription       ApplicationField = "description"
+	ApplicationFieldCommand           ApplicationField = "command"
+	ApplicationFieldArgs              ApplicationField = "args"
+	ApplicationFieldEntrypoint        ApplicationField = "entrypoint"
+	ApplicationFieldTitle             ApplicationField = "title"
+	ApplicationFieldSubtitle          ApplicationField = "subtitle"
+	ApplicationFieldMemoryLimit       ApplicationField = "memoryLimit"
+	ApplicationFieldMemoryReservation ApplicationField = "memoryReservation"
+	ApplicationFieldCPULimit          ApplicationField = "cpuLimit"
+	ApplicationFieldCPUReservation    ApplicationField = "cpuReservation"
+	ApplicationFieldPreviewEnv        ApplicationField = "previewEnv"
+	ApplicationFieldPreviewBuildArgs  ApplicationField = "previewBuildArgs"
+	ApplicationFieldPreviewWildcard   ApplicationField = "previewWildcard"
+	ApplicationFieldPreviewPath       ApplicationField = "previewPath"
+)
+
+// SourceProviderFields lists, per source type, the application settings
+// that only that source provider uses. They are cleared when an application
+// switches to another source type, since the save*Provider endpoints only
+// set the fields of the new provider.
+var SourceProviderFields = map[string][]ApplicationField{
+	"github":    {"repository", "owner", "branch", "buildPath", "githubId"},
+	"gitlab":    {"gitlabId", "gitlabProjectId", "gitlabRepository", "gitlabOwner", "gitlabBranch", "gitlabBuildPath", "gitlabPathNamespace"},
+	"bitbucket": {"bitbucketId", "bitbucketRepository", "bitbucketOwner", "bitbucketBranch", "bitbucketBuildPath"},
+	"gitea":     {"giteaId", "giteaRepository", "giteaOwner", "giteaBranch", "giteaBuildPath"},
+	"git":       {"customGitUrl", "customGitBranch", "customGitBuildPath", "customGitSSHKeyId"},
+	"docker":    {"dockerImage", "username", "password", "registryUrl", "registryId"},
+	"drop":      {"dropBuildPath"},
 }
 
 func (c *DokployClient) CreateApplication(app Application) (*Application, error) {
@@ -862,14 +1241,12 @@ func (c *DokployClient) CreateApplication(app Application) (*Application, error)
 	if app.AppName != "" {
 		createPayload["appName"] = app.AppName
 	}
-	if app.Description != "" {
-		createPayload["description"] = app.Description
-	}
+	c.describe(createPayload, app.Description, true)
 	if app.ServerID != "" {
 		createPayload["serverId"] = app.ServerID
 	}
 
-	resp, err := c.doRequest("POST", "application.create", createPayload)
+	resp, err := c.call("application.create", createPayload)
 	if err != nil {
 		return nil, err
 	}
@@ -892,13 +1269,20 @@ func (c *DokployClient) CreateApplication(app Application) (*Application, error)
 	if app.ServerID != "" {
 		createdApp.ServerID = app.ServerID
 	}
+	createdApp.Description = c.undescribe(createdApp.Description)
 
 	return &createdApp, nil
 }
 
+// Ulimit is a resource limit applied to the containers of a swarm service.
+type Ulimit struct {
+	Name string `json:"Name"`
+	Soft int64  `json:"Soft"`
+	Hard int64  `json:"Hard"`
+}
+
 func (c *DokployClient) GetApplication(id string) (*Application, error) {
-	endpoint := fmt.Sprintf("application.one?applicationId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("application.one", map[string]interface{}{"applicationId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -907,6 +1291,7 @@ func (c *DokployClient) GetApplication(id string) (*Application, error) {
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, err
 	}
+	result.Description = c.undescribe(result.Description)
 	return &result, nil
 }
 
@@ -924,9 +1309,6 @@ func (c *DokployClient) UpdateApplicationGeneral(app Application) (*Application,
 	if app.AppName != "" {
 		payload["appName"] = app.AppName
 	}
-	if app.Description != "" {
-		payload["description"] = app.Description
-	}
 	if app.SourceType != "" {
 		payload["sourceType"] = app.SourceType
 	}
@@ -959,8 +1341,65 @@ func (c *DokployClient) UpdateApplicationGeneral(app Application) (*Application,
 	if app.EntryPoint != "" {
 		payload["entrypoint"] = app.EntryPoint
 	}
+	if app.Args != "" {
+		payload["args"] = app.Args
+	}
+	for field, value := range map[string]string{
+		"title":                     app.Title,
+		"subtitle":                  app.Subtitle,
+		"previewEnv":                app.PreviewEnv,
+		"previewBuildArgs":          app.PreviewBuildArgs,
+		"previewBuildSecrets":       app.PreviewBuildSecrets,
+		"previewWildcard":           app.PreviewWildcard,
+		"previewPath":               app.PreviewPath,
+		"previewCertificateType":    app.PreviewCertificateType,
+		"previewCustomCertResolver": app.PreviewCustomCertResolver,
+		"rollbackRegistryId":        app.RollbackRegistryId,
+		"buildServerId":             app.BuildServerId,
+		"buildRegistryId":           app.BuildRegistryId,
+	} {
+		if value != "" {
+			payload[field] = value
+		}
+	}
+	if app.PreviewPort > 0 {
+		payload["previewPort"] = app.PreviewPort
+	}
+	if app.PreviewLimit > 0 {
+		payload["previewLimit"] = app.PreviewLimit
+	}
+
+	// Docker Swarm settings
+	for field, value := range map[string]map[string]interface{}{
+		"healthCheckSwarm":    app.HealthCheckSwarm,
+		"restartPolicySwarm":  app.RestartPolicySwarm,
+		"placementSwarm":      app.PlacementSwarm,
+		"updateConfigSwarm":   app.UpdateConfigSwarm,
+		"rollbackConfigSwarm": app.RollbackConfigSwarm,
+		"modeSwarm":           app.ModeSwarm,
+		"endpointSpecSwarm":   app.EndpointSpecSwarm,
+	} {
+		if value != nil {
+			payload[field] = value
+		}
+	}
+	if app.LabelsSwarm != nil {
+		payload["labelsSwarm"] = app.LabelsSwarm
+	}
+	if app.NetworkSwarm != nil {
+		payload["networkSwarm"] = app.NetworkSwarm
+	}
+	if app.StopGracePeriodSwarm != nil {
+		payload["stopGracePeriodSwarm"] = *app.StopGracePeriodSwarm
+	}
+	if app.UlimitsSwarm != nil {
+		payload["ulimitsSwarm"] = app.UlimitsSwarm
+	}
+
+	clearFields(payload, app.Clear)
+	c.describe(payload, app.Description, false)
 
-	resp, err := c.doRequest("POST", "application.update", payload)
+	resp, err := c.call("application.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -975,6 +1414,7 @@ func (c *DokployClient) UpdateApplicationGeneral(app Application) (*Application,
 		// If unmarshal fails, fetch the application
 		return c.GetApplication(app.ID)
 	}
+	result.Description = c.undescribe(result.Description)
 	return &result, nil
 }
 
@@ -988,7 +1428,7 @@ func (c *DokployClient) DeleteApplication(id string) error {
 	payload := map[string]string{
 		"applicationId": id,
 	}
-	_, err := c.doRequest("POST", "application.remove", payload)
+	_, err := c.call("application.remove", payload)
 	return err
 }
 
@@ -999,7 +1439,7 @@ func (c *DokployClient) DeployApplication(id string, serverId string) error {
 	if serverId != "" {
 		payload["serverId"] = serverId
 	}
-	_, err := c.doRequest("POST", "application.deploy", payload)
+	_, err := c.call("application.deploy", payload)
 	return err
 }
 
@@ -1007,7 +1447,7 @@ func (c *DokployClient) RedeployApplication(id string) error {
 	payload := map[string]interface{}{
 		"applicationId": id,
 	}
-	_, err := c.doRequest("POST", "application.redeploy", payload)
+	_, err := c.call("application.redeploy", payload)
 	return err
 }
 
@@ -1015,7 +1455,17 @@ func (c *DokployClient) StopApplication(id string) error {
 	payload := map[string]interface{}{
 		"applicationId": id,
 	}
-	_, err := c.doRequest("POST", "application.stop", payload)
+	_, err := c.call("application.stop", payload)
+	return err
+}
+
+// RefreshApplicationToken replaces the application's webhook refresh token,
+// invalidating deploy webhook URLs that use the old one.
+func (c *DokployClient) RefreshApplicationToken(id string) error {
+	payload := map[string]interface{}{
+		"applicationId": id,
+	}
+	_, err := c.call("application.refreshToken", payload)
 	return err
 }
 
@@ -1023,14 +1473,13 @@ func (c *DokployClient) StartApplication(id string) error {
 	payload := map[string]interface{}{
 		"applicationId": id,
 	}
-	_, err := c.doRequest("POST", "application.start", payload)
+	_, err := c.call("application.start", payload)
 	return err
 }
 
 // ReadTraefikConfig retrieves the custom Traefik configuration for an application.
 func (c *DokployClient) ReadTraefikConfig(appID string) (string, error) {
-	endpoint := fmt.Sprintf("application.readTraefikConfig?applicationId=%s", url.QueryEscape(appID))
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("application.readTraefikConfig", map[string]interface{}{"applicationId": appID})
 	if err != nil {
 		return "", err
 	}
@@ -1053,7 +1502,7 @@ func (c *DokployClient) UpdateTraefikConfig(appID, traefikConfig string) error {
 		"applicationId": appID,
 		"traefikConfig": traefikConfig,
 	}
-	_, err := c.doRequest("POST", "application.updateTraefikConfig", payload)
+	_, err := c.call("application.updateTraefikConfig", payload)
 	return err
 }
 
@@ -1063,7 +1512,7 @@ func (c *DokployClient) MoveApplication(appID, targetEnvironmentID string) (*App
 		"applicationId":       appID,
 		"targetEnvironmentId": targetEnvironmentID,
 	}
-	resp, err := c.doRequest("POST", "application.move", payload)
+	resp, err := c.call("application.move", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1077,7 +1526,7 @@ func (c *DokployClient) MoveApplication(appID, targetEnvironmentID string) (*App
 
 // ListApplications retrieves all applications. Uses project.all and extracts applications from all environments.
 func (c *DokployClient) ListApplications() ([]Application, error) {
-	resp, err := c.doRequest("GET", "project.all", nil)
+	resp, err := c.call("project.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1100,11 +1549,56 @@ func (c *DokployClient) ListApplications() ([]Application, error) {
 	return apps, nil
 }
 
+// FindApplicationByDomain resolves the application serving host, scanning
+// the domains of every application the API key can see. Hosts compare case
+// insensitively and ignore a trailing dot. Applications listed without their
+// domains are asked for them one by one, and a host shared by several
+// applications, e.g. on different paths, is an error.
+func (c *DokployClient) FindApplicationByDomain(host string) (*Application, error) {
+	apps, err := c.ListApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	host = normalizeHost(host)
+	var matches []*Application
+	for i := range apps {
+		domains := apps[i].Domains
+		if domains == nil {
+			if domains, err = c.GetDomainsByApplication(apps[i].ID); err != nil {
+				return nil, err
+			}
+		}
+		for _, d := range domains {
+			if normalizeHost(d.Host) == host {
+				matches = append(matches, &apps[i])
+				break
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%w: no application has the domain %q", ErrNotFound, host)
+	case 1:
+		return matches[0], nil
+	}
+	names := make([]string, len(matches))
+	for i, app := range matches {
+		names[i] = fmt.Sprintf("%s (%s)", app.Name, app.ID)
+	}
+	return nil, fmt.Errorf("domain %q is served by %d applications: %s", host, len(matches), strings.Join(names, ", "))
+}
+
+// normalizeHost lowercases host and removes a trailing dot.
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
+
 // ListApplicationsByEnvironment retrieves all applications in a specific environment.
 func (c *DokployClient) ListApplicationsByEnvironment(environmentID string) ([]Application, error) {
 	// First get the environment to find its project
-	endpoint := fmt.Sprintf("environment.one?environmentId=%s", url.QueryEscape(environmentID))
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("environment.one", map[string]interface{}{"environmentId": environmentID})
 	if err != nil {
 		return nil, err
 	}
@@ -1119,20 +1613,85 @@ func (c *DokployClient) ListApplicationsByEnvironment(environmentID string) ([]A
 	return env.Applications, nil
 }
 
+// FindApplicationByPath resolves an application from its project name,
+// environment name and application name (display name or appName).
+func (c *DokployClient) FindApplicationByPath(projectName, environmentName, appName string) (*Application, error) {
+	projects, err := c.ListProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proj := range projects {
+		if proj.Name != projectName {
+			continue
+		}
+		for _, env := range proj.Environments {
+			if env.Name != environmentName {
+				continue
+			}
+			for i := range env.Applications {
+				app := &env.Applications[i]
+				if app.Name == appName || app.AppName == appName {
+					return app, nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: application %q in %s/%s", ErrNotFound, appName, projectName, environmentName)
+}
+
+// FindApplicationByAppName resolves an application from its unique appName.
+func (c *DokployClient) FindApplicationByAppName(appName string) (*Application, error) {
+	apps, err := c.ListApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range apps {
+		if apps[i].AppName == appName {
+			return &apps[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: application with appName %q", ErrNotFound, appName)
+}
+
+// SaveBuildTypeInput contains all the fields for the saveBuildType endpoint.
+type SaveBuildTypeInput struct {
+	ApplicationID     string
+	BuildType         string
+	Dockerfile        string
+	DockerContextPath string
+	DockerBuildStage  string
+	PublishDirectory  string
+	HerokuVersion     string
+	RailpackVersion   string
+	IsStaticSpa       *bool
+}
+
 // SaveBuildType configures the build type settings for an application.
 // Corresponds to application.saveBuildType endpoint.
-func (c *DokployClient) SaveBuildType(appID string, buildType string, dockerfile string, dockerContextPath string, dockerBuildStage string, publishDirectory string) error {
+func (c *DokployClient) SaveBuildType(input SaveBuildTypeInput) error {
 	// The API requires all these fields to be present as strings (even if empty)
 	payload := map[string]interface{}{
-		"applicationId":     appID,
-		"buildType":         buildType,
-		"dockerfile":        dockerfile,
-		"dockerContextPath": dockerContextPath,
-		"dockerBuildStage":  dockerBuildStage,
-		"publishDirectory":  publishDirectory,
+		"applicationId":     input.ApplicationID,
+		"buildType":         input.BuildType,
+		"dockerfile":        input.Dockerfile,
+		"dockerContextPath": input.DockerContextPath,
+		"dockerBuildStage":  input.DockerBuildStage,
+		"publishDirectory":  input.PublishDirectory,
+	}
+
+	if input.HerokuVersion != "" {
+		payload["herokuVersion"] = input.HerokuVersion
+	}
+	if input.RailpackVersion != "" {
+		payload["railpackVersion"] = input.RailpackVersion
+	}
+	if input.IsStaticSpa != nil {
+		payload["isStaticSpa"] = *input.IsStaticSpa
 	}
 
-	_, err := c.doRequest("POST", "application.saveBuildType", payload)
+	_, err := c.call("application.saveBuildType", payload)
 	return err
 }
 
@@ -1169,11 +1728,12 @@ func (c *DokployClient) SaveGitProvider(input SaveGitProviderInput) error {
 	if input.EnableSubmodules {
 		payload["enableSubmodules"] = input.EnableSubmodules
 	}
-	if len(input.WatchPaths) > 0 {
+	// Send watchPaths if not nil (allows clearing by sending empty array)
+	if input.WatchPaths != nil {
 		payload["watchPaths"] = input.WatchPaths
 	}
 
-	_, err := c.doRequest("POST", "application.saveGitProvider", payload)
+	_, err := c.call("application.saveGitProvider", payload)
 	return err
 }
 
@@ -1221,14 +1781,15 @@ func (c *DokployClient) SaveGithubProvider(input SaveGithubProviderInput) error
 	if input.BuildPath != "" {
 		payload["buildPath"] = input.BuildPath
 	}
-	if len(input.WatchPaths) > 0 {
+	// Send watchPaths if not nil (allows clearing by sending empty array)
+	if input.WatchPaths != nil {
 		payload["watchPaths"] = input.WatchPaths
 	}
 	if input.TriggerType != "" {
 		payload["triggerType"] = input.TriggerType
 	}
 
-	_, err := c.doRequest("POST", "application.saveGithubProvider", payload)
+	_, err := c.call("application.saveGithubProvider", payload)
 	return err
 }
 
@@ -1278,11 +1839,12 @@ func (c *DokployClient) SaveGitlabProvider(input SaveGitlabProviderInput) error
 	if input.GitlabPathNamespace != "" {
 		payload["gitlabPathNamespace"] = input.GitlabPathNamespace
 	}
-	if len(input.WatchPaths) > 0 {
+	// Send watchPaths if not nil (allows clearing by sending empty array)
+	if input.WatchPaths != nil {
 		payload["watchPaths"] = input.WatchPaths
 	}
 
-	_, err := c.doRequest("POST", "application.saveGitlabProvider", payload)
+	_, err := c.call("application.saveGitlabProvider", payload)
 	return err
 }
 
@@ -1324,11 +1886,12 @@ func (c *DokployClient) SaveBitbucketProvider(input SaveBitbucketProviderInput)
 	if input.BitbucketBuildPath != "" {
 		payload["bitbucketBuildPath"] = input.BitbucketBuildPath
 	}
-	if len(input.WatchPaths) > 0 {
+	// Send watchPaths if not nil (allows clearing by sending empty array)
+	if input.WatchPaths != nil {
 		payload["watchPaths"] = input.WatchPaths
 	}
 
-	_, err := c.doRequest("POST", "application.saveBitbucketProvider", payload)
+	_, err := c.call("application.saveBitbucketProvider", payload)
 	return err
 }
 
@@ -1370,11 +1933,12 @@ func (c *DokployClient) SaveGiteaProvider(input SaveGiteaProviderInput) error {
 	if input.GiteaBuildPath != "" {
 		payload["giteaBuildPath"] = input.GiteaBuildPath
 	}
-	if len(input.WatchPaths) > 0 {
+	// Send watchPaths if not nil (allows clearing by sending empty array)
+	if input.WatchPaths != nil {
 		payload["watchPaths"] = input.WatchPaths
 	}
 
-	_, err := c.doRequest("POST", "application.saveGiteaProvider", payload)
+	_, err := c.call("application.saveGiteaProvider", payload)
 	return err
 }
 
@@ -1411,7 +1975,43 @@ func (c *DokployClient) SaveDockerProvider(input SaveDockerProviderInput) error
 		payload["registryId"] = input.RegistryId
 	}
 
-	_, err := c.doRequest("POST", "application.saveDockerProvider", payload)
+	_, err := c.call("application.saveDockerProvider", payload)
+	return err
+}
+
+// SaveDropProviderInput contains the fields for configuring a "drop" source,
+// where the application is built from inline Dockerfile content.
+type SaveDropProviderInput struct {
+	ApplicationID string
+	Dockerfile    string
+	DropBuildPath string
+}
+
+// SaveDropProvider configures the drop source settings for an application.
+func (c *DokployClient) SaveDropProvider(input SaveDropProviderInput) error {
+	payload := map[string]interface{}{
+		"applicationId": input.ApplicationID,
+		"sourceType":    "drop",
+		"dropBuildPath": input.DropBuildPath,
+	}
+
+	if _, err := c.call("application.update", payload); err != nil {
+		return err
+	}
+
+	if input.Dockerfile != "" {
+		return c.SaveDockerfile(input.ApplicationID, input.Dockerfile)
+	}
+	return nil
+}
+
+// SaveDockerfile stores raw Dockerfile content for an application.
+func (c *DokployClient) SaveDockerfile(appID, dockerfile string) error {
+	payload := map[string]interface{}{
+		"applicationId":     appID,
+		"dockerfileContent": dockerfile,
+	}
+	_, err := c.call("application.update", payload)
 	return err
 }
 
@@ -1444,7 +2044,7 @@ func (c *DokployClient) SaveEnvironment(input SaveEnvironmentInput) error {
 		payload["createEnvFile"] = *input.CreateEnvFile
 	}
 
-	_, err := c.doRequest("POST", "application.saveEnvironment", payload)
+	_, err := c.call("application.saveEnvironment", payload)
 	return err
 }
 
@@ -1468,12 +2068,12 @@ type Compose struct {
 	SourceType string `json:"sourceType"` // github, gitlab, bitbucket, git, raw
 
 	// Custom Git provider settings
-	CustomGitUrl       string   `json:"customGitUrl"`
-	CustomGitBranch    string   `json:"customGitBranch"`
-	CustomGitSSHKeyId  string   `json:"customGitSSHKeyId"`
-	CustomGitBuildPath string   `json:"customGitBuildPath"`
-	EnableSubmodules   bool     `json:"enableSubmodules"`
-	WatchPaths         []string `json:"watchPaths"`
+	CustomGitUrl       string     `json:"customGitUrl"`
+	CustomGitBranch    string     `json:"customGitBranch"`
+	CustomGitSSHKeyId  string     `json:"customGitSSHKeyId"`
+	CustomGitBuildPath string     `json:"customGitBuildPath"`
+	EnableSubmodules   bool       `json:"enableSubmodules"`
+	WatchPaths         WatchPaths `json:"watchPaths"`
 
 	// GitHub provider settings
 	Repository  string `json:"repository"`
@@ -1530,8 +2130,20 @@ type Compose struct {
 
 	// Timestamps
 	CreatedAt string `json:"createdAt"`
+
+	// Clear lists fields to reset on update.
+	Clear []ComposeField `json:"-"`
 }
 
+// ComposeField names an optional compose setting that can be cleared on
+// update.
+type ComposeField string
+
+const (
+	ComposeFieldDescription ComposeField = "description"
+	ComposeFieldCommand     ComposeField = "command"
+)
+
 func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 	// 1. Create compose with serverId
 	composeType := comp.ComposeType
@@ -1555,8 +2167,9 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 	if comp.ComposeFile != "" {
 		payload["composeFile"] = comp.ComposeFile
 	}
+	c.describe(payload, comp.Description, true)
 
-	resp, err := c.doRequest("POST", "compose.create", payload)
+	resp, err := c.call("compose.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1593,9 +2206,7 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 	}
 
 	// Description.
-	if comp.Description != "" {
-		updatePayload["description"] = comp.Description
-	}
+	c.describe(updatePayload, comp.Description, true)
 
 	// Custom Git provider settings.
 	if comp.CustomGitUrl != "" {
@@ -1725,7 +2336,7 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 		}
 	}
 
-	respUpdate, err := c.doRequest("POST", "compose.update", updatePayload)
+	respUpdate, err := c.call("compose.update", updatePayload)
 	if err != nil {
 		return nil, fmt.Errorf("created compose %s but failed to update config: %w", createdComp.ID, err)
 	}
@@ -1760,8 +2371,7 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 }
 
 func (c *DokployClient) GetCompose(id string) (*Compose, error) {
-	endpoint := fmt.Sprintf("compose.one?composeId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("compose.one", map[string]interface{}{"composeId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -1769,6 +2379,7 @@ func (c *DokployClient) GetCompose(id string) (*Compose, error) {
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, err
 	}
+	result.Description = c.undescribe(result.Description)
 	return &result, nil
 }
 
@@ -1780,11 +2391,6 @@ func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
 		"autoDeploy": comp.AutoDeploy,
 	}
 
-	// Description.
-	if comp.Description != "" {
-		payload["description"] = comp.Description
-	}
-
 	// Custom Git provider settings.
 	if comp.CustomGitUrl != "" {
 		payload["customGitUrl"] = comp.CustomGitUrl
@@ -1907,7 +2513,10 @@ func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
 		payload["environmentId"] = comp.EnvironmentID
 	}
 
-	resp, err := c.doRequest("POST", "compose.update", payload)
+	clearFields(payload, comp.Clear)
+	c.describe(payload, comp.Description, false)
+
+	resp, err := c.call("compose.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1916,6 +2525,7 @@ func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, err
 	}
+	result.Description = c.undescribe(result.Description)
 	return &result, nil
 }
 
@@ -1923,7 +2533,7 @@ func (c *DokployClient) DeleteCompose(id string) error {
 	payload := map[string]string{
 		"composeId": id,
 	}
-	_, err := c.doRequest("POST", "compose.remove", payload)
+	_, err := c.call("compose.remove", payload)
 	return err
 }
 
@@ -1934,7 +2544,27 @@ func (c *DokployClient) DeployCompose(id string, serverId string) error {
 	if serverId != "" {
 		payload["serverId"] = serverId
 	}
-	_, err := c.doRequest("POST", "compose.deploy", payload)
+	_, err := c.call("compose.deploy", payload)
+	return err
+}
+
+// RedeployCompose rebuilds and restarts a compose stack with its current
+// configuration. Corresponds to the compose.redeploy endpoint.
+func (c *DokployClient) RedeployCompose(id string) error {
+	payload := map[string]interface{}{
+		"composeId": id,
+	}
+	_, err := c.call("compose.redeploy", payload)
+	return err
+}
+
+// RefreshComposeToken replaces the compose stack's webhook refresh token,
+// invalidating deploy webhook URLs that use the old one.
+func (c *DokployClient) RefreshComposeToken(id string) error {
+	payload := map[string]interface{}{
+		"composeId": id,
+	}
+	_, err := c.call("compose.refreshToken", payload)
 	return err
 }
 
@@ -1944,7 +2574,7 @@ func (c *DokployClient) MoveCompose(composeID, targetEnvironmentID string) (*Com
 		"composeId":           composeID,
 		"targetEnvironmentId": targetEnvironmentID,
 	}
-	resp, err := c.doRequest("POST", "compose.move", payload)
+	resp, err := c.call("compose.move", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1958,7 +2588,7 @@ func (c *DokployClient) MoveCompose(composeID, targetEnvironmentID string) (*Com
 // ListComposes retrieves all composes, optionally filtered by environment ID.
 func (c *DokployClient) ListComposes(environmentID string) ([]Compose, error) {
 	// Composes are retrieved via project.all API
-	resp, err := c.doRequest("GET", "project.all", nil)
+	resp, err := c.call("project.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1985,6 +2615,21 @@ func (c *DokployClient) ListComposes(environmentID string) ([]Compose, error) {
 	return composes, nil
 }
 
+// FindComposeByAppName resolves a compose stack from its unique appName.
+func (c *DokployClient) FindComposeByAppName(appName string) (*Compose, error) {
+	composes, err := c.ListComposes("")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range composes {
+		if composes[i].AppName == appName {
+			return &composes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: compose with appName %q", ErrNotFound, appName)
+}
+
 // --- Database ---
 
 type Database struct {
@@ -2000,6 +2645,7 @@ type Database struct {
 	InternalPort    int64  `json:"internalPort"`
 	Password        string `json:"password"`
 	DatabaseUser    string `json:"databaseUser"`
+	DatabaseName    string `json:"databaseName"`
 	PostgresID      string `json:"postgresId"`
 	MysqlID         string `json:"mysqlId"`
 	MariadbID       string `json:"mariadbId"`
@@ -2007,6 +2653,8 @@ type Database struct {
 	RedisID         string `json:"redisId"`
 	ServerID        string `json:"serverId"`
 	ServerIPAddress string `json:"serverIpAddress"`
+	// ApplicationStatus is idle, running, done or error.
+	ApplicationStatus string `json:"applicationStatus"`
 }
 
 func (c *DokployClient) CreateDatabase(projectID, environmentID, name, dbType, password, dockerImage, username string) (*Database, error) {
@@ -2060,7 +2708,7 @@ func (c *DokployClient) CreateDatabase(projectID, environmentID, name, dbType, p
 		return nil, fmt.Errorf("unsupported database type: %s", dbType)
 	}
 
-	resp, err := c.doRequest("POST", endpoint, payload)
+	resp, err := c.call(endpoint, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2176,22 +2824,23 @@ func (c *DokployClient) CreateDatabase(projectID, environmentID, name, dbType, p
 
 func (c *DokployClient) GetDatabase(dbID string, databaseType string) (*Database, error) {
 	var endpoint string
+	var params map[string]interface{}
 	switch databaseType {
 	case "postgres":
-		endpoint = fmt.Sprintf("postgres.one?postgresId=%s", dbID)
+		endpoint, params = "postgres.one", map[string]interface{}{"postgresId": dbID}
 	case "mysql":
-		endpoint = fmt.Sprintf("mysql.one?mysqlId=%s", dbID)
+		endpoint, params = "mysql.one", map[string]interface{}{"mysqlId": dbID}
 	case "mariadb":
-		endpoint = fmt.Sprintf("mariadb.one?mariadbId=%s", dbID)
+		endpoint, params = "mariadb.one", map[string]interface{}{"mariadbId": dbID}
 	case "mongo":
-		endpoint = fmt.Sprintf("mongo.one?mongoId=%s", dbID)
+		endpoint, params = "mongo.one", map[string]interface{}{"mongoId": dbID}
 	case "redis":
-		endpoint = fmt.Sprintf("redis.one?redisId=%s", dbID)
+		endpoint, params = "redis.one", map[string]interface{}{"redisId": dbID}
 	default:
 		return nil, fmt.Errorf("unsupported database type: %s", databaseType)
 	}
 
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call(endpoint, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2326,7 +2975,24 @@ func (c *DokployClient) DeleteDatabaseWithType(id, dbType string) error {
 	payload := map[string]string{
 		idKey: id,
 	}
-	_, err := c.doRequest("POST", endpoint, payload)
+	_, err := c.call(endpoint, payload)
+	return err
+}
+
+// DeployDatabase redeploys a database service with its current settings,
+// recreating its container from the configured docker image. The data
+// volume is kept.
+func (c *DokployClient) DeployDatabase(id, dbType string) error {
+	switch dbType {
+	case "postgres", "mysql", "mariadb", "mongo", "redis":
+	default:
+		return fmt.Errorf("unsupported database type: %s", dbType)
+	}
+
+	payload := map[string]string{
+		dbType + "Id": id,
+	}
+	_, err := c.call(dbType+".deploy", payload)
 	return err
 }
 
@@ -2342,6 +3008,14 @@ type Domain struct {
 	Port            int64  `json:"port"`
 	HTTPS           bool   `json:"https"`
 	CertificateType string `json:"certificateType"`
+	// CustomCertResolver is the Traefik certificate resolver used when
+	// CertificateType is "custom".
+	CustomCertResolver string `json:"customCertResolver"`
+	StripPath          bool   `json:"stripPath"`
+	InternalPath       string `json:"internalPath"`
+	// UniqueConfigKey is assigned by Dokploy and names the Traefik router
+	// and service of the domain.
+	UniqueConfigKey int64 `json:"uniqueConfigKey"`
 }
 
 func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
@@ -2361,6 +3035,7 @@ func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
 	} else {
 		payload["certificateType"] = "none"
 	}
+	addDomainRoutingOptions(payload, domain)
 	if domain.ApplicationID != "" {
 		payload["applicationId"] = domain.ApplicationID
 	}
@@ -2371,7 +3046,7 @@ func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
 		payload["serviceName"] = domain.ServiceName
 	}
 
-	resp, err := c.doRequest("POST", "domain.create", payload)
+	resp, err := c.call("domain.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2390,6 +3065,19 @@ func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
 	return &result, nil
 }
 
+func (c *DokployClient) GetDomain(id string) (*Domain, error) {
+	resp, err := c.call("domain.one", map[string]interface{}{"domainId": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var result Domain
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 func (c *DokployClient) GetDomainsByApplication(appID string) ([]Domain, error) {
 	app, err := c.GetApplication(appID)
 	if err != nil {
@@ -2410,7 +3098,7 @@ func (c *DokployClient) DeleteDomain(id string) error {
 	payload := map[string]string{
 		"domainId": id,
 	}
-	_, err := c.doRequest("POST", "domain.remove", payload)
+	_, err := c.call("domain.remove", payload)
 	return err
 }
 
@@ -2418,7 +3106,7 @@ func (c *DokployClient) GenerateDomain(appName string) (string, error) {
 	payload := map[string]string{
 		"appName": appName,
 	}
-	resp, err := c.doRequest("POST", "domain.generateDomain", payload)
+	resp, err := c.call("domain.generateDomain", payload)
 	if err != nil {
 		return "", err
 	}
@@ -2455,7 +3143,8 @@ func (c *DokployClient) UpdateDomain(domain Domain) (*Domain, error) {
 	} else {
 		payload["certificateType"] = "none"
 	}
-	resp, err := c.doRequest("POST", "domain.update", payload)
+	addDomainRoutingOptions(payload, domain)
+	resp, err := c.call("domain.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2474,6 +3163,18 @@ func (c *DokployClient) UpdateDomain(domain Domain) (*Domain, error) {
 	return &result, nil
 }
 
+// addDomainRoutingOptions adds the path handling and certificate resolver
+// options shared by domain.create and domain.update to payload.
+func addDomainRoutingOptions(payload map[string]interface{}, domain Domain) {
+	payload["stripPath"] = domain.StripPath
+	if domain.InternalPath != "" {
+		payload["internalPath"] = domain.InternalPath
+	}
+	if payload["certificateType"] == "custom" && domain.CustomCertResolver != "" {
+		payload["customCertResolver"] = domain.CustomCertResolver
+	}
+}
+
 // --- Environment Variable ---
 
 type EnvironmentVariable struct {
@@ -2484,23 +3185,33 @@ type EnvironmentVariable struct {
 	Scope         string `json:"scope"`
 }
 
+// envWriteRetry bounds the read-modify-write retries in UpdateApplicationEnv.
+var envWriteRetry = waiter.Config{
+	Interval:    100 * time.Millisecond,
+	MaxInterval: time.Second,
+	Multiplier:  2,
+	Jitter:      0.2,
+	MaxAttempts: 5,
+}
+
 func (c *DokployClient) UpdateApplicationEnv(appID string, updateFn func(envMap map[string]string), createEnvFile *bool) error {
-	var lastErr error
-	for i := 0; i < 5; i++ { // Retry up to 5 times
+	_, err := waiter.Wait(context.Background(), envWriteRetry, func(context.Context) (bool, error) {
 		app, err := c.GetApplication(appID)
 		if err != nil {
-			return err
+			return false, err
 		}
 
-		envMap := ParseEnv(app.Env)
+		f, _ := ParseEnvFile(app.Env)
+		envMap := f.Map()
 		originalEnvStr := app.Env
 
 		updateFn(envMap) // Modify the map
+		f.Apply(envMap)
 
-		newEnvStr := formatEnv(envMap)
+		newEnvStr := f.String()
 
 		if newEnvStr == originalEnvStr {
-			return nil // No changes to be made
+			return true, nil // No changes to be made
 		}
 
 		payload := map[string]interface{}{
@@ -2511,27 +3222,23 @@ func (c *DokployClient) UpdateApplicationEnv(appID string, updateFn func(envMap
 			payload["createEnvFile"] = *createEnvFile
 		}
 
-		_, err = c.doRequest("POST", "application.saveEnvironment", payload)
+		_, err = c.call("application.saveEnvironment", payload)
 		if err != nil {
-			lastErr = err
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond) // Backoff
-			continue
+			return false, waiter.Retryable(err)
 		}
 
 		// Verify write
 		verifyApp, err := c.GetApplication(appID)
 		if err != nil {
 			// If we can't verify, we have to assume it worked or retry
-			lastErr = fmt.Errorf("failed to verify environment update: %w", err)
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
-			continue
+			return false, waiter.Retryable(fmt.Errorf("failed to verify environment update: %w", err))
 		}
 		if verifyApp.Env == newEnvStr {
-			return nil // Success
+			return true, nil // Success
 		}
-		lastErr = fmt.Errorf("environment update conflict, retrying")
-	}
-	return lastErr
+		return false, waiter.Retryable(fmt.Errorf("environment update conflict, retrying"))
+	}, func(ok bool) bool { return ok })
+	return err
 }
 
 func (c *DokployClient) CreateVariable(appID, key, value, scope string, createEnvFile *bool) (*EnvironmentVariable, error) {
@@ -2583,33 +3290,6 @@ func (c *DokployClient) DeleteVariable(id string, createEnvFile *bool) error {
 	}, createEnvFile)
 }
 
-func ParseEnv(env string) map[string]string {
-	m := make(map[string]string)
-	if env == "" {
-		return m
-	}
-	lines := strings.Split(env, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
-		}
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) == 2 {
-			m[parts[0]] = parts[1]
-		}
-	}
-	return m
-}
-
-func formatEnv(m map[string]string) string {
-	var lines []string
-	for k, v := range m {
-		lines = append(lines, fmt.Sprintf("%s=%s", k, v))
-	}
-	return strings.Join(lines, "\n")
-}
-
 // --- SSH Key ---
 
 type SSHKey struct {
@@ -2635,7 +3315,7 @@ func (c *DokployClient) CreateSSHKey(name, description, privateKey, publicKey st
 		"organizationId": user.OrganizationID,
 	}
 
-	resp, err := c.doRequest("POST", "sshKey.create", payload)
+	resp, err := c.call("sshKey.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2665,7 +3345,7 @@ func (c *DokployClient) CreateSSHKey(name, description, privateKey, publicKey st
 }
 
 func (c *DokployClient) ListSSHKeys() ([]SSHKey, error) {
-	resp, err := c.doRequest("GET", "sshKey.all", nil)
+	resp, err := c.call("sshKey.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2699,8 +3379,7 @@ func (c *DokployClient) findSSHKeyByName(name string) (*SSHKey, error) {
 }
 
 func (c *DokployClient) GetSSHKey(id string) (*SSHKey, error) {
-	endpoint := fmt.Sprintf("sshKey.one?sshKeyId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("sshKey.one", map[string]interface{}{"sshKeyId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -2725,7 +3404,7 @@ func (c *DokployClient) UpdateSSHKey(id, name, description string) (*SSHKey, err
 		"description": description,
 	}
 
-	_, err := c.doRequest("POST", "sshKey.update", payload)
+	_, err := c.call("sshKey.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2738,31 +3417,97 @@ func (c *DokployClient) DeleteSSHKey(id string) error {
 	payload := map[string]string{
 		"sshKeyId": id,
 	}
-	_, err := c.doRequest("POST", "sshKey.remove", payload)
+	_, err := c.call("sshKey.remove", payload)
 	return err
 }
 
 // --- Server ---
 
 type Server struct {
-	ID                  string `json:"serverId"`
-	Name                string `json:"name"`
-	Description         string `json:"description"`
-	IPAddress           string `json:"ipAddress"`
-	Port                int    `json:"port"`
-	Username            string `json:"username"`
-	SSHKeyID            string `json:"sshKeyId"`
-	ServerStatus        string `json:"serverStatus"`
-	ServerType          string `json:"serverType"`
-	CreatedAt           string `json:"createdAt"`
-	OrganizationID      string `json:"organizationId"`
-	AppName             string `json:"appName"`
-	EnableDockerCleanup bool   `json:"enableDockerCleanup"`
-	Command             string `json:"command"`
+	ID             string `json:"serverId"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	IPAddress      string `json:"ipAddress"`
+	Port           int    `json:"port"`
+	Username       string `json:"username"`
+	SSHKeyID       string `json:"sshKeyId"`
+	ServerStatus   string `json:"serverStatus"`
+	ServerType     string `json:"serverType"`
+	CreatedAt      string `json:"createdAt"`
+	OrganizationID string `json:"organizationId"`
+	AppName        string `json:"appName"`
+	Command        string `json:"command"`
+
+	// EnableDockerCleanup is only sent by UpdateServer when set, so a nil
+	// value keeps the setting made in the Dokploy UI.
+	EnableDockerCleanup *bool `json:"enableDockerCleanup,omitempty"`
+
+	MetricsConfig *ServerMetricsConfig `json:"metricsConfig,omitempty"`
+}
+
+// ServerMetricsConfig holds the monitoring settings of a server.
+type ServerMetricsConfig struct {
+	Server struct {
+		Port  int    `json:"port"`
+		Token string `json:"token"`
+	} `json:"server"`
+}
+
+// ServerMetric is one data point reported by a server's monitoring agent.
+// CPU and memory usage are percentages; memory sizes are in GiB.
+type ServerMetric struct {
+	Timestamp  string       `json:"timestamp"`
+	CPU        metricNumber `json:"cpu"`
+	CPUCores   metricNumber `json:"cpuCores"`
+	MemUsed    metricNumber `json:"memUsed"`
+	MemUsedGB  metricNumber `json:"memUsedGB"`
+	MemTotalGB metricNumber `json:"memTotal"`
+}
+
+// metricNumber decodes metric values that the agent reports either as JSON
+// numbers or as numeric strings.
+type metricNumber float64
+
+func (n *metricNumber) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*n = 0
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid metric value %s: %w", data, err)
+	}
+	*n = metricNumber(f)
+	return nil
+}
+
+// GetServerMetrics fetches the latest data points from the monitoring agent
+// of the server, proxied through Dokploy.
+func (c *DokployClient) GetServerMetrics(server Server, dataPoints int) ([]ServerMetric, error) {
+	if server.MetricsConfig == nil || server.MetricsConfig.Server.Port == 0 || server.MetricsConfig.Server.Token == "" {
+		return nil, fmt.Errorf("monitoring is not configured for server %s", server.ID)
+	}
+
+	metricsURL := fmt.Sprintf("http://%s:%d/metrics", server.IPAddress, server.MetricsConfig.Server.Port)
+	resp, err := c.call("server.getServerMetrics", map[string]interface{}{
+		"url":        metricsURL,
+		"token":      server.MetricsConfig.Server.Token,
+		"dataPoints": dataPoints,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []ServerMetric
+	if err := json.Unmarshal(resp, &metrics); err != nil {
+		return nil, fmt.Errorf("failed to parse server metrics: %w", err)
+	}
+	return metrics, nil
 }
 
 func (c *DokployClient) ListServers() ([]Server, error) {
-	resp, err := c.doRequest("GET", "server.all", nil)
+	resp, err := c.call("server.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2782,8 +3527,7 @@ func (c *DokployClient) ListServers() ([]Server, error) {
 }
 
 func (c *DokployClient) GetServer(id string) (*Server, error) {
-	endpoint := fmt.Sprintf("server.one?serverId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("server.one", map[string]interface{}{"serverId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -2801,6 +3545,129 @@ func (c *DokployClient) GetServer(id string) (*Server, error) {
 	return &server, nil
 }
 
+// ServerValidation is the result of Dokploy's server check, which connects
+// to the server over SSH and inspects what is installed on it.
+type ServerValidation struct {
+	Docker                    ServerTool `json:"docker"`
+	RClone                    ServerTool `json:"rclone"`
+	Nixpacks                  ServerTool `json:"nixpacks"`
+	Buildpacks                ServerTool `json:"buildpacks"`
+	Railpack                  ServerTool `json:"railpack"`
+	IsDokployNetworkInstalled bool       `json:"isDokployNetworkInstalled"`
+	IsSwarmInstalled          bool       `json:"isSwarmInstalled"`
+	IsMainDirectoryInstalled  bool       `json:"isMainDirectoryInstalled"`
+}
+
+// ServerTool reports whether a tool is installed on a server.
+type ServerTool struct {
+	Enabled bool   `json:"enabled"`
+	Version string `json:"version"`
+}
+
+// ValidateServer connects to a remote server over SSH and reports what is
+// installed on it. It fails when the server cannot be reached.
+func (c *DokployClient) ValidateServer(serverID string) (*ServerValidation, error) {
+	resp, err := c.call("server.validate", map[string]interface{}{"serverId": serverID})
+	if err != nil {
+		return nil, err
+	}
+
+	var result ServerValidation
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse server validation: %w", err)
+	}
+	return &result, nil
+}
+
+// GetServerSetupCommand returns the shell script Dokploy generates to
+// bootstrap a remote server, so it can be run from cloud-init.
+func (c *DokployClient) GetServerSetupCommand(serverID string) (string, error) {
+	resp, err := c.call("server.getDefaultCommand", map[string]interface{}{"serverId": serverID})
+	if err != nil {
+		return "", err
+	}
+
+	var command string
+	if err := json.Unmarshal(resp, &command); err != nil {
+		return "", fmt.Errorf("failed to parse server setup command: %w", err)
+	}
+	return command, nil
+}
+
+// SetupServer runs Dokploy's setup on a remote server over SSH. It installs
+// Docker, initializes Swarm, creates the dokploy-network and, on deploy
+// servers, starts Traefik. A custom Server.Command replaces the default
+// script.
+func (c *DokployClient) SetupServer(serverID string) error {
+	_, err := c.call("server.setup", map[string]interface{}{"serverId": serverID})
+	return err
+}
+
+// SetupServerMonitoring installs the monitoring agent on a remote server,
+// listening on port and authenticating requests with token.
+func (c *DokployClient) SetupServerMonitoring(serverID string, port int, token string) error {
+	payload := map[string]interface{}{
+		"serverId": serverID,
+		"metricsConfig": map[string]interface{}{
+			"server": map[string]interface{}{
+				"refreshRate":   60,
+				"port":          port,
+				"token":         token,
+				"urlCallback":   c.BaseURL + "/trpc/notification.receiveNotification",
+				"retentionDays": 2,
+				"cronJob":       "0 0 * * *",
+				"thresholds":    map[string]interface{}{"cpu": 0, "memory": 0},
+			},
+			"containers": map[string]interface{}{
+				"refreshRate": 60,
+				"services":    map[string]interface{}{"include": []string{}, "exclude": []string{}},
+			},
+		},
+	}
+	_, err := c.call("server.setupMonitoring", payload)
+	return err
+}
+
+// TraefikPort is an extra port Traefik publishes besides 80 and 443.
+type TraefikPort struct {
+	TargetPort    int    `json:"targetPort"`
+	PublishedPort int    `json:"publishedPort"`
+	Protocol      string `json:"protocol"`
+}
+
+// GetTraefikPorts returns the extra ports Traefik publishes on a server. An
+// empty serverID targets the Dokploy host.
+func (c *DokployClient) GetTraefikPorts(serverID string) ([]TraefikPort, error) {
+	params := map[string]interface{}{}
+	if serverID != "" {
+		params["serverId"] = serverID
+	}
+	resp, err := c.call("settings.getTraefikPorts", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var ports []TraefikPort
+	if err := json.Unmarshal(resp, &ports); err != nil {
+		return nil, fmt.Errorf("failed to parse Traefik ports: %w", err)
+	}
+	return ports, nil
+}
+
+// UpdateTraefikPorts replaces the extra ports Traefik publishes on a server
+// and restarts Traefik. An empty serverID targets the Dokploy host.
+func (c *DokployClient) UpdateTraefikPorts(serverID string, ports []TraefikPort) error {
+	if ports == nil {
+		ports = []TraefikPort{}
+	}
+	payload := map[string]interface{}{"additionalPorts": ports}
+	if serverID != "" {
+		payload["serverId"] = serverID
+	}
+	_, err := c.call("settings.updateTraefikPorts", payload)
+	return err
+}
+
 // --- GitHub Provider ---
 
 // GitProviderInfo contains the common git provider information nested in responses.
@@ -2819,7 +3686,7 @@ type GithubProvider struct {
 }
 
 func (c *DokployClient) ListGithubProviders() ([]GithubProvider, error) {
-	resp, err := c.doRequest("GET", "github.githubProviders", nil)
+	resp, err := c.call("github.githubProviders", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2875,26 +3742,27 @@ type Mount struct {
 // and extracting the mounts array from the response.
 func (c *DokployClient) GetMountsByService(serviceID, serviceType string) ([]Mount, error) {
 	var endpoint string
+	var params map[string]interface{}
 	switch serviceType {
 	case "application":
-		endpoint = fmt.Sprintf("application.one?applicationId=%s", serviceID)
+		endpoint, params = "application.one", map[string]interface{}{"applicationId": serviceID}
 	case "postgres":
-		endpoint = fmt.Sprintf("postgres.one?postgresId=%s", serviceID)
+		endpoint, params = "postgres.one", map[string]interface{}{"postgresId": serviceID}
 	case "mysql":
-		endpoint = fmt.Sprintf("mysql.one?mysqlId=%s", serviceID)
+		endpoint, params = "mysql.one", map[string]interface{}{"mysqlId": serviceID}
 	case "mariadb":
-		endpoint = fmt.Sprintf("mariadb.one?mariadbId=%s", serviceID)
+		endpoint, params = "mariadb.one", map[string]interface{}{"mariadbId": serviceID}
 	case "mongo":
-		endpoint = fmt.Sprintf("mongo.one?mongoId=%s", serviceID)
+		endpoint, params = "mongo.one", map[string]interface{}{"mongoId": serviceID}
 	case "redis":
-		endpoint = fmt.Sprintf("redis.one?redisId=%s", serviceID)
+		endpoint, params = "redis.one", map[string]interface{}{"redisId": serviceID}
 	case "compose":
-		endpoint = fmt.Sprintf("compose.one?composeId=%s", serviceID)
+		endpoint, params = "compose.one", map[string]interface{}{"composeId": serviceID}
 	default:
 		return nil, fmt.Errorf("unsupported service type: %s", serviceType)
 	}
 
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call(endpoint, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2931,63 +3799,30 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 		payload["filePath"] = mount.FilePath
 	}
 
-	resp, err := c.doRequest("POST", "mounts.create", payload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Try to unmarshal as Mount object
-	var result Mount
-	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
-		return &result, nil
-	}
-
-	// API returns boolean true on success - fetch the created mount from service
-	if string(resp) == "true" {
-		// Fetch all mounts for the service and find the one we just created
-		mounts, err := c.GetMountsByService(mount.ServiceID, mount.ServiceType)
-		if err != nil {
-			return nil, fmt.Errorf("mount created but failed to fetch mount details: %w", err)
-		}
-
-		// Find the mount matching our input (by type, mountPath, and optionally filePath/content)
-		// Return the most recently created one that matches
-		var bestMatch *Mount
-		for i := range mounts {
-			m := &mounts[i]
-			if m.Type == mount.Type && m.MountPath == mount.MountPath {
-				// For file mounts, also check filePath when both sides are non-empty.
-				// This allows matching mounts when either the input or returned FilePath is empty.
-				if mount.Type == "file" {
-					if mount.FilePath != "" && m.FilePath != "" && m.FilePath != mount.FilePath {
-						continue
-					}
-				}
-				// For bind mounts, check hostPath
-				if mount.Type == "bind" && m.HostPath != mount.HostPath {
-					continue
-				}
-				// For volume mounts, check volumeName
-				if mount.Type == "volume" && m.VolumeName != mount.VolumeName {
-					continue
-				}
-				bestMatch = m
+	return createChild(
+		func() ([]Mount, error) { return c.GetMountsByService(mount.ServiceID, mount.ServiceType) },
+		func(m Mount) string { return m.ID },
+		func(m Mount) bool {
+			if m.Type != mount.Type || m.MountPath != mount.MountPath {
+				return false
 			}
-		}
-
-		if bestMatch != nil {
-			return bestMatch, nil
-		}
-
-		return nil, fmt.Errorf("mount created but could not find it in service mounts")
-	}
-
-	return nil, fmt.Errorf("failed to parse mount response or mount ID not set: %s", string(resp))
+			switch mount.Type {
+			case "file":
+				// Match when either side's filePath is empty.
+				return mount.FilePath == "" || m.FilePath == "" || m.FilePath == mount.FilePath
+			case "bind":
+				return m.HostPath == mount.HostPath
+			case "volume":
+				return m.VolumeName == mount.VolumeName
+			}
+			return true
+		},
+		func() ([]byte, error) { return c.call("mounts.create", payload) },
+	)
 }
 
 func (c *DokployClient) GetMount(id string) (*Mount, error) {
-	endpoint := fmt.Sprintf("mounts.one?mountId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("mounts.one", map[string]interface{}{"mountId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -3026,7 +3861,7 @@ func (c *DokployClient) UpdateMount(mount Mount) (*Mount, error) {
 		payload["serviceType"] = mount.ServiceType
 	}
 
-	_, err := c.doRequest("POST", "mounts.update", payload)
+	_, err := c.call("mounts.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3039,7 +3874,7 @@ func (c *DokployClient) DeleteMount(id string) error {
 	payload := map[string]string{
 		"mountId": id,
 	}
-	_, err := c.doRequest("POST", "mounts.remove", payload)
+	_, err := c.call("mounts.remove", payload)
 	return err
 }
 
@@ -3057,8 +3892,7 @@ type Port struct {
 // GetPortsByApplication fetches all ports for an application by calling application.one
 // and extracting the ports array from the response.
 func (c *DokployClient) GetPortsByApplication(applicationID string) ([]Port, error) {
-	endpoint := fmt.Sprintf("application.one?applicationId=%s", applicationID)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("application.one", map[string]interface{}{"applicationId": applicationID})
 	if err != nil {
 		return nil, err
 	}
@@ -3088,46 +3922,20 @@ func (c *DokployClient) CreatePort(port Port) (*Port, error) {
 		payload["publishMode"] = port.PublishMode
 	}
 
-	resp, err := c.doRequest("POST", "port.create", payload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Try to unmarshal as Port object
-	var result Port
-	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
-		return &result, nil
-	}
-
-	// API returns boolean true on success - fetch the created port from application
-	if string(resp) == "true" {
-		// Fetch all ports for the application and find the one we just created
-		ports, err := c.GetPortsByApplication(port.ApplicationID)
-		if err != nil {
-			return nil, fmt.Errorf("port created but failed to fetch port details: %w", err)
-		}
-
-		// Find the port matching our input (by publishedPort, targetPort, and protocol if specified)
-		for i := range ports {
-			p := &ports[i]
-			if p.PublishedPort == port.PublishedPort && p.TargetPort == port.TargetPort {
-				// If a protocol was specified on creation, also require it to match.
-				if port.Protocol != "" && p.Protocol != port.Protocol {
-					continue
-				}
-				return p, nil
-			}
-		}
-
-		return nil, fmt.Errorf("port created but could not find it in application ports")
-	}
-
-	return nil, fmt.Errorf("failed to parse port response or port ID not set: %s", string(resp))
+	return createChild(
+		func() ([]Port, error) { return c.GetPortsByApplication(port.ApplicationID) },
+		func(p Port) string { return p.ID },
+		func(p Port) bool {
+			// If a protocol was specified on creation, also require it to match.
+			return p.PublishedPort == port.PublishedPort && p.TargetPort == port.TargetPort &&
+				(port.Protocol == "" || p.Protocol == port.Protocol)
+		},
+		func() ([]byte, error) { return c.call("port.create", payload) },
+	)
 }
 
 func (c *DokployClient) GetPort(id string) (*Port, error) {
-	endpoint := fmt.Sprintf("port.one?portId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("port.one", map[string]interface{}{"portId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -3153,7 +3961,7 @@ func (c *DokployClient) UpdatePort(port Port) (*Port, error) {
 		payload["publishMode"] = port.PublishMode
 	}
 
-	_, err := c.doRequest("POST", "port.update", payload)
+	_, err := c.call("port.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3166,7 +3974,7 @@ func (c *DokployClient) DeletePort(id string) error {
 	payload := map[string]string{
 		"portId": id,
 	}
-	_, err := c.doRequest("POST", "port.delete", payload)
+	_, err := c.call("port.delete", payload)
 	return err
 }
 
@@ -3184,8 +3992,7 @@ type Redirect struct {
 // GetRedirectsByApplication fetches all redirects for an application by calling application.one
 // and extracting the redirects array from the response.
 func (c *DokployClient) GetRedirectsByApplication(applicationID string) ([]Redirect, error) {
-	endpoint := fmt.Sprintf("application.one?applicationId=%s", applicationID)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("application.one", map[string]interface{}{"applicationId": applicationID})
 	if err != nil {
 		return nil, err
 	}
@@ -3209,50 +4016,18 @@ func (c *DokployClient) CreateRedirect(redirect Redirect) (*Redirect, error) {
 		"applicationId": redirect.ApplicationID,
 	}
 
-	resp, err := c.doRequest("POST", "redirects.create", payload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Try to unmarshal as Redirect object first
-	var result Redirect
-	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
-		return &result, nil
-	}
-
-	// API returns boolean true on success - fetch the created redirect from application
-	if string(resp) == "true" {
-		// Fetch all redirects for the application and find the one we just created
-		redirects, err := c.GetRedirectsByApplication(redirect.ApplicationID)
-		if err != nil {
-			return nil, fmt.Errorf("redirect created but failed to fetch redirect details: %w", err)
-		}
-
-		// Find the redirect matching our input (by regex, replacement, permanent)
-		// Return the most recently created one that matches
-		var bestMatch *Redirect
-		for i := range redirects {
-			r := &redirects[i]
-			if r.Regex == redirect.Regex && r.Replacement == redirect.Replacement && r.Permanent == redirect.Permanent {
-				if bestMatch == nil || r.CreatedAt > bestMatch.CreatedAt {
-					bestMatch = r
-				}
-			}
-		}
-
-		if bestMatch != nil {
-			return bestMatch, nil
-		}
-
-		return nil, fmt.Errorf("redirect created but could not find it in application redirects")
-	}
-
-	return nil, fmt.Errorf("unexpected API response format: %s", string(resp))
+	return createChild(
+		func() ([]Redirect, error) { return c.GetRedirectsByApplication(redirect.ApplicationID) },
+		func(r Redirect) string { return r.ID },
+		func(r Redirect) bool {
+			return r.Regex == redirect.Regex && r.Replacement == redirect.Replacement && r.Permanent == redirect.Permanent
+		},
+		func() ([]byte, error) { return c.call("redirects.create", payload) },
+	)
 }
 
 func (c *DokployClient) GetRedirect(id string) (*Redirect, error) {
-	endpoint := fmt.Sprintf("redirects.one?redirectId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("redirects.one", map[string]interface{}{"redirectId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -3272,7 +4047,7 @@ func (c *DokployClient) UpdateRedirect(redirect Redirect) (*Redirect, error) {
 		"permanent":   redirect.Permanent,
 	}
 
-	resp, err := c.doRequest("POST", "redirects.update", payload)
+	resp, err := c.call("redirects.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3294,7 +4069,7 @@ func (c *DokployClient) DeleteRedirect(id string) error {
 	payload := map[string]string{
 		"redirectId": id,
 	}
-	_, err := c.doRequest("POST", "redirects.delete", payload)
+	_, err := c.call("redirects.delete", payload)
 	return err
 }
 
@@ -3327,7 +4102,7 @@ func (c *DokployClient) CreateRegistry(registry Registry) (*Registry, error) {
 		payload["serverId"] = registry.ServerID
 	}
 
-	resp, err := c.doRequest("POST", "registry.create", payload)
+	resp, err := c.call("registry.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3340,8 +4115,7 @@ func (c *DokployClient) CreateRegistry(registry Registry) (*Registry, error) {
 }
 
 func (c *DokployClient) GetRegistry(id string) (*Registry, error) {
-	endpoint := fmt.Sprintf("registry.one?registryId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("registry.one", map[string]interface{}{"registryId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -3380,7 +4154,7 @@ func (c *DokployClient) UpdateRegistry(registry Registry) (*Registry, error) {
 		payload["serverId"] = registry.ServerID
 	}
 
-	resp, err := c.doRequest("POST", "registry.update", payload)
+	resp, err := c.call("registry.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3402,12 +4176,12 @@ func (c *DokployClient) DeleteRegistry(id string) error {
 	payload := map[string]string{
 		"registryId": id,
 	}
-	_, err := c.doRequest("POST", "registry.remove", payload)
+	_, err := c.call("registry.remove", payload)
 	return err
 }
 
 func (c *DokployClient) ListRegistries() ([]Registry, error) {
-	resp, err := c.doRequest("GET", "registry.all", nil)
+	resp, err := c.call("registry.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3419,6 +4193,31 @@ func (c *DokployClient) ListRegistries() ([]Registry, error) {
 	return registries, nil
 }
 
+// TestRegistry logs in to the registry with the given credentials, on the
+// registry's server when it has one, and fails when the login is rejected.
+func (c *DokployClient) TestRegistry(registry Registry) error {
+	payload := map[string]interface{}{
+		"registryName": registry.RegistryName,
+		"username":     registry.Username,
+		"password":     registry.Password,
+		"registryUrl":  registry.RegistryUrl,
+		"registryType": registry.RegistryType,
+		"imagePrefix":  registry.ImagePrefix,
+	}
+	if registry.ServerID != "" {
+		payload["serverId"] = registry.ServerID
+	}
+
+	resp, err := c.call("registry.testRegistry", payload)
+	if err != nil {
+		return err
+	}
+	if string(resp) == "false" {
+		return fmt.Errorf("registry %s rejected the credentials of %s", registry.RegistryUrl, registry.Username)
+	}
+	return nil
+}
+
 // Destination represents a backup destination (S3, MinIO, etc.)
 type Destination struct {
 	DestinationID   string `json:"destinationId"`
@@ -3444,7 +4243,7 @@ func (c *DokployClient) CreateDestination(dest Destination) (*Destination, error
 		"endpoint":        dest.Endpoint,
 	}
 
-	resp, err := c.doRequest("POST", "destination.create", payload)
+	resp, err := c.call("destination.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3457,8 +4256,7 @@ func (c *DokployClient) CreateDestination(dest Destination) (*Destination, error
 }
 
 func (c *DokployClient) GetDestination(id string) (*Destination, error) {
-	endpoint := fmt.Sprintf("destination.one?destinationId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("destination.one", map[string]interface{}{"destinationId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -3482,7 +4280,7 @@ func (c *DokployClient) UpdateDestination(dest Destination) (*Destination, error
 		"endpoint":        dest.Endpoint,
 	}
 
-	resp, err := c.doRequest("POST", "destination.update", payload)
+	resp, err := c.call("destination.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3498,12 +4296,12 @@ func (c *DokployClient) DeleteDestination(id string) error {
 	payload := map[string]string{
 		"destinationId": id,
 	}
-	_, err := c.doRequest("POST", "destination.remove", payload)
+	_, err := c.call("destination.remove", payload)
 	return err
 }
 
 func (c *DokployClient) ListDestinations() ([]Destination, error) {
-	resp, err := c.doRequest("GET", "destination.all", nil)
+	resp, err := c.call("destination.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3517,22 +4315,39 @@ func (c *DokployClient) ListDestinations() ([]Destination, error) {
 
 // Backup represents a scheduled backup configuration.
 type Backup struct {
-	BackupID        string `json:"backupId"`
-	AppName         string `json:"appName"`
-	Schedule        string `json:"schedule"`
-	Enabled         bool   `json:"enabled"`
-	Database        string `json:"database"`
-	Prefix          string `json:"prefix"`
-	DestinationID   string `json:"destinationId"`
-	KeepLatestCount int    `json:"keepLatestCount"`
-	BackupType      string `json:"backupType"`   // "database" or "compose"
-	DatabaseType    string `json:"databaseType"` // "postgres", "mysql", "mariadb", "mongo"
-	PostgresID      string `json:"postgresId"`
-	MysqlID         string `json:"mysqlId"`
-	MariadbID       string `json:"mariadbId"`
-	MongoID         string `json:"mongoId"`
-	ComposeID       string `json:"composeId"`
-	ServiceName     string `json:"serviceName"`
+	BackupID        string          `json:"backupId"`
+	AppName         string          `json:"appName"`
+	Schedule        string          `json:"schedule"`
+	Enabled         bool            `json:"enabled"`
+	Database        string          `json:"database"`
+	Prefix          string          `json:"prefix"`
+	DestinationID   string          `json:"destinationId"`
+	KeepLatestCount int             `json:"keepLatestCount"`
+	BackupType      string          `json:"backupType"`   // "database" or "compose"
+	DatabaseType    string          `json:"databaseType"` // "postgres", "mysql", "mariadb", "mongo"
+	PostgresID      string          `json:"postgresId"`
+	MysqlID         string          `json:"mysqlId"`
+	MariadbID       string          `json:"mariadbId"`
+	MongoID         string          `json:"mongoId"`
+	ComposeID       string          `json:"composeId"`
+	ServiceName     string          `json:"serviceName"`
+	Metadata        *BackupMetadata `json:"metadata"`
+}
+
+// BackupMetadata holds the credentials Dokploy needs to dump a database
+// running inside a compose service. Only the entry for the backup's
+// databaseType is used.
+type BackupMetadata struct {
+	Postgres *BackupCredentials `json:"postgres,omitempty"`
+	MySQL    *BackupCredentials `json:"mysql,omitempty"`
+	MariaDB  *BackupCredentials `json:"mariadb,omitempty"`
+	Mongo    *BackupCredentials `json:"mongo,omitempty"`
+}
+
+type BackupCredentials struct {
+	DatabaseUser         string `json:"databaseUser,omitempty"`
+	DatabasePassword     string `json:"databasePassword,omitempty"`
+	DatabaseRootPassword string `json:"databaseRootPassword,omitempty"`
 }
 
 func (c *DokployClient) CreateBackup(backup Backup) (*Backup, error) {
@@ -3569,8 +4384,11 @@ func (c *DokployClient) CreateBackup(backup Backup) (*Backup, error) {
 	if backup.ServiceName != "" {
 		payload["serviceName"] = backup.ServiceName
 	}
+	if backup.Metadata != nil {
+		payload["metadata"] = backup.Metadata
+	}
 
-	resp, err := c.doRequest("POST", "backup.create", payload)
+	resp, err := c.call("backup.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3631,8 +4449,7 @@ func (c *DokployClient) CreateBackup(backup Backup) (*Backup, error) {
 }
 
 func (c *DokployClient) GetBackup(id string) (*Backup, error) {
-	endpoint := fmt.Sprintf("backup.one?backupId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("backup.one", map[string]interface{}{"backupId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -3661,8 +4478,11 @@ func (c *DokployClient) UpdateBackup(backup Backup) (*Backup, error) {
 	if backup.KeepLatestCount > 0 {
 		payload["keepLatestCount"] = backup.KeepLatestCount
 	}
+	if backup.Metadata != nil {
+		payload["metadata"] = backup.Metadata
+	}
 
-	resp, err := c.doRequest("POST", "backup.update", payload)
+	resp, err := c.call("backup.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3683,7 +4503,7 @@ func (c *DokployClient) DeleteBackup(id string) error {
 	payload := map[string]string{
 		"backupId": id,
 	}
-	_, err := c.doRequest("POST", "backup.remove", payload)
+	_, err := c.call("backup.remove", payload)
 	return err
 }
 
@@ -3700,12 +4520,12 @@ type BackupFile struct {
 // search is a required prefix filter for the backup files.
 // serverId is optional and filters by server.
 func (c *DokployClient) ListBackupFiles(destinationID, search, serverID string) ([]BackupFile, error) {
-	endpoint := fmt.Sprintf("backup.listBackupFiles?destinationId=%s&search=%s", destinationID, search)
+	params := map[string]interface{}{"destinationId": destinationID, "search": search}
 	if serverID != "" {
-		endpoint += fmt.Sprintf("&serverId=%s", serverID)
+		params["serverId"] = serverID
 	}
 
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("backup.listBackupFiles", params)
 	if err != nil {
 		return nil, err
 	}
@@ -3718,24 +4538,49 @@ func (c *DokployClient) ListBackupFiles(destinationID, search, serverID string)
 	return result, nil
 }
 
+// manualBackupEndpoints maps a backup's database type to the endpoint that
+// runs it on demand.
+var manualBackupEndpoints = map[string]string{
+	"postgres": "backup.manualBackupPostgres",
+	"mysql":    "backup.manualBackupMySql",
+	"mariadb":  "backup.manualBackupMariadb",
+	"mongo":    "backup.manualBackupMongo",
+	"compose":  "backup.manualBackupCompose",
+}
+
+// RunBackup runs a configured backup immediately. backupType is the
+// database type, or "compose" for compose service backups.
+func (c *DokployClient) RunBackup(backupID, backupType string) error {
+	endpoint, ok := manualBackupEndpoints[backupType]
+	if !ok {
+		return fmt.Errorf("unsupported backup type: %s", backupType)
+	}
+	payload := map[string]string{
+		"backupId": backupID,
+	}
+	_, err := c.call(endpoint, payload)
+	return err
+}
+
 // GetBackupsByDatabaseID retrieves all backups for a specific database
 // by querying the database endpoint which includes backups in its response.
 func (c *DokployClient) GetBackupsByDatabaseID(databaseID, databaseType string) ([]Backup, error) {
 	var endpoint string
+	var params map[string]interface{}
 	switch databaseType {
 	case "postgres":
-		endpoint = fmt.Sprintf("postgres.one?postgresId=%s", databaseID)
+		endpoint, params = "postgres.one", map[string]interface{}{"postgresId": databaseID}
 	case "mysql":
-		endpoint = fmt.Sprintf("mysql.one?mysqlId=%s", databaseID)
+		endpoint, params = "mysql.one", map[string]interface{}{"mysqlId": databaseID}
 	case "mariadb":
-		endpoint = fmt.Sprintf("mariadb.one?mariadbId=%s", databaseID)
+		endpoint, params = "mariadb.one", map[string]interface{}{"mariadbId": databaseID}
 	case "mongo":
-		endpoint = fmt.Sprintf("mongo.one?mongoId=%s", databaseID)
+		endpoint, params = "mongo.one", map[string]interface{}{"mongoId": databaseID}
 	default:
 		return nil, fmt.Errorf("unsupported database type: %s", databaseType)
 	}
 
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call(endpoint, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3754,9 +4599,7 @@ func (c *DokployClient) GetBackupsByDatabaseID(databaseID, databaseType string)
 // GetBackupsByComposeID retrieves all backups for a specific compose
 // by querying the compose endpoint which includes backups in its response.
 func (c *DokployClient) GetBackupsByComposeID(composeID string) ([]Backup, error) {
-	endpoint := fmt.Sprintf("compose.one?composeId=%s", composeID)
-
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("compose.one", map[string]interface{}{"composeId": composeID})
 	if err != nil {
 		return nil, err
 	}
@@ -3786,9 +4629,9 @@ func (c *DokployClient) CreateServer(server Server) (*Server, error) {
 	if server.Description != "" {
 		payload["description"] = server.Description
 	}
-	// Note: command is NOT accepted by server.create API, only by server.update.
+	// Note: command and enableDockerCleanup are NOT accepted by server.create API, only by server.update.
 
-	resp, err := c.doRequest("POST", "server.create", payload)
+	resp, err := c.call("server.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3813,8 +4656,11 @@ func (c *DokployClient) UpdateServer(server Server) (*Server, error) {
 		"description": server.Description,
 		"command":     server.Command,
 	}
+	if server.EnableDockerCleanup != nil {
+		payload["enableDockerCleanup"] = *server.EnableDockerCleanup
+	}
 
-	resp, err := c.doRequest("POST", "server.update", payload)
+	resp, err := c.call("server.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3836,10 +4682,211 @@ func (c *DokployClient) DeleteServer(id string) error {
 	payload := map[string]string{
 		"serverId": id,
 	}
-	_, err := c.doRequest("POST", "server.remove", payload)
+	_, err := c.call("server.remove", payload)
 	return err
 }
 
+// --- Image Digest ---
+
+// manifestAcceptTypes lists the manifest media types accepted when resolving
+// a digest, so multi-arch images resolve to their index digest.
+var manifestAcceptTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// ParseImageReference splits a docker image reference into its registry host,
+// repository and tag. Docker Hub images are normalised to registry-1.docker.io.
+func ParseImageReference(image string) (registry, repository, tag string) {
+	ref := image
+	if i := strings.Index(ref, "@"); i >= 0 {
+		tag = ref[i+1:]
+		ref = ref[:i]
+	}
+
+	if slash := strings.LastIndex(ref, "/"); strings.LastIndex(ref, ":") > slash {
+		colon := strings.LastIndex(ref, ":")
+		if tag == "" {
+			tag = ref[colon+1:]
+		}
+		ref = ref[:colon]
+	}
+	if tag == "" {
+		tag = "latest"
+	}
+
+	registry = "registry-1.docker.io"
+	repository = ref
+	if i := strings.Index(ref, "/"); i >= 0 {
+		first := ref[:i]
+		if strings.ContainsAny(first, ".:") || first == "localhost" {
+			registry = first
+			repository = ref[i+1:]
+		}
+	}
+	if registry == "docker.io" || registry == "index.docker.io" {
+		registry = "registry-1.docker.io"
+	}
+	if registry == "registry-1.docker.io" && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+	return registry, repository, tag
+}
+
+// ResolveImageDigest returns the content digest of a docker image by querying
+// its registry's v2 API. Username and password are optional. Dokploy has no
+// endpoint for this, so the registry is called directly from this machine
+// rather than from the Dokploy server.
+func (c *DokployClient) ResolveImageDigest(image, username, password string) (string, error) {
+	registry, repository, tag := ParseImageReference(image)
+	if strings.HasPrefix(tag, "sha256:") {
+		return tag, nil
+	}
+
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", registry, repository, tag)
+
+	resp, err := c.headManifest(manifestURL, "")
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		auth, err := c.registryAuthorization(resp.Header.Get("WWW-Authenticate"), username, password)
+		if err != nil {
+			return "", err
+		}
+		resp, err = c.headManifest(manifestURL, auth)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("%w: image %s", ErrNotFound, image)
+	}
+	if resp.StatusCode >= 400 {
+		return "", fmt.Errorf("registry error resolving %s: %s", image, resp.Status)
+	}
+
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", fmt.Errorf("registry did not return a digest for %s", image)
+	}
+	return digest, nil
+}
+
+func (c *DokployClient) headManifest(manifestURL, authorization string) (*http.Response, error) {
+	req, err := http.NewRequest("HEAD", manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestAcceptTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+// registryAuthorization answers a registry auth challenge, fetching a bearer
+// token when required.
+func (c *DokployClient) registryAuthorization(challenge, username, password string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+
+	if strings.EqualFold(scheme, "Basic") {
+		if username == "" {
+			return "", fmt.Errorf("registry requires credentials")
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password)), nil
+	}
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported registry auth challenge: %s", challenge)
+	}
+
+	values := map[string]string{}
+	for _, part := range strings.Split(params, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok {
+			values[k] = strings.Trim(v, `"`)
+		}
+	}
+
+	tokenURL, err := url.Parse(values["realm"])
+	if err != nil || values["realm"] == "" {
+		return "", fmt.Errorf("invalid registry auth realm in challenge: %s", challenge)
+	}
+	q := tokenURL.Query()
+	if values["service"] != "" {
+		q.Set("service", values["service"])
+	}
+	if values["scope"] != "" {
+		q.Set("scope", values["scope"])
+	}
+	tokenURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequest("GET", tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if username != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return "", fmt.Errorf("registry token request failed: %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to parse registry token response: %w", err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	return "Bearer " + token.Token, nil
+}
+
+// --- Database settings ---
+
+// DatabaseField names an optional database setting that can be cleared on
+// update.
+type DatabaseField string
+
+const (
+	DatabaseFieldDescription       DatabaseField = "description"
+	DatabaseFieldCommand           DatabaseField = "command"
+	DatabaseFieldEnv               DatabaseField = "env"
+	DatabaseFieldMemoryReservation DatabaseField = "memoryReservation"
+	DatabaseFieldMemoryLimit       DatabaseField = "memoryLimit"
+	DatabaseFieldCPUReservation    DatabaseField = "cpuReservation"
+	DatabaseFieldCPULimit          DatabaseField = "cpuLimit"
+	DatabaseFieldExternalPort      DatabaseField = "externalPort"
+)
+
+// clearFields sets the given fields to null in an update payload, since empty
+// values are otherwise left out and keep the old value.
+func clearFields[F ~string](payload map[string]interface{}, fields []F) {
+	for _, field := range fields {
+		payload[string(field)] = nil
+	}
+}
+
 // --- Postgres ---
 
 // Postgres represents a PostgreSQL database instance.
@@ -3861,8 +4908,11 @@ type Postgres struct {
 	ExternalPort      int    `json:"externalPort"`
 	EnvironmentID     string `json:"environmentId"`
 	ApplicationStatus string `json:"applicationStatus"`
+	CreatedAt         string `json:"createdAt"`
 	Replicas          int    `json:"replicas"`
 	ServerID          string `json:"serverId"`
+	// Clear lists fields to reset on update.
+	Clear []DatabaseField `json:"-"`
 }
 
 // CreatePostgres creates a new PostgreSQL database instance.
@@ -3886,7 +4936,7 @@ func (c *DokployClient) CreatePostgres(postgres Postgres) (*Postgres, error) {
 		payload["serverId"] = postgres.ServerID
 	}
 
-	resp, err := c.doRequest("POST", "postgres.create", payload)
+	resp, err := c.call("postgres.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3900,8 +4950,7 @@ func (c *DokployClient) CreatePostgres(postgres Postgres) (*Postgres, error) {
 
 // GetPostgres retrieves a PostgreSQL instance by ID.
 func (c *DokployClient) GetPostgres(id string) (*Postgres, error) {
-	endpoint := fmt.Sprintf("postgres.one?postgresId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("postgres.one", map[string]interface{}{"postgresId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -3965,7 +5014,9 @@ func (c *DokployClient) UpdatePostgres(postgres Postgres) (*Postgres, error) {
 		payload["replicas"] = postgres.Replicas
 	}
 
-	resp, err := c.doRequest("POST", "postgres.update", payload)
+	clearFields(payload, postgres.Clear)
+
+	resp, err := c.call("postgres.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -3986,7 +5037,7 @@ func (c *DokployClient) DeletePostgres(id string) error {
 	payload := map[string]string{
 		"postgresId": id,
 	}
-	_, err := c.doRequest("POST", "postgres.remove", payload)
+	_, err := c.call("postgres.remove", payload)
 	return err
 }
 
@@ -4012,8 +5063,11 @@ type MySQL struct {
 	ExternalPort         int    `json:"externalPort"`
 	EnvironmentID        string `json:"environmentId"`
 	ApplicationStatus    string `json:"applicationStatus"`
+	CreatedAt            string `json:"createdAt"`
 	Replicas             int    `json:"replicas"`
 	ServerID             string `json:"serverId"`
+	// Clear lists fields to reset on update.
+	Clear []DatabaseField `json:"-"`
 }
 
 // CreateMySQL creates a new MySQL database instance.
@@ -4038,7 +5092,7 @@ func (c *DokployClient) CreateMySQL(mysql MySQL) (*MySQL, error) {
 		payload["serverId"] = mysql.ServerID
 	}
 
-	resp, err := c.doRequest("POST", "mysql.create", payload)
+	resp, err := c.call("mysql.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4052,8 +5106,7 @@ func (c *DokployClient) CreateMySQL(mysql MySQL) (*MySQL, error) {
 
 // GetMySQL retrieves a MySQL instance by ID.
 func (c *DokployClient) GetMySQL(id string) (*MySQL, error) {
-	endpoint := fmt.Sprintf("mysql.one?mysqlId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("mysql.one", map[string]interface{}{"mysqlId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -4120,7 +5173,9 @@ func (c *DokployClient) UpdateMySQL(mysql MySQL) (*MySQL, error) {
 		payload["replicas"] = mysql.Replicas
 	}
 
-	resp, err := c.doRequest("POST", "mysql.update", payload)
+	clearFields(payload, mysql.Clear)
+
+	resp, err := c.call("mysql.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4141,7 +5196,7 @@ func (c *DokployClient) DeleteMySQL(id string) error {
 	payload := map[string]string{
 		"mysqlId": id,
 	}
-	_, err := c.doRequest("POST", "mysql.remove", payload)
+	_, err := c.call("mysql.remove", payload)
 	return err
 }
 
@@ -4167,8 +5222,11 @@ type MariaDB struct {
 	ExternalPort         int    `json:"externalPort"`
 	EnvironmentID        string `json:"environmentId"`
 	ApplicationStatus    string `json:"applicationStatus"`
+	CreatedAt            string `json:"createdAt"`
 	Replicas             int    `json:"replicas"`
 	ServerID             string `json:"serverId"`
+	// Clear lists fields to reset on update.
+	Clear []DatabaseField `json:"-"`
 }
 
 // CreateMariaDB creates a new MariaDB database instance.
@@ -4193,7 +5251,7 @@ func (c *DokployClient) CreateMariaDB(mariadb MariaDB) (*MariaDB, error) {
 		payload["serverId"] = mariadb.ServerID
 	}
 
-	resp, err := c.doRequest("POST", "mariadb.create", payload)
+	resp, err := c.call("mariadb.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4207,8 +5265,7 @@ func (c *DokployClient) CreateMariaDB(mariadb MariaDB) (*MariaDB, error) {
 
 // GetMariaDB retrieves a MariaDB instance by ID.
 func (c *DokployClient) GetMariaDB(id string) (*MariaDB, error) {
-	endpoint := fmt.Sprintf("mariadb.one?mariadbId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("mariadb.one", map[string]interface{}{"mariadbId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -4275,7 +5332,9 @@ func (c *DokployClient) UpdateMariaDB(mariadb MariaDB) (*MariaDB, error) {
 		payload["replicas"] = mariadb.Replicas
 	}
 
-	resp, err := c.doRequest("POST", "mariadb.update", payload)
+	clearFields(payload, mariadb.Clear)
+
+	resp, err := c.call("mariadb.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4296,7 +5355,7 @@ func (c *DokployClient) DeleteMariaDB(id string) error {
 	payload := map[string]string{
 		"mariadbId": id,
 	}
-	_, err := c.doRequest("POST", "mariadb.remove", payload)
+	_, err := c.call("mariadb.remove", payload)
 	return err
 }
 
@@ -4321,8 +5380,11 @@ type MongoDB struct {
 	ExternalPort      int    `json:"externalPort"`
 	EnvironmentID     string `json:"environmentId"`
 	ApplicationStatus string `json:"applicationStatus"`
+	CreatedAt         string `json:"createdAt"`
 	Replicas          int    `json:"replicas"`
 	ServerID          string `json:"serverId"`
+	// Clear lists fields to reset on update.
+	Clear []DatabaseField `json:"-"`
 }
 
 // CreateMongoDB creates a new MongoDB database instance.
@@ -4349,7 +5411,7 @@ func (c *DokployClient) CreateMongoDB(mongo MongoDB) (*MongoDB, error) {
 		payload["replicaSets"] = mongo.ReplicaSets
 	}
 
-	resp, err := c.doRequest("POST", "mongo.create", payload)
+	resp, err := c.call("mongo.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4363,8 +5425,7 @@ func (c *DokployClient) CreateMongoDB(mongo MongoDB) (*MongoDB, error) {
 
 // GetMongoDB retrieves a MongoDB instance by ID.
 func (c *DokployClient) GetMongoDB(id string) (*MongoDB, error) {
-	endpoint := fmt.Sprintf("mongo.one?mongoId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("mongo.one", map[string]interface{}{"mongoId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -4427,7 +5488,9 @@ func (c *DokployClient) UpdateMongoDB(mongo MongoDB) (*MongoDB, error) {
 		payload["replicas"] = mongo.Replicas
 	}
 
-	resp, err := c.doRequest("POST", "mongo.update", payload)
+	clearFields(payload, mongo.Clear)
+
+	resp, err := c.call("mongo.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4448,7 +5511,7 @@ func (c *DokployClient) DeleteMongoDB(id string) error {
 	payload := map[string]string{
 		"mongoId": id,
 	}
-	_, err := c.doRequest("POST", "mongo.remove", payload)
+	_, err := c.call("mongo.remove", payload)
 	return err
 }
 
@@ -4471,8 +5534,11 @@ type Redis struct {
 	ExternalPort      int    `json:"externalPort"`
 	EnvironmentID     string `json:"environmentId"`
 	ApplicationStatus string `json:"applicationStatus"`
+	CreatedAt         string `json:"createdAt"`
 	Replicas          int    `json:"replicas"`
 	ServerID          string `json:"serverId"`
+	// Clear lists fields to reset on update.
+	Clear []DatabaseField `json:"-"`
 }
 
 // CreateRedis creates a new Redis database instance.
@@ -4499,7 +5565,7 @@ func (c *DokployClient) CreateRedis(redis Redis) (*Redis, error) {
 		payload["serverId"] = redis.ServerID
 	}
 
-	resp, err := c.doRequest("POST", "redis.create", payload)
+	resp, err := c.call("redis.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4513,8 +5579,7 @@ func (c *DokployClient) CreateRedis(redis Redis) (*Redis, error) {
 
 // GetRedis retrieves a Redis instance by ID.
 func (c *DokployClient) GetRedis(id string) (*Redis, error) {
-	endpoint := fmt.Sprintf("redis.one?redisId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("redis.one", map[string]interface{}{"redisId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -4572,7 +5637,9 @@ func (c *DokployClient) UpdateRedis(redis Redis) (*Redis, error) {
 		payload["replicas"] = redis.Replicas
 	}
 
-	resp, err := c.doRequest("POST", "redis.update", payload)
+	clearFields(payload, redis.Clear)
+
+	resp, err := c.call("redis.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4595,7 +5662,7 @@ func (c *DokployClient) DeleteRedis(id string) error {
 	payload := map[string]string{
 		"redisId": id,
 	}
-	_, err := c.doRequest("POST", "redis.remove", payload)
+	_, err := c.call("redis.remove", payload)
 	return err
 }
 
@@ -4655,7 +5722,7 @@ func (c *DokployClient) CreateGitlabProvider(provider GitlabProvider) (*GitlabPr
 		payload["expiresAt"] = provider.ExpiresAt
 	}
 
-	resp, err := c.doRequest("POST", "gitlab.create", payload)
+	resp, err := c.call("gitlab.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4693,8 +5760,7 @@ func (c *DokployClient) findGitlabProviderByName(name string) (*GitlabProvider,
 }
 
 func (c *DokployClient) GetGitlabProvider(id string) (*GitlabProvider, error) {
-	endpoint := fmt.Sprintf("gitlab.one?gitlabId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("gitlab.one", map[string]interface{}{"gitlabId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -4743,7 +5809,7 @@ func (c *DokployClient) UpdateGitlabProvider(provider GitlabProvider) (*GitlabPr
 		payload["authId"] = provider.AuthId
 	}
 
-	resp, err := c.doRequest("POST", "gitlab.update", payload)
+	resp, err := c.call("gitlab.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4763,12 +5829,12 @@ func (c *DokployClient) DeleteGitProvider(gitProviderId string) error {
 	payload := map[string]string{
 		"gitProviderId": gitProviderId,
 	}
-	_, err := c.doRequest("POST", "gitProvider.remove", payload)
+	_, err := c.call("gitProvider.remove", payload)
 	return err
 }
 
 func (c *DokployClient) ListGitlabProviders() ([]GitlabProviderListItem, error) {
-	resp, err := c.doRequest("GET", "gitlab.gitlabProviders", nil)
+	resp, err := c.call("gitlab.gitlabProviders", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4843,7 +5909,7 @@ func (c *DokployClient) CreateBitbucketProvider(provider BitbucketProvider) (*Bi
 		payload["bitbucketWorkspaceName"] = provider.BitbucketWorkspaceName
 	}
 
-	resp, err := c.doRequest("POST", "bitbucket.create", payload)
+	resp, err := c.call("bitbucket.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4881,8 +5947,7 @@ func (c *DokployClient) findBitbucketProviderByName(name string) (*BitbucketProv
 }
 
 func (c *DokployClient) GetBitbucketProvider(id string) (*BitbucketProvider, error) {
-	endpoint := fmt.Sprintf("bitbucket.one?bitbucketId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("bitbucket.one", map[string]interface{}{"bitbucketId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -4920,7 +5985,7 @@ func (c *DokployClient) UpdateBitbucketProvider(provider BitbucketProvider) (*Bi
 		payload["authId"] = provider.AuthId
 	}
 
-	resp, err := c.doRequest("POST", "bitbucket.update", payload)
+	resp, err := c.call("bitbucket.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -4937,7 +6002,7 @@ func (c *DokployClient) UpdateBitbucketProvider(provider BitbucketProvider) (*Bi
 }
 
 func (c *DokployClient) ListBitbucketProviders() ([]BitbucketProviderListItem, error) {
-	resp, err := c.doRequest("GET", "bitbucket.bitbucketProviders", nil)
+	resp, err := c.call("bitbucket.bitbucketProviders", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5032,7 +6097,7 @@ func (c *DokployClient) CreateGiteaProvider(provider GiteaProvider) (*GiteaProvi
 		payload["organizationName"] = provider.OrganizationName
 	}
 
-	resp, err := c.doRequest("POST", "gitea.create", payload)
+	resp, err := c.call("gitea.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -5070,8 +6135,7 @@ func (c *DokployClient) findGiteaProviderByName(name string) (*GiteaProvider, er
 }
 
 func (c *DokployClient) GetGiteaProvider(id string) (*GiteaProvider, error) {
-	endpoint := fmt.Sprintf("gitea.one?giteaId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("gitea.one", map[string]interface{}{"giteaId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -5126,7 +6190,7 @@ func (c *DokployClient) UpdateGiteaProvider(provider GiteaProvider) (*GiteaProvi
 		payload["gitProviderId"] = provider.GitProviderId
 	}
 
-	resp, err := c.doRequest("POST", "gitea.update", payload)
+	resp, err := c.call("gitea.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -5143,7 +6207,7 @@ func (c *DokployClient) UpdateGiteaProvider(provider GiteaProvider) (*GiteaProvi
 }
 
 func (c *DokployClient) ListGiteaProviders() ([]GiteaProviderListItem, error) {
-	resp, err := c.doRequest("GET", "gitea.giteaProviders", nil)
+	resp, err := c.call("gitea.giteaProviders", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5184,15 +6248,18 @@ type Organization struct {
 	OwnerID   string  `json:"ownerId"`
 }
 
-func (c *DokployClient) CreateOrganization(name string, logo *string) (*Organization, error) {
+func (c *DokployClient) CreateOrganization(org Organization) (*Organization, error) {
 	payload := map[string]interface{}{
-		"name": name,
+		"name": org.Name,
 	}
-	if logo != nil && *logo != "" {
-		payload["logo"] = *logo
+	if org.Logo != nil && *org.Logo != "" {
+		payload["logo"] = *org.Logo
+	}
+	if org.Slug != nil && *org.Slug != "" {
+		payload["slug"] = *org.Slug
 	}
 
-	resp, err := c.doRequest("POST", "organization.create", payload)
+	resp, err := c.call("organization.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -5205,8 +6272,7 @@ func (c *DokployClient) CreateOrganization(name string, logo *string) (*Organiza
 }
 
 func (c *DokployClient) GetOrganization(id string) (*Organization, error) {
-	endpoint := fmt.Sprintf("organization.one?organizationId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("organization.one", map[string]interface{}{"organizationId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -5223,11 +6289,15 @@ func (c *DokployClient) UpdateOrganization(org Organization) (*Organization, err
 		"organizationId": org.ID,
 		"name":           org.Name,
 	}
-	if org.Logo != nil && *org.Logo != "" {
+	// An empty logo clears it; nil leaves it unchanged.
+	if org.Logo != nil {
 		payload["logo"] = *org.Logo
 	}
+	if org.Slug != nil && *org.Slug != "" {
+		payload["slug"] = *org.Slug
+	}
 
-	resp, err := c.doRequest("POST", "organization.update", payload)
+	resp, err := c.call("organization.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -5243,12 +6313,12 @@ func (c *DokployClient) DeleteOrganization(id string) error {
 	payload := map[string]string{
 		"organizationId": id,
 	}
-	_, err := c.doRequest("POST", "organization.delete", payload)
+	_, err := c.call("organization.delete", payload)
 	return err
 }
 
 func (c *DokployClient) ListOrganizations() ([]Organization, error) {
-	resp, err := c.doRequest("GET", "organization.all", nil)
+	resp, err := c.call("organization.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5260,6 +6330,31 @@ func (c *DokployClient) ListOrganizations() ([]Organization, error) {
 	return result, nil
 }
 
+// Invitation represents an invitation to join an organization.
+type Invitation struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organizationId"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+	Status         string `json:"status"`
+	ExpiresAt      string `json:"expiresAt"`
+	InviterID      string `json:"inviterId"`
+}
+
+// ListInvitations returns the invitations of the active organization.
+func (c *DokployClient) ListInvitations() ([]Invitation, error) {
+	resp, err := c.call("organization.allInvitations", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Invitation
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // --- Volume Backup ---
 
 type VolumeBackup struct {
@@ -5329,7 +6424,7 @@ func (c *DokployClient) CreateVolumeBackup(backup VolumeBackup) (*VolumeBackup,
 		payload["composeId"] = *backup.ComposeID
 	}
 
-	resp, err := c.doRequest("POST", "volumeBackups.create", payload)
+	resp, err := c.call("volumeBackups.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -5342,8 +6437,7 @@ func (c *DokployClient) CreateVolumeBackup(backup VolumeBackup) (*VolumeBackup,
 }
 
 func (c *DokployClient) GetVolumeBackup(id string) (*VolumeBackup, error) {
-	endpoint := fmt.Sprintf("volumeBackups.one?volumeBackupId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("volumeBackups.one", map[string]interface{}{"volumeBackupId": id})
 	if err != nil {
 		return nil, err
 	}
@@ -5374,7 +6468,7 @@ func (c *DokployClient) UpdateVolumeBackup(backup VolumeBackup) (*VolumeBackup,
 	payload["turnOff"] = backup.TurnOff
 	payload["enabled"] = backup.Enabled
 
-	resp, err := c.doRequest("POST", "volumeBackups.update", payload)
+	resp, err := c.call("volumeBackups.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -5390,13 +6484,12 @@ func (c *DokployClient) DeleteVolumeBackup(id string) error {
 	payload := map[string]string{
 		"volumeBackupId": id,
 	}
-	_, err := c.doRequest("POST", "volumeBackups.delete", payload)
+	_, err := c.call("volumeBackups.delete", payload)
 	return err
 }
 
 func (c *DokployClient) ListVolumeBackups(serviceID, serviceType string) ([]VolumeBackup, error) {
-	endpoint := fmt.Sprintf("volumeBackups.list?id=%s&volumeBackupType=%s", serviceID, serviceType)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.call("volumeBackups.list", map[string]interface{}{"id": serviceID, "volumeBackupType": serviceType})
 	if err != nil {
 		return nil, err
 	}
@@ -5407,3 +6500,389 @@ func (c *DokployClient) ListVolumeBackups(serviceID, serviceType string) ([]Volu
 	}
 	return result, nil
 }
+
+// --- Schedule ---
+
+// Schedule is a cron job that Dokploy runs on a server, on the Dokploy host or
+// inside a service.
+type Schedule struct {
+	ScheduleID     string  `json:"scheduleId"`
+	Name           string  `json:"name"`
+	CronExpression string  `json:"cronExpression"`
+	AppName        string  `json:"appName"`
+	ServiceName    *string `json:"serviceName"`
+	ShellType      string  `json:"shellType"`
+	ScheduleType   string  `json:"scheduleType"`
+	Command        string  `json:"command"`
+	Script         *string `json:"script"`
+	ApplicationID  *string `json:"applicationId"`
+	ComposeID      *string `json:"composeId"`
+	ServerID       *string `json:"serverId"`
+	UserID         *string `json:"userId"`
+	Enabled        bool    `json:"enabled"`
+	Timezone       *string `json:"timezone"`
+	CreatedAt      string  `json:"createdAt"`
+}
+
+// schedulePayload returns the create or update payload of a schedule.
+// timezone is always sent, as null when unset, so an update clears a
+// timezone that was removed.
+func schedulePayload(schedule Schedule) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":           schedule.Name,
+		"cronExpression": schedule.CronExpression,
+		"shellType":      schedule.ShellType,
+		"scheduleType":   schedule.ScheduleType,
+		"command":        schedule.Command,
+		"enabled":        schedule.Enabled,
+		"timezone":       schedule.Timezone,
+	}
+	if schedule.AppName != "" {
+		payload["appName"] = schedule.AppName
+	}
+	if schedule.ServiceName != nil {
+		payload["serviceName"] = *schedule.ServiceName
+	}
+	if schedule.Script != nil {
+		payload["script"] = *schedule.Script
+	}
+	if schedule.ApplicationID != nil {
+		payload["applicationId"] = *schedule.ApplicationID
+	}
+	if schedule.ComposeID != nil {
+		payload["composeId"] = *schedule.ComposeID
+	}
+	if schedule.ServerID != nil {
+		payload["serverId"] = *schedule.ServerID
+	}
+	if schedule.UserID != nil {
+		payload["userId"] = *schedule.UserID
+	}
+	return payload
+}
+
+// CreateSchedule creates a schedule and returns it with its ID and app name.
+func (c *DokployClient) CreateSchedule(schedule Schedule) (*Schedule, error) {
+	resp, err := c.call("schedule.create", schedulePayload(schedule))
+	if err != nil {
+		return nil, err
+	}
+
+	var result Schedule
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSchedule returns the schedule with the given ID.
+func (c *DokployClient) GetSchedule(id string) (*Schedule, error) {
+	resp, err := c.call("schedule.one", map[string]interface{}{"scheduleId": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var result Schedule
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSchedule replaces the settings of an existing schedule.
+func (c *DokployClient) UpdateSchedule(schedule Schedule) (*Schedule, error) {
+	payload := schedulePayload(schedule)
+	payload["scheduleId"] = schedule.ScheduleID
+
+	resp, err := c.call("schedule.update", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Schedule
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSchedule deletes a schedule and stops its cron job.
+func (c *DokployClient) DeleteSchedule(id string) error {
+	payload := map[string]string{
+		"scheduleId": id,
+	}
+	_, err := c.call("schedule.delete", payload)
+	return err
+}
+
+// ListSchedules returns the schedules of a service, server or user. id is the
+// ID matching scheduleType: application, compose, server or dokploy-server.
+func (c *DokployClient) ListSchedules(id, scheduleType string) ([]Schedule, error) {
+	resp, err := c.call("schedule.list", map[string]interface{}{"id": id, "scheduleType": scheduleType})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Schedule
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// --- Deployment ---
+
+type Deployment struct {
+	DeploymentID string  `json:"deploymentId"`
+	Title        string  `json:"title"`
+	Description  *string `json:"description"`
+	Status       string  `json:"status"`
+	LogPath      string  `json:"logPath"`
+	ErrorMessage *string `json:"errorMessage"`
+	CreatedAt    string  `json:"createdAt"`
+	StartedAt    *string `json:"startedAt"`
+	FinishedAt   *string `json:"finishedAt"`
+	// Rollback is the image kept for rolling back to this deployment. It is
+	// only set for application deployments made while rollbacks are active.
+	Rollback *Rollback `json:"rollback"`
+}
+
+// Rollback is an application image that Dokploy keeps in the rollback
+// registry so the application can be rolled back to it.
+type Rollback struct {
+	RollbackID   string `json:"rollbackId"`
+	DeploymentID string `json:"deploymentId"`
+	Version      int64  `json:"version"`
+	Image        string `json:"image"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+// ListDeploymentsByType returns the deployments recorded for a service, most
+// recent first. deploymentType is one of application, compose, server,
+// schedule, previewDeployment, backup or volumeBackup.
+func (c *DokployClient) ListDeploymentsByType(id, deploymentType string) ([]Deployment, error) {
+	resp, err := c.call("deployment.allByType", map[string]interface{}{"id": id, "type": deploymentType})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Deployment
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// --- Docker ---
+
+// DockerVolume is a Docker volume as reported by the server's docker daemon.
+type DockerVolume struct {
+	Name       string `json:"Name"`
+	Driver     string `json:"Driver"`
+	Mountpoint string `json:"Mountpoint"`
+	Scope      string `json:"Scope"`
+	CreatedAt  string `json:"CreatedAt"`
+}
+
+// ListDockerVolumes lists the Docker volumes on a server. An empty serverID
+// targets the Dokploy host.
+func (c *DokployClient) ListDockerVolumes(serverID string) ([]DockerVolume, error) {
+	params := map[string]interface{}{}
+	if serverID != "" {
+		params["serverId"] = serverID
+	}
+
+	resp, err := c.call("docker.getVolumes", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []DockerVolume
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse docker volumes response: %w", err)
+	}
+	return result, nil
+}
+
+// Container is a Docker container of a compose stack.
+type Container struct {
+	ContainerID string `json:"containerId"`
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	Status      string `json:"status"`
+}
+
+// ListComposeContainers lists the containers of the compose stack appName,
+// including stopped ones. composeType is docker-compose or stack. An empty
+// serverID targets the Dokploy host.
+func (c *DokployClient) ListComposeContainers(appName, composeType, serverID string) ([]Container, error) {
+	params := map[string]interface{}{
+		"appName": appName,
+		"appType": composeType,
+	}
+	if serverID != "" {
+		params["serverId"] = serverID
+	}
+
+	resp, err := c.call("docker.getContainersByAppNameMatch", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Container
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse containers response: %w", err)
+	}
+	return result, nil
+}
+
+// SwarmService is a Docker Swarm service as listed by docker service ls.
+type SwarmService struct {
+	ID       string `json:"ID"`
+	Name     string `json:"Name"`
+	Mode     string `json:"Mode"`
+	Replicas string `json:"Replicas"`
+	Image    string `json:"Image"`
+	Ports    string `json:"Ports"`
+}
+
+// ListSwarmServices lists the swarm services running on a server. An empty
+// serverID targets the Dokploy host.
+func (c *DokployClient) ListSwarmServices(serverID string) ([]SwarmService, error) {
+	params := map[string]interface{}{}
+	if serverID != "" {
+		params["serverId"] = serverID
+	}
+
+	resp, err := c.call("swarm.getNodeApps", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []SwarmService
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse swarm services response: %w", err)
+	}
+	return result, nil
+}
+
+// --- Traefik ---
+
+// ReadMiddlewareTraefikConfig retrieves the global Traefik middlewares file.
+// An empty serverID targets the Dokploy host.
+func (c *DokployClient) ReadMiddlewareTraefikConfig(serverID string) (string, error) {
+	params := map[string]interface{}{}
+	if serverID != "" {
+		params["serverId"] = serverID
+	}
+	resp, err := c.call("settings.readMiddlewareTraefikConfig", params)
+	if err != nil {
+		return "", err
+	}
+
+	var config string
+	if err := json.Unmarshal(resp, &config); err != nil {
+		if string(resp) == "null" || string(resp) == "" {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to parse Traefik middleware config response: %w", err)
+	}
+	return config, nil
+}
+
+// UpdateMiddlewareTraefikConfig replaces the global Traefik middlewares file.
+func (c *DokployClient) UpdateMiddlewareTraefikConfig(serverID, traefikConfig string) error {
+	payload := map[string]string{
+		"traefikConfig": traefikConfig,
+	}
+	if serverID != "" {
+		payload["serverId"] = serverID
+	}
+	_, err := c.call("settings.updateMiddlewareTraefikConfig", payload)
+	return err
+}
+
+// --- Version ---
+
+// Version is a parsed Dokploy release version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// AtLeast reports whether v is the same as or newer than other.
+func (v Version) AtLeast(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch >= other.Patch
+}
+
+// ParseVersion parses versions such as "v0.23.1" or "0.24.0-canary.3".
+// Pre-release and build suffixes are ignored.
+func ParseVersion(s string) (Version, error) {
+	core := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+	var nums [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", s)
+		}
+		nums[i] = n
+	}
+	return Version{Major: nums[0], Minor: nums[1], Patch: nums[2]}, nil
+}
+
+// GetVersion returns the version reported by the Dokploy server. Successful
+// lookups are cached for the lifetime of the client.
+func (c *DokployClient) GetVersion() (string, error) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	if c.version != "" {
+		return c.version, nil
+	}
+
+	resp, err := c.call("settings.getDokployVersion", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var version string
+	if err := json.Unmarshal(resp, &version); err != nil {
+		return "", fmt.Errorf("failed to parse version response: %w", err)
+	}
+	c.version = version
+	return version, nil
+}
+
+// IsCloud reports whether the server is Dokploy Cloud. Cloud instances
+// restrict endpoints that manage the host itself.
+func (c *DokployClient) IsCloud() (bool, error) {
+	resp, err := c.call("settings.isCloud", nil)
+	if err != nil {
+		return false, err
+	}
+
+	var cloud bool
+	if err := json.Unmarshal(resp, &cloud); err != nil {
+		return false, fmt.Errorf("failed to parse isCloud response: %w", err)
+	}
+	return cloud, nil
+}
@@ -0,0 +1,466 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCreatePortDoesNotDuplicateAfterNetworkError(t *testing.T) {
+	var mu sync.Mutex
+	ports := []Port{{ID: "port-1", PublishedPort: 8080, TargetPort: 80, Protocol: "tcp"}}
+	creates := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/application.one":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"ports": ports})
+		case "/port.create":
+			creates++
+			ports = append(ports, Port{ID: "port-2", PublishedPort: 9000, TargetPort: 90, Protocol: "udp"})
+			// Drop the connection after applying the create, as a flaky
+			// network would, so the client never sees the response.
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Fatal(err)
+			}
+			conn.Close()
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL, "key")
+	port, err := c.CreatePort(Port{ApplicationID: "app-1", PublishedPort: 9000, TargetPort: 90, Protocol: "udp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port.ID != "port-2" {
+		t.Errorf("CreatePort() returned %q, want port-2", port.ID)
+	}
+	if creates != 1 {
+		t.Errorf("port.create called %d times, want 1", creates)
+	}
+}
+
+func TestCreateRedirectIgnoresExistingDuplicates(t *testing.T) {
+	redirects := []Redirect{{ID: "redirect-1", Regex: "^/old", Replacement: "/new", CreatedAt: "2030-01-01"}}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.one":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"redirects": redirects})
+		case "/redirects.create":
+			redirects = append(redirects, Redirect{ID: "redirect-2", Regex: "^/old", Replacement: "/new", CreatedAt: "2024-01-01"})
+			_, _ = w.Write([]byte("true"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL, "key")
+	redirect, err := c.CreateRedirect(Redirect{ApplicationID: "app-1", Regex: "^/old", Replacement: "/new"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redirect.ID != "redirect-2" {
+		t.Errorf("CreateRedirect() returned %q, want the newly created redirect-2", redirect.ID)
+	}
+}
+
+func TestUpdateApplicationGeneralClearsFields(t *testing.T) {
+	var payload map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.update":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatal(err)
+			}
+			_, _ = w.Write([]byte(`{"applicationId":"app-1"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL, "key")
+	_, err := c.UpdateApplicationGeneral(Application{
+		ID:    "app-1",
+		Title: "Web",
+		Clear: []ApplicationField{ApplicationFieldCommand, ApplicationFieldDescription},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, field := range []string{"command", "description"} {
+		if value, ok := payload[field]; !ok || value != nil {
+			t.Errorf("payload[%q] = %v, want explicit null", field, value)
+		}
+	}
+	if payload["title"] != "Web" {
+		t.Errorf("payload[title] = %v, want Web", payload["title"])
+	}
+	if _, ok := payload["subtitle"]; ok {
+		t.Errorf("payload includes unset subtitle")
+	}
+}
+
+func TestDescriptionSuffix(t *testing.T) {
+	var stored string
+	var updates []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		switch r.URL.Path {
+		case "/application.update":
+			updates = append(updates, payload)
+			if d, ok := payload["description"].(string); ok {
+				stored = d
+			}
+			_, _ = w.Write([]byte("true"))
+		case "/application.one":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"applicationId": "app-1", "description": stored})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL, "key")
+	c.SetDescriptionSuffix("[terraform]")
+
+	app, err := c.UpdateApplicationGeneral(Application{ID: "app-1", Description: "Web frontend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored != "Web frontend [terraform]" || app.Description != "Web frontend" {
+		t.Errorf("stored %q, read back %q", stored, app.Description)
+	}
+
+	if _, err := c.UpdateApplicationGeneral(Application{ID: "app-1", Replicas: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := updates[1]["description"]; ok {
+		t.Errorf("update without a description sent %v", updates[1]["description"])
+	}
+
+	app, err = c.UpdateApplicationGeneral(Application{ID: "app-1", Clear: []ApplicationField{ApplicationFieldDescription}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored != "[terraform]" || app.Description != "" {
+		t.Errorf("cleared description: stored %q, read back %q", stored, app.Description)
+	}
+}
+
+func TestReadDeploymentLog(t *testing.T) {
+	defer func(idle time.Duration) { deploymentLogIdle = idle }(deploymentLogIdle)
+	deploymentLogIdle = 100 * time.Millisecond
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/listen-deployment" || r.URL.Query().Get("logPath") != "/logs/a.log" || r.URL.Query().Get("serverId") != "srv-1" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		if r.Header.Get("x-api-key") != "key" || r.Header.Get("Upgrade") != "websocket" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+		for _, chunk := range []string{"Building\n", "Error: build failed\n"} {
+			buf.Write([]byte{0x81, byte(len(chunk))})
+			buf.WriteString(chunk)
+		}
+		buf.Flush()
+		// Dokploy keeps tailing the file, so the socket stays open.
+		time.Sleep(time.Second)
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL+"/api", "key")
+	log, err := c.ReadDeploymentLog("/logs/a.log", "srv-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log != "Building\nError: build failed\n" {
+		t.Errorf("log = %q", log)
+	}
+}
+
+func TestRequestHooks(t *testing.T) {
+	var requestIDs []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-Id"))
+		switch r.URL.Query().Get("applicationId") {
+		case "app-1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"applicationId": "app-1"})
+		case "broken":
+			http.Error(w, "boom", http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL, "key")
+	metrics := NewMetrics()
+	c.AddRequestHook(metrics.Record)
+	var infos []RequestInfo
+	c.AddRequestHook(func(info RequestInfo) { infos = append(infos, info) })
+
+	for _, id := range []string{"app-1", "missing", "broken", "broken"} {
+		_, _ = c.GetApplication(id)
+	}
+
+	if len(infos) != 4 {
+		t.Fatalf("hooks called %d times, want 4", len(infos))
+	}
+	if info := infos[0]; info.Method != "GET" || info.Endpoint != "application.one" || info.StatusCode != 200 || info.Err != nil {
+		t.Errorf("first request = %+v", info)
+	}
+	if info := infos[2]; info.StatusCode != 500 || !IsServerError(info.Err) {
+		t.Errorf("failed request = %+v", info)
+	}
+	for i, info := range infos {
+		if info.RequestID == "" || info.RequestID != requestIDs[i] {
+			t.Errorf("request %d: hook saw ID %q, server saw %q", i, info.RequestID, requestIDs[i])
+		}
+	}
+	if infos[2].RequestID == infos[3].RequestID {
+		t.Errorf("requests share ID %q", infos[2].RequestID)
+	}
+	var apiErr *APIError
+	if !errors.As(infos[2].Err, &apiErr) || apiErr.RequestID != requestIDs[2] || !strings.Contains(apiErr.Error(), requestIDs[2]) {
+		t.Errorf("API error %v does not carry request ID %q", infos[2].Err, requestIDs[2])
+	}
+
+	got := metrics.Snapshot()["application.one"]
+	if got.Requests != 4 || got.Errors != 2 || got.ErrorRate() != 0.5 {
+		t.Errorf("metrics = %+v, want 4 requests with 2 errors", got)
+	}
+	if got.Max > got.Total || got.Average() > got.Max {
+		t.Errorf("inconsistent latencies: %+v", got)
+	}
+}
+
+func TestWatchPathsDecoding(t *testing.T) {
+	tests := map[string][]string{
+		`["src/**","package.json"]`:       {"src/**", "package.json"},
+		`"[\"src/**\",\"package.json\"]"`: {"src/**", "package.json"},
+		`""`:                              nil,
+		`null`:                            nil,
+		`[]`:                              {},
+	}
+	for raw, want := range tests {
+		var app Application
+		if err := json.Unmarshal([]byte(`{"watchPaths":`+raw+`}`), &app); err != nil {
+			t.Errorf("decoding %s: %v", raw, err)
+			continue
+		}
+		if len(app.WatchPaths) != len(want) {
+			t.Errorf("decoding %s = %#v, want %#v", raw, app.WatchPaths, want)
+			continue
+		}
+		for i := range want {
+			if app.WatchPaths[i] != want[i] {
+				t.Errorf("decoding %s = %#v, want %#v", raw, app.WatchPaths, want)
+			}
+		}
+	}
+
+	var app Application
+	if err := json.Unmarshal([]byte(`{"watchPaths":"src/**"}`), &app); err == nil {
+		t.Errorf("decoding a plain string succeeded with %#v, want an error", app.WatchPaths)
+	}
+}
+
+func TestFindApplicationByDomain(t *testing.T) {
+	domainLookups := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/project.all":
+			_, _ = w.Write([]byte(`[{"environments": [
+				{"applications": [
+					{"applicationId": "web", "name": "web", "domains": [{"host": "App.Example.com"}]},
+					{"applicationId": "docs", "name": "docs", "domains": []}
+				]},
+				{"applications": [
+					{"applicationId": "legacy", "name": "legacy"},
+					{"applicationId": "web-v2", "name": "web-v2", "domains": [{"host": "shared.example.com", "path": "/v2"}]}
+				]}
+			]}]`))
+		case "/application.one":
+			domainLookups++
+			_, _ = w.Write([]byte(`{"applicationId": "legacy", "domains": [{"host": "old.example.com"}, {"host": "shared.example.com", "path": "/v1"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := NewDokployClient(srv.URL, "key")
+
+	app, err := c.FindApplicationByDomain("app.example.com.")
+	if err != nil || app.ID != "web" {
+		t.Errorf("FindApplicationByDomain(app.example.com.) = %v, %v, want web", app, err)
+	}
+	app, err = c.FindApplicationByDomain("old.example.com")
+	if err != nil || app.ID != "legacy" {
+		t.Errorf("FindApplicationByDomain(old.example.com) = %v, %v, want legacy", app, err)
+	}
+	if _, err := c.FindApplicationByDomain("missing.example.com"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("FindApplicationByDomain(missing.example.com) = %v, want ErrNotFound", err)
+	}
+	if _, err := c.FindApplicationByDomain("shared.example.com"); err == nil || !strings.Contains(err.Error(), "legacy (legacy), web-v2 (web-v2)") {
+		t.Errorf("FindApplicationByDomain(shared.example.com) = %v, want an error naming both applications", err)
+	}
+	// Only the application listed without domains is asked for them.
+	if domainLookups != 4 {
+		t.Errorf("domains looked up %d times, want once per search", domainLookups)
+	}
+}
+
+func TestScheduleRequests(t *testing.T) {
+	var queries, bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		queries = append(queries, r.URL.RawQuery)
+		bodies = append(bodies, string(body))
+		if strings.HasSuffix(r.URL.Path, "schedule.list") {
+			w.Write([]byte("[]"))
+			return
+		}
+		w.Write([]byte(`{"scheduleId":"s/1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL, "key")
+	if _, err := c.GetSchedule("s/1 &x"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListSchedules("srv&1", "server"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.UpdateSchedule(Schedule{ScheduleID: "s/1", Name: "cleanup"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if queries[0] != "scheduleId=s%2F1+%26x" {
+		t.Errorf("schedule.one query = %q", queries[0])
+	}
+	if queries[1] != "id=srv%261&scheduleType=server" {
+		t.Errorf("schedule.list query = %q", queries[1])
+	}
+	// A removed timezone is cleared rather than left out of the update.
+	if !strings.Contains(bodies[2], `"timezone":null`) {
+		t.Errorf("schedule.update body = %s, want timezone null", bodies[2])
+	}
+}
+
+func TestUpdateServerKeepsDockerCleanup(t *testing.T) {
+	var bodies []map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Write([]byte(`{"serverId":"srv-1","enableDockerCleanup":true}`))
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL, "key")
+	if _, err := c.UpdateServer(Server{ID: "srv-1", Name: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	off := false
+	if _, err := c.UpdateServer(Server{ID: "srv-1", EnableDockerCleanup: &off}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A rename must not turn off cleanup enabled in the UI.
+	if _, ok := bodies[0]["enableDockerCleanup"]; ok {
+		t.Errorf("server.update body = %v, want no enableDockerCleanup", bodies[0])
+	}
+	if v, ok := bodies[1]["enableDockerCleanup"]; !ok || v != false {
+		t.Errorf("server.update body = %v, want enableDockerCleanup false", bodies[1])
+	}
+}
+
+func TestListMembersCache(t *testing.T) {
+	var mu sync.Mutex
+	fetches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "user.all") {
+			mu.Lock()
+			fetches++
+			mu.Unlock()
+			// Give concurrent callers time to pile up behind the first fetch.
+			time.Sleep(10 * time.Millisecond)
+			w.Write([]byte(`[{"id":"mem-1","role":"member"}]`))
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+	fetchCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return fetches
+	}
+
+	c := NewDokployClient(srv.URL, "key")
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.ListMembers(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := fetchCount(); got != 1 {
+		t.Fatalf("concurrent first use made %d user.all calls, want 1", got)
+	}
+
+	members, err := c.ListMembers()
+	if err != nil || len(members) != 1 || fetchCount() != 1 {
+		t.Fatalf("cache hit: members = %v, error = %v, user.all calls = %d", members, err, fetchCount())
+	}
+
+	// Any member write clears the cache, not just permission changes.
+	if err := c.AssignUserPermissions(UserPermissionsInput{MemberID: "mem-1"}); err != nil {
+		t.Fatal(err)
+	}
+	c.ListMembers()
+	if got := fetchCount(); got != 2 {
+		t.Errorf("after user.assignPermissions: user.all calls = %d, want 2", got)
+	}
+	if _, err := c.call("organization.update", map[string]any{"organizationId": "org-1"}); err != nil {
+		t.Fatal(err)
+	}
+	c.ListMembers()
+	if got := fetchCount(); got != 3 {
+		t.Errorf("after organization.update: user.all calls = %d, want 3", got)
+	}
+
+	defer func(ttl time.Duration) { membersTTL = ttl }(membersTTL)
+	membersTTL = 0
+	c.ListMembers()
+	if got := fetchCount(); got != 4 {
+		t.Errorf("after the TTL: user.all calls = %d, want 4", got)
+	}
+}
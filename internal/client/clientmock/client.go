@@ -0,0 +1,2127 @@
+// Code generated by gen. DO NOT EDIT.
+
+package clientmock
+
+import (
+	"sync"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+)
+
+var _ client.Client = (*Client)(nil)
+
+// Client is a mock implementation of client.Client. Each method delegates to
+// the matching Func field and fails with ErrNotMocked when it is unset.
+type Client struct {
+	mu    sync.Mutex
+	calls map[string]int
+
+	EndpointFunc                      func() string
+	GetVersionFunc                    func() (string, error)
+	IsCloudFunc                       func() (bool, error)
+	GetUserFunc                       func() (*client.User, error)
+	GetCurrentMemberFunc              func() (*client.OrganizationMember, error)
+	ListMembersFunc                   func() ([]client.OrganizationMember, error)
+	GetMemberByUserIDFunc             func(userID string) (*client.OrganizationMember, error)
+	GetMemberByIDFunc                 func(memberID string) (*client.OrganizationMember, error)
+	AssignUserPermissionsFunc         func(input client.UserPermissionsInput) error
+	CreateApiKeyFunc                  func(input client.ApiKeyCreateInput) (*client.ApiKey, error)
+	DeleteApiKeyFunc                  func(apiKeyID string) error
+	GetApiKeyByIDFunc                 func(apiKeyID string) (*client.ApiKey, error)
+	CreateAIFunc                      func(name string, apiURL string, apiKey string, model string, isEnabled bool) (*client.AI, error)
+	GetAIFunc                         func(aiID string) (*client.AI, error)
+	ListAIsFunc                       func() ([]client.AI, error)
+	UpdateAIFunc                      func(ai client.AI) error
+	DeleteAIFunc                      func(aiID string) error
+	GetAIModelsFunc                   func(apiURL string, apiKey string) ([]client.AIModel, error)
+	CreateCertificateFunc             func(cert client.Certificate) (*client.Certificate, error)
+	GetCertificateFunc                func(id string) (*client.Certificate, error)
+	ListCertificatesFunc              func() ([]client.Certificate, error)
+	DeleteCertificateFunc             func(id string) error
+	ListProjectsFunc                  func() ([]client.Project, error)
+	CreateProjectFunc                 func(name string, description string) (*client.Project, error)
+	GetProjectFunc                    func(id string) (*client.Project, error)
+	UpdateProjectFunc                 func(id string, name string, description string) (*client.Project, error)
+	DeleteProjectFunc                 func(id string) error
+	CreateEnvironmentFunc             func(projectID string, name string, description string) (*client.Environment, error)
+	GetEnvironmentFunc                func(id string) (*client.Environment, error)
+	UpdateEnvironmentFunc             func(env client.Environment) (*client.Environment, error)
+	SaveEnvironmentEnvFunc            func(id string, env string) error
+	UpdateEnvironmentEnvFunc          func(id string, updateFn func(envMap map[string]string)) error
+	DeleteEnvironmentFunc             func(id string) error
+	CreateApplicationFunc             func(app client.Application) (*client.Application, error)
+	GetApplicationFunc                func(id string) (*client.Application, error)
+	UpdateApplicationGeneralFunc      func(app client.Application) (*client.Application, error)
+	UpdateApplicationFunc             func(app client.Application) (*client.Application, error)
+	DeleteApplicationFunc             func(id string) error
+	DeployApplicationFunc             func(id string, serverId string) error
+	RedeployApplicationFunc           func(id string) error
+	StopApplicationFunc               func(id string) error
+	StartApplicationFunc              func(id string) error
+	RefreshApplicationTokenFunc       func(id string) error
+	ReadTraefikConfigFunc             func(appID string) (string, error)
+	UpdateTraefikConfigFunc           func(appID string, traefikConfig string) error
+	MoveApplicationFunc               func(appID string, targetEnvironmentID string) (*client.Application, error)
+	ListApplicationsFunc              func() ([]client.Application, error)
+	ListApplicationsByEnvironmentFunc func(environmentID string) ([]client.Application, error)
+	FindApplicationByDomainFunc       func(host string) (*client.Application, error)
+	FindApplicationByPathFunc         func(projectName string, environmentName string, appName string) (*client.Application, error)
+	FindApplicationByAppNameFunc      func(appName string) (*client.Application, error)
+	SaveBuildTypeFunc                 func(input client.SaveBuildTypeInput) error
+	SaveGitProviderFunc               func(input client.SaveGitProviderInput) error
+	SaveGithubProviderFunc            func(input client.SaveGithubProviderInput) error
+	SaveGitlabProviderFunc            func(input client.SaveGitlabProviderInput) error
+	SaveBitbucketProviderFunc         func(input client.SaveBitbucketProviderInput) error
+	SaveGiteaProviderFunc             func(input client.SaveGiteaProviderInput) error
+	SaveDockerProviderFunc            func(input client.SaveDockerProviderInput) error
+	SaveDropProviderFunc              func(input client.SaveDropProviderInput) error
+	SaveDockerfileFunc                func(appID string, dockerfile string) error
+	SaveEnvironmentFunc               func(input client.SaveEnvironmentInput) error
+	ResolveImageDigestFunc            func(image string, username string, password string) (string, error)
+	UpdateApplicationEnvFunc          func(appID string, updateFn func(envMap map[string]string), createEnvFile *bool) error
+	CreateVariableFunc                func(appID string, key string, value string, scope string, createEnvFile *bool) (*client.EnvironmentVariable, error)
+	GetVariablesByApplicationFunc     func(appID string) ([]client.EnvironmentVariable, error)
+	DeleteVariableFunc                func(id string, createEnvFile *bool) error
+	CreateComposeFunc                 func(comp client.Compose) (*client.Compose, error)
+	GetComposeFunc                    func(id string) (*client.Compose, error)
+	UpdateComposeFunc                 func(comp client.Compose) (*client.Compose, error)
+	DeleteComposeFunc                 func(id string) error
+	DeployComposeFunc                 func(id string, serverId string) error
+	RedeployComposeFunc               func(id string) error
+	RefreshComposeTokenFunc           func(id string) error
+	MoveComposeFunc                   func(composeID string, targetEnvironmentID string) (*client.Compose, error)
+	ListComposesFunc                  func(environmentID string) ([]client.Compose, error)
+	FindComposeByAppNameFunc          func(appName string) (*client.Compose, error)
+	CreateDatabaseFunc                func(projectID string, environmentID string, name string, dbType string, password string, dockerImage string, username string) (*client.Database, error)
+	GetDatabaseFunc                   func(dbID string, databaseType string) (*client.Database, error)
+	DeleteDatabaseFunc                func(id string) error
+	DeleteDatabaseWithTypeFunc        func(id string, dbType string) error
+	DeployDatabaseFunc                func(id string, dbType string) error
+	CreatePostgresFunc                func(postgres client.Postgres) (*client.Postgres, error)
+	GetPostgresFunc                   func(id string) (*client.Postgres, error)
+	UpdatePostgresFunc                func(postgres client.Postgres) (*client.Postgres, error)
+	DeletePostgresFunc                func(id string) error
+	CreateMySQLFunc                   func(mysql client.MySQL) (*client.MySQL, error)
+	GetMySQLFunc                      func(id string) (*client.MySQL, error)
+	UpdateMySQLFunc                   func(mysql client.MySQL) (*client.MySQL, error)
+	DeleteMySQLFunc                   func(id string) error
+	CreateMariaDBFunc                 func(mariadb client.MariaDB) (*client.MariaDB, error)
+	GetMariaDBFunc                    func(id string) (*client.MariaDB, error)
+	UpdateMariaDBFunc                 func(mariadb client.MariaDB) (*client.MariaDB, error)
+	DeleteMariaDBFunc                 func(id string) error
+	CreateMongoDBFunc                 func(mongo client.MongoDB) (*client.MongoDB, error)
+	GetMongoDBFunc                    func(id string) (*client.MongoDB, error)
+	UpdateMongoDBFunc                 func(mongo client.MongoDB) (*client.MongoDB, error)
+	DeleteMongoDBFunc                 func(id string) error
+	CreateRedisFunc                   func(redis client.Redis) (*client.Redis, error)
+	GetRedisFunc                      func(id string) (*client.Redis, error)
+	UpdateRedisFunc                   func(redis client.Redis) (*client.Redis, error)
+	DeleteRedisFunc                   func(id string) error
+	CreateDomainFunc                  func(domain client.Domain) (*client.Domain, error)
+	GetDomainFunc                     func(id string) (*client.Domain, error)
+	GetDomainsByApplicationFunc       func(appID string) ([]client.Domain, error)
+	GetDomainsByComposeFunc           func(composeID string) ([]client.Domain, error)
+	UpdateDomainFunc                  func(domain client.Domain) (*client.Domain, error)
+	DeleteDomainFunc                  func(id string) error
+	GenerateDomainFunc                func(appName string) (string, error)
+	CreateSSHKeyFunc                  func(name string, description string, privateKey string, publicKey string) (*client.SSHKey, error)
+	ListSSHKeysFunc                   func() ([]client.SSHKey, error)
+	GetSSHKeyFunc                     func(id string) (*client.SSHKey, error)
+	UpdateSSHKeyFunc                  func(id string, name string, description string) (*client.SSHKey, error)
+	DeleteSSHKeyFunc                  func(id string) error
+	ListServersFunc                   func() ([]client.Server, error)
+	GetServerFunc                     func(id string) (*client.Server, error)
+	GetServerSetupCommandFunc         func(serverID string) (string, error)
+	GetServerMetricsFunc              func(server client.Server, dataPoints int) ([]client.ServerMetric, error)
+	ValidateServerFunc                func(serverID string) (*client.ServerValidation, error)
+	SetupServerFunc                   func(serverID string) error
+	SetupServerMonitoringFunc         func(serverID string, port int, token string) error
+	GetTraefikPortsFunc               func(serverID string) ([]client.TraefikPort, error)
+	UpdateTraefikPortsFunc            func(serverID string, ports []client.TraefikPort) error
+	CreateServerFunc                  func(server client.Server) (*client.Server, error)
+	UpdateServerFunc                  func(server client.Server) (*client.Server, error)
+	DeleteServerFunc                  func(id string) error
+	ListGithubProvidersFunc           func() ([]client.GithubProvider, error)
+	CreateGitlabProviderFunc          func(provider client.GitlabProvider) (*client.GitlabProvider, error)
+	GetGitlabProviderFunc             func(id string) (*client.GitlabProvider, error)
+	UpdateGitlabProviderFunc          func(provider client.GitlabProvider) (*client.GitlabProvider, error)
+	ListGitlabProvidersFunc           func() ([]client.GitlabProviderListItem, error)
+	CreateBitbucketProviderFunc       func(provider client.BitbucketProvider) (*client.BitbucketProvider, error)
+	GetBitbucketProviderFunc          func(id string) (*client.BitbucketProvider, error)
+	UpdateBitbucketProviderFunc       func(provider client.BitbucketProvider) (*client.BitbucketProvider, error)
+	ListBitbucketProvidersFunc        func() ([]client.BitbucketProviderListItem, error)
+	CreateGiteaProviderFunc           func(provider client.GiteaProvider) (*client.GiteaProvider, error)
+	GetGiteaProviderFunc              func(id string) (*client.GiteaProvider, error)
+	UpdateGiteaProviderFunc           func(provider client.GiteaProvider) (*client.GiteaProvider, error)
+	ListGiteaProvidersFunc            func() ([]client.GiteaProviderListItem, error)
+	DeleteGitProviderFunc             func(gitProviderId string) error
+	GetMountsByServiceFunc            func(serviceID string, serviceType string) ([]client.Mount, error)
+	CreateMountFunc                   func(mount client.Mount) (*client.Mount, error)
+	GetMountFunc                      func(id string) (*client.Mount, error)
+	UpdateMountFunc                   func(mount client.Mount) (*client.Mount, error)
+	DeleteMountFunc                   func(id string) error
+	GetPortsByApplicationFunc         func(applicationID string) ([]client.Port, error)
+	CreatePortFunc                    func(port client.Port) (*client.Port, error)
+	GetPortFunc                       func(id string) (*client.Port, error)
+	UpdatePortFunc                    func(port client.Port) (*client.Port, error)
+	DeletePortFunc                    func(id string) error
+	GetRedirectsByApplicationFunc     func(applicationID string) ([]client.Redirect, error)
+	CreateRedirectFunc                func(redirect client.Redirect) (*client.Redirect, error)
+	GetRedirectFunc                   func(id string) (*client.Redirect, error)
+	UpdateRedirectFunc                func(redirect client.Redirect) (*client.Redirect, error)
+	DeleteRedirectFunc                func(id string) error
+	CreateRegistryFunc                func(registry client.Registry) (*client.Registry, error)
+	GetRegistryFunc                   func(id string) (*client.Registry, error)
+	UpdateRegistryFunc                func(registry client.Registry) (*client.Registry, error)
+	DeleteRegistryFunc                func(id string) error
+	ListRegistriesFunc                func() ([]client.Registry, error)
+	TestRegistryFunc                  func(registry client.Registry) error
+	CreateDestinationFunc             func(dest client.Destination) (*client.Destination, error)
+	GetDestinationFunc                func(id string) (*client.Destination, error)
+	UpdateDestinationFunc             func(dest client.Destination) (*client.Destination, error)
+	DeleteDestinationFunc             func(id string) error
+	ListDestinationsFunc              func() ([]client.Destination, error)
+	CreateBackupFunc                  func(backup client.Backup) (*client.Backup, error)
+	GetBackupFunc                     func(id string) (*client.Backup, error)
+	UpdateBackupFunc                  func(backup client.Backup) (*client.Backup, error)
+	DeleteBackupFunc                  func(id string) error
+	ListBackupFilesFunc               func(destinationID string, search string, serverID string) ([]client.BackupFile, error)
+	GetBackupsByDatabaseIDFunc        func(databaseID string, databaseType string) ([]client.Backup, error)
+	GetBackupsByComposeIDFunc         func(composeID string) ([]client.Backup, error)
+	RunBackupFunc                     func(backupID string, backupType string) error
+	CreateOrganizationFunc            func(org client.Organization) (*client.Organization, error)
+	GetOrganizationFunc               func(id string) (*client.Organization, error)
+	UpdateOrganizationFunc            func(org client.Organization) (*client.Organization, error)
+	DeleteOrganizationFunc            func(id string) error
+	ListOrganizationsFunc             func() ([]client.Organization, error)
+	GetCurrentOrganizationIDFunc      func() (string, error)
+	ListInvitationsFunc               func() ([]client.Invitation, error)
+	CreateVolumeBackupFunc            func(backup client.VolumeBackup) (*client.VolumeBackup, error)
+	GetVolumeBackupFunc               func(id string) (*client.VolumeBackup, error)
+	UpdateVolumeBackupFunc            func(backup client.VolumeBackup) (*client.VolumeBackup, error)
+	DeleteVolumeBackupFunc            func(id string) error
+	ListVolumeBackupsFunc             func(serviceID string, serviceType string) ([]client.VolumeBackup, error)
+	CreateScheduleFunc                func(schedule client.Schedule) (*client.Schedule, error)
+	GetScheduleFunc                   func(id string) (*client.Schedule, error)
+	UpdateScheduleFunc                func(schedule client.Schedule) (*client.Schedule, error)
+	DeleteScheduleFunc                func(id string) error
+	ListSchedulesFunc                 func(id string, scheduleType string) ([]client.Schedule, error)
+	ListDeploymentsByTypeFunc         func(id string, deploymentType string) ([]client.Deployment, error)
+	ReadDeploymentLogFunc             func(logPath string, serverID string) (string, error)
+	ListDockerVolumesFunc             func(serverID string) ([]client.DockerVolume, error)
+	ListSwarmServicesFunc             func(serverID string) ([]client.SwarmService, error)
+	ListComposeContainersFunc         func(appName string, composeType string, serverID string) ([]client.Container, error)
+	ReadMiddlewareTraefikConfigFunc   func(serverID string) (string, error)
+	UpdateMiddlewareTraefikConfigFunc func(serverID string, traefikConfig string) error
+}
+
+// Endpoint calls EndpointFunc.
+func (m *Client) Endpoint() string {
+	m.record("Endpoint")
+	if m.EndpointFunc == nil {
+		var r0 string
+		return r0
+	}
+	return m.EndpointFunc()
+}
+
+// GetVersion calls GetVersionFunc.
+func (m *Client) GetVersion() (string, error) {
+	m.record("GetVersion")
+	if m.GetVersionFunc == nil {
+		var r0 string
+		return r0, notMocked("GetVersion")
+	}
+	return m.GetVersionFunc()
+}
+
+// IsCloud calls IsCloudFunc.
+func (m *Client) IsCloud() (bool, error) {
+	m.record("IsCloud")
+	if m.IsCloudFunc == nil {
+		var r0 bool
+		return r0, notMocked("IsCloud")
+	}
+	return m.IsCloudFunc()
+}
+
+// GetUser calls GetUserFunc.
+func (m *Client) GetUser() (*client.User, error) {
+	m.record("GetUser")
+	if m.GetUserFunc == nil {
+		var r0 *client.User
+		return r0, notMocked("GetUser")
+	}
+	return m.GetUserFunc()
+}
+
+// GetCurrentMember calls GetCurrentMemberFunc.
+func (m *Client) GetCurrentMember() (*client.OrganizationMember, error) {
+	m.record("GetCurrentMember")
+	if m.GetCurrentMemberFunc == nil {
+		var r0 *client.OrganizationMember
+		return r0, notMocked("GetCurrentMember")
+	}
+	return m.GetCurrentMemberFunc()
+}
+
+// ListMembers calls ListMembersFunc.
+func (m *Client) ListMembers() ([]client.OrganizationMember, error) {
+	m.record("ListMembers")
+	if m.ListMembersFunc == nil {
+		var r0 []client.OrganizationMember
+		return r0, notMocked("ListMembers")
+	}
+	return m.ListMembersFunc()
+}
+
+// GetMemberByUserID calls GetMemberByUserIDFunc.
+func (m *Client) GetMemberByUserID(userID string) (*client.OrganizationMember, error) {
+	m.record("GetMemberByUserID")
+	if m.GetMemberByUserIDFunc == nil {
+		var r0 *client.OrganizationMember
+		return r0, notMocked("GetMemberByUserID")
+	}
+	return m.GetMemberByUserIDFunc(userID)
+}
+
+// GetMemberByID calls GetMemberByIDFunc.
+func (m *Client) GetMemberByID(memberID string) (*client.OrganizationMember, error) {
+	m.record("GetMemberByID")
+	if m.GetMemberByIDFunc == nil {
+		var r0 *client.OrganizationMember
+		return r0, notMocked("GetMemberByID")
+	}
+	return m.GetMemberByIDFunc(memberID)
+}
+
+// AssignUserPermissions calls AssignUserPermissionsFunc.
+func (m *Client) AssignUserPermissions(input client.UserPermissionsInput) error {
+	m.record("AssignUserPermissions")
+	if m.AssignUserPermissionsFunc == nil {
+		return notMocked("AssignUserPermissions")
+	}
+	return m.AssignUserPermissionsFunc(input)
+}
+
+// CreateApiKey calls CreateApiKeyFunc.
+func (m *Client) CreateApiKey(input client.ApiKeyCreateInput) (*client.ApiKey, error) {
+	m.record("CreateApiKey")
+	if m.CreateApiKeyFunc == nil {
+		var r0 *client.ApiKey
+		return r0, notMocked("CreateApiKey")
+	}
+	return m.CreateApiKeyFunc(input)
+}
+
+// DeleteApiKey calls DeleteApiKeyFunc.
+func (m *Client) DeleteApiKey(apiKeyID string) error {
+	m.record("DeleteApiKey")
+	if m.DeleteApiKeyFunc == nil {
+		return notMocked("DeleteApiKey")
+	}
+	return m.DeleteApiKeyFunc(apiKeyID)
+}
+
+// GetApiKeyByID calls GetApiKeyByIDFunc.
+func (m *Client) GetApiKeyByID(apiKeyID string) (*client.ApiKey, error) {
+	m.record("GetApiKeyByID")
+	if m.GetApiKeyByIDFunc == nil {
+		var r0 *client.ApiKey
+		return r0, notMocked("GetApiKeyByID")
+	}
+	return m.GetApiKeyByIDFunc(apiKeyID)
+}
+
+// CreateAI calls CreateAIFunc.
+func (m *Client) CreateAI(name string, apiURL string, apiKey string, model string, isEnabled bool) (*client.AI, error) {
+	m.record("CreateAI")
+	if m.CreateAIFunc == nil {
+		var r0 *client.AI
+		return r0, notMocked("CreateAI")
+	}
+	return m.CreateAIFunc(name, apiURL, apiKey, model, isEnabled)
+}
+
+// GetAI calls GetAIFunc.
+func (m *Client) GetAI(aiID string) (*client.AI, error) {
+	m.record("GetAI")
+	if m.GetAIFunc == nil {
+		var r0 *client.AI
+		return r0, notMocked("GetAI")
+	}
+	return m.GetAIFunc(aiID)
+}
+
+// ListAIs calls ListAIsFunc.
+func (m *Client) ListAIs() ([]client.AI, error) {
+	m.record("ListAIs")
+	if m.ListAIsFunc == nil {
+		var r0 []client.AI
+		return r0, notMocked("ListAIs")
+	}
+	return m.ListAIsFunc()
+}
+
+// UpdateAI calls UpdateAIFunc.
+func (m *Client) UpdateAI(ai client.AI) error {
+	m.record("UpdateAI")
+	if m.UpdateAIFunc == nil {
+		return notMocked("UpdateAI")
+	}
+	return m.UpdateAIFunc(ai)
+}
+
+// DeleteAI calls DeleteAIFunc.
+func (m *Client) DeleteAI(aiID string) error {
+	m.record("DeleteAI")
+	if m.DeleteAIFunc == nil {
+		return notMocked("DeleteAI")
+	}
+	return m.DeleteAIFunc(aiID)
+}
+
+// GetAIModels calls GetAIModelsFunc.
+func (m *Client) GetAIModels(apiURL string, apiKey string) ([]client.AIModel, error) {
+	m.record("GetAIModels")
+	if m.GetAIModelsFunc == nil {
+		var r0 []client.AIModel
+		return r0, notMocked("GetAIModels")
+	}
+	return m.GetAIModelsFunc(apiURL, apiKey)
+}
+
+// CreateCertificate calls CreateCertificateFunc.
+func (m *Client) CreateCertificate(cert client.Certificate) (*client.Certificate, error) {
+	m.record("CreateCertificate")
+	if m.CreateCertificateFunc == nil {
+		var r0 *client.Certificate
+		return r0, notMocked("CreateCertificate")
+	}
+	return m.CreateCertificateFunc(cert)
+}
+
+// GetCertificate calls GetCertificateFunc.
+func (m *Client) GetCertificate(id string) (*client.Certificate, error) {
+	m.record("GetCertificate")
+	if m.GetCertificateFunc == nil {
+		var r0 *client.Certificate
+		return r0, notMocked("GetCertificate")
+	}
+	return m.GetCertificateFunc(id)
+}
+
+// ListCertificates calls ListCertificatesFunc.
+func (m *Client) ListCertificates() ([]client.Certificate, error) {
+	m.record("ListCertificates")
+	if m.ListCertificatesFunc == nil {
+		var r0 []client.Certificate
+		return r0, notMocked("ListCertificates")
+	}
+	return m.ListCertificatesFunc()
+}
+
+// DeleteCertificate calls DeleteCertificateFunc.
+func (m *Client) DeleteCertificate(id string) error {
+	m.record("DeleteCertificate")
+	if m.DeleteCertificateFunc == nil {
+		return notMocked("DeleteCertificate")
+	}
+	return m.DeleteCertificateFunc(id)
+}
+
+// ListProjects calls ListProjectsFunc.
+func (m *Client) ListProjects() ([]client.Project, error) {
+	m.record("ListProjects")
+	if m.ListProjectsFunc == nil {
+		var r0 []client.Project
+		return r0, notMocked("ListProjects")
+	}
+	return m.ListProjectsFunc()
+}
+
+// CreateProject calls CreateProjectFunc.
+func (m *Client) CreateProject(name string, description string) (*client.Project, error) {
+	m.record("CreateProject")
+	if m.CreateProjectFunc == nil {
+		var r0 *client.Project
+		return r0, notMocked("CreateProject")
+	}
+	return m.CreateProjectFunc(name, description)
+}
+
+// GetProject calls GetProjectFunc.
+func (m *Client) GetProject(id string) (*client.Project, error) {
+	m.record("GetProject")
+	if m.GetProjectFunc == nil {
+		var r0 *client.Project
+		return r0, notMocked("GetProject")
+	}
+	return m.GetProjectFunc(id)
+}
+
+// UpdateProject calls UpdateProjectFunc.
+func (m *Client) UpdateProject(id string, name string, description string) (*client.Project, error) {
+	m.record("UpdateProject")
+	if m.UpdateProjectFunc == nil {
+		var r0 *client.Project
+		return r0, notMocked("UpdateProject")
+	}
+	return m.UpdateProjectFunc(id, name, description)
+}
+
+// DeleteProject calls DeleteProjectFunc.
+func (m *Client) DeleteProject(id string) error {
+	m.record("DeleteProject")
+	if m.DeleteProjectFunc == nil {
+		return notMocked("DeleteProject")
+	}
+	return m.DeleteProjectFunc(id)
+}
+
+// CreateEnvironment calls CreateEnvironmentFunc.
+func (m *Client) CreateEnvironment(projectID string, name string, description string) (*client.Environment, error) {
+	m.record("CreateEnvironment")
+	if m.CreateEnvironmentFunc == nil {
+		var r0 *client.Environment
+		return r0, notMocked("CreateEnvironment")
+	}
+	return m.CreateEnvironmentFunc(projectID, name, description)
+}
+
+// GetEnvironment calls GetEnvironmentFunc.
+func (m *Client) GetEnvironment(id string) (*client.Environment, error) {
+	m.record("GetEnvironment")
+	if m.GetEnvironmentFunc == nil {
+		var r0 *client.Environment
+		return r0, notMocked("GetEnvironment")
+	}
+	return m.GetEnvironmentFunc(id)
+}
+
+// UpdateEnvironment calls UpdateEnvironmentFunc.
+func (m *Client) UpdateEnvironment(env client.Environment) (*client.Environment, error) {
+	m.record("UpdateEnvironment")
+	if m.UpdateEnvironmentFunc == nil {
+		var r0 *client.Environment
+		return r0, notMocked("UpdateEnvironment")
+	}
+	return m.UpdateEnvironmentFunc(env)
+}
+
+// SaveEnvironmentEnv calls SaveEnvironmentEnvFunc.
+func (m *Client) SaveEnvironmentEnv(id string, env string) error {
+	m.record("SaveEnvironmentEnv")
+	if m.SaveEnvironmentEnvFunc == nil {
+		return notMocked("SaveEnvironmentEnv")
+	}
+	return m.SaveEnvironmentEnvFunc(id, env)
+}
+
+// UpdateEnvironmentEnv calls UpdateEnvironmentEnvFunc.
+func (m *Client) UpdateEnvironmentEnv(id string, updateFn func(envMap map[string]string)) error {
+	m.record("UpdateEnvironmentEnv")
+	if m.UpdateEnvironmentEnvFunc == nil {
+		return notMocked("UpdateEnvironmentEnv")
+	}
+	return m.UpdateEnvironmentEnvFunc(id, updateFn)
+}
+
+// DeleteEnvironment calls DeleteEnvironmentFunc.
+func (m *Client) DeleteEnvironment(id string) error {
+	m.record("DeleteEnvironment")
+	if m.DeleteEnvironmentFunc == nil {
+		return notMocked("DeleteEnvironment")
+	}
+	return m.DeleteEnvironmentFunc(id)
+}
+
+// CreateApplication calls CreateApplicationFunc.
+func (m *Client) CreateApplication(app client.Application) (*client.Application, error) {
+	m.record("CreateApplication")
+	if m.CreateApplicationFunc == nil {
+		var r0 *client.Application
+		return r0, notMocked("CreateApplication")
+	}
+	return m.CreateApplicationFunc(app)
+}
+
+// GetApplication calls GetApplicationFunc.
+func (m *Client) GetApplication(id string) (*client.Application, error) {
+	m.record("GetApplication")
+	if m.GetApplicationFunc == nil {
+		var r0 *client.Application
+		return r0, notMocked("GetApplication")
+	}
+	return m.GetApplicationFunc(id)
+}
+
+// UpdateApplicationGeneral calls UpdateApplicationGeneralFunc.
+func (m *Client) UpdateApplicationGeneral(app client.Application) (*client.Application, error) {
+	m.record("UpdateApplicationGeneral")
+	if m.UpdateApplicationGeneralFunc == nil {
+		var r0 *client.Application
+		return r0, notMocked("UpdateApplicationGeneral")
+	}
+	return m.UpdateApplicationGeneralFunc(app)
+}
+
+// UpdateApplication calls UpdateApplicationFunc.
+func (m *Client) UpdateApplication(app client.Application) (*client.Application, error) {
+	m.record("UpdateApplication")
+	if m.UpdateApplicationFunc == nil {
+		var r0 *client.Application
+		return r0, notMocked("UpdateApplication")
+	}
+	return m.UpdateApplicationFunc(app)
+}
+
+// DeleteApplication calls DeleteApplicationFunc.
+func (m *Client) DeleteApplication(id string) error {
+	m.record("DeleteApplication")
+	if m.DeleteApplicationFunc == nil {
+		return notMocked("DeleteApplication")
+	}
+	return m.DeleteApplicationFunc(id)
+}
+
+// DeployApplication calls DeployApplicationFunc.
+func (m *Client) DeployApplication(id string, serverId string) error {
+	m.record("DeployApplication")
+	if m.DeployApplicationFunc == nil {
+		return notMocked("DeployApplication")
+	}
+	return m.DeployApplicationFunc(id, serverId)
+}
+
+// RedeployApplication calls RedeployApplicationFunc.
+func (m *Client) RedeployApplication(id string) error {
+	m.record("RedeployApplication")
+	if m.RedeployApplicationFunc == nil {
+		return notMocked("RedeployApplication")
+	}
+	return m.RedeployApplicationFunc(id)
+}
+
+// StopApplication calls StopApplicationFunc.
+func (m *Client) StopApplication(id string) error {
+	m.record("StopApplication")
+	if m.StopApplicationFunc == nil {
+		return notMocked("StopApplication")
+	}
+	return m.StopApplicationFunc(id)
+}
+
+// StartApplication calls StartApplicationFunc.
+func (m *Client) StartApplication(id string) error {
+	m.record("StartApplication")
+	if m.StartApplicationFunc == nil {
+		return notMocked("StartApplication")
+	}
+	return m.StartApplicationFunc(id)
+}
+
+// RefreshApplicationToken calls RefreshApplicationTokenFunc.
+func (m *Client) RefreshApplicationToken(id string) error {
+	m.record("RefreshApplicationToken")
+	if m.RefreshApplicationTokenFunc == nil {
+		return notMocked("RefreshApplicationToken")
+	}
+	return m.RefreshApplicationTokenFunc(id)
+}
+
+// ReadTraefikConfig calls ReadTraefikConfigFunc.
+func (m *Client) ReadTraefikConfig(appID string) (string, error) {
+	m.record("ReadTraefikConfig")
+	if m.ReadTraefikConfigFunc == nil {
+		var r0 string
+		return r0, notMocked("ReadTraefikConfig")
+	}
+	return m.ReadTraefikConfigFunc(appID)
+}
+
+// UpdateTraefikConfig calls UpdateTraefikConfigFunc.
+func (m *Client) UpdateTraefikConfig(appID string, traefikConfig string) error {
+	m.record("UpdateTraefikConfig")
+	if m.UpdateTraefikConfigFunc == nil {
+		return notMocked("UpdateTraefikConfig")
+	}
+	return m.UpdateTraefikConfigFunc(appID, traefikConfig)
+}
+
+// MoveApplication calls MoveApplicationFunc.
+func (m *Client) MoveApplication(appID string, targetEnvironmentID string) (*client.Application, error) {
+	m.record("MoveApplication")
+	if m.MoveApplicationFunc == nil {
+		var r0 *client.Application
+		return r0, notMocked("MoveApplication")
+	}
+	return m.MoveApplicationFunc(appID, targetEnvironmentID)
+}
+
+// ListApplications calls ListApplicationsFunc.
+func (m *Client) ListApplications() ([]client.Application, error) {
+	m.record("ListApplications")
+	if m.ListApplicationsFunc == nil {
+		var r0 []client.Application
+		return r0, notMocked("ListApplications")
+	}
+	return m.ListApplicationsFunc()
+}
+
+// ListApplicationsByEnvironment calls ListApplicationsByEnvironmentFunc.
+func (m *Client) ListApplicationsByEnvironment(environmentID string) ([]client.Application, error) {
+	m.record("ListApplicationsByEnvironment")
+	if m.ListApplicationsByEnvironmentFunc == nil {
+		var r0 []client.Application
+		return r0, notMocked("ListApplicationsByEnvironment")
+	}
+	return m.ListApplicationsByEnvironmentFunc(environmentID)
+}
+
+// FindApplicationByDomain calls FindApplicationByDomainFunc.
+func (m *Client) FindApplicationByDomain(host string) (*client.Application, error) {
+	m.record("FindApplicationByDomain")
+	if m.FindApplicationByDomainFunc == nil {
+		var r0 *client.Application
+		return r0, notMocked("FindApplicationByDomain")
+	}
+	return m.FindApplicationByDomainFunc(host)
+}
+
+// FindApplicationByPath calls FindApplicationByPathFunc.
+func (m *Client) FindApplicationByPath(projectName string, environmentName string, appName string) (*client.Application, error) {
+	m.record("FindApplicationByPath")
+	if m.FindApplicationByPathFunc == nil {
+		var r0 *client.Application
+		return r0, notMocked("FindApplicationByPath")
+	}
+	return m.FindApplicationByPathFunc(projectName, environmentName, appName)
+}
+
+// FindApplicationByAppName calls FindApplicationByAppNameFunc.
+func (m *Client) FindApplicationByAppName(appName string) (*client.Application, error) {
+	m.record("FindApplicationByAppName")
+	if m.FindApplicationByAppNameFunc == nil {
+		var r0 *client.Application
+		return r0, notMocked("FindApplicationByAppName")
+	}
+	return m.FindApplicationByAppNameFunc(appName)
+}
+
+// SaveBuildType calls SaveBuildTypeFunc.
+func (m *Client) SaveBuildType(input client.SaveBuildTypeInput) error {
+	m.record("SaveBuildType")
+	if m.SaveBuildTypeFunc == nil {
+		return notMocked("SaveBuildType")
+	}
+	return m.SaveBuildTypeFunc(input)
+}
+
+// SaveGitProvider calls SaveGitProviderFunc.
+func (m *Client) SaveGitProvider(input client.SaveGitProviderInput) error {
+	m.record("SaveGitProvider")
+	if m.SaveGitProviderFunc == nil {
+		return notMocked("SaveGitProvider")
+	}
+	return m.SaveGitProviderFunc(input)
+}
+
+// SaveGithubProvider calls SaveGithubProviderFunc.
+func (m *Client) SaveGithubProvider(input client.SaveGithubProviderInput) error {
+	m.record("SaveGithubProvider")
+	if m.SaveGithubProviderFunc == nil {
+		return notMocked("SaveGithubProvider")
+	}
+	return m.SaveGithubProviderFunc(input)
+}
+
+// SaveGitlabProvider calls SaveGitlabProviderFunc.
+func (m *Client) SaveGitlabProvider(input client.SaveGitlabProviderInput) error {
+	m.record("SaveGitlabProvider")
+	if m.SaveGitlabProviderFunc == nil {
+		return notMocked("SaveGitlabProvider")
+	}
+	return m.SaveGitlabProviderFunc(input)
+}
+
+// SaveBitbucketProvider calls SaveBitbucketProviderFunc.
+func (m *Client) SaveBitbucketProvider(input client.SaveBitbucketProviderInput) error {
+	m.record("SaveBitbucketProvider")
+	if m.SaveBitbucketProviderFunc == nil {
+		return notMocked("SaveBitbucketProvider")
+	}
+	return m.SaveBitbucketProviderFunc(input)
+}
+
+// SaveGiteaProvider calls SaveGiteaProviderFunc.
+func (m *Client) SaveGiteaProvider(input client.SaveGiteaProviderInput) error {
+	m.record("SaveGiteaProvider")
+	if m.SaveGiteaProviderFunc == nil {
+		return notMocked("SaveGiteaProvider")
+	}
+	return m.SaveGiteaProviderFunc(input)
+}
+
+// SaveDockerProvider calls SaveDockerProviderFunc.
+func (m *Client) SaveDockerProvider(input client.SaveDockerProviderInput) error {
+	m.record("SaveDockerProvider")
+	if m.SaveDockerProviderFunc == nil {
+		return notMocked("SaveDockerProvider")
+	}
+	return m.SaveDockerProviderFunc(input)
+}
+
+// SaveDropProvider calls SaveDropProviderFunc.
+func (m *Client) SaveDropProvider(input client.SaveDropProviderInput) error {
+	m.record("SaveDropProvider")
+	if m.SaveDropProviderFunc == nil {
+		return notMocked("SaveDropProvider")
+	}
+	return m.SaveDropProviderFunc(input)
+}
+
+// SaveDockerfile calls SaveDockerfileFunc.
+func (m *Client) SaveDockerfile(appID string, dockerfile string) error {
+	m.record("SaveDockerfile")
+	if m.SaveDockerfileFunc == nil {
+		return notMocked("SaveDockerfile")
+	}
+	return m.SaveDockerfileFunc(appID, dockerfile)
+}
+
+// SaveEnvironment calls SaveEnvironmentFunc.
+func (m *Client) SaveEnvironment(input client.SaveEnvironmentInput) error {
+	m.record("SaveEnvironment")
+	if m.SaveEnvironmentFunc == nil {
+		return notMocked("SaveEnvironment")
+	}
+	return m.SaveEnvironmentFunc(input)
+}
+
+// ResolveImageDigest calls ResolveImageDigestFunc.
+func (m *Client) ResolveImageDigest(image string, username string, password string) (string, error) {
+	m.record("ResolveImageDigest")
+	if m.ResolveImageDigestFunc == nil {
+		var r0 string
+		return r0, notMocked("ResolveImageDigest")
+	}
+	return m.ResolveImageDigestFunc(image, username, password)
+}
+
+// UpdateApplicationEnv calls UpdateApplicationEnvFunc.
+func (m *Client) UpdateApplicationEnv(appID string, updateFn func(envMap map[string]string), createEnvFile *bool) error {
+	m.record("UpdateApplicationEnv")
+	if m.UpdateApplicationEnvFunc == nil {
+		return notMocked("UpdateApplicationEnv")
+	}
+	return m.UpdateApplicationEnvFunc(appID, updateFn, createEnvFile)
+}
+
+// CreateVariable calls CreateVariableFunc.
+func (m *Client) CreateVariable(appID string, key string, value string, scope string, createEnvFile *bool) (*client.EnvironmentVariable, error) {
+	m.record("CreateVariable")
+	if m.CreateVariableFunc == nil {
+		var r0 *client.EnvironmentVariable
+		return r0, notMocked("CreateVariable")
+	}
+	return m.CreateVariableFunc(appID, key, value, scope, createEnvFile)
+}
+
+// GetVariablesByApplication calls GetVariablesByApplicationFunc.
+func (m *Client) GetVariablesByApplication(appID string) ([]client.EnvironmentVariable, error) {
+	m.record("GetVariablesByApplication")
+	if m.GetVariablesByApplicationFunc == nil {
+		var r0 []client.EnvironmentVariable
+		return r0, notMocked("GetVariablesByApplication")
+	}
+	return m.GetVariablesByApplicationFunc(appID)
+}
+
+// DeleteVariable calls DeleteVariableFunc.
+func (m *Client) DeleteVariable(id string, createEnvFile *bool) error {
+	m.record("DeleteVariable")
+	if m.DeleteVariableFunc == nil {
+		return notMocked("DeleteVariable")
+	}
+	return m.DeleteVariableFunc(id, createEnvFile)
+}
+
+// CreateCompose calls CreateComposeFunc.
+func (m *Client) CreateCompose(comp client.Compose) (*client.Compose, error) {
+	m.record("CreateCompose")
+	if m.CreateComposeFunc == nil {
+		var r0 *client.Compose
+		return r0, notMocked("CreateCompose")
+	}
+	return m.CreateComposeFunc(comp)
+}
+
+// GetCompose calls GetComposeFunc.
+func (m *Client) GetCompose(id string) (*client.Compose, error) {
+	m.record("GetCompose")
+	if m.GetComposeFunc == nil {
+		var r0 *client.Compose
+		return r0, notMocked("GetCompose")
+	}
+	return m.GetComposeFunc(id)
+}
+
+// UpdateCompose calls UpdateComposeFunc.
+func (m *Client) UpdateCompose(comp client.Compose) (*client.Compose, error) {
+	m.record("UpdateCompose")
+	if m.UpdateComposeFunc == nil {
+		var r0 *client.Compose
+		return r0, notMocked("UpdateCompose")
+	}
+	return m.UpdateComposeFunc(comp)
+}
+
+// DeleteCompose calls DeleteComposeFunc.
+func (m *Client) DeleteCompose(id string) error {
+	m.record("DeleteCompose")
+	if m.DeleteComposeFunc == nil {
+		return notMocked("DeleteCompose")
+	}
+	return m.DeleteComposeFunc(id)
+}
+
+// DeployCompose calls DeployComposeFunc.
+func (m *Client) DeployCompose(id string, serverId string) error {
+	m.record("DeployCompose")
+	if m.DeployComposeFunc == nil {
+		return notMocked("DeployCompose")
+	}
+	return m.DeployComposeFunc(id, serverId)
+}
+
+// RedeployCompose calls RedeployComposeFunc.
+func (m *Client) RedeployCompose(id string) error {
+	m.record("RedeployCompose")
+	if m.RedeployComposeFunc == nil {
+		return notMocked("RedeployCompose")
+	}
+	return m.RedeployComposeFunc(id)
+}
+
+// RefreshComposeToken calls RefreshComposeTokenFunc.
+func (m *Client) RefreshComposeToken(id string) error {
+	m.record("RefreshComposeToken")
+	if m.RefreshComposeTokenFunc == nil {
+		return notMocked("RefreshComposeToken")
+	}
+	return m.RefreshComposeTokenFunc(id)
+}
+
+// MoveCompose calls MoveComposeFunc.
+func (m *Client) MoveCompose(composeID string, targetEnvironmentID string) (*client.Compose, error) {
+	m.record("MoveCompose")
+	if m.MoveComposeFunc == nil {
+		var r0 *client.Compose
+		return r0, notMocked("MoveCompose")
+	}
+	return m.MoveComposeFunc(composeID, targetEnvironmentID)
+}
+
+// ListComposes calls ListComposesFunc.
+func (m *Client) ListComposes(environmentID string) ([]client.Compose, error) {
+	m.record("ListComposes")
+	if m.ListComposesFunc == nil {
+		var r0 []client.Compose
+		return r0, notMocked("ListComposes")
+	}
+	return m.ListComposesFunc(environmentID)
+}
+
+// FindComposeByAppName calls FindComposeByAppNameFunc.
+func (m *Client) FindComposeByAppName(appName string) (*client.Compose, error) {
+	m.record("FindComposeByAppName")
+	if m.FindComposeByAppNameFunc == nil {
+		var r0 *client.Compose
+		return r0, notMocked("FindComposeByAppName")
+	}
+	return m.FindComposeByAppNameFunc(appName)
+}
+
+// CreateDatabase calls CreateDatabaseFunc.
+func (m *Client) CreateDatabase(projectID string, environmentID string, name string, dbType string, password string, dockerImage string, username string) (*client.Database, error) {
+	m.record("CreateDatabase")
+	if m.CreateDatabaseFunc == nil {
+		var r0 *client.Database
+		return r0, notMocked("CreateDatabase")
+	}
+	return m.CreateDatabaseFunc(projectID, environmentID, name, dbType, password, dockerImage, username)
+}
+
+// GetDatabase calls GetDatabaseFunc.
+func (m *Client) GetDatabase(dbID string, databaseType string) (*client.Database, error) {
+	m.record("GetDatabase")
+	if m.GetDatabaseFunc == nil {
+		var r0 *client.Database
+		return r0, notMocked("GetDatabase")
+	}
+	return m.GetDatabaseFunc(dbID, databaseType)
+}
+
+// DeleteDatabase calls DeleteDatabaseFunc.
+func (m *Client) DeleteDatabase(id string) error {
+	m.record("DeleteDatabase")
+	if m.DeleteDatabaseFunc == nil {
+		return notMocked("DeleteDatabase")
+	}
+	return m.DeleteDatabaseFunc(id)
+}
+
+// DeleteDatabaseWithType calls DeleteDatabaseWithTypeFunc.
+func (m *Client) DeleteDatabaseWithType(id string, dbType string) error {
+	m.record("DeleteDatabaseWithType")
+	if m.DeleteDatabaseWithTypeFunc == nil {
+		return notMocked("DeleteDatabaseWithType")
+	}
+	return m.DeleteDatabaseWithTypeFunc(id, dbType)
+}
+
+// DeployDatabase calls DeployDatabaseFunc.
+func (m *Client) DeployDatabase(id string, dbType string) error {
+	m.record("DeployDatabase")
+	if m.DeployDatabaseFunc == nil {
+		return notMocked("DeployDatabase")
+	}
+	return m.DeployDatabaseFunc(id, dbType)
+}
+
+// CreatePostgres calls CreatePostgresFunc.
+func (m *Client) CreatePostgres(postgres client.Postgres) (*client.Postgres, error) {
+	m.record("CreatePostgres")
+	if m.CreatePostgresFunc == nil {
+		var r0 *client.Postgres
+		return r0, notMocked("CreatePostgres")
+	}
+	return m.CreatePostgresFunc(postgres)
+}
+
+// GetPostgres calls GetPostgresFunc.
+func (m *Client) GetPostgres(id string) (*client.Postgres, error) {
+	m.record("GetPostgres")
+	if m.GetPostgresFunc == nil {
+		var r0 *client.Postgres
+		return r0, notMocked("GetPostgres")
+	}
+	return m.GetPostgresFunc(id)
+}
+
+// UpdatePostgres calls UpdatePostgresFunc.
+func (m *Client) UpdatePostgres(postgres client.Postgres) (*client.Postgres, error) {
+	m.record("UpdatePostgres")
+	if m.UpdatePostgresFunc == nil {
+		var r0 *client.Postgres
+		return r0, notMocked("UpdatePostgres")
+	}
+	return m.UpdatePostgresFunc(postgres)
+}
+
+// DeletePostgres calls DeletePostgresFunc.
+func (m *Client) DeletePostgres(id string) error {
+	m.record("DeletePostgres")
+	if m.DeletePostgresFunc == nil {
+		return notMocked("DeletePostgres")
+	}
+	return m.DeletePostgresFunc(id)
+}
+
+// CreateMySQL calls CreateMySQLFunc.
+func (m *Client) CreateMySQL(mysql client.MySQL) (*client.MySQL, error) {
+	m.record("CreateMySQL")
+	if m.CreateMySQLFunc == nil {
+		var r0 *client.MySQL
+		return r0, notMocked("CreateMySQL")
+	}
+	return m.CreateMySQLFunc(mysql)
+}
+
+// GetMySQL calls GetMySQLFunc.
+func (m *Client) GetMySQL(id string) (*client.MySQL, error) {
+	m.record("GetMySQL")
+	if m.GetMySQLFunc == nil {
+		var r0 *client.MySQL
+		return r0, notMocked("GetMySQL")
+	}
+	return m.GetMySQLFunc(id)
+}
+
+// UpdateMySQL calls UpdateMySQLFunc.
+func (m *Client) UpdateMySQL(mysql client.MySQL) (*client.MySQL, error) {
+	m.record("UpdateMySQL")
+	if m.UpdateMySQLFunc == nil {
+		var r0 *client.MySQL
+		return r0, notMocked("UpdateMySQL")
+	}
+	return m.UpdateMySQLFunc(mysql)
+}
+
+// DeleteMySQL calls DeleteMySQLFunc.
+func (m *Client) DeleteMySQL(id string) error {
+	m.record("DeleteMySQL")
+	if m.DeleteMySQLFunc == nil {
+		return notMocked("DeleteMySQL")
+	}
+	return m.DeleteMySQLFunc(id)
+}
+
+// CreateMariaDB calls CreateMariaDBFunc.
+func (m *Client) CreateMariaDB(mariadb client.MariaDB) (*client.MariaDB, error) {
+	m.record("CreateMariaDB")
+	if m.CreateMariaDBFunc == nil {
+		var r0 *client.MariaDB
+		return r0, notMocked("CreateMariaDB")
+	}
+	return m.CreateMariaDBFunc(mariadb)
+}
+
+// GetMariaDB calls GetMariaDBFunc.
+func (m *Client) GetMariaDB(id string) (*client.MariaDB, error) {
+	m.record("GetMariaDB")
+	if m.GetMariaDBFunc == nil {
+		var r0 *client.MariaDB
+		return r0, notMocked("GetMariaDB")
+	}
+	return m.GetMariaDBFunc(id)
+}
+
+// UpdateMariaDB calls UpdateMariaDBFunc.
+func (m *Client) UpdateMariaDB(mariadb client.MariaDB) (*client.MariaDB, error) {
+	m.record("UpdateMariaDB")
+	if m.UpdateMariaDBFunc == nil {
+		var r0 *client.MariaDB
+		return r0, notMocked("UpdateMariaDB")
+	}
+	return m.UpdateMariaDBFunc(mariadb)
+}
+
+// DeleteMariaDB calls DeleteMariaDBFunc.
+func (m *Client) DeleteMariaDB(id string) error {
+	m.record("DeleteMariaDB")
+	if m.DeleteMariaDBFunc == nil {
+		return notMocked("DeleteMariaDB")
+	}
+	return m.DeleteMariaDBFunc(id)
+}
+
+// CreateMongoDB calls CreateMongoDBFunc.
+func (m *Client) CreateMongoDB(mongo client.MongoDB) (*client.MongoDB, error) {
+	m.record("CreateMongoDB")
+	if m.CreateMongoDBFunc == nil {
+		var r0 *client.MongoDB
+		return r0, notMocked("CreateMongoDB")
+	}
+	return m.CreateMongoDBFunc(mongo)
+}
+
+// GetMongoDB calls GetMongoDBFunc.
+func (m *Client) GetMongoDB(id string) (*client.MongoDB, error) {
+	m.record("GetMongoDB")
+	if m.GetMongoDBFunc == nil {
+		var r0 *client.MongoDB
+		return r0, notMocked("GetMongoDB")
+	}
+	return m.GetMongoDBFunc(id)
+}
+
+// UpdateMongoDB calls UpdateMongoDBFunc.
+func (m *Client) UpdateMongoDB(mongo client.MongoDB) (*client.MongoDB, error) {
+	m.record("UpdateMongoDB")
+	if m.UpdateMongoDBFunc == nil {
+		var r0 *client.MongoDB
+		return r0, notMocked("UpdateMongoDB")
+	}
+	return m.UpdateMongoDBFunc(mongo)
+}
+
+// DeleteMongoDB calls DeleteMongoDBFunc.
+func (m *Client) DeleteMongoDB(id string) error {
+	m.record("DeleteMongoDB")
+	if m.DeleteMongoDBFunc == nil {
+		return notMocked("DeleteMongoDB")
+	}
+	return m.DeleteMongoDBFunc(id)
+}
+
+// CreateRedis calls CreateRedisFunc.
+func (m *Client) CreateRedis(redis client.Redis) (*client.Redis, error) {
+	m.record("CreateRedis")
+	if m.CreateRedisFunc == nil {
+		var r0 *client.Redis
+		return r0, notMocked("CreateRedis")
+	}
+	return m.CreateRedisFunc(redis)
+}
+
+// GetRedis calls GetRedisFunc.
+func (m *Client) GetRedis(id string) (*client.Redis, error) {
+	m.record("GetRedis")
+	if m.GetRedisFunc == nil {
+		var r0 *client.Redis
+		return r0, notMocked("GetRedis")
+	}
+	return m.GetRedisFunc(id)
+}
+
+// UpdateRedis calls UpdateRedisFunc.
+func (m *Client) UpdateRedis(redis client.Redis) (*client.Redis, error) {
+	m.record("UpdateRedis")
+	if m.UpdateRedisFunc == nil {
+		var r0 *client.Redis
+		return r0, notMocked("UpdateRedis")
+	}
+	return m.UpdateRedisFunc(redis)
+}
+
+// DeleteRedis calls DeleteRedisFunc.
+func (m *Client) DeleteRedis(id string) error {
+	m.record("DeleteRedis")
+	if m.DeleteRedisFunc == nil {
+		return notMocked("DeleteRedis")
+	}
+	return m.DeleteRedisFunc(id)
+}
+
+// CreateDomain calls CreateDomainFunc.
+func (m *Client) CreateDomain(domain client.Domain) (*client.Domain, error) {
+	m.record("CreateDomain")
+	if m.CreateDomainFunc == nil {
+		var r0 *client.Domain
+		return r0, notMocked("CreateDomain")
+	}
+	return m.CreateDomainFunc(domain)
+}
+
+// GetDomain calls GetDomainFunc.
+func (m *Client) GetDomain(id string) (*client.Domain, error) {
+	m.record("GetDomain")
+	if m.GetDomainFunc == nil {
+		var r0 *client.Domain
+		return r0, notMocked("GetDomain")
+	}
+	return m.GetDomainFunc(id)
+}
+
+// GetDomainsByApplication calls GetDomainsByApplicationFunc.
+func (m *Client) GetDomainsByApplication(appID string) ([]client.Domain, error) {
+	m.record("GetDomainsByApplication")
+	if m.GetDomainsByApplicationFunc == nil {
+		var r0 []client.Domain
+		return r0, notMocked("GetDomainsByApplication")
+	}
+	return m.GetDomainsByApplicationFunc(appID)
+}
+
+// GetDomainsByCompose calls GetDomainsByComposeFunc.
+func (m *Client) GetDomainsByCompose(composeID string) ([]client.Domain, error) {
+	m.record("GetDomainsByCompose")
+	if m.GetDomainsByComposeFunc == nil {
+		var r0 []client.Domain
+		return r0, notMocked("GetDomainsByCompose")
+	}
+	return m.GetDomainsByComposeFunc(composeID)
+}
+
+// UpdateDomain calls UpdateDomainFunc.
+func (m *Client) UpdateDomain(domain client.Domain) (*client.Domain, error) {
+	m.record("UpdateDomain")
+	if m.UpdateDomainFunc == nil {
+		var r0 *client.Domain
+		return r0, notMocked("UpdateDomain")
+	}
+	return m.UpdateDomainFunc(domain)
+}
+
+// DeleteDomain calls DeleteDomainFunc.
+func (m *Client) DeleteDomain(id string) error {
+	m.record("DeleteDomain")
+	if m.DeleteDomainFunc == nil {
+		return notMocked("DeleteDomain")
+	}
+	return m.DeleteDomainFunc(id)
+}
+
+// GenerateDomain calls GenerateDomainFunc.
+func (m *Client) GenerateDomain(appName string) (string, error) {
+	m.record("GenerateDomain")
+	if m.GenerateDomainFunc == nil {
+		var r0 string
+		return r0, notMocked("GenerateDomain")
+	}
+	return m.GenerateDomainFunc(appName)
+}
+
+// CreateSSHKey calls CreateSSHKeyFunc.
+func (m *Client) CreateSSHKey(name string, description string, privateKey string, publicKey string) (*client.SSHKey, error) {
+	m.record("CreateSSHKey")
+	if m.CreateSSHKeyFunc == nil {
+		var r0 *client.SSHKey
+		return r0, notMocked("CreateSSHKey")
+	}
+	return m.CreateSSHKeyFunc(name, description, privateKey, publicKey)
+}
+
+// ListSSHKeys calls ListSSHKeysFunc.
+func (m *Client) ListSSHKeys() ([]client.SSHKey, error) {
+	m.record("ListSSHKeys")
+	if m.ListSSHKeysFunc == nil {
+		var r0 []client.SSHKey
+		return r0, notMocked("ListSSHKeys")
+	}
+	return m.ListSSHKeysFunc()
+}
+
+// GetSSHKey calls GetSSHKeyFunc.
+func (m *Client) GetSSHKey(id string) (*client.SSHKey, error) {
+	m.record("GetSSHKey")
+	if m.GetSSHKeyFunc == nil {
+		var r0 *client.SSHKey
+		return r0, notMocked("GetSSHKey")
+	}
+	return m.GetSSHKeyFunc(id)
+}
+
+// UpdateSSHKey calls UpdateSSHKeyFunc.
+func (m *Client) UpdateSSHKey(id string, name string, description string) (*client.SSHKey, error) {
+	m.record("UpdateSSHKey")
+	if m.UpdateSSHKeyFunc == nil {
+		var r0 *client.SSHKey
+		return r0, notMocked("UpdateSSHKey")
+	}
+	return m.UpdateSSHKeyFunc(id, name, description)
+}
+
+// DeleteSSHKey calls DeleteSSHKeyFunc.
+func (m *Client) DeleteSSHKey(id string) error {
+	m.record("DeleteSSHKey")
+	if m.DeleteSSHKeyFunc == nil {
+		return notMocked("DeleteSSHKey")
+	}
+	return m.DeleteSSHKeyFunc(id)
+}
+
+// ListServers calls ListServersFunc.
+func (m *Client) ListServers() ([]client.Server, error) {
+	m.record("ListServers")
+	if m.ListServersFunc == nil {
+		var r0 []client.Server
+		return r0, notMocked("ListServers")
+	}
+	return m.ListServersFunc()
+}
+
+// GetServer calls GetServerFunc.
+func (m *Client) GetServer(id string) (*client.Server, error) {
+	m.record("GetServer")
+	if m.GetServerFunc == nil {
+		var r0 *client.Server
+		return r0, notMocked("GetServer")
+	}
+	return m.GetServerFunc(id)
+}
+
+// GetServerSetupCommand calls GetServerSetupCommandFunc.
+func (m *Client) GetServerSetupCommand(serverID string) (string, error) {
+	m.record("GetServerSetupCommand")
+	if m.GetServerSetupCommandFunc == nil {
+		var r0 string
+		return r0, notMocked("GetServerSetupCommand")
+	}
+	return m.GetServerSetupCommandFunc(serverID)
+}
+
+// GetServerMetrics calls GetServerMetricsFunc.
+func (m *Client) GetServerMetrics(server client.Server, dataPoints int) ([]client.ServerMetric, error) {
+	m.record("GetServerMetrics")
+	if m.GetServerMetricsFunc == nil {
+		var r0 []client.ServerMetric
+		return r0, notMocked("GetServerMetrics")
+	}
+	return m.GetServerMetricsFunc(server, dataPoints)
+}
+
+// ValidateServer calls ValidateServerFunc.
+func (m *Client) ValidateServer(serverID string) (*client.ServerValidation, error) {
+	m.record("ValidateServer")
+	if m.ValidateServerFunc == nil {
+		var r0 *client.ServerValidation
+		return r0, notMocked("ValidateServer")
+	}
+	return m.ValidateServerFunc(serverID)
+}
+
+// SetupServer calls SetupServerFunc.
+func (m *Client) SetupServer(serverID string) error {
+	m.record("SetupServer")
+	if m.SetupServerFunc == nil {
+		return notMocked("SetupServer")
+	}
+	return m.SetupServerFunc(serverID)
+}
+
+// SetupServerMonitoring calls SetupServerMonitoringFunc.
+func (m *Client) SetupServerMonitoring(serverID string, port int, token string) error {
+	m.record("SetupServerMonitoring")
+	if m.SetupServerMonitoringFunc == nil {
+		return notMocked("SetupServerMonitoring")
+	}
+	return m.SetupServerMonitoringFunc(serverID, port, token)
+}
+
+// GetTraefikPorts calls GetTraefikPortsFunc.
+func (m *Client) GetTraefikPorts(serverID string) ([]client.TraefikPort, error) {
+	m.record("GetTraefikPorts")
+	if m.GetTraefikPortsFunc == nil {
+		var r0 []client.TraefikPort
+		return r0, notMocked("GetTraefikPorts")
+	}
+	return m.GetTraefikPortsFunc(serverID)
+}
+
+// UpdateTraefikPorts calls UpdateTraefikPortsFunc.
+func (m *Client) UpdateTraefikPorts(serverID string, ports []client.TraefikPort) error {
+	m.record("UpdateTraefikPorts")
+	if m.UpdateTraefikPortsFunc == nil {
+		return notMocked("UpdateTraefikPorts")
+	}
+	return m.UpdateTraefikPortsFunc(serverID, ports)
+}
+
+// CreateServer calls CreateServerFunc.
+func (m *Client) CreateServer(server client.Server) (*client.Server, error) {
+	m.record("CreateServer")
+	if m.CreateServerFunc == nil {
+		var r0 *client.Server
+		return r0, notMocked("CreateServer")
+	}
+	return m.CreateServerFunc(server)
+}
+
+// UpdateServer calls UpdateServerFunc.
+func (m *Client) UpdateServer(server client.Server) (*client.Server, error) {
+	m.record("UpdateServer")
+	if m.UpdateServerFunc == nil {
+		var r0 *client.Server
+		return r0, notMocked("UpdateServer")
+	}
+	return m.UpdateServerFunc(server)
+}
+
+// DeleteServer calls DeleteServerFunc.
+func (m *Client) DeleteServer(id string) error {
+	m.record("DeleteServer")
+	if m.DeleteServerFunc == nil {
+		return notMocked("DeleteServer")
+	}
+	return m.DeleteServerFunc(id)
+}
+
+// ListGithubProviders calls ListGithubProvidersFunc.
+func (m *Client) ListGithubProviders() ([]client.GithubProvider, error) {
+	m.record("ListGithubProviders")
+	if m.ListGithubProvidersFunc == nil {
+		var r0 []client.GithubProvider
+		return r0, notMocked("ListGithubProviders")
+	}
+	return m.ListGithubProvidersFunc()
+}
+
+// CreateGitlabProvider calls CreateGitlabProviderFunc.
+func (m *Client) CreateGitlabProvider(provider client.GitlabProvider) (*client.GitlabProvider, error) {
+	m.record("CreateGitlabProvider")
+	if m.CreateGitlabProviderFunc == nil {
+		var r0 *client.GitlabProvider
+		return r0, notMocked("CreateGitlabProvider")
+	}
+	return m.CreateGitlabProviderFunc(provider)
+}
+
+// GetGitlabProvider calls GetGitlabProviderFunc.
+func (m *Client) GetGitlabProvider(id string) (*client.GitlabProvider, error) {
+	m.record("GetGitlabProvider")
+	if m.GetGitlabProviderFunc == nil {
+		var r0 *client.GitlabProvider
+		return r0, notMocked("GetGitlabProvider")
+	}
+	return m.GetGitlabProviderFunc(id)
+}
+
+// UpdateGitlabProvider calls UpdateGitlabProviderFunc.
+func (m *Client) UpdateGitlabProvider(provider client.GitlabProvider) (*client.GitlabProvider, error) {
+	m.record("UpdateGitlabProvider")
+	if m.UpdateGitlabProviderFunc == nil {
+		var r0 *client.GitlabProvider
+		return r0, notMocked("UpdateGitlabProvider")
+	}
+	return m.UpdateGitlabProviderFunc(provider)
+}
+
+// ListGitlabProviders calls ListGitlabProvidersFunc.
+func (m *Client) ListGitlabProviders() ([]client.GitlabProviderListItem, error) {
+	m.record("ListGitlabProviders")
+	if m.ListGitlabProvidersFunc == nil {
+		var r0 []client.GitlabProviderListItem
+		return r0, notMocked("ListGitlabProviders")
+	}
+	return m.ListGitlabProvidersFunc()
+}
+
+// CreateBitbucketProvider calls CreateBitbucketProviderFunc.
+func (m *Client) CreateBitbucketProvider(provider client.BitbucketProvider) (*client.BitbucketProvider, error) {
+	m.record("CreateBitbucketProvider")
+	if m.CreateBitbucketProviderFunc == nil {
+		var r0 *client.BitbucketProvider
+		return r0, notMocked("CreateBitbucketProvider")
+	}
+	return m.CreateBitbucketProviderFunc(provider)
+}
+
+// GetBitbucketProvider calls GetBitbucketProviderFunc.
+func (m *Client) GetBitbucketProvider(id string) (*client.BitbucketProvider, error) {
+	m.record("GetBitbucketProvider")
+	if m.GetBitbucketProviderFunc == nil {
+		var r0 *client.BitbucketProvider
+		return r0, notMocked("GetBitbucketProvider")
+	}
+	return m.GetBitbucketProviderFunc(id)
+}
+
+// UpdateBitbucketProvider calls UpdateBitbucketProviderFunc.
+func (m *Client) UpdateBitbucketProvider(provider client.BitbucketProvider) (*client.BitbucketProvider, error) {
+	m.record("UpdateBitbucketProvider")
+	if m.UpdateBitbucketProviderFunc == nil {
+		var r0 *client.BitbucketProvider
+		return r0, notMocked("UpdateBitbucketProvider")
+	}
+	return m.UpdateBitbucketProviderFunc(provider)
+}
+
+// ListBitbucketProviders calls ListBitbucketProvidersFunc.
+func (m *Client) ListBitbucketProviders() ([]client.BitbucketProviderListItem, error) {
+	m.record("ListBitbucketProviders")
+	if m.ListBitbucketProvidersFunc == nil {
+		var r0 []client.BitbucketProviderListItem
+		return r0, notMocked("ListBitbucketProviders")
+	}
+	return m.ListBitbucketProvidersFunc()
+}
+
+// CreateGiteaProvider calls CreateGiteaProviderFunc.
+func (m *Client) CreateGiteaProvider(provider client.GiteaProvider) (*client.GiteaProvider, error) {
+	m.record("CreateGiteaProvider")
+	if m.CreateGiteaProviderFunc == nil {
+		var r0 *client.GiteaProvider
+		return r0, notMocked("CreateGiteaProvider")
+	}
+	return m.CreateGiteaProviderFunc(provider)
+}
+
+// GetGiteaProvider calls GetGiteaProviderFunc.
+func (m *Client) GetGiteaProvider(id string) (*client.GiteaProvider, error) {
+	m.record("GetGiteaProvider")
+	if m.GetGiteaProviderFunc == nil {
+		var r0 *client.GiteaProvider
+		return r0, notMocked("GetGiteaProvider")
+	}
+	return m.GetGiteaProviderFunc(id)
+}
+
+// UpdateGiteaProvider calls UpdateGiteaProviderFunc.
+func (m *Client) UpdateGiteaProvider(provider client.GiteaProvider) (*client.GiteaProvider, error) {
+	m.record("UpdateGiteaProvider")
+	if m.UpdateGiteaProviderFunc == nil {
+		var r0 *client.GiteaProvider
+		return r0, notMocked("UpdateGiteaProvider")
+	}
+	return m.UpdateGiteaProviderFunc(provider)
+}
+
+// ListGiteaProviders calls ListGiteaProvidersFunc.
+func (m *Client) ListGiteaProviders() ([]client.GiteaProviderListItem, error) {
+	m.record("ListGiteaProviders")
+	if m.ListGiteaProvidersFunc == nil {
+		var r0 []client.GiteaProviderListItem
+		return r0, notMocked("ListGiteaProviders")
+	}
+	return m.ListGiteaProvidersFunc()
+}
+
+// DeleteGitProvider calls DeleteGitProviderFunc.
+func (m *Client) DeleteGitProvider(gitProviderId string) error {
+	m.record("DeleteGitProvider")
+	if m.DeleteGitProviderFunc == nil {
+		return notMocked("DeleteGitProvider")
+	}
+	return m.DeleteGitProviderFunc(gitProviderId)
+}
+
+// GetMountsByService calls GetMountsByServiceFunc.
+func (m *Client) GetMountsByService(serviceID string, serviceType string) ([]client.Mount, error) {
+	m.record("GetMountsByService")
+	if m.GetMountsByServiceFunc == nil {
+		var r0 []client.Mount
+		return r0, notMocked("GetMountsByService")
+	}
+	return m.GetMountsByServiceFunc(serviceID, serviceType)
+}
+
+// CreateMount calls CreateMountFunc.
+func (m *Client) CreateMount(mount client.Mount) (*client.Mount, error) {
+	m.record("CreateMount")
+	if m.CreateMountFunc == nil {
+		var r0 *client.Mount
+		return r0, notMocked("CreateMount")
+	}
+	return m.CreateMountFunc(mount)
+}
+
+// GetMount calls GetMountFunc.
+func (m *Client) GetMount(id string) (*client.Mount, error) {
+	m.record("GetMount")
+	if m.GetMountFunc == nil {
+		var r0 *client.Mount
+		return r0, notMocked("GetMount")
+	}
+	return m.GetMountFunc(id)
+}
+
+// UpdateMount calls UpdateMountFunc.
+func (m *Client) UpdateMount(mount client.Mount) (*client.Mount, error) {
+	m.record("UpdateMount")
+	if m.UpdateMountFunc == nil {
+		var r0 *client.Mount
+		return r0, notMocked("UpdateMount")
+	}
+	return m.UpdateMountFunc(mount)
+}
+
+// DeleteMount calls DeleteMountFunc.
+func (m *Client) DeleteMount(id string) error {
+	m.record("DeleteMount")
+	if m.DeleteMountFunc == nil {
+		return notMocked("DeleteMount")
+	}
+	return m.DeleteMountFunc(id)
+}
+
+// GetPortsByApplication calls GetPortsByApplicationFunc.
+func (m *Client) GetPortsByApplication(applicationID string) ([]client.Port, error) {
+	m.record("GetPortsByApplication")
+	if m.GetPortsByApplicationFunc == nil {
+		var r0 []client.Port
+		return r0, notMocked("GetPortsByApplication")
+	}
+	return m.GetPortsByApplicationFunc(applicationID)
+}
+
+// CreatePort calls CreatePortFunc.
+func (m *Client) CreatePort(port client.Port) (*client.Port, error) {
+	m.record("CreatePort")
+	if m.CreatePortFunc == nil {
+		var r0 *client.Port
+		return r0, notMocked("CreatePort")
+	}
+	return m.CreatePortFunc(port)
+}
+
+// GetPort calls GetPortFunc.
+func (m *Client) GetPort(id string) (*client.Port, error) {
+	m.record("GetPort")
+	if m.GetPortFunc == nil {
+		var r0 *client.Port
+		return r0, notMocked("GetPort")
+	}
+	return m.GetPortFunc(id)
+}
+
+// UpdatePort calls UpdatePortFunc.
+func (m *Client) UpdatePort(port client.Port) (*client.Port, error) {
+	m.record("UpdatePort")
+	if m.UpdatePortFunc == nil {
+		var r0 *client.Port
+		return r0, notMocked("UpdatePort")
+	}
+	return m.UpdatePortFunc(port)
+}
+
+// DeletePort calls DeletePortFunc.
+func (m *Client) DeletePort(id string) error {
+	m.record("DeletePort")
+	if m.DeletePortFunc == nil {
+		return notMocked("DeletePort")
+	}
+	return m.DeletePortFunc(id)
+}
+
+// GetRedirectsByApplication calls GetRedirectsByApplicationFunc.
+func (m *Client) GetRedirectsByApplication(applicationID string) ([]client.Redirect, error) {
+	m.record("GetRedirectsByApplication")
+	if m.GetRedirectsByApplicationFunc == nil {
+		var r0 []client.Redirect
+		return r0, notMocked("GetRedirectsByApplication")
+	}
+	return m.GetRedirectsByApplicationFunc(applicationID)
+}
+
+// CreateRedirect calls CreateRedirectFunc.
+func (m *Client) CreateRedirect(redirect client.Redirect) (*client.Redirect, error) {
+	m.record("CreateRedirect")
+	if m.CreateRedirectFunc == nil {
+		var r0 *client.Redirect
+		return r0, notMocked("CreateRedirect")
+	}
+	return m.CreateRedirectFunc(redirect)
+}
+
+// GetRedirect calls GetRedirectFunc.
+func (m *Client) GetRedirect(id string) (*client.Redirect, error) {
+	m.record("GetRedirect")
+	if m.GetRedirectFunc == nil {
+		var r0 *client.Redirect
+		return r0, notMocked("GetRedirect")
+	}
+	return m.GetRedirectFunc(id)
+}
+
+// UpdateRedirect calls UpdateRedirectFunc.
+func (m *Client) UpdateRedirect(redirect client.Redirect) (*client.Redirect, error) {
+	m.record("UpdateRedirect")
+	if m.UpdateRedirectFunc == nil {
+		var r0 *client.Redirect
+		return r0, notMocked("UpdateRedirect")
+	}
+	return m.UpdateRedirectFunc(redirect)
+}
+
+// DeleteRedirect calls DeleteRedirectFunc.
+func (m *Client) DeleteRedirect(id string) error {
+	m.record("DeleteRedirect")
+	if m.DeleteRedirectFunc == nil {
+		return notMocked("DeleteRedirect")
+	}
+	return m.DeleteRedirectFunc(id)
+}
+
+// CreateRegistry calls CreateRegistryFunc.
+func (m *Client) CreateRegistry(registry client.Registry) (*client.Registry, error) {
+	m.record("CreateRegistry")
+	if m.CreateRegistryFunc == nil {
+		var r0 *client.Registry
+		return r0, notMocked("CreateRegistry")
+	}
+	return m.CreateRegistryFunc(registry)
+}
+
+// GetRegistry calls GetRegistryFunc.
+func (m *Client) GetRegistry(id string) (*client.Registry, error) {
+	m.record("GetRegistry")
+	if m.GetRegistryFunc == nil {
+		var r0 *client.Registry
+		return r0, notMocked("GetRegistry")
+	}
+	return m.GetRegistryFunc(id)
+}
+
+// UpdateRegistry calls UpdateRegistryFunc.
+func (m *Client) UpdateRegistry(registry client.Registry) (*client.Registry, error) {
+	m.record("UpdateRegistry")
+	if m.UpdateRegistryFunc == nil {
+		var r0 *client.Registry
+		return r0, notMocked("UpdateRegistry")
+	}
+	return m.UpdateRegistryFunc(registry)
+}
+
+// DeleteRegistry calls DeleteRegistryFunc.
+func (m *Client) DeleteRegistry(id string) error {
+	m.record("DeleteRegistry")
+	if m.DeleteRegistryFunc == nil {
+		return notMocked("DeleteRegistry")
+	}
+	return m.DeleteRegistryFunc(id)
+}
+
+// ListRegistries calls ListRegistriesFunc.
+func (m *Client) ListRegistries() ([]client.Registry, error) {
+	m.record("ListRegistries")
+	if m.ListRegistriesFunc == nil {
+		var r0 []client.Registry
+		return r0, notMocked("ListRegistries")
+	}
+	return m.ListRegistriesFunc()
+}
+
+// TestRegistry calls TestRegistryFunc.
+func (m *Client) TestRegistry(registry client.Registry) error {
+	m.record("TestRegistry")
+	if m.TestRegistryFunc == nil {
+		return notMocked("TestRegistry")
+	}
+	return m.TestRegistryFunc(registry)
+}
+
+// CreateDestination calls CreateDestinationFunc.
+func (m *Client) CreateDestination(dest client.Destination) (*client.Destination, error) {
+	m.record("CreateDestination")
+	if m.CreateDestinationFunc == nil {
+		var r0 *client.Destination
+		return r0, notMocked("CreateDestination")
+	}
+	return m.CreateDestinationFunc(dest)
+}
+
+// GetDestination calls GetDestinationFunc.
+func (m *Client) GetDestination(id string) (*client.Destination, error) {
+	m.record("GetDestination")
+	if m.GetDestinationFunc == nil {
+		var r0 *client.Destination
+		return r0, notMocked("GetDestination")
+	}
+	return m.GetDestinationFunc(id)
+}
+
+// UpdateDestination calls UpdateDestinationFunc.
+func (m *Client) UpdateDestination(dest client.Destination) (*client.Destination, error) {
+	m.record("UpdateDestination")
+	if m.UpdateDestinationFunc == nil {
+		var r0 *client.Destination
+		return r0, notMocked("UpdateDestination")
+	}
+	return m.UpdateDestinationFunc(dest)
+}
+
+// DeleteDestination calls DeleteDestinationFunc.
+func (m *Client) DeleteDestination(id string) error {
+	m.record("DeleteDestination")
+	if m.DeleteDestinationFunc == nil {
+		return notMocked("DeleteDestination")
+	}
+	return m.DeleteDestinationFunc(id)
+}
+
+// ListDestinations calls ListDestinationsFunc.
+func (m *Client) ListDestinations() ([]client.Destination, error) {
+	m.record("ListDestinations")
+	if m.ListDestinationsFunc == nil {
+		var r0 []client.Destination
+		return r0, notMocked("ListDestinations")
+	}
+	return m.ListDestinationsFunc()
+}
+
+// CreateBackup calls CreateBackupFunc.
+func (m *Client) CreateBackup(backup client.Backup) (*client.Backup, error) {
+	m.record("CreateBackup")
+	if m.CreateBackupFunc == nil {
+		var r0 *client.Backup
+		return r0, notMocked("CreateBackup")
+	}
+	return m.CreateBackupFunc(backup)
+}
+
+// GetBackup calls GetBackupFunc.
+func (m *Client) GetBackup(id string) (*client.Backup, error) {
+	m.record("GetBackup")
+	if m.GetBackupFunc == nil {
+		var r0 *client.Backup
+		return r0, notMocked("GetBackup")
+	}
+	return m.GetBackupFunc(id)
+}
+
+// UpdateBackup calls UpdateBackupFunc.
+func (m *Client) UpdateBackup(backup client.Backup) (*client.Backup, error) {
+	m.record("UpdateBackup")
+	if m.UpdateBackupFunc == nil {
+		var r0 *client.Backup
+		return r0, notMocked("UpdateBackup")
+	}
+	return m.UpdateBackupFunc(backup)
+}
+
+// DeleteBackup calls DeleteBackupFunc.
+func (m *Client) DeleteBackup(id string) error {
+	m.record("DeleteBackup")
+	if m.DeleteBackupFunc == nil {
+		return notMocked("DeleteBackup")
+	}
+	return m.DeleteBackupFunc(id)
+}
+
+// ListBackupFiles calls ListBackupFilesFunc.
+func (m *Client) ListBackupFiles(destinationID string, search string, serverID string) ([]client.BackupFile, error) {
+	m.record("ListBackupFiles")
+	if m.ListBackupFilesFunc == nil {
+		var r0 []client.BackupFile
+		return r0, notMocked("ListBackupFiles")
+	}
+	return m.ListBackupFilesFunc(destinationID, search, serverID)
+}
+
+// GetBackupsByDatabaseID calls GetBackupsByDatabaseIDFunc.
+func (m *Client) GetBackupsByDatabaseID(databaseID string, databaseType string) ([]client.Backup, error) {
+	m.record("GetBackupsByDatabaseID")
+	if m.GetBackupsByDatabaseIDFunc == nil {
+		var r0 []client.Backup
+		return r0, notMocked("GetBackupsByDatabaseID")
+	}
+	return m.GetBackupsByDatabaseIDFunc(databaseID, databaseType)
+}
+
+// GetBackupsByComposeID calls GetBackupsByComposeIDFunc.
+func (m *Client) GetBackupsByComposeID(composeID string) ([]client.Backup, error) {
+	m.record("GetBackupsByComposeID")
+	if m.GetBackupsByComposeIDFunc == nil {
+		var r0 []client.Backup
+		return r0, notMocked("GetBackupsByComposeID")
+	}
+	return m.GetBackupsByComposeIDFunc(composeID)
+}
+
+// RunBackup calls RunBackupFunc.
+func (m *Client) RunBackup(backupID string, backupType string) error {
+	m.record("RunBackup")
+	if m.RunBackupFunc == nil {
+		return notMocked("RunBackup")
+	}
+	return m.RunBackupFunc(backupID, backupType)
+}
+
+// CreateOrganization calls CreateOrganizationFunc.
+func (m *Client) CreateOrganization(org client.Organization) (*client.Organization, error) {
+	m.record("CreateOrganization")
+	if m.CreateOrganizationFunc == nil {
+		var r0 *client.Organization
+		return r0, notMocked("CreateOrganization")
+	}
+	return m.CreateOrganizationFunc(org)
+}
+
+// GetOrganization calls GetOrganizationFunc.
+func (m *Client) GetOrganization(id string) (*client.Organization, error) {
+	m.record("GetOrganization")
+	if m.GetOrganizationFunc == nil {
+		var r0 *client.Organization
+		return r0, notMocked("GetOrganization")
+	}
+	return m.GetOrganizationFunc(id)
+}
+
+// UpdateOrganization calls UpdateOrganizationFunc.
+func (m *Client) UpdateOrganization(org client.Organization) (*client.Organization, error) {
+	m.record("UpdateOrganization")
+	if m.UpdateOrganizationFunc == nil {
+		var r0 *client.Organization
+		return r0, notMocked("UpdateOrganization")
+	}
+	return m.UpdateOrganizationFunc(org)
+}
+
+// DeleteOrganization calls DeleteOrganizationFunc.
+func (m *Client) DeleteOrganization(id string) error {
+	m.record("DeleteOrganization")
+	if m.DeleteOrganizationFunc == nil {
+		return notMocked("DeleteOrganization")
+	}
+	return m.DeleteOrganizationFunc(id)
+}
+
+// ListOrganizations calls ListOrganizationsFunc.
+func (m *Client) ListOrganizations() ([]client.Organization, error) {
+	m.record("ListOrganizations")
+	if m.ListOrganizationsFunc == nil {
+		var r0 []client.Organization
+		return r0, notMocked("ListOrganizations")
+	}
+	return m.ListOrganizationsFunc()
+}
+
+// GetCurrentOrganizationID calls GetCurrentOrganizationIDFunc.
+func (m *Client) GetCurrentOrganizationID() (string, error) {
+	m.record("GetCurrentOrganizationID")
+	if m.GetCurrentOrganizationIDFunc == nil {
+		var r0 string
+		return r0, notMocked("GetCurrentOrganizationID")
+	}
+	return m.GetCurrentOrganizationIDFunc()
+}
+
+// ListInvitations calls ListInvitationsFunc.
+func (m *Client) ListInvitations() ([]client.Invitation, error) {
+	m.record("ListInvitations")
+	if m.ListInvitationsFunc == nil {
+		var r0 []client.Invitation
+		return r0, notMocked("ListInvitations")
+	}
+	return m.ListInvitationsFunc()
+}
+
+// CreateVolumeBackup calls CreateVolumeBackupFunc.
+func (m *Client) CreateVolumeBackup(backup client.VolumeBackup) (*client.VolumeBackup, error) {
+	m.record("CreateVolumeBackup")
+	if m.CreateVolumeBackupFunc == nil {
+		var r0 *client.VolumeBackup
+		return r0, notMocked("CreateVolumeBackup")
+	}
+	return m.CreateVolumeBackupFunc(backup)
+}
+
+// GetVolumeBackup calls GetVolumeBackupFunc.
+func (m *Client) GetVolumeBackup(id string) (*client.VolumeBackup, error) {
+	m.record("GetVolumeBackup")
+	if m.GetVolumeBackupFunc == nil {
+		var r0 *client.VolumeBackup
+		return r0, notMocked("GetVolumeBackup")
+	}
+	return m.GetVolumeBackupFunc(id)
+}
+
+// UpdateVolumeBackup calls UpdateVolumeBackupFunc.
+func (m *Client) UpdateVolumeBackup(backup client.VolumeBackup) (*client.VolumeBackup, error) {
+	m.record("UpdateVolumeBackup")
+	if m.UpdateVolumeBackupFunc == nil {
+		var r0 *client.VolumeBackup
+		return r0, notMocked("UpdateVolumeBackup")
+	}
+	return m.UpdateVolumeBackupFunc(backup)
+}
+
+// DeleteVolumeBackup calls DeleteVolumeBackupFunc.
+func (m *Client) DeleteVolumeBackup(id string) error {
+	m.record("DeleteVolumeBackup")
+	if m.DeleteVolumeBackupFunc == nil {
+		return notMocked("DeleteVolumeBackup")
+	}
+	return m.DeleteVolumeBackupFunc(id)
+}
+
+// ListVolumeBackups calls ListVolumeBackupsFunc.
+func (m *Client) ListVolumeBackups(serviceID string, serviceType string) ([]client.VolumeBackup, error) {
+	m.record("ListVolumeBackups")
+	if m.ListVolumeBackupsFunc == nil {
+		var r0 []client.VolumeBackup
+		return r0, notMocked("ListVolumeBackups")
+	}
+	return m.ListVolumeBackupsFunc(serviceID, serviceType)
+}
+
+// CreateSchedule calls CreateScheduleFunc.
+func (m *Client) CreateSchedule(schedule client.Schedule) (*client.Schedule, error) {
+	m.record("CreateSchedule")
+	if m.CreateScheduleFunc == nil {
+		var r0 *client.Schedule
+		return r0, notMocked("CreateSchedule")
+	}
+	return m.CreateScheduleFunc(schedule)
+}
+
+// GetSchedule calls GetScheduleFunc.
+func (m *Client) GetSchedule(id string) (*client.Schedule, error) {
+	m.record("GetSchedule")
+	if m.GetScheduleFunc == nil {
+		var r0 *client.Schedule
+		return r0, notMocked("GetSchedule")
+	}
+	return m.GetScheduleFunc(id)
+}
+
+// UpdateSchedule calls UpdateScheduleFunc.
+func (m *Client) UpdateSchedule(schedule client.Schedule) (*client.Schedule, error) {
+	m.record("UpdateSchedule")
+	if m.UpdateScheduleFunc == nil {
+		var r0 *client.Schedule
+		return r0, notMocked("UpdateSchedule")
+	}
+	return m.UpdateScheduleFunc(schedule)
+}
+
+// DeleteSchedule calls DeleteScheduleFunc.
+func (m *Client) DeleteSchedule(id string) error {
+	m.record("DeleteSchedule")
+	if m.DeleteScheduleFunc == nil {
+		return notMocked("DeleteSchedule")
+	}
+	return m.DeleteScheduleFunc(id)
+}
+
+// ListSchedules calls ListSchedulesFunc.
+func (m *Client) ListSchedules(id string, scheduleType string) ([]client.Schedule, error) {
+	m.record("ListSchedules")
+	if m.ListSchedulesFunc == nil {
+		var r0 []client.Schedule
+		return r0, notMocked("ListSchedules")
+	}
+	return m.ListSchedulesFunc(id, scheduleType)
+}
+
+// ListDeploymentsByType calls ListDeploymentsByTypeFunc.
+func (m *Client) ListDeploymentsByType(id string, deploymentType string) ([]client.Deployment, error) {
+	m.record("ListDeploymentsByType")
+	if m.ListDeploymentsByTypeFunc == nil {
+		var r0 []client.Deployment
+		return r0, notMocked("ListDeploymentsByType")
+	}
+	return m.ListDeploymentsByTypeFunc(id, deploymentType)
+}
+
+// ReadDeploymentLog calls ReadDeploymentLogFunc.
+func (m *Client) ReadDeploymentLog(logPath string, serverID string) (string, error) {
+	m.record("ReadDeploymentLog")
+	if m.ReadDeploymentLogFunc == nil {
+		var r0 string
+		return r0, notMocked("ReadDeploymentLog")
+	}
+	return m.ReadDeploymentLogFunc(logPath, serverID)
+}
+
+// ListDockerVolumes calls ListDockerVolumesFunc.
+func (m *Client) ListDockerVolumes(serverID string) ([]client.DockerVolume, error) {
+	m.record("ListDockerVolumes")
+	if m.ListDockerVolumesFunc == nil {
+		var r0 []client.DockerVolume
+		return r0, notMocked("ListDockerVolumes")
+	}
+	return m.ListDockerVolumesFunc(serverID)
+}
+
+// ListSwarmServices calls ListSwarmServicesFunc.
+func (m *Client) ListSwarmServices(serverID string) ([]client.SwarmService, error) {
+	m.record("ListSwarmServices")
+	if m.ListSwarmServicesFunc == nil {
+		var r0 []client.SwarmService
+		return r0, notMocked("ListSwarmServices")
+	}
+	return m.ListSwarmServicesFunc(serverID)
+}
+
+// ListComposeContainers calls ListComposeContainersFunc.
+func (m *Client) ListComposeContainers(appName string, composeType string, serverID string) ([]client.Container, error) {
+	m.record("ListComposeContainers")
+	if m.ListComposeContainersFunc == nil {
+		var r0 []client.Container
+		return r0, notMocked("ListComposeContainers")
+	}
+	return m.ListComposeContainersFunc(appName, composeType, serverID)
+}
+
+// ReadMiddlewareTraefikConfig calls ReadMiddlewareTraefikConfigFunc.
+func (m *Client) ReadMiddlewareTraefikConfig(serverID string) (string, error) {
+	m.record("ReadMiddlewareTraefikConfig")
+	if m.ReadMiddlewareTraefikConfigFunc == nil {
+		var r0 string
+		return r0, notMocked("ReadMiddlewareTraefikConfig")
+	}
+	return m.ReadMiddlewareTraefikConfigFunc(serverID)
+}
+
+// UpdateMiddlewareTraefikConfig calls UpdateMiddlewareTraefikConfigFunc.
+func (m *Client) UpdateMiddlewareTraefikConfig(serverID string, traefikConfig string) error {
+	m.record("UpdateMiddlewareTraefikConfig")
+	if m.UpdateMiddlewareTraefikConfigFunc == nil {
+		return notMocked("UpdateMiddlewareTraefikConfig")
+	}
+	return m.UpdateMiddlewareTraefikConfigFunc(serverID, traefikConfig)
+}
@@ -0,0 +1,38 @@
+// Package clientmock provides a mock client.Client for unit testing resources
+// without a live Dokploy server.
+package clientmock
+
+import (
+	"errors"
+	"fmt"
+)
+
+//go:generate go run ./gen -source ../interface.go -out client.go
+
+// ErrNotMocked is returned by methods whose Func field has not been set.
+var ErrNotMocked = errors.New("method not mocked")
+
+func notMocked(name string) error {
+	return fmt.Errorf("%w: %s", ErrNotMocked, name)
+}
+
+// New returns an empty mock client.
+func New() *Client {
+	return &Client{}
+}
+
+func (m *Client) record(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.calls == nil {
+		m.calls = make(map[string]int)
+	}
+	m.calls[name]++
+}
+
+// Calls returns how many times the named method has been called.
+func (m *Client) Calls(name string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[name]
+}
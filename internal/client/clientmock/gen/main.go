@@ -0,0 +1,193 @@
+// Command gen generates clientmock.Client from the interfaces declared in
+// internal/client/interface.go.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type param struct {
+	name string
+	typ  string
+}
+
+func main() {
+	source := flag.String("source", "../interface.go", "file declaring the client interfaces")
+	out := flag.String("out", "client.go", "output file")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *source, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var methods []method
+	ast.Inspect(file, func(n ast.Node) bool {
+		iface, ok := n.(*ast.InterfaceType)
+		if !ok {
+			return true
+		}
+		for _, field := range iface.Methods.List {
+			fn, ok := field.Type.(*ast.FuncType)
+			if !ok {
+				continue // embedded interface
+			}
+			methods = append(methods, parseMethod(fset, field.Names[0].Name, fn))
+		}
+		return false
+	})
+
+	src, err := format.Source(render(methods))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseMethod(fset *token.FileSet, name string, fn *ast.FuncType) method {
+	m := method{name: name}
+	i := 0
+	for _, field := range fn.Params.List {
+		typ := typeString(fset, field.Type)
+		if len(field.Names) == 0 {
+			m.params = append(m.params, param{fmt.Sprintf("p%d", i), typ})
+			i++
+			continue
+		}
+		for _, n := range field.Names {
+			m.params = append(m.params, param{n.Name, typ})
+			i++
+		}
+	}
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			m.results = append(m.results, typeString(fset, field.Type))
+		}
+	}
+	return m
+}
+
+// typeString prints a type expression, qualifying exported identifiers with
+// the client package.
+func typeString(fset *token.FileSet, expr ast.Expr) string {
+	expr = qualify(expr)
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+func qualify(expr ast.Expr) ast.Expr {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return &ast.SelectorExpr{X: ast.NewIdent("client"), Sel: ast.NewIdent(t.Name)}
+		}
+	case *ast.StarExpr:
+		t.X = qualify(t.X)
+	case *ast.ArrayType:
+		t.Elt = qualify(t.Elt)
+	case *ast.MapType:
+		t.Key = qualify(t.Key)
+		t.Value = qualify(t.Value)
+	case *ast.FuncType:
+		for _, f := range t.Params.List {
+			f.Type = qualify(f.Type)
+		}
+		if t.Results != nil {
+			for _, f := range t.Results.List {
+				f.Type = qualify(f.Type)
+			}
+		}
+	}
+	return expr
+}
+
+func render(methods []method) []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen. DO NOT EDIT.\n\n")
+	b.WriteString("package clientmock\n\n")
+	b.WriteString("import (\n\t\"sync\"\n\n\t\"github.com/ahmedali6/terraform-provider-dokploy/internal/client\"\n)\n\n")
+	b.WriteString("var _ client.Client = (*Client)(nil)\n\n")
+	b.WriteString("// Client is a mock implementation of client.Client. Each method delegates to\n")
+	b.WriteString("// the matching Func field and fails with ErrNotMocked when it is unset.\n")
+	b.WriteString("type Client struct {\n")
+	b.WriteString("\tmu    sync.Mutex\n\tcalls map[string]int\n\n")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\t%sFunc func(%s) %s\n", m.name, paramList(m.params), resultList(m.results))
+	}
+	b.WriteString("}\n\n")
+
+	for _, m := range methods {
+		names := make([]string, len(m.params))
+		for i, p := range m.params {
+			names[i] = p.name
+		}
+
+		fmt.Fprintf(&b, "// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(&b, "func (m *Client) %s(%s) %s {\n", m.name, paramList(m.params), resultList(m.results))
+		fmt.Fprintf(&b, "\tm.record(%q)\n", m.name)
+		fmt.Fprintf(&b, "\tif m.%sFunc == nil {\n", m.name)
+		var zero []string
+		for i, r := range m.results {
+			if r == "error" {
+				zero = append(zero, fmt.Sprintf("notMocked(%q)", m.name))
+				continue
+			}
+			fmt.Fprintf(&b, "\t\tvar r%d %s\n", i, r)
+			zero = append(zero, fmt.Sprintf("r%d", i))
+		}
+		if len(zero) > 0 {
+			fmt.Fprintf(&b, "\t\treturn %s\n", strings.Join(zero, ", "))
+		} else {
+			b.WriteString("\t\treturn\n")
+		}
+		b.WriteString("\t}\n")
+		call := fmt.Sprintf("m.%sFunc(%s)", m.name, strings.Join(names, ", "))
+		if len(m.results) > 0 {
+			fmt.Fprintf(&b, "\treturn %s\n", call)
+		} else {
+			fmt.Fprintf(&b, "\t%s\n", call)
+		}
+		b.WriteString("}\n\n")
+	}
+	return b.Bytes()
+}
+
+func paramList(params []param) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		parts[i] = p.name + " " + p.typ
+	}
+	return strings.Join(parts, ", ")
+}
+
+func resultList(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return results[0]
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
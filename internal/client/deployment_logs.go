@@ -0,0 +1,198 @@
+package client
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Bounds for ReadDeploymentLog. Dokploy streams the log by tailing the file
+// and never closes the socket, so reading stops once the log goes quiet.
+var (
+	deploymentLogIdle    = 2 * time.Second
+	deploymentLogTimeout = 15 * time.Second
+)
+
+// ReadDeploymentLog returns the build log of a deployment. Dokploy only
+// exposes logs over the websocket its UI uses, so this opens that socket,
+// collects what the server sends until it goes quiet, and closes it. An
+// empty serverID reads logs stored on the Dokploy host.
+func (c *DokployClient) ReadDeploymentLog(logPath, serverID string) (string, error) {
+	base, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	params := url.Values{"logPath": {logPath}}
+	if serverID != "" {
+		params.Set("serverId", serverID)
+	}
+	target := &url.URL{
+		Scheme:   base.Scheme,
+		Host:     base.Host,
+		Path:     strings.TrimSuffix(strings.TrimSuffix(base.Path, "/"), "/api") + "/listen-deployment",
+		RawQuery: params.Encode(),
+	}
+
+	conn, err := c.dialWebsocket(target)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	var log strings.Builder
+	deadline := time.Now().Add(deploymentLogTimeout)
+	for {
+		idle := time.Now().Add(deploymentLogIdle)
+		if idle.After(deadline) {
+			idle = deadline
+		}
+		if err := conn.SetReadDeadline(idle); err != nil {
+			return log.String(), err
+		}
+
+		opcode, payload, err := readWebsocketFrame(conn.reader)
+		if err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, io.EOF) {
+				return log.String(), nil
+			}
+			return log.String(), err
+		}
+		switch opcode {
+		case wsText, wsContinuation:
+			log.Write(payload)
+		case wsClose:
+			return log.String(), nil
+		}
+	}
+}
+
+// Websocket opcodes the log reader handles; other control frames are
+// ignored.
+const (
+	wsContinuation = 0x0
+	wsText         = 0x1
+	wsClose        = 0x8
+)
+
+// wsConn is a websocket connection that is only ever read from.
+type wsConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+// dialWebsocket opens a websocket to target, authenticating with the API key.
+func (c *DokployClient) dialWebsocket(target *url.URL) (*wsConn, error) {
+	addr := target.Host
+	if target.Port() == "" {
+		if target.Scheme == "https" {
+			addr += ":443"
+		} else {
+			addr += ":80"
+		}
+	}
+
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	var conn net.Conn
+	var err error
+	if target.Scheme == "https" {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{ServerName: target.Hostname()})
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	requestID := newRequestID()
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        target,
+		Host:       target.Host,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Upgrade":               {"websocket"},
+			"Connection":            {"Upgrade"},
+			"Sec-Websocket-Key":     {base64.StdEncoding.EncodeToString(nonce)},
+			"Sec-Websocket-Version": {"13"},
+			"X-Api-Key":             {c.APIKey},
+			requestIDHeader:         {requestID},
+		},
+	}
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		conn.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, string(body))
+		}
+		return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: string(body), RequestID: requestID}
+	}
+
+	return &wsConn{Conn: conn, reader: reader}, nil
+}
+
+// readWebsocketFrame reads one frame sent by the server, which per RFC 6455
+// is never masked.
+func readWebsocketFrame(r *bufio.Reader) (byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	opcode := header[0] & 0x0f
+
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > 16<<20 {
+		return 0, nil, fmt.Errorf("websocket frame of %d bytes is too large", length)
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	return opcode, payload, nil
+}
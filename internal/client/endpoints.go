@@ -0,0 +1,301 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// endpointShape describes how the arguments of a procedure are sent.
+type endpointShape int
+
+const (
+	// jsonBody sends the arguments as a JSON request body.
+	jsonBody endpointShape = iota
+	// queryParams sends the arguments as URL query parameters.
+	queryParams
+)
+
+// endpoint is the HTTP method and argument shape of a Dokploy procedure.
+type endpoint struct {
+	method string
+	shape  endpointShape
+}
+
+var (
+	getQuery = endpoint{method: http.MethodGet, shape: queryParams}
+	postJSON = endpoint{method: http.MethodPost, shape: jsonBody}
+)
+
+// endpoints maps every Dokploy procedure the client calls to how it is
+// requested. Procedures that use other verbs, such as PUT or DELETE, are
+// registered with an explicit endpoint value.
+var endpoints = map[string]endpoint{
+	"ai.create":    postJSON,
+	"ai.delete":    postJSON,
+	"ai.get":       getQuery,
+	"ai.getAll":    getQuery,
+	"ai.getModels": getQuery,
+	"ai.update":    postJSON,
+
+	"application.create":                postJSON,
+	"application.deploy":                postJSON,
+	"application.move":                  postJSON,
+	"application.one":                   getQuery,
+	"application.readTraefikConfig":     getQuery,
+	"application.redeploy":              postJSON,
+	"application.refreshToken":          postJSON,
+	"application.remove":                postJSON,
+	"application.saveBitbucketProvider": postJSON,
+	"application.saveBuildType":         postJSON,
+	"application.saveDockerProvider":    postJSON,
+	"application.saveEnvironment":       postJSON,
+	"application.saveGitProvider":       postJSON,
+	"application.saveGiteaProvider":     postJSON,
+	"application.saveGithubProvider":    postJSON,
+	"application.saveGitlabProvider":    postJSON,
+	"application.start":                 postJSON,
+	"application.stop":                  postJSON,
+	"application.update":                postJSON,
+	"application.updateTraefikConfig":   postJSON,
+
+	"backup.create":               postJSON,
+	"backup.listBackupFiles":      getQuery,
+	"backup.manualBackupCompose":  postJSON,
+	"backup.manualBackupMariadb":  postJSON,
+	"backup.manualBackupMongo":    postJSON,
+	"backup.manualBackupMySql":    postJSON,
+	"backup.manualBackupPostgres": postJSON,
+	"backup.one":                  getQuery,
+	"backup.remove":               postJSON,
+	"backup.update":               postJSON,
+
+	"bitbucket.bitbucketProviders": getQuery,
+	"bitbucket.create":             postJSON,
+	"bitbucket.one":                getQuery,
+	"bitbucket.update":             postJSON,
+
+	"certificates.all":    getQuery,
+	"certificates.create": postJSON,
+	"certificates.one":    getQuery,
+	"certificates.remove": postJSON,
+
+	"compose.create":       postJSON,
+	"compose.deploy":       postJSON,
+	"compose.move":         postJSON,
+	"compose.one":          getQuery,
+	"compose.redeploy":     postJSON,
+	"compose.refreshToken": postJSON,
+	"compose.remove":       postJSON,
+	"compose.update":       postJSON,
+
+	"deployment.allByType": getQuery,
+
+	"destination.all":    getQuery,
+	"destination.create": postJSON,
+	"destination.one":    getQuery,
+	"destination.remove": postJSON,
+	"destination.update": postJSON,
+
+	"docker.getContainersByAppNameMatch": getQuery,
+	"docker.getVolumes":                  getQuery,
+
+	"domain.create":         postJSON,
+	"domain.generateDomain": postJSON,
+	"domain.one":            getQuery,
+	"domain.remove":         postJSON,
+	"domain.update":         postJSON,
+
+	"environment.create": postJSON,
+	"environment.one":    getQuery,
+	"environment.remove": postJSON,
+	"environment.update": postJSON,
+
+	"gitProvider.remove": postJSON,
+
+	"gitea.create":         postJSON,
+	"gitea.giteaProviders": getQuery,
+	"gitea.one":            getQuery,
+	"gitea.update":         postJSON,
+
+	"github.githubProviders": getQuery,
+
+	"gitlab.create":          postJSON,
+	"gitlab.gitlabProviders": getQuery,
+	"gitlab.one":             getQuery,
+	"gitlab.update":          postJSON,
+
+	"mariadb.create": postJSON,
+	"mariadb.deploy": postJSON,
+	"mariadb.one":    getQuery,
+	"mariadb.remove": postJSON,
+	"mariadb.update": postJSON,
+
+	"mongo.create": postJSON,
+	"mongo.deploy": postJSON,
+	"mongo.one":    getQuery,
+	"mongo.remove": postJSON,
+	"mongo.update": postJSON,
+
+	"mounts.create": postJSON,
+	"mounts.one":    getQuery,
+	"mounts.remove": postJSON,
+	"mounts.update": postJSON,
+
+	"mysql.create": postJSON,
+	"mysql.deploy": postJSON,
+	"mysql.one":    getQuery,
+	"mysql.remove": postJSON,
+	"mysql.update": postJSON,
+
+	"organization.all":            getQuery,
+	"organization.allInvitations": getQuery,
+	"organization.create":         postJSON,
+	"organization.delete":         postJSON,
+	"organization.one":            getQuery,
+	"organization.update":         postJSON,
+
+	"port.create": postJSON,
+	"port.delete": postJSON,
+	"port.one":    getQuery,
+	"port.update": postJSON,
+
+	"postgres.create": postJSON,
+	"postgres.deploy": postJSON,
+	"postgres.one":    getQuery,
+	"postgres.remove": postJSON,
+	"postgres.update": postJSON,
+
+	"project.all":    getQuery,
+	"project.create": postJSON,
+	"project.one":    getQuery,
+	"project.remove": postJSON,
+	"project.update": postJSON,
+
+	"redirects.create": postJSON,
+	"redirects.delete": postJSON,
+	"redirects.one":    getQuery,
+	"redirects.update": postJSON,
+
+	"redis.create": postJSON,
+	"redis.deploy": postJSON,
+	"redis.one":    getQuery,
+	"redis.remove": postJSON,
+	"redis.update": postJSON,
+
+	"registry.all":          getQuery,
+	"registry.create":       postJSON,
+	"registry.one":          getQuery,
+	"registry.remove":       postJSON,
+	"registry.testRegistry": postJSON,
+	"registry.update":       postJSON,
+
+	"schedule.create": postJSON,
+	"schedule.delete": postJSON,
+	"schedule.list":   getQuery,
+	"schedule.one":    getQuery,
+	"schedule.update": postJSON,
+
+	"server.all":               getQuery,
+	"server.create":            postJSON,
+	"server.getDefaultCommand": getQuery,
+	"server.getServerMetrics":  getQuery,
+	"server.one":               getQuery,
+	"server.remove":            postJSON,
+	"server.setup":             postJSON,
+	"server.setupMonitoring":   postJSON,
+	"server.update":            postJSON,
+	"server.validate":          getQuery,
+
+	"settings.getDokployVersion":             getQuery,
+	"settings.getTraefikPorts":               getQuery,
+	"settings.isCloud":                       getQuery,
+	"settings.readMiddlewareTraefikConfig":   getQuery,
+	"settings.updateMiddlewareTraefikConfig": postJSON,
+	"settings.updateTraefikPorts":            postJSON,
+
+	"sshKey.all":    getQuery,
+	"sshKey.create": postJSON,
+	"sshKey.one":    getQuery,
+	"sshKey.remove": postJSON,
+	"sshKey.update": postJSON,
+
+	"swarm.getNodeApps": getQuery,
+
+	"user.all":               getQuery,
+	"user.assignPermissions": postJSON,
+	"user.createApiKey":      postJSON,
+	"user.deleteApiKey":      postJSON,
+	"user.get":               getQuery,
+
+	"volumeBackups.create": postJSON,
+	"volumeBackups.delete": postJSON,
+	"volumeBackups.list":   getQuery,
+	"volumeBackups.one":    getQuery,
+	"volumeBackups.update": postJSON,
+}
+
+// call requests a registered procedure, sending params as a JSON body or
+// as query parameters according to its entry in endpoints.
+func (c *DokployClient) call(procedure string, params interface{}) ([]byte, error) {
+	spec, ok := endpoints[procedure]
+	if !ok {
+		return nil, fmt.Errorf("unknown Dokploy procedure %q", procedure)
+	}
+
+	if spec.shape == queryParams {
+		values, err := queryString(params)
+		if err != nil {
+			return nil, err
+		}
+		return c.doRequest(spec.method, procedure+values, nil)
+	}
+	if changesMembers(procedure) {
+		// Invalidate even on errors, since the write may have been applied.
+		defer c.invalidateMembers()
+	}
+	return c.doRequest(spec.method, procedure, params)
+}
+
+// changesMembers reports whether a write procedure may change organization
+// members, their roles or their permissions.
+func changesMembers(procedure string) bool {
+	return strings.HasPrefix(procedure, "user.") || strings.HasPrefix(procedure, "organization.")
+}
+
+// queryString encodes params as a query string, including the leading '?'.
+// params is marshalled to JSON first so struct tags apply; strings are sent
+// as is, nulls are omitted and other values are sent JSON-encoded.
+func queryString(params interface{}) (string, error) {
+	if params == nil {
+		return "", nil
+	}
+
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return "", fmt.Errorf("query parameters must be an object: %w", err)
+	}
+
+	values := url.Values{}
+	for key, value := range fields {
+		var s string
+		switch {
+		case string(value) == "null":
+			continue
+		case json.Unmarshal(value, &s) == nil:
+			values.Set(key, s)
+		default:
+			values.Set(key, string(value))
+		}
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	return "?" + values.Encode(), nil
+}
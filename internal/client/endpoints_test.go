@@ -0,0 +1,104 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestEndpointsRegisterEveryProcedure(t *testing.T) {
+	src, err := os.ReadFile("client.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Procedures are either passed to c.call directly or chosen per service
+	// type through an endpoint variable.
+	calls := regexp.MustCompile(`(?:c\.call\(|endpoint(?:, params)? = )"([^"]+)"`).FindAllSubmatch(src, -1)
+	if len(calls) == 0 {
+		t.Fatal("found no c.call sites in client.go")
+	}
+	for _, procedure := range manualBackupEndpoints {
+		if _, ok := endpoints[procedure]; !ok {
+			t.Errorf("procedure %q is called but not registered in endpoints", procedure)
+		}
+	}
+	for _, m := range calls {
+		if _, ok := endpoints[string(m[1])]; !ok {
+			t.Errorf("procedure %q is called but not registered in endpoints", m[1])
+		}
+	}
+}
+
+func TestQueryString(t *testing.T) {
+	tests := []struct {
+		params interface{}
+		want   string
+	}{
+		{nil, ""},
+		{map[string]interface{}{}, ""},
+		{map[string]interface{}{"applicationId": "app 1"}, "?applicationId=app+1"},
+		{map[string]interface{}{"dataPoints": 50, "url": "http://10.0.0.1:4500/metrics"}, "?dataPoints=50&url=http%3A%2F%2F10.0.0.1%3A4500%2Fmetrics"},
+		{map[string]interface{}{"serverId": nil, "all": true}, "?all=true"},
+		{struct {
+			ID string `json:"composeId"`
+		}{"c-1"}, "?composeId=c-1"},
+	}
+
+	for _, tt := range tests {
+		got, err := queryString(tt.params)
+		if err != nil {
+			t.Fatalf("queryString(%v) error: %v", tt.params, err)
+		}
+		if got != tt.want {
+			t.Errorf("queryString(%v) = %q, want %q", tt.params, got, tt.want)
+		}
+	}
+
+	if _, err := queryString("not an object"); err == nil {
+		t.Error("queryString(string) succeeded, want error")
+	}
+}
+
+func TestCallUsesRegisteredMethod(t *testing.T) {
+	endpoints["test.replace"] = endpoint{method: http.MethodPut, shape: jsonBody}
+	endpoints["test.remove"] = endpoint{method: http.MethodDelete, shape: queryParams}
+	defer delete(endpoints, "test.replace")
+	defer delete(endpoints, "test.remove")
+
+	type request struct{ method, query, contentType, body string }
+	var got []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = append(got, request{r.Method, r.URL.RawQuery, r.Header.Get("Content-Type"), string(body)})
+		w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+
+	c := NewDokployClient(srv.URL, "key")
+	if _, err := c.call("test.replace", map[string]string{"id": "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.call("test.remove", map[string]string{"id": "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.call("test.unknown", nil); err == nil {
+		t.Error("call of an unregistered procedure succeeded, want error")
+	}
+
+	want := []request{
+		{http.MethodPut, "", "application/json", `{"id":"1"}`},
+		{http.MethodDelete, "id=1", "", ""},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("server got %d requests, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("request %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
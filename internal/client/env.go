@@ -0,0 +1,228 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EnvFile is a parsed KEY=VALUE env document. It keeps comments, blank
+// lines, invalid lines and the formatting of untouched entries, so changing
+// one key does not rewrite the rest of the document.
+type EnvFile struct {
+	entries []envEntry
+}
+
+// envEntry is one logical line of an env document. key is empty for
+// comments, blank lines and lines that failed to parse.
+type envEntry struct {
+	raw    string
+	key    string
+	value  string
+	export bool
+}
+
+// EnvProblem describes a line of an env document that could not be parsed.
+type EnvProblem struct {
+	Line    int
+	Message string
+}
+
+func (p EnvProblem) String() string {
+	return fmt.Sprintf("line %d: %s", p.Line, p.Message)
+}
+
+// ParseEnv parses an env document into a map. Invalid lines are skipped; use
+// ParseEnvFile to report them.
+func ParseEnv(env string) map[string]string {
+	f, _ := ParseEnvFile(env)
+	return f.Map()
+}
+
+// ParseEnvFile parses an env document. Lines may start with "export ", and
+// values may be single quoted (taken literally) or double quoted (with \n,
+// \r, \t, \" and \\ escapes, and spanning several lines). Lines that cannot
+// be parsed are kept verbatim and reported as problems.
+func ParseEnvFile(env string) (*EnvFile, []EnvProblem) {
+	f := &EnvFile{}
+	if env == "" {
+		return f, nil
+	}
+
+	var problems []EnvProblem
+	lines := strings.Split(env, "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			f.entries = append(f.entries, envEntry{raw: lines[i]})
+			continue
+		}
+
+		entry := envEntry{raw: lines[i]}
+		rest := line
+		if after, ok := strings.CutPrefix(rest, "export "); ok {
+			entry.export = true
+			rest = strings.TrimSpace(after)
+		}
+
+		key, value, ok := strings.Cut(rest, "=")
+		key = strings.TrimSpace(key)
+		switch {
+		case !ok:
+			problems = append(problems, EnvProblem{lineNo, fmt.Sprintf("%q is not in KEY=VALUE format", line)})
+			f.entries = append(f.entries, entry)
+			continue
+		case key == "" || strings.ContainsAny(key, " \t\"'"):
+			problems = append(problems, EnvProblem{lineNo, fmt.Sprintf("%q is not a valid variable name", key)})
+			f.entries = append(f.entries, entry)
+			continue
+		}
+
+		if value != "" && (value[0] == '"' || value[0] == '\'') {
+			// A quoted value may continue on the following lines.
+			quoted, end := value, i
+			parsed, trailing, closed := unquoteEnvValue(quoted)
+			for !closed && end+1 < len(lines) {
+				end++
+				quoted += "\n" + lines[end]
+				parsed, trailing, closed = unquoteEnvValue(quoted)
+			}
+			if !closed {
+				problems = append(problems, EnvProblem{lineNo, fmt.Sprintf("value of %s has no closing %c", key, value[0])})
+				f.entries = append(f.entries, entry)
+				continue
+			}
+			entry.raw = strings.Join(lines[i:end+1], "\n")
+			i = end
+			if t := strings.TrimSpace(trailing); t != "" && !strings.HasPrefix(t, "#") {
+				problems = append(problems, EnvProblem{lineNo, fmt.Sprintf("unexpected %q after the quoted value of %s", t, key)})
+				f.entries = append(f.entries, entry)
+				continue
+			}
+			value = parsed
+		}
+
+		entry.key, entry.value = key, value
+		f.entries = append(f.entries, entry)
+	}
+	return f, problems
+}
+
+// unquoteEnvValue unquotes a value starting with a quote. It returns the
+// text after the closing quote, and false when the quote is not closed.
+func unquoteEnvValue(s string) (value, trailing string, closed bool) {
+	quote := s[0]
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == quote:
+			return b.String(), s[i+1:], true
+		case c == '\\' && quote == '"' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '"', '\\':
+				b.WriteByte(s[i])
+			default:
+				b.WriteByte('\\')
+				b.WriteByte(s[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", false
+}
+
+// FormatEnvLine renders KEY=VALUE, quoting the value when it would not
+// otherwise parse back unchanged.
+func FormatEnvLine(key, value string) string {
+	quote := value != "" && (value[0] == '"' || value[0] == '\'' ||
+		value != strings.TrimSpace(value) || strings.ContainsAny(value, "\n\r"))
+	switch {
+	case !quote:
+		return key + "=" + value
+	case !strings.ContainsAny(value, "'\n\r"):
+		return key + "='" + value + "'"
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`)
+	return key + `="` + r.Replace(value) + `"`
+}
+
+// Map returns the variables of the document. When a key is repeated, the
+// last value wins.
+func (f *EnvFile) Map() map[string]string {
+	m := make(map[string]string)
+	for _, e := range f.entries {
+		if e.key != "" {
+			m[e.key] = e.value
+		}
+	}
+	return m
+}
+
+// Set updates the last occurrence of key, keeping its export prefix, or
+// appends it.
+func (f *EnvFile) Set(key, value string) {
+	for i := len(f.entries) - 1; i >= 0; i-- {
+		e := &f.entries[i]
+		if e.key != key {
+			continue
+		}
+		if e.value != value {
+			e.value = value
+			e.raw = FormatEnvLine(key, value)
+			if e.export {
+				e.raw = "export " + e.raw
+			}
+		}
+		return
+	}
+	f.entries = append(f.entries, envEntry{raw: FormatEnvLine(key, value), key: key, value: value})
+}
+
+// Delete removes every occurrence of key.
+func (f *EnvFile) Delete(key string) {
+	entries := f.entries[:0]
+	for _, e := range f.entries {
+		if e.key != key {
+			entries = append(entries, e)
+		}
+	}
+	f.entries = entries
+}
+
+// Apply makes the variables of the document match m: keys missing from m are
+// deleted, changed keys are updated in place and new keys are appended in
+// sorted order.
+func (f *EnvFile) Apply(m map[string]string) {
+	for key := range f.Map() {
+		if _, ok := m[key]; !ok {
+			f.Delete(key)
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		f.Set(key, m[key])
+	}
+}
+
+// String renders the document.
+func (f *EnvFile) String() string {
+	lines := make([]string, len(f.entries))
+	for i, e := range f.entries {
+		lines[i] = e.raw
+	}
+	return strings.Join(lines, "\n")
+}
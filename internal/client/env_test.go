@@ -0,0 +1,70 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	env := `# database
+DB_HOST=db.internal
+export DB_PORT=5432
+GREETING="hello\nworld" # trailing comment
+LITERAL='a\nb'
+MULTI="line one
+line two"
+EMPTY=
+URL=http://x/#anchor
+TYPO
+bad key=1
+OPEN="never closed`
+
+	f, problems := ParseEnvFile(env)
+	want := map[string]string{
+		"DB_HOST":  "db.internal",
+		"DB_PORT":  "5432",
+		"GREETING": "hello\nworld",
+		"LITERAL":  `a\nb`,
+		"MULTI":    "line one\nline two",
+		"EMPTY":    "",
+		"URL":      "http://x/#anchor",
+	}
+	if got := f.Map(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %#v, want %#v", got, want)
+	}
+
+	var lines []int
+	for _, p := range problems {
+		lines = append(lines, p.Line)
+	}
+	if !reflect.DeepEqual(lines, []int{10, 11, 12}) {
+		t.Errorf("problems on lines %v, want 10, 11 and 12: %v", lines, problems)
+	}
+	if f.String() != env {
+		t.Errorf("String() does not round-trip:\n%s", f.String())
+	}
+}
+
+func TestEnvFileApplyKeepsComments(t *testing.T) {
+	f, _ := ParseEnvFile("# shared\nexport A=1\n\n# b is important\nB=2\nTYPO")
+	m := f.Map()
+	m["A"] = "one"
+	delete(m, "B")
+	m["D"] = "4"
+	m["C"] = " padded "
+	f.Apply(m)
+
+	want := "# shared\nexport A=one\n\n# b is important\nTYPO\nC=' padded '\nD=4"
+	if got := f.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatEnvLineRoundTrips(t *testing.T) {
+	for _, value := range []string{"", "plain", "has space", " padded", `"quoted"`, "it's", "two\nlines", `back\slash`, "'single'\nand more"} {
+		line := FormatEnvLine("K", value)
+		if got := ParseEnv(line)["K"]; got != value {
+			t.Errorf("FormatEnvLine(%q) = %q, parses back as %q", value, line, got)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package client
+
+// Client is the set of Dokploy API operations used by the provider. It is
+// implemented by DokployClient and by clientmock.Client for tests.
+type Client interface {
+	Users
+	AIs
+	Certificates
+	Projects
+	Environments
+	Applications
+	EnvironmentVariables
+	Composes
+	Databases
+	Domains
+	SSHKeys
+	Servers
+	GitProviders
+	Mounts
+	Ports
+	Redirects
+	Registries
+	Destinations
+	Backups
+	Organizations
+	VolumeBackups
+	Schedules
+	Deployments
+	Docker
+	Traefik
+
+	// Endpoint returns the base URL of the Dokploy API.
+	Endpoint() string
+	// GetVersion returns the version reported by the Dokploy server.
+	GetVersion() (string, error)
+	// IsCloud reports whether the server is Dokploy Cloud rather than a
+	// self-hosted instance.
+	IsCloud() (bool, error)
+}
+
+var _ Client = (*DokployClient)(nil)
+
+// Users covers users, organization members, permissions and API keys.
+type Users interface {
+	GetUser() (*User, error)
+	GetCurrentMember() (*OrganizationMember, error)
+	ListMembers() ([]OrganizationMember, error)
+	GetMemberByUserID(userID string) (*OrganizationMember, error)
+	GetMemberByID(memberID string) (*OrganizationMember, error)
+	AssignUserPermissions(input UserPermissionsInput) error
+	CreateApiKey(input ApiKeyCreateInput) (*ApiKey, error)
+	DeleteApiKey(apiKeyID string) error
+	GetApiKeyByID(apiKeyID string) (*ApiKey, error)
+}
+
+// AIs covers AI provider configurations.
+type AIs interface {
+	CreateAI(name, apiURL, apiKey, model string, isEnabled bool) (*AI, error)
+	GetAI(aiID string) (*AI, error)
+	ListAIs() ([]AI, error)
+	UpdateAI(ai AI) error
+	DeleteAI(aiID string) error
+	GetAIModels(apiURL, apiKey string) ([]AIModel, error)
+}
+
+// Certificates covers TLS certificates.
+type Certificates interface {
+	CreateCertificate(cert Certificate) (*Certificate, error)
+	GetCertificate(id string) (*Certificate, error)
+	ListCertificates() ([]Certificate, error)
+	DeleteCertificate(id string) error
+}
+
+// Projects covers projects.
+type Projects interface {
+	ListProjects() ([]Project, error)
+	CreateProject(name, description string) (*Project, error)
+	GetProject(id string) (*Project, error)
+	UpdateProject(id, name, description string) (*Project, error)
+	DeleteProject(id string) error
+}
+
+// Environments covers project environments and their shared env.
+type Environments interface {
+	CreateEnvironment(projectID, name, description string) (*Environment, error)
+	GetEnvironment(id string) (*Environment, error)
+	UpdateEnvironment(env Environment) (*Environment, error)
+	SaveEnvironmentEnv(id, env string) error
+	UpdateEnvironmentEnv(id string, updateFn func(envMap map[string]string)) error
+	DeleteEnvironment(id string) error
+}
+
+// Applications covers applications and their source, build and runtime settings.
+type Applications interface {
+	CreateApplication(app Application) (*Application, error)
+	GetApplication(id string) (*Application, error)
+	UpdateApplicationGeneral(app Application) (*Application, error)
+	UpdateApplication(app Application) (*Application, error)
+	DeleteApplication(id string) error
+	DeployApplication(id string, serverId string) error
+	RedeployApplication(id string) error
+	StopApplication(id string) error
+	StartApplication(id string) error
+	RefreshApplicationToken(id string) error
+	ReadTraefikConfig(appID string) (string, error)
+	UpdateTraefikConfig(appID, traefikConfig string) error
+	MoveApplication(appID, targetEnvironmentID string) (*Application, error)
+	ListApplications() ([]Application, error)
+	ListApplicationsByEnvironment(environmentID string) ([]Application, error)
+	FindApplicationByDomain(host string) (*Application, error)
+	FindApplicationByPath(projectName, environmentName, appName string) (*Application, error)
+	FindApplicationByAppName(appName string) (*Application, error)
+	SaveBuildType(input SaveBuildTypeInput) error
+	SaveGitProvider(input SaveGitProviderInput) error
+	SaveGithubProvider(input SaveGithubProviderInput) error
+	SaveGitlabProvider(input SaveGitlabProviderInput) error
+	SaveBitbucketProvider(input SaveBitbucketProviderInput) error
+	SaveGiteaProvider(input SaveGiteaProviderInput) error
+	SaveDockerProvider(input SaveDockerProviderInput) error
+	SaveDropProvider(input SaveDropProviderInput) error
+	SaveDockerfile(appID, dockerfile string) error
+	SaveEnvironment(input SaveEnvironmentInput) error
+	ResolveImageDigest(image, username, password string) (string, error)
+}
+
+// EnvironmentVariables covers application environment variables.
+type EnvironmentVariables interface {
+	UpdateApplicationEnv(appID string, updateFn func(envMap map[string]string), createEnvFile *bool) error
+	CreateVariable(appID, key, value, scope string, createEnvFile *bool) (*EnvironmentVariable, error)
+	GetVariablesByApplication(appID string) ([]EnvironmentVariable, error)
+	DeleteVariable(id string, createEnvFile *bool) error
+}
+
+// Composes covers compose stacks.
+type Composes interface {
+	CreateCompose(comp Compose) (*Compose, error)
+	GetCompose(id string) (*Compose, error)
+	UpdateCompose(comp Compose) (*Compose, error)
+	DeleteCompose(id string) error
+	DeployCompose(id string, serverId string) error
+	RedeployCompose(id string) error
+	RefreshComposeToken(id string) error
+	MoveCompose(composeID, targetEnvironmentID string) (*Compose, error)
+	ListComposes(environmentID string) ([]Compose, error)
+	FindComposeByAppName(appName string) (*Compose, error)
+}
+
+// Databases covers the generic database API and each database engine.
+type Databases interface {
+	CreateDatabase(projectID, environmentID, name, dbType, password, dockerImage, username string) (*Database, error)
+	GetDatabase(dbID string, databaseType string) (*Database, error)
+	DeleteDatabase(id string) error
+	DeleteDatabaseWithType(id, dbType string) error
+	DeployDatabase(id, dbType string) error
+
+	CreatePostgres(postgres Postgres) (*Postgres, error)
+	GetPostgres(id string) (*Postgres, error)
+	UpdatePostgres(postgres Postgres) (*Postgres, error)
+	DeletePostgres(id string) error
+
+	CreateMySQL(mysql MySQL) (*MySQL, error)
+	GetMySQL(id string) (*MySQL, error)
+	UpdateMySQL(mysql MySQL) (*MySQL, error)
+	DeleteMySQL(id string) error
+
+	CreateMariaDB(mariadb MariaDB) (*MariaDB, error)
+	GetMariaDB(id string) (*MariaDB, error)
+	UpdateMariaDB(mariadb MariaDB) (*MariaDB, error)
+	DeleteMariaDB(id string) error
+
+	CreateMongoDB(mongo MongoDB) (*MongoDB, error)
+	GetMongoDB(id string) (*MongoDB, error)
+	UpdateMongoDB(mongo MongoDB) (*MongoDB, error)
+	DeleteMongoDB(id string) error
+
+	CreateRedis(redis Redis) (*Redis, error)
+	GetRedis(id string) (*Redis, error)
+	UpdateRedis(redis Redis) (*Redis, error)
+	DeleteRedis(id string) error
+}
+
+// Domains covers application and compose domains.
+type Domains interface {
+	CreateDomain(domain Domain) (*Domain, error)
+	GetDomain(id string) (*Domain, error)
+	GetDomainsByApplication(appID string) ([]Domain, error)
+	GetDomainsByCompose(composeID string) ([]Domain, error)
+	UpdateDomain(domain Domain) (*Domain, error)
+	DeleteDomain(id string) error
+	GenerateDomain(appName string) (string, error)
+}
+
+// SSHKeys covers SSH keys.
+type SSHKeys interface {
+	CreateSSHKey(name, description, privateKey, publicKey string) (*SSHKey, error)
+	ListSSHKeys() ([]SSHKey, error)
+	GetSSHKey(id string) (*SSHKey, error)
+	UpdateSSHKey(id, name, description string) (*SSHKey, error)
+	DeleteSSHKey(id string) error
+}
+
+// Servers covers remote deploy and build servers.
+type Servers interface {
+	ListServers() ([]Server, error)
+	GetServer(id string) (*Server, error)
+	GetServerSetupCommand(serverID string) (string, error)
+	GetServerMetrics(server Server, dataPoints int) ([]ServerMetric, error)
+	ValidateServer(serverID string) (*ServerValidation, error)
+	SetupServer(serverID string) error
+	SetupServerMonitoring(serverID string, port int, token string) error
+	GetTraefikPorts(serverID string) ([]TraefikPort, error)
+	UpdateTraefikPorts(serverID string, ports []TraefikPort) error
+	CreateServer(server Server) (*Server, error)
+	UpdateServer(server Server) (*Server, error)
+	DeleteServer(id string) error
+}
+
+// GitProviders covers GitHub, GitLab, Bitbucket and Gitea integrations.
+type GitProviders interface {
+	ListGithubProviders() ([]GithubProvider, error)
+
+	CreateGitlabProvider(provider GitlabProvider) (*GitlabProvider, error)
+	GetGitlabProvider(id string) (*GitlabProvider, error)
+	UpdateGitlabProvider(provider GitlabProvider) (*GitlabProvider, error)
+	ListGitlabProviders() ([]GitlabProviderListItem, error)
+
+	CreateBitbucketProvider(provider BitbucketProvider) (*BitbucketProvider, error)
+	GetBitbucketProvider(id string) (*BitbucketProvider, error)
+	UpdateBitbucketProvider(provider BitbucketProvider) (*BitbucketProvider, error)
+	ListBitbucketProviders() ([]BitbucketProviderListItem, error)
+
+	CreateGiteaProvider(provider GiteaProvider) (*GiteaProvider, error)
+	GetGiteaProvider(id string) (*GiteaProvider, error)
+	UpdateGiteaProvider(provider GiteaProvider) (*GiteaProvider, error)
+	ListGiteaProviders() ([]GiteaProviderListItem, error)
+
+	DeleteGitProvider(gitProviderId string) error
+}
+
+// Mounts covers volume, bind and file mounts.
+type Mounts interface {
+	GetMountsByService(serviceID, serviceType string) ([]Mount, error)
+	CreateMount(mount Mount) (*Mount, error)
+	GetMount(id string) (*Mount, error)
+	UpdateMount(mount Mount) (*Mount, error)
+	DeleteMount(id string) error
+}
+
+// Ports covers application port mappings.
+type Ports interface {
+	GetPortsByApplication(applicationID string) ([]Port, error)
+	CreatePort(port Port) (*Port, error)
+	GetPort(id string) (*Port, error)
+	UpdatePort(port Port) (*Port, error)
+	DeletePort(id string) error
+}
+
+// Redirects covers application redirects.
+type Redirects interface {
+	GetRedirectsByApplication(applicationID string) ([]Redirect, error)
+	CreateRedirect(redirect Redirect) (*Redirect, error)
+	GetRedirect(id string) (*Redirect, error)
+	UpdateRedirect(redirect Redirect) (*Redirect, error)
+	DeleteRedirect(id string) error
+}
+
+// Registries covers docker registry credentials.
+type Registries interface {
+	CreateRegistry(registry Registry) (*Registry, error)
+	GetRegistry(id string) (*Registry, error)
+	UpdateRegistry(registry Registry) (*Registry, error)
+	DeleteRegistry(id string) error
+	ListRegistries() ([]Registry, error)
+	TestRegistry(registry Registry) error
+}
+
+// Destinations covers S3 backup destinations.
+type Destinations interface {
+	CreateDestination(dest Destination) (*Destination, error)
+	GetDestination(id string) (*Destination, error)
+	UpdateDestination(dest Destination) (*Destination, error)
+	DeleteDestination(id string) error
+	ListDestinations() ([]Destination, error)
+}
+
+// Backups covers scheduled database and compose backups.
+type Backups interface {
+	CreateBackup(backup Backup) (*Backup, error)
+	GetBackup(id string) (*Backup, error)
+	UpdateBackup(backup Backup) (*Backup, error)
+	DeleteBackup(id string) error
+	ListBackupFiles(destinationID, search, serverID string) ([]BackupFile, error)
+	GetBackupsByDatabaseID(databaseID, databaseType string) ([]Backup, error)
+	GetBackupsByComposeID(composeID string) ([]Backup, error)
+	RunBackup(backupID, backupType string) error
+}
+
+// Organizations covers organizations.
+type Organizations interface {
+	CreateOrganization(org Organization) (*Organization, error)
+	GetOrganization(id string) (*Organization, error)
+	UpdateOrganization(org Organization) (*Organization, error)
+	DeleteOrganization(id string) error
+	ListOrganizations() ([]Organization, error)
+	GetCurrentOrganizationID() (string, error)
+	ListInvitations() ([]Invitation, error)
+}
+
+// VolumeBackups covers scheduled volume backups.
+type VolumeBackups interface {
+	CreateVolumeBackup(backup VolumeBackup) (*VolumeBackup, error)
+	GetVolumeBackup(id string) (*VolumeBackup, error)
+	UpdateVolumeBackup(backup VolumeBackup) (*VolumeBackup, error)
+	DeleteVolumeBackup(id string) error
+	ListVolumeBackups(serviceID, serviceType string) ([]VolumeBackup, error)
+}
+
+// Schedules covers scheduled jobs.
+type Schedules interface {
+	CreateSchedule(schedule Schedule) (*Schedule, error)
+	GetSchedule(id string) (*Schedule, error)
+	UpdateSchedule(schedule Schedule) (*Schedule, error)
+	DeleteSchedule(id string) error
+	ListSchedules(id, scheduleType string) ([]Schedule, error)
+}
+
+// Deployments covers deployment history and logs.
+type Deployments interface {
+	ListDeploymentsByType(id, deploymentType string) ([]Deployment, error)
+	ReadDeploymentLog(logPath, serverID string) (string, error)
+}
+
+// Docker covers direct queries against a server's docker daemon.
+type Docker interface {
+	ListDockerVolumes(serverID string) ([]DockerVolume, error)
+	ListSwarmServices(serverID string) ([]SwarmService, error)
+	ListComposeContainers(appName, composeType, serverID string) ([]Container, error)
+}
+
+// Traefik covers the global Traefik file provider configuration.
+type Traefik interface {
+	ReadMiddlewareTraefikConfig(serverID string) (string, error)
+	UpdateMiddlewareTraefikConfig(serverID, traefikConfig string) error
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestInfo describes a finished Dokploy API request.
+type RequestInfo struct {
+	Method string
+	// Endpoint is the procedure called, without query parameters.
+	Endpoint string
+	// RequestID is the X-Request-Id sent with the request.
+	RequestID string
+	// StatusCode is 0 when no response was received.
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// AddRequestHook registers a function called after every Dokploy API
+// request, for logging and metrics. Hooks run synchronously on the
+// requesting goroutine, so they must be cheap and safe for concurrent use.
+// Hooks must be added before the client is used.
+func (c *DokployClient) AddRequestHook(hook func(RequestInfo)) {
+	c.requestHooks = append(c.requestHooks, hook)
+}
+
+// observe passes a finished request to the request hooks.
+func (c *DokployClient) observe(method, endpoint, requestID string, statusCode int, duration time.Duration, err error) {
+	if len(c.requestHooks) == 0 {
+		return
+	}
+	endpoint, _, _ = strings.Cut(endpoint, "?")
+	info := RequestInfo{Method: method, Endpoint: endpoint, RequestID: requestID, StatusCode: statusCode, Duration: duration, Err: err}
+	for _, hook := range c.requestHooks {
+		hook(info)
+	}
+}
+
+// EndpointMetrics aggregates the requests made to one endpoint.
+type EndpointMetrics struct {
+	Requests int
+	// Errors counts failed requests. Not-found answers are expected while
+	// reading and do not count.
+	Errors int
+	Total  time.Duration
+	Max    time.Duration
+}
+
+// ErrorRate returns the fraction of requests that failed.
+func (m EndpointMetrics) ErrorRate() float64 {
+	if m.Requests == 0 {
+		return 0
+	}
+	return float64(m.Errors) / float64(m.Requests)
+}
+
+// Average returns the mean request latency.
+func (m EndpointMetrics) Average() time.Duration {
+	if m.Requests == 0 {
+		return 0
+	}
+	return m.Total / time.Duration(m.Requests)
+}
+
+// Metrics aggregates requests per endpoint. Its Record method is meant to
+// be registered with AddRequestHook.
+type Metrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*EndpointMetrics
+}
+
+// NewMetrics returns empty request metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{endpoints: map[string]*EndpointMetrics{}}
+}
+
+// Record adds a finished request to the metrics.
+func (m *Metrics) Record(info RequestInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.endpoints[info.Endpoint]
+	if !ok {
+		e = &EndpointMetrics{}
+		m.endpoints[info.Endpoint] = e
+	}
+	e.Requests++
+	if info.Err != nil && !errors.Is(info.Err, ErrNotFound) {
+		e.Errors++
+	}
+	e.Total += info.Duration
+	e.Max = max(e.Max, info.Duration)
+}
+
+// Snapshot returns a copy of the metrics, keyed by endpoint.
+func (m *Metrics) Snapshot() map[string]EndpointMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[string]EndpointMetrics, len(m.endpoints))
+	for endpoint, e := range m.endpoints {
+		snapshot[endpoint] = *e
+	}
+	return snapshot
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applicationPortModel is one entry of dokploy_application's ports.
+type applicationPortModel struct {
+	PublishedPort types.Int64  `tfsdk:"published_port"`
+	TargetPort    types.Int64  `tfsdk:"target_port"`
+	Protocol      types.String `tfsdk:"protocol"`
+	PublishMode   types.String `tfsdk:"publish_mode"`
+}
+
+// applicationRedirectModel is one entry of dokploy_application's redirects.
+type applicationRedirectModel struct {
+	Regex       types.String `tfsdk:"regex"`
+	Replacement types.String `tfsdk:"replacement"`
+	Permanent   types.Bool   `tfsdk:"permanent"`
+}
+
+// applicationMountModel is one entry of dokploy_application's mounts.
+type applicationMountModel struct {
+	Type       types.String `tfsdk:"type"`
+	MountPath  types.String `tfsdk:"mount_path"`
+	HostPath   types.String `tfsdk:"host_path"`
+	VolumeName types.String `tfsdk:"volume_name"`
+	FilePath   types.String `tfsdk:"file_path"`
+	Content    types.String `tfsdk:"content"`
+}
+
+func portsAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Optional:    true,
+		Description: "Port mappings for the application. When set, Terraform manages the full set of ports and removes any others, so do not combine it with dokploy_port resources for the same application. Removing the attribute stops managing ports and leaves the existing ones in place.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"published_port": schema.Int64Attribute{
+					Required:    true,
+					Description: "The port exposed on the host.",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"target_port": schema.Int64Attribute{
+					Required:    true,
+					Description: "The port inside the container.",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"protocol": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("tcp"),
+					Description: "Protocol: tcp or udp. Defaults to tcp.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("tcp", "udp"),
+					},
+				},
+				"publish_mode": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("ingress"),
+					Description: "Publish mode: ingress or host. Defaults to ingress.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("ingress", "host"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func redirectsAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Optional:    true,
+		Description: "URL redirects for the application. When set, Terraform manages the full set of redirects and removes any others, so do not combine it with dokploy_redirect resources for the same application. Removing the attribute stops managing redirects and leaves the existing ones in place. Dokploy has no redirect priority and applies redirects in the order they were added; new redirects are created in order of their regex, and to move an existing redirect behind a new one, change its regex so it is recreated.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"regex": schema.StringAttribute{
+					Required:    true,
+					Description: "Regular expression to match the URL.",
+				},
+				"replacement": schema.StringAttribute{
+					Required:    true,
+					Description: "Replacement URL pattern.",
+				},
+				"permanent": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(true),
+					Description: "Whether the redirect is permanent (301) or temporary (302). Defaults to true.",
+				},
+			},
+		},
+	}
+}
+
+func mountsAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Optional:    true,
+		Description: "Volume, bind and file mounts for the application. When set, Terraform manages the full set of mounts and removes any others, so do not combine it with dokploy_mount resources for the same application. Removing the attribute stops managing mounts and leaves the existing ones in place.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:    true,
+					Description: "Type of mount: bind, volume, or file.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("bind", "volume", "file"),
+					},
+				},
+				"mount_path": schema.StringAttribute{
+					Required:    true,
+					Description: "Path where the mount will be mounted inside the container.",
+				},
+				"host_path": schema.StringAttribute{
+					Optional:    true,
+					Description: "Host path for bind mounts.",
+				},
+				"volume_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "Volume name for volume mounts.",
+				},
+				"file_path": schema.StringAttribute{
+					Optional:    true,
+					Description: "File path for file mounts.",
+				},
+				"content": schema.StringAttribute{
+					Optional:    true,
+					Description: "Content for file mounts.",
+				},
+			},
+		},
+	}
+}
+
+// reconcileChildren deletes, updates and creates items so that current
+// matches desired. Items are matched on key; matched items are updated when
+// same reports a difference.
+func reconcileChildren[T any](current, desired []T, key func(T) string, id func(T) string, same func(prior, wanted T) bool, remove func(id string) error, create func(T) error, update func(id string, wanted T) error) error {
+	existing := make(map[string]T, len(current))
+	for _, item := range current {
+		existing[key(item)] = item
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, item := range desired {
+		wanted[key(item)] = true
+	}
+
+	for _, item := range current {
+		if !wanted[key(item)] {
+			if err := remove(id(item)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, item := range desired {
+		prior, ok := existing[key(item)]
+		if !ok {
+			if err := create(item); err != nil {
+				return err
+			}
+			continue
+		}
+		if same(prior, item) {
+			continue
+		}
+		if err := update(id(prior), item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func portKey(p client.Port) string {
+	return strconv.FormatInt(p.PublishedPort, 10) + "/" + portProtocol(p.Protocol)
+}
+
+func portProtocol(protocol string) string {
+	if protocol == "" {
+		return "tcp"
+	}
+	return protocol
+}
+
+func portPublishMode(mode string) string {
+	if mode == "" {
+		return "ingress"
+	}
+	return mode
+}
+
+// reconcilePorts makes the application's ports match desired. Ports are
+// matched on published port and protocol.
+func reconcilePorts(c client.Client, current, desired []client.Port) error {
+	return reconcileChildren(current, desired, portKey,
+		func(p client.Port) string { return p.ID },
+		func(prior, wanted client.Port) bool {
+			return prior.TargetPort == wanted.TargetPort && portPublishMode(prior.PublishMode) == portPublishMode(wanted.PublishMode)
+		},
+		c.DeletePort,
+		func(p client.Port) error { _, err := c.CreatePort(p); return err },
+		func(id string, p client.Port) error { p.ID = id; _, err := c.UpdatePort(p); return err },
+	)
+}
+
+// reconcileRedirects makes the application's redirects match desired.
+// Redirects are matched on their regex. Dokploy has no redirect priority and
+// applies redirects in the order they were added, so new redirects are
+// created in order of their regex rather than in the arbitrary order of the
+// set.
+func reconcileRedirects(c client.Client, current, desired []client.Redirect) error {
+	desired = slices.Clone(desired)
+	slices.SortStableFunc(desired, func(a, b client.Redirect) int { return strings.Compare(a.Regex, b.Regex) })
+	return reconcileChildren(current, desired,
+		func(r client.Redirect) string { return r.Regex },
+		func(r client.Redirect) string { return r.ID },
+		func(prior, wanted client.Redirect) bool {
+			return prior.Replacement == wanted.Replacement && prior.Permanent == wanted.Permanent
+		},
+		c.DeleteRedirect,
+		func(r client.Redirect) error { _, err := c.CreateRedirect(r); return err },
+		func(id string, r client.Redirect) error { r.ID = id; _, err := c.UpdateRedirect(r); return err },
+	)
+}
+
+// reconcileMounts makes the application's mounts match desired. Mounts are
+// matched on their mount path.
+func reconcileMounts(c client.Client, current, desired []client.Mount) error {
+	return reconcileChildren(current, desired,
+		func(m client.Mount) string { return m.MountPath },
+		func(m client.Mount) string { return m.ID },
+		func(prior, wanted client.Mount) bool {
+			return prior.Type == wanted.Type && prior.HostPath == wanted.HostPath && prior.VolumeName == wanted.VolumeName &&
+				prior.FilePath == wanted.FilePath && prior.Content == wanted.Content
+		},
+		c.DeleteMount,
+		func(m client.Mount) error { _, err := c.CreateMount(m); return err },
+		func(id string, m client.Mount) error { m.ID = id; _, err := c.UpdateMount(m); return err },
+	)
+}
+
+func applicationPortsFromModel(appID string, ports []applicationPortModel) []client.Port {
+	result := make([]client.Port, 0, len(ports))
+	for _, p := range ports {
+		result = append(result, client.Port{
+			ApplicationID: appID,
+			PublishedPort: p.PublishedPort.ValueInt64(),
+			TargetPort:    p.TargetPort.ValueInt64(),
+			Protocol:      p.Protocol.ValueString(),
+			PublishMode:   p.PublishMode.ValueString(),
+		})
+	}
+	return result
+}
+
+func applicationPortsFromAPI(ports []client.Port) []applicationPortModel {
+	result := make([]applicationPortModel, 0, len(ports))
+	for _, p := range ports {
+		result = append(result, applicationPortModel{
+			PublishedPort: types.Int64Value(p.PublishedPort),
+			TargetPort:    types.Int64Value(p.TargetPort),
+			Protocol:      types.StringValue(portProtocol(p.Protocol)),
+			PublishMode:   types.StringValue(portPublishMode(p.PublishMode)),
+		})
+	}
+	return result
+}
+
+func applicationRedirectsFromModel(appID string, redirects []applicationRedirectModel) []client.Redirect {
+	result := make([]client.Redirect, 0, len(redirects))
+	for _, r := range redirects {
+		result = append(result, client.Redirect{
+			ApplicationID: appID,
+			Regex:         r.Regex.ValueString(),
+			Replacement:   r.Replacement.ValueString(),
+			Permanent:     r.Permanent.ValueBool(),
+		})
+	}
+	return result
+}
+
+func applicationRedirectsFromAPI(redirects []client.Redirect) []applicationRedirectModel {
+	result := make([]applicationRedirectModel, 0, len(redirects))
+	for _, r := range redirects {
+		result = append(result, applicationRedirectModel{
+			Regex:       types.StringValue(r.Regex),
+			Replacement: types.StringValue(r.Replacement),
+			Permanent:   types.BoolValue(r.Permanent),
+		})
+	}
+	return result
+}
+
+func applicationMountsFromModel(appID string, mounts []applicationMountModel) []client.Mount {
+	result := make([]client.Mount, 0, len(mounts))
+	for _, m := range mounts {
+		result = append(result, client.Mount{
+			ServiceID:   appID,
+			ServiceType: "application",
+			Type:        m.Type.ValueString(),
+			MountPath:   m.MountPath.ValueString(),
+			HostPath:    m.HostPath.ValueString(),
+			VolumeName:  m.VolumeName.ValueString(),
+			FilePath:    m.FilePath.ValueString(),
+			Content:     m.Content.ValueString(),
+		})
+	}
+	return result
+}
+
+func applicationMountsFromAPI(mounts []client.Mount) []applicationMountModel {
+	result := make([]applicationMountModel, 0, len(mounts))
+	for _, m := range mounts {
+		result = append(result, applicationMountModel{
+			Type:       types.StringValue(m.Type),
+			MountPath:  types.StringValue(m.MountPath),
+			HostPath:   optionalString(m.HostPath),
+			VolumeName: optionalString(m.VolumeName),
+			FilePath:   optionalString(m.FilePath),
+			Content:    optionalString(m.Content),
+		})
+	}
+	return result
+}
+
+// optionalString maps an empty API value to null.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
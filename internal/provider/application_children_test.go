@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+)
+
+func TestReconcilePorts(t *testing.T) {
+	current := []client.Port{
+		{ID: "p1", PublishedPort: 8080, TargetPort: 80, Protocol: "tcp", PublishMode: "ingress"},
+		{ID: "p2", PublishedPort: 9000, TargetPort: 9000, Protocol: "tcp"},
+		{ID: "p3", PublishedPort: 5353, TargetPort: 53, Protocol: "udp"},
+	}
+	desired := []client.Port{
+		{PublishedPort: 8080, TargetPort: 80, Protocol: "tcp", PublishMode: "ingress"},
+		{PublishedPort: 9000, TargetPort: 9001, Protocol: "tcp", PublishMode: "ingress"},
+		{PublishedPort: 5353, TargetPort: 53, Protocol: "tcp", PublishMode: "ingress"},
+	}
+
+	mock := clientmock.New()
+	var created, updated, deleted []string
+	mock.CreatePortFunc = func(p client.Port) (*client.Port, error) {
+		created = append(created, portKey(p))
+		return &p, nil
+	}
+	mock.UpdatePortFunc = func(p client.Port) (*client.Port, error) {
+		updated = append(updated, p.ID)
+		return &p, nil
+	}
+	mock.DeletePortFunc = func(id string) error {
+		deleted = append(deleted, id)
+		return nil
+	}
+
+	if err := reconcilePorts(mock, current, desired); err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 1 || created[0] != "5353/tcp" {
+		t.Errorf("created %v, want 5353/tcp", created)
+	}
+	if len(updated) != 1 || updated[0] != "p2" {
+		t.Errorf("updated %v, want p2", updated)
+	}
+	if len(deleted) != 1 || deleted[0] != "p3" {
+		t.Errorf("deleted %v, want p3", deleted)
+	}
+}
+
+func TestReconcileRedirects(t *testing.T) {
+	current := []client.Redirect{
+		{ID: "r1", Regex: "^http://(.*)", Replacement: "https://$1", Permanent: true},
+		{ID: "r2", Regex: "^/old", Replacement: "/new", Permanent: true},
+	}
+	desired := []client.Redirect{
+		{Regex: "^http://(.*)", Replacement: "https://$1", Permanent: false},
+		{Regex: "^/docs", Replacement: "/help", Permanent: true},
+	}
+
+	mock := clientmock.New()
+	var created, updated, deleted []string
+	mock.CreateRedirectFunc = func(r client.Redirect) (*client.Redirect, error) {
+		created = append(created, r.Regex)
+		return &r, nil
+	}
+	mock.UpdateRedirectFunc = func(r client.Redirect) (*client.Redirect, error) {
+		updated = append(updated, r.ID)
+		return &r, nil
+	}
+	mock.DeleteRedirectFunc = func(id string) error {
+		deleted = append(deleted, id)
+		return nil
+	}
+
+	if err := reconcileRedirects(mock, current, desired); err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 1 || created[0] != "^/docs" {
+		t.Errorf("created %v, want ^/docs", created)
+	}
+	if len(updated) != 1 || updated[0] != "r1" {
+		t.Errorf("updated %v, want r1", updated)
+	}
+	if len(deleted) != 1 || deleted[0] != "r2" {
+		t.Errorf("deleted %v, want r2", deleted)
+	}
+
+	// New redirects are created in a stable order, whatever the order of
+	// the set they come from.
+	created = nil
+	desired = []client.Redirect{
+		{Regex: "^/team", Replacement: "/about"},
+		{Regex: "^/blog/(.*)", Replacement: "/news/$1"},
+		{Regex: "^/careers", Replacement: "/jobs"},
+	}
+	if err := reconcileRedirects(mock, nil, desired); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"^/blog/(.*)", "^/careers", "^/team"}; !slices.Equal(created, want) {
+		t.Errorf("created %v, want %v", created, want)
+	}
+	if desired[0].Regex != "^/team" {
+		t.Error("reconcileRedirects must not reorder the caller's slice")
+	}
+}
+
+func TestApplicationMountsFromAPI(t *testing.T) {
+	got := applicationMountsFromAPI([]client.Mount{
+		{Type: "volume", VolumeName: "data", MountPath: "/data"},
+	})
+	if len(got) != 1 {
+		t.Fatalf("got %d mounts, want 1", len(got))
+	}
+	if got[0].VolumeName.ValueString() != "data" || !got[0].HostPath.IsNull() || !got[0].Content.IsNull() {
+		t.Errorf("unexpected mount %+v", got[0])
+	}
+}
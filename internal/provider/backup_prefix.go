@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// backupPrefixToken matches a template token such as {{project}} in a
+// backup prefix.
+var backupPrefixToken = regexp.MustCompile(`\{\{\s*([a-z_]+)\s*\}\}`)
+
+// backupPrefixTokens are the tokens a backup prefix may contain.
+var backupPrefixTokens = []string{"project", "environment", "service"}
+
+// backupPrefixValidator rejects prefix tokens the provider cannot expand.
+type backupPrefixValidator struct{}
+
+func (v backupPrefixValidator) Description(_ context.Context) string {
+	return "prefix tokens must be one of {{project}}, {{environment}} or {{service}}"
+}
+
+func (v backupPrefixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v backupPrefixValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, match := range backupPrefixToken.FindAllStringSubmatch(req.ConfigValue.ValueString(), -1) {
+		if !isBackupPrefixToken(match[1]) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Unknown Prefix Token",
+				fmt.Sprintf("%s is not a prefix token. Use {{%s}}.", match[0], strings.Join(backupPrefixTokens, "}}, {{")))
+		}
+	}
+}
+
+func isBackupPrefixToken(name string) bool {
+	for _, token := range backupPrefixTokens {
+		if token == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBackupPrefixTokens reports whether prefix is a template.
+func hasBackupPrefixTokens(prefix string) bool {
+	return backupPrefixToken.MatchString(prefix)
+}
+
+// expandBackupPrefix replaces the tokens of prefix with values. Slashes in
+// values are replaced so that a name cannot add path segments.
+func expandBackupPrefix(prefix string, values map[string]string) string {
+	return backupPrefixToken.ReplaceAllStringFunc(prefix, func(token string) string {
+		name := backupPrefixToken.FindStringSubmatch(token)[1]
+		return strings.ReplaceAll(values[name], "/", "-")
+	})
+}
+
+// resolveBackupPrefix returns the prefix to send to Dokploy for m: prefix
+// with its tokens expanded from the names of the backed up service, its
+// environment and its project. It is unknown while prefix or the service
+// is.
+func resolveBackupPrefix(c client.Client, m *BackupResourceModel) (types.String, error) {
+	if m.Prefix.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+	prefix := m.Prefix.ValueString()
+	if !hasBackupPrefixTokens(prefix) {
+		return m.Prefix, nil
+	}
+
+	serviceType, serviceID := m.DatabaseType, m.DatabaseID
+	if m.BackupType.ValueString() == "compose" {
+		serviceType, serviceID = types.StringValue("compose"), m.ComposeID
+	}
+	if serviceType.IsUnknown() || serviceID.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+
+	var service, environmentID string
+	if serviceType.ValueString() == "compose" {
+		comp, err := c.GetCompose(serviceID.ValueString())
+		if err != nil {
+			return types.StringNull(), fmt.Errorf("reading compose stack %s: %w", serviceID.ValueString(), err)
+		}
+		service, environmentID = comp.Name, comp.EnvironmentID
+	} else {
+		db, err := c.GetDatabase(serviceID.ValueString(), serviceType.ValueString())
+		if err != nil {
+			return types.StringNull(), fmt.Errorf("reading %s database %s: %w", serviceType.ValueString(), serviceID.ValueString(), err)
+		}
+		service, environmentID = db.Name, db.EnvironmentID
+	}
+
+	env, err := c.GetEnvironment(environmentID)
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("reading environment %s: %w", environmentID, err)
+	}
+	project, err := c.GetProject(env.ProjectID)
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("reading project %s: %w", env.ProjectID, err)
+	}
+
+	return types.StringValue(expandBackupPrefix(prefix, map[string]string{
+		"project":     project.Name,
+		"environment": env.Name,
+		"service":     service,
+	})), nil
+}
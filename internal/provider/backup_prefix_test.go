@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveBackupPrefix(t *testing.T) {
+	mock := clientmock.New()
+	mock.GetDatabaseFunc = func(dbID, databaseType string) (*client.Database, error) {
+		return &client.Database{ID: dbID, Name: "orders-db", EnvironmentID: "env-1"}, nil
+	}
+	mock.GetComposeFunc = func(id string) (*client.Compose, error) {
+		return &client.Compose{ID: id, Name: "shop/api", EnvironmentID: "env-1"}, nil
+	}
+	mock.GetEnvironmentFunc = func(id string) (*client.Environment, error) {
+		return &client.Environment{ID: id, Name: "production", ProjectID: "proj-1"}, nil
+	}
+	mock.GetProjectFunc = func(id string) (*client.Project, error) {
+		return &client.Project{ID: id, Name: "shop"}, nil
+	}
+
+	tests := []struct {
+		name  string
+		model BackupResourceModel
+		want  types.String
+	}{
+		{
+			name: "database",
+			model: BackupResourceModel{
+				Prefix:       types.StringValue("{{project}}/{{ environment }}/{{service}}"),
+				BackupType:   types.StringValue("database"),
+				DatabaseType: types.StringValue("postgres"),
+				DatabaseID:   types.StringValue("pg-1"),
+			},
+			want: types.StringValue("shop/production/orders-db"),
+		},
+		{
+			name: "compose names cannot add path segments",
+			model: BackupResourceModel{
+				Prefix:     types.StringValue("{{project}}/{{service}}/"),
+				BackupType: types.StringValue("compose"),
+				ComposeID:  types.StringValue("cmp-1"),
+			},
+			want: types.StringValue("shop/shop-api/"),
+		},
+		{
+			name:  "plain prefix",
+			model: BackupResourceModel{Prefix: types.StringValue("nightly/")},
+			want:  types.StringValue("nightly/"),
+		},
+		{
+			name: "service created in the same apply",
+			model: BackupResourceModel{
+				Prefix:       types.StringValue("{{service}}"),
+				BackupType:   types.StringValue("database"),
+				DatabaseType: types.StringValue("postgres"),
+				DatabaseID:   types.StringUnknown(),
+			},
+			want: types.StringUnknown(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveBackupPrefix(mock, &tt.model)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("resolveBackupPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackupPrefixValidator(t *testing.T) {
+	for prefix, wantError := range map[string]bool{
+		"{{project}}/{{environment}}/{{service}}": false,
+		"backups/":             false,
+		"{{project}}/{{team}}": true,
+	} {
+		req := validator.StringRequest{Path: path.Root("prefix"), ConfigValue: types.StringValue(prefix)}
+		var resp validator.StringResponse
+		backupPrefixValidator{}.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() != wantError {
+			t.Errorf("%q: error %v, want %v", prefix, resp.Diagnostics.HasError(), wantError)
+		}
+	}
+}
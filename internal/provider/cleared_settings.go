@@ -0,0 +1,26 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// clearedSetting is an optional string setting with its planned and prior
+// values.
+type clearedSetting[F ~string] struct {
+	field       F
+	plan, state types.String
+}
+
+// clearedSettings returns the settings that were set before and that the plan
+// leaves null or empty, so updates reset them instead of keeping the previous
+// value. Unknown planned values are left alone.
+func clearedSettings[F ~string](settings ...clearedSetting[F]) []F {
+	var fields []F
+	for _, setting := range settings {
+		if setting.plan.IsUnknown() || setting.plan.ValueString() != "" {
+			continue
+		}
+		if setting.state.ValueString() != "" {
+			fields = append(fields, setting.field)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestClearedApplicationFields(t *testing.T) {
+	state := ApplicationResourceModel{
+		Description: types.StringValue("web"),
+		Command:     types.StringValue("npm start"),
+		CommandList: types.ListNull(types.StringType),
+		Title:       types.StringValue("Web"),
+		Subtitle:    types.StringValue("Frontend"),
+		PreviewPath: types.StringValue("/preview"),
+	}
+	plan := ApplicationResourceModel{
+		Description: types.StringNull(),
+		Command:     types.StringNull(),
+		CommandList: types.ListNull(types.StringType),
+		Title:       types.StringValue(""),
+		Subtitle:    types.StringValue("Frontend"),
+		PreviewPath: types.StringUnknown(),
+	}
+
+	got := clearedApplicationFields(&plan, &state)
+	want := []client.ApplicationField{
+		client.ApplicationFieldDescription,
+		client.ApplicationFieldCommand,
+		client.ApplicationFieldTitle,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("clearedApplicationFields() = %v, want %v", got, want)
+	}
+
+	// Switching from a command string to the exec form list is not a clear.
+	plan.CommandList = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("npm"), types.StringValue("start")})
+	for _, field := range clearedApplicationFields(&plan, &state) {
+		if field == client.ApplicationFieldCommand {
+			t.Errorf("clearedApplicationFields() cleared command while command_list is set")
+		}
+	}
+}
+
+func TestClearedApplicationFieldsOnSourceTypeChange(t *testing.T) {
+	state := ApplicationResourceModel{SourceType: types.StringValue("github")}
+	plan := ApplicationResourceModel{SourceType: types.StringValue("docker")}
+
+	got := clearedApplicationFields(&plan, &state)
+	if !reflect.DeepEqual(got, client.SourceProviderFields["github"]) {
+		t.Errorf("clearedApplicationFields() = %v, want the github fields", got)
+	}
+
+	for _, sourceType := range []types.String{types.StringValue("github"), types.StringUnknown()} {
+		plan.SourceType = sourceType
+		if got := clearedApplicationFields(&plan, &state); len(got) != 0 {
+			t.Errorf("clearedApplicationFields() with source_type %v = %v, want none", sourceType, got)
+		}
+	}
+}
+
+func TestClearedSettingsIgnoresUnsetState(t *testing.T) {
+	got := clearedSettings(
+		clearedSetting[client.ComposeField]{client.ComposeFieldDescription, types.StringNull(), types.StringNull()},
+		clearedSetting[client.ComposeField]{client.ComposeFieldCommand, types.StringNull(), types.StringValue("")},
+	)
+	if got != nil {
+		t.Errorf("clearedSettings() = %v, want nil", got)
+	}
+}
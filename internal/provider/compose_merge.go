@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// mergeComposeFiles merges compose files the way `docker compose -f base.yml
+// -f override.yml` does, so a base stack and per-environment overrides can
+// be deployed as one raw compose file. Every document of every file is
+// applied in order:
+//
+//   - mappings are merged key by key, later values replacing earlier ones
+//   - environment, labels and annotations are merged by variable name,
+//     whether written as a list or a mapping
+//   - volumes and devices of a service are merged by their container path
+//   - command, entrypoint and healthcheck tests are replaced
+//   - other lists, such as ports, are concatenated without duplicates
+//
+// As in Compose, a value tagged !reset removes the key and one tagged
+// !override replaces it instead of being merged.
+func mergeComposeFiles(files []string) (string, error) {
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	documents := 0
+	for i, content := range files {
+		decoder := yaml.NewDecoder(strings.NewReader(content))
+		for {
+			var doc yaml.Node
+			err := decoder.Decode(&doc)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return "", fmt.Errorf("compose file %d is not valid YAML: %w", i+1, err)
+			}
+			if len(doc.Content) == 0 || doc.Content[0].Tag == "!!null" {
+				continue
+			}
+			root := doc.Content[0]
+			if root.Kind != yaml.MappingNode {
+				return "", fmt.Errorf("compose file %d must be a mapping with top-level keys such as services", i+1)
+			}
+			mergeComposeMapping(merged, root, nil)
+			documents++
+		}
+	}
+	if documents == 0 {
+		return "", errors.New("at least one non-empty compose file is required")
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(merged); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// mergeComposeMapping merges the entries of src into dst, which is at path.
+func mergeComposeMapping(dst, src *yaml.Node, path []string) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		index := mappingIndex(dst, key.Value)
+		switch {
+		case value.Tag == "!reset":
+			if index >= 0 {
+				dst.Content = append(dst.Content[:index], dst.Content[index+2:]...)
+			}
+		case index < 0:
+			dst.Content = append(dst.Content, key, cleanComposeNode(value))
+		case value.Tag == "!override":
+			dst.Content[index+1] = cleanComposeNode(value)
+		default:
+			dst.Content[index+1] = mergeComposeValue(dst.Content[index+1], value, append(path, key.Value))
+		}
+	}
+}
+
+// mergeComposeValue returns dst with src, found at path, merged into it.
+func mergeComposeValue(dst, src *yaml.Node, path []string) *yaml.Node {
+	key := path[len(path)-1]
+	inService := len(path) == 3 && path[0] == "services"
+
+	switch {
+	case inService && (key == "environment" || key == "labels" || key == "annotations"):
+		if dst.Kind == yaml.SequenceNode || src.Kind == yaml.SequenceNode {
+			dst, src = composeListToMapping(dst), composeListToMapping(src)
+		}
+	case inService && (key == "command" || key == "entrypoint"),
+		key == "test" && len(path) >= 2 && path[len(path)-2] == "healthcheck":
+		return cleanComposeNode(src)
+	}
+
+	if dst.Kind == yaml.MappingNode && src.Kind == yaml.MappingNode {
+		mergeComposeMapping(dst, src, path)
+		return dst
+	}
+	if dst.Kind == yaml.SequenceNode && src.Kind == yaml.SequenceNode {
+		if inService && (key == "volumes" || key == "devices") {
+			return mergeComposeMounts(dst, src)
+		}
+		for _, item := range src.Content {
+			if !containsComposeNode(dst, item) {
+				dst.Content = append(dst.Content, cleanComposeNode(item))
+			}
+		}
+		return dst
+	}
+	return cleanComposeNode(src)
+}
+
+// mergeComposeMounts merges volumes or devices, an entry replacing the one
+// mounted at the same container path.
+func mergeComposeMounts(dst, src *yaml.Node) *yaml.Node {
+	for _, item := range src.Content {
+		target := composeMountTarget(item)
+		replaced := false
+		for j, existing := range dst.Content {
+			if target != "" && composeMountTarget(existing) == target {
+				dst.Content[j] = cleanComposeNode(item)
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			dst.Content = append(dst.Content, cleanComposeNode(item))
+		}
+	}
+	return dst
+}
+
+// composeMountTarget returns the container path of a volume or device in
+// short ("source:target:mode") or long syntax.
+func composeMountTarget(n *yaml.Node) string {
+	if n.Kind == yaml.MappingNode {
+		if i := mappingIndex(n, "target"); i >= 0 {
+			return n.Content[i+1].Value
+		}
+		return ""
+	}
+	parts := strings.Split(n.Value, ":")
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return parts[1]
+}
+
+// composeListToMapping converts a list of KEY=VALUE entries into a mapping,
+// a KEY without a value becoming null as Compose reads it.
+func composeListToMapping(n *yaml.Node) *yaml.Node {
+	if n.Kind != yaml.SequenceNode {
+		return n
+	}
+	m := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, item := range n.Content {
+		name, value, found := strings.Cut(item.Value, "=")
+		valueNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+		if found {
+			valueNode = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+		}
+		if i := mappingIndex(m, name); i >= 0 {
+			m.Content[i+1] = valueNode
+			continue
+		}
+		m.Content = append(m.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name}, valueNode)
+	}
+	return m
+}
+
+// cleanComposeNode drops the !reset entries and !override tags of n, which
+// only have meaning while merging.
+func cleanComposeNode(n *yaml.Node) *yaml.Node {
+	if n.Tag == "!override" {
+		n.Tag = ""
+	}
+	switch n.Kind {
+	case yaml.MappingNode:
+		content := n.Content[:0]
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i+1].Tag == "!reset" {
+				continue
+			}
+			content = append(content, n.Content[i], cleanComposeNode(n.Content[i+1]))
+		}
+		n.Content = content
+	case yaml.SequenceNode:
+		for i, item := range n.Content {
+			n.Content[i] = cleanComposeNode(item)
+		}
+	}
+	return n
+}
+
+// containsComposeNode reports whether the sequence seq has an entry equal to n.
+func containsComposeNode(seq, n *yaml.Node) bool {
+	want, err := yaml.Marshal(n)
+	if err != nil {
+		return false
+	}
+	for _, item := range seq.Content {
+		if got, err := yaml.Marshal(item); err == nil && bytes.Equal(got, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// mappingIndex returns the index of key in the mapping n, or -1.
+func mappingIndex(n *yaml.Node, key string) int {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMergeComposeFiles(t *testing.T) {
+	base := `services:
+  web:
+    image: nginx:1.25
+    command: ["nginx", "-g", "daemon off;"]
+    ports:
+      - "80:80"
+    environment:
+      - LOG_LEVEL=info
+      - TZ=UTC
+    volumes:
+      - data:/usr/share/nginx/html
+      - ./conf:/etc/nginx/conf.d:ro
+    healthcheck:
+      test: ["CMD", "curl", "-f", "http://localhost"]
+      interval: 30s
+  debug:
+    image: busybox
+volumes:
+  data: {}
+`
+	override := `services:
+  web:
+    image: nginx:1.27
+    command: ["nginx-debug"]
+    ports:
+      - "80:80"
+      - "443:443"
+    environment:
+      LOG_LEVEL: debug
+    volumes:
+      - ./staging-conf:/etc/nginx/conf.d:ro
+    healthcheck:
+      test: ["CMD", "true"]
+---
+services:
+  debug: !reset null
+  web:
+    labels: !override
+      tier: staging
+`
+	want := `services:
+  web:
+    image: nginx:1.27
+    command: ["nginx-debug"]
+    ports:
+      - "80:80"
+      - "443:443"
+    environment:
+      LOG_LEVEL: debug
+      TZ: UTC
+    volumes:
+      - data:/usr/share/nginx/html
+      - ./staging-conf:/etc/nginx/conf.d:ro
+    healthcheck:
+      test: ["CMD", "true"]
+      interval: 30s
+    labels:
+      tier: staging
+volumes:
+  data: {}
+`
+	got, err := mergeComposeFiles([]string{base, override})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("merged compose file:\n%s\nwant:\n%s", got, want)
+	}
+
+	invalid := map[string][]string{
+		"at least one non-empty compose file": {"", "---\n"},
+		"compose file 2 is not valid YAML":    {base, "services: ["},
+		"compose file 1 must be a mapping":    {"- web"},
+	}
+	for want, files := range invalid {
+		_, err := mergeComposeFiles(files)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("mergeComposeFiles(%q) = %v, want error containing %q", files, err, want)
+		}
+	}
+}
+
+func TestComposeMergeFunction(t *testing.T) {
+	files, _ := types.ListValueFrom(context.Background(), types.StringType, []string{
+		"services:\n  web:\n    image: nginx:1.25\n",
+		"services:\n  web:\n    image: nginx:1.27\n",
+	})
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewComposeMergeFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{files}),
+	}, resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if got := resp.Result.Value(); !got.Equal(types.StringValue("services:\n  web:\n    image: nginx:1.27\n")) {
+		t.Errorf("result = %s", got)
+	}
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// composeServiceOverrideModel is a service_overrides entry.
+type composeServiceOverrideModel struct {
+	Replicas          types.Int64  `tfsdk:"replicas"`
+	CPULimit          types.String `tfsdk:"cpu_limit"`
+	MemoryLimit       types.String `tfsdk:"memory_limit"`
+	CPUReservation    types.String `tfsdk:"cpu_reservation"`
+	MemoryReservation types.String `tfsdk:"memory_reservation"`
+}
+
+// composeFileContent returns the compose file to send to Dokploy: the
+// configured compose_file_content with service_overrides merged into it.
+func composeFileContent(ctx context.Context, m *ComposeResourceModel, diags *diag.Diagnostics) string {
+	content := m.ComposeFileContent.ValueString()
+	if m.ServiceOverrides.IsNull() || m.ServiceOverrides.IsUnknown() || len(m.ServiceOverrides.Elements()) == 0 {
+		return content
+	}
+
+	var overrides map[string]composeServiceOverrideModel
+	diags.Append(m.ServiceOverrides.ElementsAs(ctx, &overrides, false)...)
+	if diags.HasError() {
+		return content
+	}
+	merged, err := applyServiceOverrides(content, overrides)
+	if err != nil {
+		diags.AddAttributeError(path.Root("service_overrides"), "Invalid Service Overrides", err.Error())
+		return content
+	}
+	return merged
+}
+
+// composeFileMatches reports whether the compose file Dokploy returned is
+// the configured compose_file_content with service_overrides applied, in
+// which case state keeps the configured content.
+func composeFileMatches(ctx context.Context, m *ComposeResourceModel, remote string) bool {
+	if m.ComposeFileContent.IsNull() || m.ComposeFileContent.IsUnknown() {
+		return false
+	}
+	var diags diag.Diagnostics
+	return composeFileContent(ctx, m, &diags) == remote && !diags.HasError()
+}
+
+// applyServiceOverrides merges the replicas and resources of overrides into
+// the deploy section of the services of a compose file, which Docker Swarm
+// and Docker Compose both honour. Every overridden service must exist.
+func applyServiceOverrides(content string, overrides map[string]composeServiceOverrideModel) (string, error) {
+	base, err := mergeComposeFiles([]string{content})
+	if err != nil {
+		return "", err
+	}
+	var doc struct {
+		Services map[string]yaml.Node `yaml:"services"`
+	}
+	if err := yaml.Unmarshal([]byte(base), &doc); err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	services := map[string]interface{}{}
+	for _, name := range names {
+		if _, ok := doc.Services[name]; !ok {
+			return "", fmt.Errorf("service %q is not defined in compose_file_content", name)
+		}
+		if deploy := overrides[name].deploy(); len(deploy) > 0 {
+			services[name] = map[string]interface{}{"deploy": deploy}
+		}
+	}
+	if len(services) == 0 {
+		return content, nil
+	}
+
+	override, err := yaml.Marshal(map[string]interface{}{"services": services})
+	if err != nil {
+		return "", err
+	}
+	return mergeComposeFiles([]string{content, string(override)})
+}
+
+// deploy returns the compose deploy section setting the overridden values.
+func (o composeServiceOverrideModel) deploy() map[string]interface{} {
+	deploy := map[string]interface{}{}
+	if !o.Replicas.IsNull() && !o.Replicas.IsUnknown() {
+		deploy["replicas"] = o.Replicas.ValueInt64()
+	}
+	resources := map[string]interface{}{}
+	for key, values := range map[string][2]types.String{
+		"limits":       {o.CPULimit, o.MemoryLimit},
+		"reservations": {o.CPUReservation, o.MemoryReservation},
+	} {
+		section := map[string]string{}
+		if cpus := values[0]; !cpus.IsNull() && !cpus.IsUnknown() {
+			section["cpus"] = cpus.ValueString()
+		}
+		if memory := values[1]; !memory.IsNull() && !memory.IsUnknown() {
+			section["memory"] = memory.ValueString()
+		}
+		if len(section) > 0 {
+			resources[key] = section
+		}
+	}
+	if len(resources) > 0 {
+		deploy["resources"] = resources
+	}
+	return deploy
+}
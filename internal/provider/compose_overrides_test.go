@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestComposeServiceOverrides(t *testing.T) {
+	ctx := context.Background()
+	content := `services:
+  web:
+    image: nginx:1.27
+    deploy:
+      replicas: 1
+      resources:
+        limits:
+          memory: 128M
+  worker:
+    image: busybox
+`
+	overrideTypes := map[string]attr.Type{
+		"replicas":           types.Int64Type,
+		"cpu_limit":          types.StringType,
+		"memory_limit":       types.StringType,
+		"cpu_reservation":    types.StringType,
+		"memory_reservation": types.StringType,
+	}
+	override := func(replicas types.Int64, cpuLimit types.String) attr.Value {
+		return types.ObjectValueMust(overrideTypes, map[string]attr.Value{
+			"replicas":           replicas,
+			"cpu_limit":          cpuLimit,
+			"memory_limit":       types.StringNull(),
+			"cpu_reservation":    types.StringNull(),
+			"memory_reservation": types.StringValue("64M"),
+		})
+	}
+	overrides := func(elems map[string]attr.Value) types.Map {
+		return types.MapValueMust(types.ObjectType{AttrTypes: overrideTypes}, elems)
+	}
+
+	m := ComposeResourceModel{
+		ComposeFileContent: types.StringValue(content),
+		ServiceOverrides:   types.MapNull(types.ObjectType{AttrTypes: overrideTypes}),
+	}
+	var diags diag.Diagnostics
+	if got := composeFileContent(ctx, &m, &diags); got != content || diags.HasError() {
+		t.Fatalf("without overrides the file must be sent unchanged, got %q, diags %v", got, diags)
+	}
+
+	m.ServiceOverrides = overrides(map[string]attr.Value{
+		"web": override(types.Int64Value(3), types.StringValue("0.5")),
+	})
+	got := composeFileContent(ctx, &m, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	for _, want := range []string{"replicas: 3", "cpus: \"0.5\"", "memory: 128M", "memory: 64M", "image: busybox"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("merged file lacks %q:\n%s", want, got)
+		}
+	}
+	if !composeFileMatches(ctx, &m, got) {
+		t.Error("the file sent to Dokploy should match the configuration")
+	}
+	if composeFileMatches(ctx, &m, content) {
+		t.Error("a file without the overrides should not match")
+	}
+
+	m.ServiceOverrides = overrides(map[string]attr.Value{
+		"api": override(types.Int64Value(2), types.StringNull()),
+	})
+	composeFileContent(ctx, &m, &diags)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), `"api"`) {
+		t.Errorf("expected an error for an undefined service, got %v", diags)
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/waiter"
+)
+
+// Polling bounds for waitForConsistentRead.
+var (
+	consistencyTimeout  = 30 * time.Second
+	consistencyInterval = time.Second
+)
+
+// waitForConsistentRead re-reads a freshly created object until ready reports
+// that the API has finished populating it. Dokploy can briefly return partial
+// data (or a 404) right after a create, which otherwise surfaces as
+// inconsistent result errors. When the timeout elapses the last successful
+// read is returned so the caller can carry on with what is available.
+func waitForConsistentRead[T any](ctx context.Context, read func() (T, error), ready func(T) bool) (T, error) {
+	var haveLast bool
+	cfg := waiter.Config{
+		Interval: consistencyInterval,
+		Jitter:   0.1,
+		Timeout:  consistencyTimeout,
+	}
+	result, err := waiter.Wait(ctx, cfg, func(context.Context) (T, error) {
+		result, err := read()
+		if err != nil {
+			return result, waiter.Retryable(err)
+		}
+		haveLast = true
+		return result, nil
+	}, ready)
+	if err != nil && haveLast {
+		return result, nil
+	}
+	return result, err
+}
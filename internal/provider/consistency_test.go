@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForConsistentRead(t *testing.T) {
+	consistencyTimeout, consistencyInterval = 50*time.Millisecond, time.Millisecond
+	t.Cleanup(func() { consistencyTimeout, consistencyInterval = 30*time.Second, time.Second })
+
+	ready := func(s string) bool { return s != "" }
+
+	// Retries through errors and partial results until ready.
+	reads := []struct {
+		value string
+		err   error
+	}{{"", errors.New("404 not found")}, {"", nil}, {"app-a1b2c3", nil}}
+	calls := 0
+	got, err := waitForConsistentRead(context.Background(), func() (string, error) {
+		r := reads[calls]
+		calls++
+		return r.value, r.err
+	}, ready)
+	if err != nil || got != "app-a1b2c3" || calls != 3 {
+		t.Fatalf("got %q, %v after %d reads", got, err, calls)
+	}
+
+	// Falls back to the last successful read on timeout.
+	got, err = waitForConsistentRead(context.Background(), func() (string, error) { return "", nil }, ready)
+	if err != nil || got != "" {
+		t.Fatalf("timeout: got %q, %v", got, err)
+	}
+
+	// Surfaces the error when no read ever succeeded.
+	_, err = waitForConsistentRead(context.Background(), func() (string, error) { return "", errors.New("boom") }, ready)
+	if err == nil {
+		t.Fatal("expected error when every read fails")
+	}
+}
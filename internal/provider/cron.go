@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // Aliases for min, min+1, ... (e.g., JAN for 1).
+	anyValue bool     // Whether ? is accepted.
+	last     bool     // Whether L is accepted: the last day, or e.g. 5L for the last Friday.
+	weekday  bool     // Whether W is accepted: e.g. 15W for the weekday nearest the 15th.
+	nth      bool     // Whether # is accepted: e.g. 1#2 for the second Monday.
+}
+
+var (
+	cronSecond  = cronField{name: "second", min: 0, max: 59}
+	cronMinute  = cronField{name: "minute", min: 0, max: 59}
+	cronHour    = cronField{name: "hour", min: 0, max: 23}
+	cronDay     = cronField{name: "day of month", min: 1, max: 31, anyValue: true, last: true, weekday: true}
+	cronMonth   = cronField{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	cronWeekday = cronField{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}, anyValue: true, last: true, nth: true}
+)
+
+// cronMacros are the predefined schedules accepted in place of the fields.
+var cronMacros = map[string]bool{
+	"@yearly":   true,
+	"@annually": true,
+	"@monthly":  true,
+	"@weekly":   true,
+	"@daily":    true,
+	"@midnight": true,
+	"@hourly":   true,
+}
+
+// validateCron checks a cron expression as accepted by Dokploy's scheduler:
+// five fields (minute hour day-of-month month day-of-week), six with a
+// leading seconds field, or a macro such as @daily. The error explains what
+// is wrong in plain words.
+func validateCron(expr string) error {
+	if strings.HasPrefix(strings.TrimSpace(expr), "@") {
+		if !cronMacros[strings.ToLower(strings.TrimSpace(expr))] {
+			return fmt.Errorf("cron expression %q is not a known macro, use one of @yearly, @annually, @monthly, @weekly, @daily, @midnight or @hourly", expr)
+		}
+		return nil
+	}
+
+	fields := strings.Fields(expr)
+	var layout []cronField
+	switch len(fields) {
+	case 5:
+		layout = []cronField{cronMinute, cronHour, cronDay, cronMonth, cronWeekday}
+	case 6:
+		layout = []cronField{cronSecond, cronMinute, cronHour, cronDay, cronMonth, cronWeekday}
+	case 0:
+		return fmt.Errorf("cron expression is empty, 5 fields required (minute hour day-of-month month day-of-week)")
+	default:
+		return fmt.Errorf("cron expression %q has %d fields, 5 fields required (minute hour day-of-month month day-of-week), or 6 with a leading seconds field", expr, len(fields))
+	}
+
+	for i, f := range layout {
+		if err := f.validate(fields[i]); err != nil {
+			return fmt.Errorf("cron expression %q: %w", expr, err)
+		}
+	}
+	return nil
+}
+
+// validate checks a single field: a comma-separated list of *, ?, values,
+// ranges (a-b), steps (*/n, a-b/n, a/n) and, where the field allows them,
+// the L, W and # forms.
+func (f cronField) validate(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		if part == "" {
+			return fmt.Errorf("%s field %q has an empty list entry", f.name, value)
+		}
+
+		base, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("%s field %q has an invalid step %q, must be a positive number", f.name, value, step)
+			}
+		}
+
+		switch {
+		case base == "*":
+			continue
+		case base == "?":
+			if !f.anyValue {
+				return fmt.Errorf("%s field does not accept ?, only day of month and day of week do", f.name)
+			}
+			if hasStep {
+				return fmt.Errorf("%s field %q cannot combine ? with a step", f.name, value)
+			}
+			continue
+		}
+
+		if handled, err := f.validateSpecial(base, hasStep); handled || err != nil {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		low, high, isRange := strings.Cut(base, "-")
+		lo, err := f.parse(low)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		hi, err := f.parse(high)
+		if err != nil {
+			return err
+		}
+		if lo > hi {
+			return fmt.Errorf("%s field has range %q whose start is after its end", f.name, base)
+		}
+	}
+	return nil
+}
+
+// validateSpecial checks the L, W and # forms of day fields and reports
+// whether base was one of them.
+func (f cronField) validateSpecial(base string, hasStep bool) (bool, error) {
+	upper := strings.ToUpper(base)
+	var err error
+	switch {
+	case f.last && f.weekday && upper == "LW":
+	case f.last && upper == "L":
+	case f.last && f.nth && strings.HasSuffix(upper, "L"):
+		_, err = f.parse(base[:len(base)-1])
+	case f.weekday && strings.HasSuffix(upper, "W"):
+		_, err = f.parse(base[:len(base)-1])
+	case f.nth && strings.Contains(base, "#"):
+		day, n, _ := strings.Cut(base, "#")
+		if _, err = f.parse(day); err == nil {
+			if k, convErr := strconv.Atoi(n); convErr != nil || k < 1 || k > 5 {
+				err = fmt.Errorf("%s field %q has an invalid occurrence %q, must be 1-5", f.name, base, n)
+			}
+		}
+	default:
+		return false, nil
+	}
+	if err == nil && hasStep {
+		err = fmt.Errorf("%s field %q cannot combine L, W or # with a step", f.name, base)
+	}
+	return true, err
+}
+
+// parse converts a single value or alias to a number within the field's range.
+func (f cronField) parse(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		if len(f.names) > 0 {
+			return 0, fmt.Errorf("%s value %q is not a number or a name like %s", f.name, value, f.names[0])
+		}
+		return 0, fmt.Errorf("%s value %q is not a number", f.name, value)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%s value %d is out of range, must be %d-%d", f.name, n, f.min, f.max)
+	}
+	return n, nil
+}
+
+// cronValidator validates cron expression attributes at plan time.
+type cronValidator struct{}
+
+func (v cronValidator) Description(_ context.Context) string {
+	return "value must be a cron expression with 5 fields, 6 with a leading seconds field, or a macro such as @daily"
+}
+
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := validateCron(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Cron Expression", err.Error())
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateCron(t *testing.T) {
+	valid := []string{
+		"0 2 * * *",
+		"*/15 * * * *",
+		"0 4 * * 0",
+		"0 0 1,15 * *",
+		"30 9-17/2 * JAN-MAR mon-fri",
+		"0 0 ? * 7",
+		"30 0 2 * * *",
+		"@daily",
+		"@HOURLY",
+		"0 0 L * *",
+		"0 0 LW * *",
+		"0 0 15W * *",
+		"0 0 ? * 5L",
+		"0 0 ? * 1#2",
+		"0 0 ? * MON#1",
+	}
+	for _, expr := range valid {
+		if err := validateCron(expr); err != nil {
+			t.Errorf("validateCron(%q) = %v, want nil", expr, err)
+		}
+	}
+
+	invalid := map[string]string{
+		"":                "empty",
+		"0 2 * *":         "5 fields required",
+		"0 0 0 2 * * * *": "5 fields required",
+		"60 * * * *":      "minute value 60 is out of range",
+		"0 24 * * *":      "hour value 24 is out of range",
+		"0 0 0 * *":       "day of month value 0 is out of range",
+		"0 0 * 13 *":      "month value 13 is out of range",
+		"0 0 * FOO *":     "not a number or a name like JAN",
+		"*/0 * * * *":     "invalid step",
+		"0 0 * * 5-1":     "start is after its end",
+		"? * * * *":       "minute field does not accept ?",
+		"0 0 1,,2 * *":    "empty list entry",
+		"@fortnightly":    "not a known macro",
+		"0 0 32W * *":     "day of month value 32 is out of range",
+		"0 0 ? * 1#6":     "invalid occurrence",
+		"0 0 ? * 8L":      "day of week value 8 is out of range",
+		"0 L * * *":       "hour value \"L\" is not a number",
+		"0 0 ? * 1W":      "day of week value \"1W\" is not a number",
+		"0 0 L/2 * *":     "cannot combine L, W or # with a step",
+	}
+	for expr, want := range invalid {
+		err := validateCron(expr)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("validateCron(%q) = %v, want error containing %q", expr, err, want)
+		}
+	}
+}
+
+func TestValidateCronFunction(t *testing.T) {
+	run := func(expr string) *function.RunResponse {
+		resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		NewValidateCronFunction().Run(context.Background(), function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(expr)}),
+		}, resp)
+		return resp
+	}
+
+	resp := run("0 2 * * *")
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if got := resp.Result.Value(); !got.Equal(types.StringValue("0 2 * * *")) {
+		t.Errorf("result = %s, want the expression", got)
+	}
+
+	resp = run("0 2 * *")
+	if resp.Error == nil || !strings.Contains(resp.Error.Error(), "5 fields required") {
+		t.Errorf("error = %v, want field count error", resp.Error)
+	}
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dashboardURLAttribute returns the schema of the computed dashboard_url
+// attribute of a service resource.
+func dashboardURLAttribute(service string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Description: fmt.Sprintf("URL of the %s's page in the Dokploy dashboard, for linking operators to it from outputs or CI summaries.", service),
+		PlanModifiers: []planmodifier.String{
+			dashboardURLPlanModifier{},
+		},
+	}
+}
+
+// dashboardURLPlanModifier keeps dashboard_url from state unless the
+// service moves to another environment, which changes the URL.
+type dashboardURLPlanModifier struct{}
+
+func (m dashboardURLPlanModifier) Description(_ context.Context) string {
+	return "Keeps the dashboard URL unless environment_id changes."
+}
+
+func (m dashboardURLPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m dashboardURLPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("environment_id"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment_id"), &prior)...)
+	if resp.Diagnostics.HasError() || planned.IsUnknown() || !planned.Equal(prior) {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// dashboardURL returns the URL of the dashboard page of a service of the
+// given type: application, compose, or a database engine. The dashboard is
+// served next to the API at endpoint.
+func dashboardURL(endpoint, projectID, environmentID, serviceType, serviceID string) string {
+	base := strings.TrimSuffix(strings.TrimSuffix(endpoint, "/"), "/api")
+	return fmt.Sprintf("%s/dashboard/project/%s/environment/%s/services/%s/%s", base, projectID, environmentID, serviceType, serviceID)
+}
+
+// readDashboardURL sets target to the dashboard URL of a service, looking up
+// the project of its environment. Lookup failures are reported as warnings
+// and leave target null.
+func readDashboardURL(c client.Client, serviceType, serviceID, environmentID string, target *types.String, diags *diag.Diagnostics) {
+	*target = types.StringNull()
+	if serviceID == "" || environmentID == "" {
+		return
+	}
+
+	env, err := c.GetEnvironment(environmentID)
+	if err != nil {
+		diags.AddWarning("Unable to Read Dashboard URL", err.Error())
+		return
+	}
+	*target = types.StringValue(dashboardURL(c.Endpoint(), env.ProjectID, environmentID, serviceType, serviceID))
+}
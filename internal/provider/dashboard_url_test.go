@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReadDashboardURL(t *testing.T) {
+	mock := clientmock.New()
+	mock.EndpointFunc = func() string { return "https://dokploy.example.com/api/" }
+	mock.GetEnvironmentFunc = func(id string) (*client.Environment, error) {
+		if id != "env-1" {
+			return nil, errors.New("not found")
+		}
+		return &client.Environment{ID: id, ProjectID: "proj-1"}, nil
+	}
+
+	var got types.String
+	var diags diag.Diagnostics
+	readDashboardURL(mock, "postgres", "pg-1", "env-1", &got, &diags)
+	if want := "https://dokploy.example.com/dashboard/project/proj-1/environment/env-1/services/postgres/pg-1"; got.ValueString() != want || diags.HasError() {
+		t.Errorf("readDashboardURL() = %v, want %q, diags %v", got, want, diags)
+	}
+
+	readDashboardURL(mock, "compose", "cmp-1", "env-2", &got, &diags)
+	if !got.IsNull() || diags.WarningsCount() != 1 {
+		t.Errorf("expected a null URL and a warning, got %v, diags %v", got, diags)
+	}
+}
@@ -17,7 +17,7 @@ func NewAIModelsDataSource() datasource.DataSource {
 }
 
 type AIModelsDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type AIModelsDataSourceModel struct {
@@ -82,9 +82,9 @@ func (d *AIModelsDataSource) Configure(_ context.Context, req datasource.Configu
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,11 +20,12 @@ func NewApplicationDataSource() datasource.DataSource {
 }
 
 type ApplicationDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ApplicationDataSourceModel struct {
 	ID            types.String `tfsdk:"id"`
+	ByDomain      types.String `tfsdk:"by_domain"`
 	Name          types.String `tfsdk:"name"`
 	AppName       types.String `tfsdk:"app_name"`
 	Description   types.String `tfsdk:"description"`
@@ -65,11 +69,19 @@ func (d *ApplicationDataSource) Metadata(_ context.Context, req datasource.Metad
 
 func (d *ApplicationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a single Dokploy application by its ID.",
+		Description: "Fetches a single Dokploy application by its ID, or by one of its domains.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "The unique identifier of the application.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the application. Exactly one of id or by_domain must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("by_domain")),
+				},
+			},
+			"by_domain": schema.StringAttribute{
+				Optional:    true,
+				Description: "Host of a domain of the application, e.g. \"app.example.com\". The domains of every application in every project are scanned, so the application serving a hostname can be found without knowing its project. Fails when no application, or more than one, has the domain.",
 			},
 			"name": schema.StringAttribute{
 				Computed:    true,
@@ -155,9 +167,9 @@ func (d *ApplicationDataSource) Configure(_ context.Context, req datasource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -171,6 +183,15 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	if !data.ByDomain.IsNull() {
+		found, err := d.client.FindApplicationByDomain(data.ByDomain.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("by_domain"), "Unable to Find Application by Domain", err.Error())
+			return
+		}
+		data.ID = types.StringValue(found.ID)
+	}
+
 	app, err := d.client.GetApplication(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read Application", err.Error())
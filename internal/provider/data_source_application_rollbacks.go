@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ApplicationRollbacksDataSource{}
+
+func NewApplicationRollbacksDataSource() datasource.DataSource {
+	return &ApplicationRollbacksDataSource{}
+}
+
+type ApplicationRollbacksDataSource struct {
+	client client.Client
+}
+
+type ApplicationRollbacksDataSourceModel struct {
+	ApplicationID types.String    `tfsdk:"application_id"`
+	Rollbacks     []RollbackModel `tfsdk:"rollbacks"`
+}
+
+type RollbackModel struct {
+	ID           types.String `tfsdk:"id"`
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	Version      types.Int64  `tfsdk:"version"`
+	Image        types.String `tfsdk:"image"`
+	Tag          types.String `tfsdk:"tag"`
+	Digest       types.String `tfsdk:"digest"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+func (d *ApplicationRollbacksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_rollbacks"
+}
+
+func (d *ApplicationRollbacksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the images an application can be rolled back to, most recent first. " +
+			"Dokploy only keeps rollback images for deployments made while rollback_active is enabled on the application.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the application to list rollbacks for.",
+			},
+			"rollbacks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Rollback images of the application, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the rollback.",
+						},
+						"deployment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the deployment the image was built for.",
+						},
+						"version": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Version number Dokploy assigned to the rollback.",
+						},
+						"image": schema.StringAttribute{
+							Computed:    true,
+							Description: "Full image reference in the rollback registry.",
+						},
+						"tag": schema.StringAttribute{
+							Computed:    true,
+							Description: "Tag of the image. Null if the image is only referenced by digest.",
+						},
+						"digest": schema.StringAttribute{
+							Computed:    true,
+							Description: "Digest of the image, e.g. \"sha256:...\". Null if the image is referenced by tag.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation timestamp of the rollback.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationRollbacksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ApplicationRollbacksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ApplicationRollbacksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployments, err := d.client.ListDeploymentsByType(config.ApplicationID.ValueString(), "application")
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Rollbacks", err.Error())
+		return
+	}
+
+	config.Rollbacks = []RollbackModel{}
+	for _, dep := range deployments {
+		if dep.Rollback == nil {
+			continue
+		}
+		tag, digest := splitImageTagDigest(dep.Rollback.Image)
+		config.Rollbacks = append(config.Rollbacks, RollbackModel{
+			ID:           types.StringValue(dep.Rollback.RollbackID),
+			DeploymentID: types.StringValue(dep.DeploymentID),
+			Version:      types.Int64Value(dep.Rollback.Version),
+			Image:        types.StringValue(dep.Rollback.Image),
+			Tag:          optionalString(tag),
+			Digest:       optionalString(digest),
+			CreatedAt:    types.StringValue(dep.Rollback.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// splitImageTagDigest returns the tag and digest of an image reference such as
+// "registry.example.com:5000/app:v3@sha256:abc". Either may be empty.
+func splitImageTagDigest(image string) (tag, digest string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, digest = image[:i], image[i+1:]
+	}
+	if colon := strings.LastIndex(image, ":"); colon > strings.LastIndex(image, "/") {
+		tag = image[colon+1:]
+	}
+	return tag, digest
+}
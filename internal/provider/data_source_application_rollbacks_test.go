@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSplitImageTagDigest(t *testing.T) {
+	tests := []struct {
+		image, tag, digest string
+	}{
+		{"nginx", "", ""},
+		{"registry.example.com:5000/app:v3", "v3", ""},
+		{"registry.example.com:5000/app", "", ""},
+		{"ghcr.io/acme/app@sha256:abc", "", "sha256:abc"},
+		{"ghcr.io/acme/app:v3@sha256:abc", "v3", "sha256:abc"},
+	}
+	for _, tt := range tests {
+		tag, digest := splitImageTagDigest(tt.image)
+		if tag != tt.tag || digest != tt.digest {
+			t.Errorf("splitImageTagDigest(%q) = %q, %q; want %q, %q", tt.image, tag, digest, tt.tag, tt.digest)
+		}
+	}
+}
+
+func TestAccApplicationRollbacksDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationRollbacksConfig(`rollback_active = true`),
+				ExpectError: regexp.MustCompile("Missing Rollback Registry"),
+			},
+			{
+				Config: testAccApplicationRollbacksConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					// Rollbacks are disabled, so Dokploy keeps no images.
+					resource.TestCheckResourceAttr("data.dokploy_application_rollbacks.test", "rollbacks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationRollbacksConfig(rollback string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-rollbacks"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "tf-acc-rollbacks"
+}
+
+resource "dokploy_application" "test" {
+  name           = "tf-acc-rollbacks"
+  environment_id = dokploy_environment.test.id
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+  %s
+}
+
+data "dokploy_application_rollbacks" "test" {
+  application_id = dokploy_application.test.id
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), rollback)
+}
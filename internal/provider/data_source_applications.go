@@ -3,10 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,11 +21,15 @@ func NewApplicationsDataSource() datasource.DataSource {
 }
 
 type ApplicationsDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ApplicationsDataSourceModel struct {
+	ProjectID     types.String           `tfsdk:"project_id"`
 	EnvironmentID types.String           `tfsdk:"environment_id"`
+	NameRegex     types.String           `tfsdk:"name_regex"`
+	SourceType    types.String           `tfsdk:"source_type"`
+	Tags          types.Map              `tfsdk:"tags"`
 	Applications  []ApplicationDataModel `tfsdk:"applications"`
 }
 
@@ -37,6 +45,7 @@ type ApplicationDataModel struct {
 	AutoDeploy        types.Bool   `tfsdk:"auto_deploy"`
 	Replicas          types.Int64  `tfsdk:"replicas"`
 	ApplicationStatus types.String `tfsdk:"application_status"`
+	Tags              types.Map    `tfsdk:"tags"`
 	CreatedAt         types.String `tfsdk:"created_at"`
 }
 
@@ -46,12 +55,38 @@ func (d *ApplicationsDataSource) Metadata(_ context.Context, req datasource.Meta
 
 func (d *ApplicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches all Dokploy applications, optionally filtered by environment.",
+		Description: "Fetches all Dokploy applications, optionally filtered by project, environment, name, source type and tags. Useful to for_each over applications created in the Dokploy UI.",
 		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Optional project ID to filter applications. Returns the applications of every environment in the project.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("environment_id")),
+				},
+			},
 			"environment_id": schema.StringAttribute{
 				Optional:    true,
 				Description: "Optional environment ID to filter applications. If not provided, returns all applications across all environments.",
 			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Optional regular expression (RE2 syntax) the display name of an application must match, e.g. \"^api-\".",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"source_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Optional source type to filter applications: github, gitlab, bitbucket, gitea, git, docker, or drop.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("github", "gitlab", "bitbucket", "gitea", "git", "docker", "drop"),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Optional tags to filter applications. Only applications carrying all of the given tags are returned.",
+			},
 			"applications": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of applications.",
@@ -101,6 +136,11 @@ func (d *ApplicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							Computed:    true,
 							Description: "Current status: idle, running, done, or error.",
 						},
+						"tags": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Organizational tags of the application.",
+						},
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Timestamp when the application was created.",
@@ -116,9 +156,9 @@ func (d *ApplicationsDataSource) Configure(_ context.Context, req datasource.Con
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -135,10 +175,19 @@ func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	var apps []client.Application
 	var err error
 
-	// If environment_id is provided, filter by environment
-	if !data.EnvironmentID.IsNull() && !data.EnvironmentID.IsUnknown() && data.EnvironmentID.ValueString() != "" {
+	// If environment_id or project_id is provided, only list their applications
+	switch {
+	case !data.EnvironmentID.IsNull() && !data.EnvironmentID.IsUnknown() && data.EnvironmentID.ValueString() != "":
 		apps, err = d.client.ListApplicationsByEnvironment(data.EnvironmentID.ValueString())
-	} else {
+	case !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() && data.ProjectID.ValueString() != "":
+		var project *client.Project
+		project, err = d.client.GetProject(data.ProjectID.ValueString())
+		if err == nil {
+			for _, env := range project.Environments {
+				apps = append(apps, env.Applications...)
+			}
+		}
+	default:
 		apps, err = d.client.ListApplications()
 	}
 
@@ -147,8 +196,23 @@ func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		nameRegex = regexp.MustCompile(data.NameRegex.ValueString())
+	}
+	apps = filterApplications(apps, nameRegex, data.SourceType.ValueString())
+
+	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
+		apps, err = d.filterByTags(apps, data.Tags)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Application Tags", err.Error())
+			return
+		}
+	}
+
 	data.Applications = make([]ApplicationDataModel, len(apps))
 	for i, app := range apps {
+		_, tags := splitApplicationTags(app.LabelsSwarm)
 		data.Applications[i] = ApplicationDataModel{
 			ID:                types.StringValue(app.ID),
 			Name:              types.StringValue(app.Name),
@@ -159,6 +223,7 @@ func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 			AutoDeploy:        types.BoolValue(app.AutoDeploy),
 			Replicas:          types.Int64Value(int64(app.Replicas)),
 			ApplicationStatus: types.StringValue(app.ApplicationStatus),
+			Tags:              types.MapValueMust(types.StringType, tags),
 			CreatedAt:         types.StringValue(app.CreatedAt),
 		}
 
@@ -174,3 +239,68 @@ func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
+
+// filterByTags returns the applications carrying every tag in want. The list
+// endpoints may omit swarm labels, in which case the full application is read.
+func (d *ApplicationsDataSource) filterByTags(apps []client.Application, want types.Map) ([]client.Application, error) {
+	var matched []client.Application
+	for _, app := range apps {
+		if app.LabelsSwarm == nil {
+			full, err := d.client.GetApplication(app.ID)
+			if err != nil {
+				return nil, err
+			}
+			app.LabelsSwarm = full.LabelsSwarm
+		}
+
+		_, tags := splitApplicationTags(app.LabelsSwarm)
+		match := true
+		for k, v := range want.Elements() {
+			if got, ok := tags[k]; !ok || !got.Equal(v) {
+				match = false
+				break
+			}
+		}
+		if match {
+			matched = append(matched, app)
+		}
+	}
+	return matched, nil
+}
+
+// filterApplications returns the applications whose display name matches
+// nameRegex and whose source type is sourceType. A nil regex or empty source
+// type disables that filter.
+func filterApplications(apps []client.Application, nameRegex *regexp.Regexp, sourceType string) []client.Application {
+	var matched []client.Application
+	for _, app := range apps {
+		if nameRegex != nil && !nameRegex.MatchString(app.Name) {
+			continue
+		}
+		if sourceType != "" && app.SourceType != sourceType {
+			continue
+		}
+		matched = append(matched, app)
+	}
+	return matched
+}
+
+// regexValidator validates regular expression attributes at plan time.
+type regexValidator struct{}
+
+func (v regexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Regular Expression", err.Error())
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationsDataSourceFilters(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-applications-filters"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "tf-acc-applications-filters"
+}
+
+resource "dokploy_application" "api" {
+  environment_id = dokploy_environment.test.id
+  name           = "api-users"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_application" "web" {
+  environment_id = dokploy_environment.test.id
+  name           = "web"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+data "dokploy_applications" "api" {
+  project_id  = dokploy_project.test.id
+  name_regex  = "^api-"
+  source_type = "docker"
+
+  depends_on = [dokploy_application.api, dokploy_application.web]
+}
+`, host, apiKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dokploy_applications.api", "applications.#", "1"),
+					resource.TestCheckResourceAttr("data.dokploy_applications.api", "applications.0.name", "api-users"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterApplications(t *testing.T) {
+	apps := []client.Application{
+		{ID: "1", Name: "api-users", SourceType: "github"},
+		{ID: "2", Name: "api-orders", SourceType: "docker"},
+		{ID: "3", Name: "web", SourceType: "github"},
+	}
+
+	tests := []struct {
+		name       string
+		nameRegex  *regexp.Regexp
+		sourceType string
+		want       string
+	}{
+		{"no filters", nil, "", "[1 2 3]"},
+		{"name", regexp.MustCompile("^api-"), "", "[1 2]"},
+		{"source type", nil, "github", "[1 3]"},
+		{"both", regexp.MustCompile("^api-"), "github", "[1]"},
+		{"no match", regexp.MustCompile("^worker"), "", "[]"},
+	}
+	for _, tt := range tests {
+		var ids []string
+		for _, app := range filterApplications(apps, tt.nameRegex, tt.sourceType) {
+			ids = append(ids, app.ID)
+		}
+		if got := fmt.Sprint(ids); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -17,7 +17,7 @@ func NewBackupFilesDataSource() datasource.DataSource {
 }
 
 type BackupFilesDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type BackupFilesDataSourceModel struct {
@@ -91,9 +91,9 @@ func (d *BackupFilesDataSource) Configure(_ context.Context, req datasource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CapacityCheckDataSource{}
+
+func NewCapacityCheckDataSource() datasource.DataSource {
+	return &CapacityCheckDataSource{}
+}
+
+type CapacityCheckDataSource struct {
+	client client.Client
+}
+
+type CapacityCheckDataSourceModel struct {
+	ServerID          types.String  `tfsdk:"server_id"`
+	MemoryReservation unitValue     `tfsdk:"memory_reservation"`
+	CPUReservation    unitValue     `tfsdk:"cpu_reservation"`
+	HasCapacity       types.Bool    `tfsdk:"has_capacity"`
+	Reasons           types.List    `tfsdk:"reasons"`
+	CPUCores          types.Float64 `tfsdk:"cpu_cores"`
+	CPUUsedPercent    types.Float64 `tfsdk:"cpu_used_percent"`
+	AvailableCPU      types.Float64 `tfsdk:"available_cpu"`
+	MemoryTotal       types.Int64   `tfsdk:"memory_total"`
+	MemoryUsedPercent types.Float64 `tfsdk:"memory_used_percent"`
+	AvailableMemory   types.Int64   `tfsdk:"available_memory"`
+	SampledAt         types.String  `tfsdk:"sampled_at"`
+}
+
+func (d *CapacityCheckDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_capacity_check"
+}
+
+func (d *CapacityCheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether a server has enough free memory and CPU for the requested reservations, based on the latest sample from its Dokploy monitoring agent. Use it in lifecycle preconditions before scaling.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the server to check. Monitoring must be enabled for it.",
+			},
+			"memory_reservation": schema.StringAttribute{
+				CustomType:  unitType{kind: unitMemory},
+				Optional:    true,
+				Description: "Memory that must be free, e.g. \"2Gi\". Plain numbers are bytes.",
+			},
+			"cpu_reservation": schema.StringAttribute{
+				CustomType:  unitType{kind: unitCPU},
+				Optional:    true,
+				Description: "CPUs that must be idle, e.g. \"1.5\" or \"500m\". Numbers of 1000 or more are nanocores.",
+			},
+			"has_capacity": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the server has room for both reservations.",
+			},
+			"reasons": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Why the server lacks capacity. Empty when has_capacity is true.",
+			},
+			"cpu_cores": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Number of CPU cores on the server.",
+			},
+			"cpu_used_percent": schema.Float64Attribute{
+				Computed:    true,
+				Description: "CPU usage in percent.",
+			},
+			"available_cpu": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Idle CPU, in cores.",
+			},
+			"memory_total": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Total memory in bytes.",
+			},
+			"memory_used_percent": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Memory usage in percent.",
+			},
+			"available_memory": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Free memory in bytes.",
+			},
+			"sampled_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the metrics sample used for the check.",
+			},
+		},
+	}
+}
+
+func (d *CapacityCheckDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *CapacityCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CapacityCheckDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := d.client.GetServer(data.ServerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Server", err.Error())
+		return
+	}
+
+	metrics, err := d.client.GetServerMetrics(*server, 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Server Metrics", err.Error())
+		return
+	}
+	if len(metrics) == 0 {
+		resp.Diagnostics.AddError("Unable to Read Server Metrics", fmt.Sprintf("The monitoring agent of server %s has not reported any metrics yet.", server.ID))
+		return
+	}
+
+	var memory, cpu int64
+	if !data.MemoryReservation.IsNull() {
+		if memory, err = data.MemoryReservation.Int64(); err != nil {
+			resp.Diagnostics.AddError("Invalid Memory Reservation", err.Error())
+			return
+		}
+	}
+	if !data.CPUReservation.IsNull() {
+		if cpu, err = data.CPUReservation.Int64(); err != nil {
+			resp.Diagnostics.AddError("Invalid CPU Reservation", err.Error())
+			return
+		}
+	}
+
+	result := checkCapacity(metrics[len(metrics)-1], memory, cpu)
+	reasons, diags := types.ListValueFrom(ctx, types.StringType, result.reasons)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.HasCapacity = types.BoolValue(len(result.reasons) == 0)
+	data.Reasons = reasons
+	data.CPUCores = types.Float64Value(result.cpuCores)
+	data.CPUUsedPercent = types.Float64Value(result.cpuUsedPercent)
+	data.AvailableCPU = types.Float64Value(result.availableCPU)
+	data.MemoryTotal = types.Int64Value(result.memoryTotal)
+	data.MemoryUsedPercent = types.Float64Value(result.memoryUsedPercent)
+	data.AvailableMemory = types.Int64Value(result.availableMemory)
+	data.SampledAt = types.StringValue(result.sampledAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// capacityResult is the outcome of checking a metrics sample against the
+// requested reservations.
+type capacityResult struct {
+	cpuCores          float64
+	cpuUsedPercent    float64
+	availableCPU      float64
+	memoryTotal       int64
+	memoryUsedPercent float64
+	availableMemory   int64
+	sampledAt         string
+	reasons           []string
+}
+
+// checkCapacity compares the free memory (bytes) and idle CPU (nanocores) in
+// the sample with the requested reservations.
+func checkCapacity(metric client.ServerMetric, memory, cpu int64) capacityResult {
+	const gib = 1 << 30
+
+	result := capacityResult{
+		cpuCores:          float64(metric.CPUCores),
+		cpuUsedPercent:    float64(metric.CPU),
+		memoryTotal:       int64(float64(metric.MemTotalGB) * gib),
+		memoryUsedPercent: float64(metric.MemUsed),
+		sampledAt:         metric.Timestamp,
+		reasons:           []string{},
+	}
+	result.availableCPU = result.cpuCores * (1 - result.cpuUsedPercent/100)
+	result.availableMemory = result.memoryTotal - int64(float64(metric.MemUsedGB)*gib)
+	if result.availableMemory < 0 {
+		result.availableMemory = 0
+	}
+
+	if memory > result.availableMemory {
+		result.reasons = append(result.reasons, fmt.Sprintf("needs %d bytes of memory but only %d are free", memory, result.availableMemory))
+	}
+	if requested := float64(cpu) / 1e9; requested > result.availableCPU {
+		result.reasons = append(result.reasons, fmt.Sprintf("needs %s CPUs but only %s are idle", formatCPUs(requested), formatCPUs(result.availableCPU)))
+	}
+	return result
+}
+
+func formatCPUs(cpus float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", cpus), "0"), ".")
+}
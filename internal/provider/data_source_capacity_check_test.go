@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCheckCapacity(t *testing.T) {
+	metric := client.ServerMetric{
+		Timestamp:  "2024-01-01T00:00:00Z",
+		CPU:        25,
+		CPUCores:   4,
+		MemUsed:    50,
+		MemUsedGB:  4,
+		MemTotalGB: 8,
+	}
+
+	tests := []struct {
+		name    string
+		memory  int64
+		cpu     int64
+		reasons []string
+	}{
+		{name: "no reservations", reasons: []string{}},
+		{name: "fits", memory: 4 << 30, cpu: 3e9, reasons: []string{}},
+		{name: "too much memory", memory: 5 << 30, reasons: []string{"needs 5368709120 bytes of memory but only 4294967296 are free"}},
+		{name: "too much cpu", cpu: 3500e6, reasons: []string{"needs 3.5 CPUs but only 3 are idle"}},
+		{name: "both", memory: 8 << 30, cpu: 4e9, reasons: []string{
+			"needs 8589934592 bytes of memory but only 4294967296 are free",
+			"needs 4 CPUs but only 3 are idle",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkCapacity(metric, tt.memory, tt.cpu)
+			if !reflect.DeepEqual(got.reasons, tt.reasons) {
+				t.Errorf("reasons = %q, want %q", got.reasons, tt.reasons)
+			}
+			if got.availableCPU != 3 {
+				t.Errorf("availableCPU = %v, want 3", got.availableCPU)
+			}
+			if got.availableMemory != 4<<30 {
+				t.Errorf("availableMemory = %d, want %d", got.availableMemory, int64(4<<30))
+			}
+		})
+	}
+}
+
+// TestAccCapacityCheckDataSource checks a tiny reservation against a server
+// with monitoring enabled. Set TEST_SERVER_ID to run it.
+func TestAccCapacityCheckDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+	serverID := os.Getenv("TEST_SERVER_ID")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	if serverID == "" {
+		t.Skip("TEST_SERVER_ID must be set to a server with monitoring enabled")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityCheckDataSourceConfig(serverID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dokploy_capacity_check.test", "has_capacity", "true"),
+					resource.TestCheckResourceAttr("data.dokploy_capacity_check.test", "reasons.#", "0"),
+					resource.TestCheckResourceAttrSet("data.dokploy_capacity_check.test", "available_memory"),
+					resource.TestCheckResourceAttrSet("data.dokploy_capacity_check.test", "available_cpu"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityCheckDataSourceConfig(serverID string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+data "dokploy_capacity_check" "test" {
+  server_id          = "%s"
+  memory_reservation = "1Mi"
+  cpu_reservation    = "1m"
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), serverID)
+}
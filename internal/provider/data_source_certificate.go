@@ -17,7 +17,7 @@ func NewCertificateDataSource() datasource.DataSource {
 }
 
 type CertificateDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type CertificateDataSourceModel struct {
@@ -69,9 +69,9 @@ func (d *CertificateDataSource) Configure(_ context.Context, req datasource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.DokployClient)
+	c, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = c
@@ -17,7 +17,7 @@ func NewCertificatesDataSource() datasource.DataSource {
 }
 
 type CertificatesDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type CertificatesDataSourceModel struct {
@@ -81,9 +81,9 @@ func (d *CertificatesDataSource) Configure(_ context.Context, req datasource.Con
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.DokployClient)
+	c, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = c
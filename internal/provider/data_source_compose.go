@@ -17,7 +17,7 @@ func NewComposeDataSource() datasource.DataSource {
 }
 
 type ComposeDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ComposeDataSourceModel struct {
@@ -325,9 +325,9 @@ func (d *ComposeDataSource) Configure(_ context.Context, req datasource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -17,7 +17,7 @@ func NewComposesDataSource() datasource.DataSource {
 }
 
 type ComposesDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ComposesDataSourceModel struct {
@@ -139,9 +139,9 @@ func (d *ComposesDataSource) Configure(_ context.Context, req datasource.Configu
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeploymentsDataSource{}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+type DeploymentsDataSource struct {
+	client client.Client
+}
+
+type DeploymentsDataSourceModel struct {
+	ServiceID   types.String      `tfsdk:"service_id"`
+	Type        types.String      `tfsdk:"type"`
+	Since       types.String      `tfsdk:"since"`
+	Limit       types.Int64       `tfsdk:"limit"`
+	Deployments []DeploymentModel `tfsdk:"deployments"`
+}
+
+type DeploymentModel struct {
+	ID           types.String `tfsdk:"id"`
+	Title        types.String `tfsdk:"title"`
+	Description  types.String `tfsdk:"description"`
+	Status       types.String `tfsdk:"status"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	StartedAt    types.String `tfsdk:"started_at"`
+	FinishedAt   types.String `tfsdk:"finished_at"`
+}
+
+func (d *DeploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the deployment history of a service, most recent first, for exporting activity to audit or compliance tooling. " +
+			"Dokploy does not record which user triggered a deployment, so the history only carries what Dokploy stores: title, description (for git sources usually the commit message), status and timestamps.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service to list deployments for.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of service: application, compose, server, schedule, previewDeployment, backup or volumeBackup.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("application", "compose", "server", "schedule", "previewDeployment", "backup", "volumeBackup"),
+				},
+			},
+			"since": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deployments created at or after this RFC 3339 timestamp, e.g. \"2024-06-01T00:00:00Z\".",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of deployments to return.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Deployments of the service, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the deployment.",
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Title of the deployment, e.g. \"Manual deployment\" or \"Rebuild deployment\".",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the deployment. For git sources this is usually the commit message.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the deployment: running, done or error.",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Error message of a failed deployment.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation timestamp of the deployment.",
+						},
+						"started_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp the deployment started.",
+						},
+						"finished_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp the deployment finished.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployments, err := d.client.ListDeploymentsByType(config.ServiceID.ValueString(), config.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Deployments", err.Error())
+		return
+	}
+
+	var since time.Time
+	if !config.Since.IsNull() {
+		since, _ = time.Parse(time.RFC3339, config.Since.ValueString())
+	}
+
+	config.Deployments = []DeploymentModel{}
+	for _, dep := range filterDeployments(deployments, since, config.Limit.ValueInt64()) {
+		config.Deployments = append(config.Deployments, DeploymentModel{
+			ID:           types.StringValue(dep.DeploymentID),
+			Title:        types.StringValue(dep.Title),
+			Description:  types.StringPointerValue(dep.Description),
+			Status:       types.StringValue(dep.Status),
+			ErrorMessage: types.StringPointerValue(dep.ErrorMessage),
+			CreatedAt:    types.StringValue(dep.CreatedAt),
+			StartedAt:    types.StringPointerValue(dep.StartedAt),
+			FinishedAt:   types.StringPointerValue(dep.FinishedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// filterDeployments drops deployments created before since and keeps at most
+// limit of them. A zero since or limit disables that filter. Deployments with
+// an unparseable creation time are kept.
+func filterDeployments(deployments []client.Deployment, since time.Time, limit int64) []client.Deployment {
+	result := []client.Deployment{}
+	for _, dep := range deployments {
+		if limit > 0 && int64(len(result)) >= limit {
+			break
+		}
+		if !since.IsZero() {
+			if created, err := time.Parse(time.RFC3339, dep.CreatedAt); err == nil && created.Before(since) {
+				continue
+			}
+		}
+		result = append(result, dep)
+	}
+	return result
+}
+
+// rfc3339Validator validates timestamp attributes at plan time.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timestamp", fmt.Sprintf("Expected an RFC 3339 timestamp such as \"2024-06-01T00:00:00Z\", got %q.", req.ConfigValue.ValueString()))
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFilterDeployments(t *testing.T) {
+	deployments := []client.Deployment{
+		{DeploymentID: "d3", CreatedAt: "2024-06-03T10:00:00.000Z"},
+		{DeploymentID: "d2", CreatedAt: "2024-06-02T10:00:00.000Z"},
+		{DeploymentID: "d1", CreatedAt: "2024-06-01T10:00:00.000Z"},
+	}
+	since := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		since time.Time
+		limit int64
+		want  []string
+	}{
+		{name: "all", want: []string{"d3", "d2", "d1"}},
+		{name: "since", since: since, want: []string{"d3", "d2"}},
+		{name: "limit", limit: 1, want: []string{"d3"}},
+		{name: "since and limit", since: since, limit: 5, want: []string{"d3", "d2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterDeployments(deployments, tt.since, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d deployments, want %d", len(got), len(tt.want))
+			}
+			for i, dep := range got {
+				if dep.DeploymentID != tt.want[i] {
+					t.Errorf("deployment %d = %s, want %s", i, dep.DeploymentID, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAccDeploymentsDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dokploy_deployments.test", "deployments.#", "1"),
+					resource.TestCheckResourceAttrSet("data.dokploy_deployments.test", "deployments.0.id"),
+					resource.TestCheckResourceAttrSet("data.dokploy_deployments.test", "deployments.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentsDataSourceConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-deployments-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-deployments-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id   = dokploy_environment.test.id
+  name             = "test-deployments-app"
+  source_type      = "docker"
+  docker_image     = "nginx:alpine"
+  deploy_on_create = true
+}
+
+data "dokploy_deployments" "test" {
+  service_id = dokploy_application.test.id
+  type       = "application"
+  since      = "2024-01-01T00:00:00Z"
+  limit      = 1
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DestinationsDataSource{}
+
+func NewDestinationsDataSource() datasource.DataSource {
+	return &DestinationsDataSource{}
+}
+
+// DestinationsDataSource lists backup destinations, so backups in other
+// stacks can reference destinations managed centrally without sharing state.
+type DestinationsDataSource struct {
+	client client.Client
+}
+
+type DestinationsDataSourceModel struct {
+	Name         types.String           `tfsdk:"name"`
+	Destinations []DestinationDataModel `tfsdk:"destinations"`
+}
+
+// DestinationDataModel is a destination without its credentials.
+type DestinationDataModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	StorageProvider   types.String `tfsdk:"storage_provider"`
+	Bucket            types.String `tfsdk:"bucket"`
+	Region            types.String `tfsdk:"region"`
+	Endpoint          types.String `tfsdk:"endpoint"`
+	CanonicalEndpoint types.String `tfsdk:"canonical_endpoint"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+}
+
+func (d *DestinationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_destinations"
+}
+
+func (d *DestinationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the backup destinations of the organization, optionally looking one up by name. Credentials are not exposed.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the destination with this exact name. Reading fails when no destination has it.",
+			},
+			"destinations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of destinations, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the destination, for destination_id on backups.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the destination.",
+						},
+						"storage_provider": schema.StringAttribute{
+							Computed:    true,
+							Description: "Storage provider type (e.g., 's3', 'minio').",
+						},
+						"bucket": schema.StringAttribute{
+							Computed:    true,
+							Description: "Bucket backups are stored in.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Region of the bucket.",
+						},
+						"endpoint": schema.StringAttribute{
+							Computed:    true,
+							Description: "Endpoint URL stored in Dokploy.",
+						},
+						"canonical_endpoint": schema.StringAttribute{
+							Computed:    true,
+							Description: "Endpoint backups are uploaded to: the endpoint with a scheme and without a trailing slash, or the one derived from the region.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation timestamp of the destination.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DestinationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *DestinationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DestinationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destinations, err := d.client.ListDestinations()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Destinations", err.Error())
+		return
+	}
+
+	data.Destinations = destinationsFromAPI(destinations, data.Name)
+	if !data.Name.IsNull() && len(data.Destinations) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Destination Not Found",
+			fmt.Sprintf("No backup destination is named %q.", data.Name.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// destinationsFromAPI converts destinations into the data source model,
+// keeping only the ones called name when it is set.
+func destinationsFromAPI(destinations []client.Destination, name types.String) []DestinationDataModel {
+	sorted := append([]client.Destination(nil), destinations...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	result := []DestinationDataModel{}
+	for _, dest := range sorted {
+		if !name.IsNull() && dest.Name != name.ValueString() {
+			continue
+		}
+		location := DestinationResourceModel{
+			StorageProvider: types.StringValue(dest.Provider),
+			Region:          optionalString(dest.Region),
+			Endpoint:        optionalString(dest.Endpoint),
+		}
+		result = append(result, DestinationDataModel{
+			ID:                types.StringValue(dest.DestinationID),
+			Name:              types.StringValue(dest.Name),
+			StorageProvider:   types.StringValue(dest.Provider),
+			Bucket:            types.StringValue(dest.Bucket),
+			Region:            optionalString(dest.Region),
+			Endpoint:          optionalString(dest.Endpoint),
+			CanonicalEndpoint: optionalString(canonicalDestinationEndpoint(&location)),
+			CreatedAt:         optionalString(dest.CreatedAt),
+		})
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDestinationsFromAPI(t *testing.T) {
+	destinations := []client.Destination{
+		{DestinationID: "d-2", Name: "r2-archive", Provider: "r2", Bucket: "archive", Endpoint: "acct.r2.cloudflarestorage.com/", SecretAccessKey: "secret"},
+		{DestinationID: "d-1", Name: "aws-backups", Provider: "s3", Bucket: "backups", Region: "eu-west-1"},
+	}
+
+	all := destinationsFromAPI(destinations, types.StringNull())
+	if len(all) != 2 || all[0].Name.ValueString() != "aws-backups" {
+		t.Fatalf("destinations = %+v, want both sorted by name", all)
+	}
+	if got := all[0].CanonicalEndpoint.ValueString(); got != "https://s3.eu-west-1.amazonaws.com" {
+		t.Errorf("derived endpoint = %q", got)
+	}
+	if !all[0].Endpoint.IsNull() {
+		t.Errorf("endpoint = %v, want null when Dokploy has none", all[0].Endpoint)
+	}
+	if got := all[1].CanonicalEndpoint.ValueString(); got != "https://acct.r2.cloudflarestorage.com" {
+		t.Errorf("normalized endpoint = %q", got)
+	}
+
+	byName := destinationsFromAPI(destinations, types.StringValue("r2-archive"))
+	if len(byName) != 1 || byName[0].ID.ValueString() != "d-2" {
+		t.Errorf("lookup by name = %+v, want d-2", byName)
+	}
+	if got := destinationsFromAPI(destinations, types.StringValue("missing")); len(got) != 0 {
+		t.Errorf("lookup of a missing name = %+v, want none", got)
+	}
+}
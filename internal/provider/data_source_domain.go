@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainDataSource{}
+
+func NewDomainDataSource() datasource.DataSource {
+	return &DomainDataSource{}
+}
+
+// DomainDataSource finds the domain routing a host, searching every
+// application and compose stack of the organization.
+type DomainDataSource struct {
+	client client.Client
+}
+
+type DomainDataSourceModel struct {
+	Host            types.String `tfsdk:"host"`
+	Path            types.String `tfsdk:"path"`
+	Exists          types.Bool   `tfsdk:"exists"`
+	ID              types.String `tfsdk:"id"`
+	ServiceType     types.String `tfsdk:"service_type"`
+	ApplicationID   types.String `tfsdk:"application_id"`
+	ComposeID       types.String `tfsdk:"compose_id"`
+	ServiceName     types.String `tfsdk:"service_name"`
+	OwnerName       types.String `tfsdk:"owner_name"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	EnvironmentID   types.String `tfsdk:"environment_id"`
+	Port            types.Int64  `tfsdk:"port"`
+	HTTPS           types.Bool   `tfsdk:"https"`
+	CertificateType types.String `tfsdk:"certificate_type"`
+}
+
+// domainOwner is a domain together with the service it routes to.
+type domainOwner struct {
+	domain        client.Domain
+	serviceType   string
+	name          string
+	projectID     string
+	environmentID string
+}
+
+func (d *DomainDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (d *DomainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a domain by host across every application and compose stack of the organization, to find which service owns it. A host that is not in use is not an error: exists is false and the other attributes are null, so the data source can guard a new dokploy_domain against conflicts.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Host to look up, e.g. 'app.example.com'. Compared case-insensitively.",
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the domain, to choose between domains that share the host.",
+			},
+			"exists": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a domain with this host, and path when set, exists.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the domain.",
+			},
+			"service_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of service the domain routes to: application or compose.",
+			},
+			"application_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the application that owns the domain.",
+			},
+			"compose_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the compose stack that owns the domain.",
+			},
+			"service_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Compose service the domain routes to.",
+			},
+			"owner_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the application or compose stack that owns the domain.",
+			},
+			"project_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the project of the owning service.",
+			},
+			"environment_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the environment of the owning service.",
+			},
+			"port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Container port the domain routes to.",
+			},
+			"https": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether HTTPS is enabled for the domain.",
+			},
+			"certificate_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Certificate type of the domain: none, letsencrypt or custom.",
+			},
+		},
+	}
+}
+
+func (d *DomainDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches, err := findDomainsByHost(d.client, data.Host.ValueString(), data.Path)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Search Domains", err.Error())
+		return
+	}
+	if len(matches) > 1 {
+		var owners []string
+		for _, m := range matches {
+			owners = append(owners, fmt.Sprintf("%s %q (path %q)", m.serviceType, m.name, m.domain.Path))
+		}
+		resp.Diagnostics.AddError("Multiple Domains Found",
+			fmt.Sprintf("Host %q is used by %s. Set path to choose one.", data.Host.ValueString(), strings.Join(owners, ", ")))
+		return
+	}
+
+	data.Exists = types.BoolValue(len(matches) == 1)
+	if len(matches) == 0 {
+		data.ID = types.StringNull()
+		data.ServiceType = types.StringNull()
+		data.ApplicationID = types.StringNull()
+		data.ComposeID = types.StringNull()
+		data.ServiceName = types.StringNull()
+		data.OwnerName = types.StringNull()
+		data.ProjectID = types.StringNull()
+		data.EnvironmentID = types.StringNull()
+		data.Port = types.Int64Null()
+		data.HTTPS = types.BoolNull()
+		data.CertificateType = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	m := matches[0]
+	data.ID = types.StringValue(m.domain.ID)
+	data.ServiceType = types.StringValue(m.serviceType)
+	data.ApplicationID = optionalString(m.domain.ApplicationID)
+	data.ComposeID = optionalString(m.domain.ComposeID)
+	data.ServiceName = optionalString(m.domain.ServiceName)
+	data.OwnerName = types.StringValue(m.name)
+	data.ProjectID = types.StringValue(m.projectID)
+	data.EnvironmentID = types.StringValue(m.environmentID)
+	data.Port = types.Int64Value(m.domain.Port)
+	data.HTTPS = types.BoolValue(m.domain.HTTPS)
+	data.CertificateType = types.StringValue(m.domain.CertificateType)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findDomainsByHost returns the domains of every application and compose
+// stack that use host, and path when it is set.
+func findDomainsByHost(c client.Client, host string, path types.String) ([]domainOwner, error) {
+	projects, err := c.ListProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []domainOwner
+	collect := func(domains []client.Domain, owner domainOwner) {
+		for _, domain := range domains {
+			if !strings.EqualFold(domain.Host, host) {
+				continue
+			}
+			if !path.IsNull() && domain.Path != path.ValueString() {
+				continue
+			}
+			owner.domain = domain
+			matches = append(matches, owner)
+		}
+	}
+
+	for _, project := range projects {
+		for _, env := range project.Environments {
+			for _, app := range env.Applications {
+				domains, err := c.GetDomainsByApplication(app.ID)
+				if err != nil {
+					return nil, fmt.Errorf("listing domains of application %s: %w", app.ID, err)
+				}
+				collect(domains, domainOwner{serviceType: "application", name: app.Name, projectID: project.ID, environmentID: env.ID})
+			}
+			for _, comp := range env.Compose {
+				domains, err := c.GetDomainsByCompose(comp.ID)
+				if err != nil {
+					return nil, fmt.Errorf("listing domains of compose %s: %w", comp.ID, err)
+				}
+				collect(domains, domainOwner{serviceType: "compose", name: comp.Name, projectID: project.ID, environmentID: env.ID})
+			}
+		}
+	}
+	return matches, nil
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFindDomainsByHost(t *testing.T) {
+	mock := clientmock.New()
+	mock.ListProjectsFunc = func() ([]client.Project, error) {
+		return []client.Project{{
+			ID: "proj-1",
+			Environments: []client.Environment{{
+				ID:           "env-1",
+				Applications: []client.Application{{ID: "app-1", Name: "web"}},
+				Compose:      []client.Compose{{ID: "comp-1", Name: "blog"}},
+			}},
+		}}, nil
+	}
+	mock.GetDomainsByApplicationFunc = func(appID string) ([]client.Domain, error) {
+		return []client.Domain{
+			{ID: "dom-1", ApplicationID: appID, Host: "app.example.com", Path: "/"},
+			{ID: "dom-2", ApplicationID: appID, Host: "other.example.com", Path: "/"},
+		}, nil
+	}
+	mock.GetDomainsByComposeFunc = func(composeID string) ([]client.Domain, error) {
+		return []client.Domain{{ID: "dom-3", ComposeID: composeID, Host: "App.Example.com", Path: "/blog"}}, nil
+	}
+
+	matches, err := findDomainsByHost(mock, "app.example.com", types.StringNull())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("got %d matches, want the application and compose domains", len(matches))
+	}
+
+	matches, err = findDomainsByHost(mock, "app.example.com", types.StringValue("/blog"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0].domain.ID != "dom-3" || matches[0].serviceType != "compose" || matches[0].name != "blog" || matches[0].environmentID != "env-1" {
+		t.Errorf("matches = %+v, want the compose domain", matches)
+	}
+
+	if matches, _ := findDomainsByHost(mock, "unused.example.com", types.StringNull()); len(matches) != 0 {
+		t.Errorf("matches = %+v, want none", matches)
+	}
+}
@@ -17,7 +17,7 @@ func NewGitlabProvidersDataSource() datasource.DataSource {
 }
 
 type GitlabProvidersDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type GitlabProvidersDataSourceModel struct {
@@ -86,9 +86,9 @@ func (d *GitlabProvidersDataSource) Configure(_ context.Context, req datasource.
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &CurrentMemberDataSource{}
+	_ datasource.DataSource = &MembersDataSource{}
+)
+
+func NewCurrentMemberDataSource() datasource.DataSource {
+	return &CurrentMemberDataSource{}
+}
+
+func NewMembersDataSource() datasource.DataSource {
+	return &MembersDataSource{}
+}
+
+// CurrentMemberDataSource reads the organization membership of the API key's
+// user, flattened at the top level.
+type CurrentMemberDataSource struct {
+	client client.Client
+}
+
+type CurrentMemberDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	UserModel
+}
+
+// MembersDataSource lists the members of the organization, optionally
+// filtered by role.
+type MembersDataSource struct {
+	client client.Client
+}
+
+type MembersDataSourceModel struct {
+	Role    types.String `tfsdk:"role"`
+	Members []UserModel  `tfsdk:"members"`
+}
+
+func (d *CurrentMemberDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_member"
+}
+
+func (d *CurrentMemberDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := memberAttributes()
+	attrs["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The organization membership ID, same as member_id.",
+	}
+	resp.Schema = schema.Schema{
+		Description: "Fetches the organization membership of the user the provider authenticates as, including its organization, role and permissions.",
+		Attributes:  attrs,
+	}
+}
+
+func (d *CurrentMemberDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *CurrentMemberDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	member, err := d.client.GetCurrentMember()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Current Member", err.Error())
+		return
+	}
+
+	model, diags := memberModelFromAPI(ctx, *member)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := CurrentMemberDataSourceModel{ID: model.MemberID, UserModel: model}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *MembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_members"
+}
+
+func (d *MembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the members of the current Dokploy organization with their roles and permissions.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return members with this role, e.g. 'owner', 'admin' or 'member'.",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Members of the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: memberAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *MembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *MembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state MembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.ListMembers()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Members", err.Error())
+		return
+	}
+
+	state.Members = []UserModel{}
+	for _, member := range members {
+		if !state.Role.IsNull() && member.Role != state.Role.ValueString() {
+			continue
+		}
+		model, diags := memberModelFromAPI(ctx, member)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Members = append(state.Members, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// memberAttributes is the schema of one organization member, shared by
+// dokploy_users, dokploy_members and dokploy_current_member.
+func memberAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"member_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The organization membership ID.",
+		},
+		"organization_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the organization.",
+		},
+		"user_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique user ID.",
+		},
+		"role": schema.StringAttribute{
+			Computed:    true,
+			Description: "The user's role in the organization (e.g., 'owner', 'member').",
+		},
+		"team_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The team ID if the user belongs to a team.",
+		},
+		"is_default": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether this is the default organization membership.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the membership was created.",
+		},
+
+		// Permission fields
+		"can_create_projects": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can create projects.",
+		},
+		"can_access_to_ssh_keys": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can access SSH keys.",
+		},
+		"can_create_services": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can create services.",
+		},
+		"can_delete_projects": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can delete projects.",
+		},
+		"can_delete_services": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can delete services.",
+		},
+		"can_access_to_docker": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can access Docker.",
+		},
+		"can_access_to_api": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can access the API.",
+		},
+		"can_access_to_git_providers": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can access Git providers.",
+		},
+		"can_access_to_traefik_files": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can access Traefik files.",
+		},
+		"can_delete_environments": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can delete environments.",
+		},
+		"can_create_environments": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user can create environments.",
+		},
+		"accessed_projects": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "List of project IDs the user has access to.",
+		},
+		"accessed_environments": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "List of environment IDs the user has access to.",
+		},
+		"accessed_services": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "List of service IDs the user has access to.",
+		},
+
+		// User details
+		"first_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The user's first name.",
+		},
+		"last_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The user's last name.",
+		},
+		"email": schema.StringAttribute{
+			Computed:    true,
+			Description: "The user's email address.",
+		},
+		"email_verified": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user's email is verified.",
+		},
+		"two_factor_enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether two-factor authentication is enabled.",
+		},
+		"image": schema.StringAttribute{
+			Computed:    true,
+			Description: "The user's profile image URL.",
+		},
+	}
+}
+
+// memberModelFromAPI converts an organization member to its schema model.
+func memberModelFromAPI(ctx context.Context, member client.OrganizationMember) (UserModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := UserModel{
+		MemberID:       types.StringValue(member.ID),
+		OrganizationID: types.StringValue(member.OrganizationID),
+		UserID:         types.StringValue(member.UserID),
+		Role:           types.StringValue(member.Role),
+		TeamID:         types.StringPointerValue(member.TeamID),
+		IsDefault:      types.BoolValue(member.IsDefault),
+		CreatedAt:      types.StringValue(member.CreatedAt),
+
+		// Permissions
+		CanCreateProjects:       types.BoolValue(member.CanCreateProjects),
+		CanAccessToSSHKeys:      types.BoolValue(member.CanAccessToSSHKeys),
+		CanCreateServices:       types.BoolValue(member.CanCreateServices),
+		CanDeleteProjects:       types.BoolValue(member.CanDeleteProjects),
+		CanDeleteServices:       types.BoolValue(member.CanDeleteServices),
+		CanAccessToDocker:       types.BoolValue(member.CanAccessToDocker),
+		CanAccessToAPI:          types.BoolValue(member.CanAccessToAPI),
+		CanAccessToGitProviders: types.BoolValue(member.CanAccessToGitProviders),
+		CanAccessToTraefikFiles: types.BoolValue(member.CanAccessToTraefikFiles),
+		CanDeleteEnvironments:   types.BoolValue(member.CanDeleteEnvironments),
+		CanCreateEnvironments:   types.BoolValue(member.CanCreateEnvironments),
+
+		// User details
+		FirstName:        types.StringValue(member.User.FirstName),
+		LastName:         types.StringValue(member.User.LastName),
+		Email:            types.StringValue(member.User.Email),
+		EmailVerified:    types.BoolValue(member.User.EmailVerified),
+		TwoFactorEnabled: types.BoolValue(member.User.TwoFactorEnabled),
+		Image:            types.StringPointerValue(member.User.Image),
+	}
+
+	var d diag.Diagnostics
+	model.AccessedProjects, d = types.ListValueFrom(ctx, types.StringType, member.AccessedProjects)
+	diags.Append(d...)
+	model.AccessedEnvironments, d = types.ListValueFrom(ctx, types.StringType, member.AccessedEnvironments)
+	diags.Append(d...)
+	model.AccessedServices, d = types.ListValueFrom(ctx, types.StringType, member.AccessedServices)
+	diags.Append(d...)
+	return model, diags
+}
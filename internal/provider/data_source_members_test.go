@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMemberModelFromAPI(t *testing.T) {
+	team := "team-1"
+	member := client.OrganizationMember{
+		ID:                "mem-1",
+		UserID:            "user-1",
+		Role:              "admin",
+		TeamID:            &team,
+		CanCreateProjects: true,
+		AccessedProjects:  []string{"proj-1"},
+	}
+
+	model, diags := memberModelFromAPI(context.Background(), member)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if model.MemberID.ValueString() != "mem-1" || model.Role.ValueString() != "admin" || model.TeamID.ValueString() != "team-1" {
+		t.Errorf("unexpected model %+v", model)
+	}
+	if !model.CanCreateProjects.ValueBool() || len(model.AccessedProjects.Elements()) != 1 {
+		t.Errorf("permissions not mapped: %+v", model)
+	}
+	if !model.Image.IsNull() {
+		t.Errorf("image = %v, want null", model.Image)
+	}
+}
+
+func TestAccMembersDataSources(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+data "dokploy_current_member" "me" {}
+
+data "dokploy_members" "owners" {
+  role = "owner"
+}
+`, host, apiKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dokploy_current_member.me", "organization_id"),
+					resource.TestCheckResourceAttrSet("data.dokploy_current_member.me", "role"),
+					resource.TestCheckResourceAttrPair("data.dokploy_current_member.me", "id", "data.dokploy_current_member.me", "member_id"),
+					resource.TestCheckResourceAttr("data.dokploy_members.owners", "members.0.role", "owner"),
+				),
+			},
+		},
+	})
+}
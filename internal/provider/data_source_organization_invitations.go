@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrganizationInvitationsDataSource{}
+
+func NewOrganizationInvitationsDataSource() datasource.DataSource {
+	return &OrganizationInvitationsDataSource{}
+}
+
+type OrganizationInvitationsDataSource struct {
+	client client.Client
+}
+
+type OrganizationInvitationsDataSourceModel struct {
+	Status      types.String      `tfsdk:"status"`
+	Invitations []InvitationModel `tfsdk:"invitations"`
+}
+
+type InvitationModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Email          types.String `tfsdk:"email"`
+	Role           types.String `tfsdk:"role"`
+	Status         types.String `tfsdk:"status"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Expired        types.Bool   `tfsdk:"expired"`
+	InviterID      types.String `tfsdk:"inviter_id"`
+}
+
+func (d *OrganizationInvitationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invitations"
+}
+
+func (d *OrganizationInvitationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the invitations of the current organization. Use expired to find stale invitations that can be cleaned up.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return invitations with this status: pending, accepted, rejected, or canceled. Defaults to pending.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "accepted", "rejected", "canceled"),
+				},
+			},
+			"invitations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of matching invitations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the invitation.",
+						},
+						"organization_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the organization the invitation is for.",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "The email address that was invited.",
+						},
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The role the invitee will get (e.g., member, admin).",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The invitation status.",
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the invitation expires.",
+						},
+						"expired": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the invitation has passed its expiry time.",
+						},
+						"inviter_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user who sent the invitation.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationInvitationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *OrganizationInvitationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config OrganizationInvitationsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := "pending"
+	if !config.Status.IsNull() {
+		status = config.Status.ValueString()
+	}
+
+	invitations, err := d.client.ListInvitations()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Invitations", err.Error())
+		return
+	}
+
+	state := OrganizationInvitationsDataSourceModel{
+		Status:      config.Status,
+		Invitations: []InvitationModel{},
+	}
+
+	now := time.Now()
+	for _, invitation := range invitations {
+		if invitation.Status != status {
+			continue
+		}
+		state.Invitations = append(state.Invitations, InvitationModel{
+			ID:             types.StringValue(invitation.ID),
+			OrganizationID: types.StringValue(invitation.OrganizationID),
+			Email:          types.StringValue(invitation.Email),
+			Role:           types.StringValue(invitation.Role),
+			Status:         types.StringValue(invitation.Status),
+			ExpiresAt:      types.StringValue(invitation.ExpiresAt),
+			Expired:        types.BoolValue(invitationExpired(invitation.ExpiresAt, now)),
+			InviterID:      types.StringValue(invitation.InviterID),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// invitationExpired reports whether an RFC 3339 expiry is before now.
+// Unparseable timestamps are treated as not expired.
+func invitationExpired(expiresAt string, now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+	return t.Before(now)
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+type ProjectDataSource struct {
+	client client.Client
+}
+
+type ProjectDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (d *ProjectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single project by its ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the project. Exactly one of id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the project. Exactly one of id or name must be set.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the project.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the project was created.",
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The organization ID this project belongs to.",
+			},
+		},
+	}
+}
+
+func (d *ProjectDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *ProjectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var project *client.Project
+	if !data.ID.IsNull() {
+		p, err := d.client.GetProject(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Project", err.Error())
+			return
+		}
+		project = p
+	} else {
+		projects, err := d.client.ListProjects()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to List Projects", err.Error())
+			return
+		}
+		for i := range projects {
+			if projects[i].Name == data.Name.ValueString() {
+				if project != nil {
+					resp.Diagnostics.AddError("Multiple Projects Found", fmt.Sprintf("More than one project is named %q. Look it up by id instead.", data.Name.ValueString()))
+					return
+				}
+				project = &projects[i]
+			}
+		}
+		if project == nil {
+			resp.Diagnostics.AddError("Project Not Found", fmt.Sprintf("No project named %q was found.", data.Name.ValueString()))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(project.ID)
+	data.Name = types.StringValue(project.Name)
+	data.Description = types.StringValue(project.Description)
+	data.CreatedAt = types.StringValue(project.CreatedAt)
+	data.OrganizationID = types.StringValue(project.OrganizationID)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerSetupScriptDataSource{}
+
+func NewServerSetupScriptDataSource() datasource.DataSource {
+	return &ServerSetupScriptDataSource{}
+}
+
+type ServerSetupScriptDataSource struct {
+	client client.Client
+}
+
+type ServerSetupScriptDataSourceModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+	Script   types.String `tfsdk:"script"`
+}
+
+func (d *ServerSetupScriptDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_setup_script"
+}
+
+func (d *ServerSetupScriptDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the script Dokploy generates to bootstrap a remote server, for use in cloud-init or other provisioning.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the server to generate the setup script for.",
+			},
+			"script": schema.StringAttribute{
+				Computed:    true,
+				Description: "The shell script that installs Docker and the Dokploy requirements on the server.",
+			},
+		},
+	}
+}
+
+func (d *ServerSetupScriptDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ServerSetupScriptDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServerSetupScriptDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	script, err := d.client.GetServerSetupCommand(data.ServerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Server Setup Script", err.Error())
+		return
+	}
+	data.Script = types.StringValue(script)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
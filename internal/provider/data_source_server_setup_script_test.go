@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccServerSetupScriptDataSource reads the setup script for a new server
+// record. Set TEST_SERVER_IP and TEST_SSH_KEY_ID to run it.
+func TestAccServerSetupScriptDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+	serverIP := os.Getenv("TEST_SERVER_IP")
+	sshKeyID := os.Getenv("TEST_SSH_KEY_ID")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	if serverIP == "" || sshKeyID == "" {
+		t.Skip("TEST_SERVER_IP and TEST_SSH_KEY_ID must be set for server acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerSetupScriptDataSourceConfig(serverIP, sshKeyID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dokploy_server_setup_script.test", "server_id", "dokploy_server.test", "id"),
+					resource.TestCheckResourceAttrSet("data.dokploy_server_setup_script.test", "script"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerSetupScriptDataSourceConfig(ipAddress, sshKeyID string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_server" "test" {
+  name        = "tf-acc-setup-script-server"
+  ip_address  = "%s"
+  port        = 22
+  username    = "root"
+  ssh_key_id  = "%s"
+  server_type = "deploy"
+}
+
+data "dokploy_server_setup_script" "test" {
+  server_id = dokploy_server.test.id
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), ipAddress, sshKeyID)
+}
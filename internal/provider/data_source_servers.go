@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,7 +19,7 @@ func NewServersDataSource() datasource.DataSource {
 }
 
 type ServersDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ServersDataSourceModel struct {
@@ -48,7 +50,10 @@ func (d *ServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 		Attributes: map[string]schema.Attribute{
 			"server_type": schema.StringAttribute{
 				Optional:    true,
-				Description: "Filter servers by type. Valid values: 'deploy', 'build'. If not specified, returns all servers.",
+				Description: "Filter servers by type. Valid values: 'deploy', 'build'. If not specified, returns all servers. Servers without a type are deploy servers.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("deploy", "build"),
+				},
 			},
 			"servers": schema.ListNestedAttribute{
 				Computed:    true,
@@ -106,9 +111,9 @@ func (d *ServersDataSource) Configure(_ context.Context, req datasource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -137,6 +142,11 @@ func (d *ServersDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	for _, server := range servers {
+		// Servers created before build servers existed have no type and
+		// are deploy servers.
+		if server.ServerType == "" {
+			server.ServerType = "deploy"
+		}
 		// Filter by server_type if specified
 		if filterType != "" && server.ServerType != filterType {
 			continue
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServiceLinkDataSource{}
+
+func NewServiceLinkDataSource() datasource.DataSource {
+	return &ServiceLinkDataSource{}
+}
+
+type ServiceLinkDataSource struct {
+	client client.Client
+}
+
+type ServiceLinkDataSourceModel struct {
+	ServiceID     types.String `tfsdk:"service_id"`
+	ServiceType   types.String `tfsdk:"service_type"`
+	Port          types.Int64  `tfsdk:"port"`
+	Host          types.String `tfsdk:"host"`
+	Address       types.String `tfsdk:"address"`
+	URL           types.String `tfsdk:"url"`
+	ConnectionURL types.String `tfsdk:"connection_url"`
+}
+
+func (d *ServiceLinkDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_link"
+}
+
+func (d *ServiceLinkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolves the internal address of another Dokploy service on the Dokploy network, for building environment variables such as DATABASE_URL.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service to link to.",
+			},
+			"service_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the service: application, postgres, mysql, mariadb, mongo, or redis.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("application", "postgres", "mysql", "mariadb", "mongo", "redis"),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Port the service listens on inside the network. Required for applications; defaults to the engine port for databases.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal hostname of the service (its appName).",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal host and port, in the form host:port.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the service without credentials (e.g., http://app-a1b2c3:3000 or postgresql://db-a1b2c3:5432/app).",
+			},
+			"connection_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Connection URL including credentials. Only set for databases. Use the dokploy_database_credentials ephemeral resource to keep credentials out of state.",
+			},
+		},
+	}
+}
+
+func (d *ServiceLinkDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ServiceLinkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceLinkDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+	serviceType := data.ServiceType.ValueString()
+
+	if serviceType == "application" {
+		if data.Port.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("port"), "Missing Port", "port is required when service_type is 'application'.")
+			return
+		}
+
+		app, err := d.client.GetApplication(serviceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Application", err.Error())
+			return
+		}
+
+		port := int(data.Port.ValueInt64())
+		address := net.JoinHostPort(app.AppName, strconv.Itoa(port))
+		data.Host = types.StringValue(app.AppName)
+		data.Address = types.StringValue(address)
+		data.URL = types.StringValue("http://" + address)
+		data.ConnectionURL = types.StringNull()
+
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	creds, err := getDatabaseCredentials(d.client, serviceType, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Database", err.Error())
+		return
+	}
+
+	port := creds.internalPort
+	if !data.Port.IsNull() {
+		port = int(data.Port.ValueInt64())
+	}
+
+	anonymous := *creds
+	anonymous.username = ""
+	anonymous.password = ""
+
+	data.Port = types.Int64Value(int64(port))
+	data.Host = types.StringValue(creds.appName)
+	data.Address = types.StringValue(net.JoinHostPort(creds.appName, strconv.Itoa(port)))
+	data.URL = types.StringValue(anonymous.connectionURL(creds.appName, port))
+	data.ConnectionURL = types.StringValue(creds.connectionURL(creds.appName, port))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
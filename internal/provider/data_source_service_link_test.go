@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceLinkDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLinkDataSourceConfig("tf-acc-link-project", "test-link-env", "test-link-pg", "test-link-app"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dokploy_service_link.db", "host", "dokploy_postgres.test", "app_name"),
+					resource.TestCheckResourceAttr("data.dokploy_service_link.db", "port", "5432"),
+					resource.TestMatchResourceAttr("data.dokploy_service_link.db", "url", regexp.MustCompile(`^postgresql://[^@]+:5432/testdb$`)),
+					resource.TestMatchResourceAttr("data.dokploy_service_link.db", "connection_url", regexp.MustCompile(`^postgresql://testuser:test_postgres_password_123@`)),
+					resource.TestCheckResourceAttrPair("data.dokploy_service_link.app", "host", "dokploy_application.test", "app_name"),
+					resource.TestMatchResourceAttr("data.dokploy_service_link.app", "url", regexp.MustCompile(`^http://.+:8080$`)),
+					resource.TestCheckNoResourceAttr("data.dokploy_service_link.app", "connection_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceLinkDataSourceConfig(projectName, envName, pgName, appName string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "%s"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
+  name              = "%s"
+  app_name          = "testlinkpg"
+  database_name     = "testdb"
+  database_user     = "testuser"
+  database_password = "test_postgres_password_123"
+  environment_id    = dokploy_environment.test.id
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "%s"
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+}
+
+data "dokploy_service_link" "db" {
+  service_id   = dokploy_postgres.test.id
+  service_type = "postgres"
+}
+
+data "dokploy_service_link" "app" {
+  service_id   = dokploy_application.test.id
+  service_type = "application"
+  port         = 8080
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, pgName, appName)
+}
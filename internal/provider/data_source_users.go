@@ -17,7 +17,7 @@ func NewUsersDataSource() datasource.DataSource {
 }
 
 type UsersDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type UsersDataSourceModel struct {
@@ -71,123 +71,7 @@ func (d *UsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 				Description: "List of users in the organization.",
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"member_id": schema.StringAttribute{
-							Computed:    true,
-							Description: "The organization membership ID.",
-						},
-						"organization_id": schema.StringAttribute{
-							Computed:    true,
-							Description: "The ID of the organization.",
-						},
-						"user_id": schema.StringAttribute{
-							Computed:    true,
-							Description: "The unique user ID.",
-						},
-						"role": schema.StringAttribute{
-							Computed:    true,
-							Description: "The user's role in the organization (e.g., 'owner', 'member').",
-						},
-						"team_id": schema.StringAttribute{
-							Computed:    true,
-							Description: "The team ID if the user belongs to a team.",
-						},
-						"is_default": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether this is the default organization membership.",
-						},
-						"created_at": schema.StringAttribute{
-							Computed:    true,
-							Description: "The timestamp when the membership was created.",
-						},
-
-						// Permission fields
-						"can_create_projects": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can create projects.",
-						},
-						"can_access_to_ssh_keys": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can access SSH keys.",
-						},
-						"can_create_services": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can create services.",
-						},
-						"can_delete_projects": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can delete projects.",
-						},
-						"can_delete_services": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can delete services.",
-						},
-						"can_access_to_docker": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can access Docker.",
-						},
-						"can_access_to_api": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can access the API.",
-						},
-						"can_access_to_git_providers": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can access Git providers.",
-						},
-						"can_access_to_traefik_files": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can access Traefik files.",
-						},
-						"can_delete_environments": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can delete environments.",
-						},
-						"can_create_environments": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user can create environments.",
-						},
-						"accessed_projects": schema.ListAttribute{
-							Computed:    true,
-							ElementType: types.StringType,
-							Description: "List of project IDs the user has access to.",
-						},
-						"accessed_environments": schema.ListAttribute{
-							Computed:    true,
-							ElementType: types.StringType,
-							Description: "List of environment IDs the user has access to.",
-						},
-						"accessed_services": schema.ListAttribute{
-							Computed:    true,
-							ElementType: types.StringType,
-							Description: "List of service IDs the user has access to.",
-						},
-
-						// User details
-						"first_name": schema.StringAttribute{
-							Computed:    true,
-							Description: "The user's first name.",
-						},
-						"last_name": schema.StringAttribute{
-							Computed:    true,
-							Description: "The user's last name.",
-						},
-						"email": schema.StringAttribute{
-							Computed:    true,
-							Description: "The user's email address.",
-						},
-						"email_verified": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether the user's email is verified.",
-						},
-						"two_factor_enabled": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Whether two-factor authentication is enabled.",
-						},
-						"image": schema.StringAttribute{
-							Computed:    true,
-							Description: "The user's profile image URL.",
-						},
-					},
+					Attributes: memberAttributes(),
 				},
 			},
 		},
@@ -198,9 +82,9 @@ func (d *UsersDataSource) Configure(_ context.Context, req datasource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
@@ -216,71 +100,11 @@ func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	var state UsersDataSourceModel
 
 	for _, member := range members {
-		userModel := UserModel{
-			MemberID:       types.StringValue(member.ID),
-			OrganizationID: types.StringValue(member.OrganizationID),
-			UserID:         types.StringValue(member.UserID),
-			Role:           types.StringValue(member.Role),
-			IsDefault:      types.BoolValue(member.IsDefault),
-			CreatedAt:      types.StringValue(member.CreatedAt),
-
-			// Permissions
-			CanCreateProjects:       types.BoolValue(member.CanCreateProjects),
-			CanAccessToSSHKeys:      types.BoolValue(member.CanAccessToSSHKeys),
-			CanCreateServices:       types.BoolValue(member.CanCreateServices),
-			CanDeleteProjects:       types.BoolValue(member.CanDeleteProjects),
-			CanDeleteServices:       types.BoolValue(member.CanDeleteServices),
-			CanAccessToDocker:       types.BoolValue(member.CanAccessToDocker),
-			CanAccessToAPI:          types.BoolValue(member.CanAccessToAPI),
-			CanAccessToGitProviders: types.BoolValue(member.CanAccessToGitProviders),
-			CanAccessToTraefikFiles: types.BoolValue(member.CanAccessToTraefikFiles),
-			CanDeleteEnvironments:   types.BoolValue(member.CanDeleteEnvironments),
-			CanCreateEnvironments:   types.BoolValue(member.CanCreateEnvironments),
-
-			// User details
-			FirstName:        types.StringValue(member.User.FirstName),
-			LastName:         types.StringValue(member.User.LastName),
-			Email:            types.StringValue(member.User.Email),
-			EmailVerified:    types.BoolValue(member.User.EmailVerified),
-			TwoFactorEnabled: types.BoolValue(member.User.TwoFactorEnabled),
-		}
-
-		// Handle optional team_id
-		if member.TeamID != nil {
-			userModel.TeamID = types.StringValue(*member.TeamID)
-		} else {
-			userModel.TeamID = types.StringNull()
-		}
-
-		// Handle optional image
-		if member.User.Image != nil {
-			userModel.Image = types.StringValue(*member.User.Image)
-		} else {
-			userModel.Image = types.StringNull()
-		}
-
-		// Convert string slices to list values
-		accessedProjects, diags := types.ListValueFrom(ctx, types.StringType, member.AccessedProjects)
+		userModel, diags := memberModelFromAPI(ctx, member)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		userModel.AccessedProjects = accessedProjects
-
-		accessedEnvironments, diags := types.ListValueFrom(ctx, types.StringType, member.AccessedEnvironments)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		userModel.AccessedEnvironments = accessedEnvironments
-
-		accessedServices, diags := types.ListValueFrom(ctx, types.StringType, member.AccessedServices)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		userModel.AccessedServices = accessedServices
-
 		state.Users = append(state.Users, userModel)
 	}
 
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VersionDataSource{}
+
+func NewVersionDataSource() datasource.DataSource {
+	return &VersionDataSource{}
+}
+
+type VersionDataSource struct {
+	client client.Client
+}
+
+type VersionDataSourceModel struct {
+	Version types.String `tfsdk:"version"`
+	Major   types.Int64  `tfsdk:"major"`
+	Minor   types.Int64  `tfsdk:"minor"`
+	Patch   types.Int64  `tfsdk:"patch"`
+}
+
+func (d *VersionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version"
+}
+
+func (d *VersionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the version of the Dokploy server the provider is connected to.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version string reported by the server (e.g., v0.23.1).",
+			},
+			"major": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The major version number.",
+			},
+			"minor": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The minor version number.",
+			},
+			"patch": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The patch version number.",
+			},
+		},
+	}
+}
+
+func (d *VersionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *VersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	raw, err := d.client.GetVersion()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Dokploy Version", err.Error())
+		return
+	}
+
+	version, err := client.ParseVersion(raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Parse Dokploy Version", err.Error())
+		return
+	}
+
+	data := VersionDataSourceModel{
+		Version: types.StringValue(raw),
+		Major:   types.Int64Value(int64(version.Major)),
+		Minor:   types.Int64Value(int64(version.Minor)),
+		Patch:   types.Int64Value(int64(version.Patch)),
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
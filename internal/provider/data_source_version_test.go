@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVersionDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVersionDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.dokploy_version.test", "version", regexp.MustCompile(`^v?\d+\.\d+\.\d+`)),
+					resource.TestCheckResourceAttrSet("data.dokploy_version.test", "minor"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVersionDataSourceConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+data "dokploy_version" "test" {}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
+
+func TestRequireDokployVersion(t *testing.T) {
+	cases := []struct {
+		name    string
+		version string
+		err     error
+		want    bool
+	}{
+		{"newer", "v0.24.2", nil, true},
+		{"equal", "v0.23.0", nil, true},
+		{"canary of newer", "v0.23.1-canary.4", nil, true},
+		{"older", "v0.20.8", nil, false},
+		{"unknown", "", errors.New("404 not found"), true},
+		{"unparseable", "dev", nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := clientmock.New()
+			mock.GetVersionFunc = func() (string, error) { return tc.version, tc.err }
+
+			var diags diag.Diagnostics
+			got := requireDokployVersion(mock, minVersionEnvironments, "dokploy_environment", &diags)
+			if got != tc.want || diags.HasError() == tc.want {
+				t.Fatalf("requireDokployVersion(%q) = %v, diags %v", tc.version, got, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VolumeDataSource{}
+
+func NewVolumeDataSource() datasource.DataSource {
+	return &VolumeDataSource{}
+}
+
+type VolumeDataSource struct {
+	client client.Client
+}
+
+type VolumeDataSourceModel struct {
+	ServerID   types.String  `tfsdk:"server_id"`
+	Name       types.String  `tfsdk:"name"`
+	NamePrefix types.String  `tfsdk:"name_prefix"`
+	Volumes    []VolumeModel `tfsdk:"volumes"`
+}
+
+type VolumeModel struct {
+	Name       types.String `tfsdk:"name"`
+	Driver     types.String `tfsdk:"driver"`
+	Mountpoint types.String `tfsdk:"mountpoint"`
+	Scope      types.String `tfsdk:"scope"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+func (d *VolumeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume"
+}
+
+func (d *VolumeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Docker volumes on a server. Set name to confirm a volume exists before mounting or backing it up.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the server to list volumes on. If not set, lists volumes on the Dokploy host.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Exact volume name to look up. The data source fails if no such volume exists.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("name_prefix")),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return volumes whose name starts with this prefix (e.g., an application's app_name).",
+			},
+			"volumes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of matching volumes.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The volume name.",
+						},
+						"driver": schema.StringAttribute{
+							Computed:    true,
+							Description: "The volume driver (e.g., local).",
+						},
+						"mountpoint": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path of the volume data on the host.",
+						},
+						"scope": schema.StringAttribute{
+							Computed:    true,
+							Description: "The volume scope: local or global.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the volume was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VolumeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *VolumeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config VolumeDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := ""
+	if !config.ServerID.IsNull() && !config.ServerID.IsUnknown() {
+		serverID = config.ServerID.ValueString()
+	}
+
+	volumes, err := d.client.ListDockerVolumes(serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Docker Volumes", err.Error())
+		return
+	}
+
+	state := VolumeDataSourceModel{
+		ServerID:   config.ServerID,
+		Name:       config.Name,
+		NamePrefix: config.NamePrefix,
+		Volumes:    []VolumeModel{},
+	}
+
+	for _, volume := range volumes {
+		if !config.Name.IsNull() && volume.Name != config.Name.ValueString() {
+			continue
+		}
+		if !config.NamePrefix.IsNull() && !strings.HasPrefix(volume.Name, config.NamePrefix.ValueString()) {
+			continue
+		}
+		state.Volumes = append(state.Volumes, VolumeModel{
+			Name:       types.StringValue(volume.Name),
+			Driver:     types.StringValue(volume.Driver),
+			Mountpoint: types.StringValue(volume.Mountpoint),
+			Scope:      types.StringValue(volume.Scope),
+			CreatedAt:  types.StringValue(volume.CreatedAt),
+		})
+	}
+
+	if !config.Name.IsNull() && len(state.Volumes) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Volume Not Found",
+			fmt.Sprintf("No Docker volume named %q exists on the server.", config.Name.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -19,7 +19,7 @@ func NewVolumeBackupsDataSource() datasource.DataSource {
 }
 
 type VolumeBackupsDataSource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type VolumeBackupsDataSourceModel struct {
@@ -131,9 +131,9 @@ func (d *VolumeBackupsDataSource) Configure(_ context.Context, req datasource.Co
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	d.client = client
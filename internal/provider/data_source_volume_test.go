@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVolumeDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// List all volumes on the Dokploy host
+			{
+				Config: testAccVolumeDataSourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dokploy_volume.test", "volumes.#"),
+				),
+			},
+			// Looking up a missing volume fails
+			{
+				Config:      testAccVolumeDataSourceConfig(`name = "tf-acc-missing-volume"`),
+				ExpectError: regexp.MustCompile("Volume Not Found"),
+			},
+		},
+	})
+}
+
+func testAccVolumeDataSourceConfig(filter string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+data "dokploy_volume" "test" {
+  %s
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), filter)
+}
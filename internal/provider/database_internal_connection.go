@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// internalHostAttribute is the internal_host attribute shared by the
+// database resources.
+func internalHostAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Description: "Hostname of the database on the Dokploy network, i.e. its generated app name.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// internalPortAttribute is the internal_port attribute shared by the database
+// resources.
+func internalPortAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Computed:    true,
+		Description: "Port of the database on the Dokploy network.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// internalConnectionURLAttribute is the internal_connection_url attribute
+// shared by the database resources. It embeds the password, so it is not
+// carried over from state when the database changes.
+func internalConnectionURLAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Sensitive:   true,
+		Description: "Connection URL for applications and compose stacks on the Dokploy network, including the credentials.",
+	}
+}
+
+// internalConnection returns the internal_host, internal_port and
+// internal_connection_url values for creds. The configured password is used
+// when the API response does not include one.
+func internalConnection(creds *databaseCredentials, password types.String) (types.String, types.Int64, types.String) {
+	if creds.password == "" {
+		creds.password = password.ValueString()
+	}
+	return types.StringValue(creds.appName),
+		types.Int64Value(int64(creds.internalPort)),
+		types.StringValue(creds.connectionURL(creds.appName, creds.internalPort))
+}
+
+func postgresCredentials(db *client.Postgres) *databaseCredentials {
+	return &databaseCredentials{"postgresql", db.DatabaseUser, db.DatabasePassword, db.DatabaseName, db.AppName, 5432, db.ExternalPort, db.ServerID}
+}
+
+func mysqlCredentials(db *client.MySQL) *databaseCredentials {
+	return &databaseCredentials{"mysql", db.DatabaseUser, db.DatabasePassword, db.DatabaseName, db.AppName, 3306, db.ExternalPort, db.ServerID}
+}
+
+func mariadbCredentials(db *client.MariaDB) *databaseCredentials {
+	return &databaseCredentials{"mariadb", db.DatabaseUser, db.DatabasePassword, db.DatabaseName, db.AppName, 3306, db.ExternalPort, db.ServerID}
+}
+
+func mongoCredentials(db *client.MongoDB) *databaseCredentials {
+	return &databaseCredentials{"mongodb", db.DatabaseUser, db.DatabasePassword, "", db.AppName, 27017, db.ExternalPort, db.ServerID}
+}
+
+func redisCredentials(db *client.Redis) *databaseCredentials {
+	return &databaseCredentials{"redis", "default", db.DatabasePassword, "", db.AppName, 6379, db.ExternalPort, db.ServerID}
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clearedDatabaseFields returns the optional database settings that the plan
+// leaves null or empty, so updates reset them instead of keeping the previous
+// value. Unknown values are left alone.
+func clearedDatabaseFields(description, command, env, memoryReservation, memoryLimit, cpuReservation, cpuLimit types.String, externalPort types.Int64) []client.DatabaseField {
+	var fields []client.DatabaseField
+	for _, setting := range []struct {
+		field client.DatabaseField
+		value types.String
+	}{
+		{client.DatabaseFieldDescription, description},
+		{client.DatabaseFieldCommand, command},
+		{client.DatabaseFieldEnv, env},
+		{client.DatabaseFieldMemoryReservation, memoryReservation},
+		{client.DatabaseFieldMemoryLimit, memoryLimit},
+		{client.DatabaseFieldCPUReservation, cpuReservation},
+		{client.DatabaseFieldCPULimit, cpuLimit},
+	} {
+		if !setting.value.IsUnknown() && setting.value.ValueString() == "" {
+			fields = append(fields, setting.field)
+		}
+	}
+	if !externalPort.IsUnknown() && externalPort.ValueInt64() == 0 {
+		fields = append(fields, client.DatabaseFieldExternalPort)
+	}
+	return fields
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestClearedDatabaseFields(t *testing.T) {
+	got := clearedDatabaseFields(
+		types.StringValue("db"),
+		types.StringNull(),
+		types.StringValue(""),
+		types.StringUnknown(),
+		types.StringValue("512m"),
+		types.StringNull(),
+		types.StringValue("1"),
+		types.Int64Null(),
+	)
+	want := []client.DatabaseField{
+		client.DatabaseFieldCommand,
+		client.DatabaseFieldEnv,
+		client.DatabaseFieldCPUReservation,
+		client.DatabaseFieldExternalPort,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("clearedDatabaseFields() = %v, want %v", got, want)
+	}
+
+	if got := clearedDatabaseFields(
+		types.StringValue("db"),
+		types.StringValue("redis-server"),
+		types.StringValue("A=1"),
+		types.StringValue("256m"),
+		types.StringValue("512m"),
+		types.StringValue("0.5"),
+		types.StringValue("1"),
+		types.Int64Unknown(),
+	); got != nil {
+		t.Errorf("clearedDatabaseFields() = %v, want nil", got)
+	}
+}
+
+// testAccDatabaseSettings renders the optional database settings that can be
+// cleared, for use inside a database resource block.
+func testAccDatabaseSettings(command string, externalPort int) string {
+	return fmt.Sprintf(`
+  description        = "Database with settings"
+  command            = %q
+  env                = "TZ=UTC"
+  memory_reservation = "128"
+  memory_limit       = "256"
+  cpu_reservation    = "0.25"
+  cpu_limit          = "0.5"
+  external_port      = %d
+`, command, externalPort)
+}
+
+// testAccCheckDatabaseSettingsCleared checks that every clearable setting was
+// removed from the resource's state.
+func testAccCheckDatabaseSettingsCleared(resourceName string) resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	for _, attr := range []string{
+		"description", "command", "env",
+		"memory_reservation", "memory_limit",
+		"cpu_reservation", "cpu_limit",
+		"external_port",
+	} {
+		checks = append(checks, resource.TestCheckNoResourceAttr(resourceName, attr))
+	}
+	return resource.ComposeTestCheckFunc(checks...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/waiter"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Polling bounds for waitForDatabase.
+var (
+	databaseDeployTimeout  = 15 * time.Minute
+	databaseDeployInterval = 5 * time.Second
+)
+
+// applyImmediatelyAttribute is the apply_immediately attribute shared by the
+// database resources.
+func applyImmediatelyAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "Whether to redeploy the database when docker_image changes and wait until it is healthy again. Otherwise Dokploy only records the new image, which is used the next time the database is deployed. Defaults to false.",
+	}
+}
+
+// backupBeforeUpgradeAttribute is the backup_before_upgrade attribute shared
+// by the database resources that support backups.
+func backupBeforeUpgradeAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "Whether to run the database's configured backups once before apply_immediately redeploys it with a new docker_image. The upgrade is aborted if a backup fails. Defaults to false.",
+	}
+}
+
+// imageUpgrade reports whether an update changes the docker image of a
+// database that should be redeployed right away.
+func imageUpgrade(prior, planned types.String, applyImmediately types.Bool) bool {
+	if !applyImmediately.ValueBool() || planned.IsNull() || planned.IsUnknown() {
+		return false
+	}
+	return prior.ValueString() != planned.ValueString()
+}
+
+// runUpgradeBackups runs every backup configured for the database once
+// before an upgrade. It returns false if any of them fail, so the upgrade
+// is not attempted.
+func runUpgradeBackups(c client.Client, databaseID, databaseType string, diags *diag.Diagnostics) bool {
+	backups, err := c.GetBackupsByDatabaseID(databaseID, databaseType)
+	if err != nil {
+		diags.AddError("Error reading backups before upgrade", err.Error())
+		return false
+	}
+	if len(backups) == 0 {
+		diags.AddWarning(
+			"No Upgrade Backup Taken",
+			fmt.Sprintf("No backups are configured for %s database %s, so no backup was taken before upgrading it.", databaseType, databaseID),
+		)
+		return true
+	}
+
+	for _, backup := range backups {
+		if err := c.RunBackup(backup.BackupID, databaseType); err != nil {
+			diags.AddError(
+				"Error running upgrade backup",
+				fmt.Sprintf("Backup %s failed, the database was not upgraded: %s. Set backup_before_upgrade = false to upgrade it without a backup.", backup.BackupID, err.Error()),
+			)
+			return false
+		}
+	}
+	return true
+}
+
+// redeployDatabase redeploys a database so that it runs its new docker image
+// and waits for it to come back. It returns the final application status,
+// or "" with an error diagnostic.
+func redeployDatabase(ctx context.Context, c client.Client, databaseID, databaseType string, diags *diag.Diagnostics) string {
+	if err := c.DeployDatabase(databaseID, databaseType); err != nil {
+		diags.AddError("Error redeploying database", fmt.Sprintf("The new docker image was saved but %s database %s could not be redeployed: %s", databaseType, databaseID, err))
+		return ""
+	}
+
+	db, err := waitForDatabase(ctx, c, databaseID, databaseType)
+	if err != nil {
+		diags.AddError("Error waiting for database", fmt.Sprintf("%s database %s did not become healthy after redeploying: %s", databaseType, databaseID, err))
+		return ""
+	}
+	if db.ApplicationStatus != "done" {
+		diags.AddError(
+			"Database Upgrade Failed",
+			fmt.Sprintf("%s database %s finished redeploying with status %q. Check its logs in Dokploy; the previous data volume is kept.", databaseType, databaseID, db.ApplicationStatus),
+		)
+		return ""
+	}
+	return db.ApplicationStatus
+}
+
+// waitForDatabase polls a database until its deployment has finished,
+// successfully or not.
+func waitForDatabase(ctx context.Context, c client.Client, databaseID, databaseType string) (*client.Database, error) {
+	cfg := waiter.Config{
+		Interval:    databaseDeployInterval,
+		MaxInterval: 4 * databaseDeployInterval,
+		Multiplier:  1.5,
+		Jitter:      0.1,
+		Timeout:     databaseDeployTimeout,
+	}
+	return waiter.Wait(ctx, cfg, func(context.Context) (*client.Database, error) {
+		db, err := c.GetDatabase(databaseID, databaseType)
+		if err != nil {
+			return nil, waiter.Retryable(err)
+		}
+		return db, nil
+	}, func(db *client.Database) bool {
+		return db != nil && (db.ApplicationStatus == "done" || db.ApplicationStatus == "error")
+	})
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImageUpgrade(t *testing.T) {
+	tests := []struct {
+		name             string
+		prior, planned   types.String
+		applyImmediately types.Bool
+		want             bool
+	}{
+		{"changed image", types.StringValue("postgres:15"), types.StringValue("postgres:16"), types.BoolValue(true), true},
+		{"same image", types.StringValue("postgres:16"), types.StringValue("postgres:16"), types.BoolValue(true), false},
+		{"not applied immediately", types.StringValue("postgres:15"), types.StringValue("postgres:16"), types.BoolValue(false), false},
+		{"unknown image", types.StringValue("postgres:15"), types.StringUnknown(), types.BoolValue(true), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := imageUpgrade(tt.prior, tt.planned, tt.applyImmediately); got != tt.want {
+				t.Errorf("imageUpgrade() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedeployDatabase(t *testing.T) {
+	defer func(interval time.Duration) { databaseDeployInterval = interval }(databaseDeployInterval)
+	databaseDeployInterval = time.Millisecond
+
+	t.Run("waits until healthy", func(t *testing.T) {
+		mock := clientmock.New()
+		var deployed string
+		mock.DeployDatabaseFunc = func(id, dbType string) error {
+			deployed = dbType + "/" + id
+			return nil
+		}
+		statuses := []string{"running", "running", "done"}
+		mock.GetDatabaseFunc = func(dbID, databaseType string) (*client.Database, error) {
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			return &client.Database{ID: dbID, ApplicationStatus: status}, nil
+		}
+
+		var diags diag.Diagnostics
+		if status := redeployDatabase(context.Background(), mock, "pg-1", "postgres", &diags); status != "done" || diags.HasError() {
+			t.Fatalf("redeployDatabase() = %q, diags %v", status, diags)
+		}
+		if deployed != "postgres/pg-1" {
+			t.Errorf("deployed %q, want postgres/pg-1", deployed)
+		}
+	})
+
+	t.Run("reports a failed deployment", func(t *testing.T) {
+		mock := clientmock.New()
+		mock.DeployDatabaseFunc = func(id, dbType string) error { return nil }
+		mock.GetDatabaseFunc = func(dbID, databaseType string) (*client.Database, error) {
+			return &client.Database{ID: dbID, ApplicationStatus: "error"}, nil
+		}
+
+		var diags diag.Diagnostics
+		if status := redeployDatabase(context.Background(), mock, "pg-1", "postgres", &diags); status != "" || !diags.HasError() {
+			t.Fatalf("expected failure, got %q, diags %v", status, diags)
+		}
+	})
+
+	t.Run("reports a deploy error", func(t *testing.T) {
+		mock := clientmock.New()
+		mock.DeployDatabaseFunc = func(id, dbType string) error { return errors.New("boom") }
+
+		var diags diag.Diagnostics
+		if status := redeployDatabase(context.Background(), mock, "pg-1", "postgres", &diags); status != "" || !diags.HasError() {
+			t.Fatalf("expected failure, got %q, diags %v", status, diags)
+		}
+	})
+}
+
+func TestRunUpgradeBackups(t *testing.T) {
+	mock := clientmock.New()
+	mock.GetBackupsByDatabaseIDFunc = func(databaseID, databaseType string) ([]client.Backup, error) {
+		return []client.Backup{{BackupID: "b1"}}, nil
+	}
+	mock.RunBackupFunc = func(backupID, backupType string) error {
+		return errors.New("destination unreachable")
+	}
+
+	var diags diag.Diagnostics
+	if runUpgradeBackups(mock, "pg-1", "postgres", &diags) || !diags.HasError() {
+		t.Fatalf("expected the upgrade to be aborted, diags %v", diags)
+	}
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/waiter"
+)
+
+// Backoff bounds for deleteChild.
+var (
+	childDeleteInterval = time.Second
+	childDeleteAttempts = 5
+)
+
+// deleteChild deletes an object that belongs to a parent, such as a port of
+// an application or an application of an environment. When a large stack is
+// destroyed, Terraform deletes siblings in parallel and may delete the
+// parent at the same time; Dokploy then answers the child's delete with a
+// 404, or a 500 while the parent's delete cascades. So a child that is
+// already gone, or whose parent is gone, counts as deleted, and server and
+// network errors are retried with backoff while the parent still exists.
+//
+// parent reads the parent and may be nil for top-level objects, or when the
+// parent is not known, which only get the retries.
+func deleteChild(ctx context.Context, remove func() error, parent func() error) error {
+	cfg := waiter.Config{
+		Interval:    childDeleteInterval,
+		MaxInterval: 8 * childDeleteInterval,
+		Multiplier:  2,
+		Jitter:      0.2,
+		MaxAttempts: childDeleteAttempts,
+	}
+	_, err := waiter.Wait(ctx, cfg, func(context.Context) (bool, error) {
+		err := remove()
+		if err == nil || errors.Is(err, client.ErrNotFound) {
+			return true, nil
+		}
+		if parent != nil && errors.Is(parent(), client.ErrNotFound) {
+			return true, nil
+		}
+		var urlErr *url.Error
+		if client.IsServerError(err) || errors.As(err, &urlErr) {
+			return false, waiter.Retryable(err)
+		}
+		return false, err
+	}, func(deleted bool) bool { return deleted })
+	return err
+}
+
+// serviceParent returns a function reading the service of the given type
+// for deleteChild, or nil when id is empty.
+func serviceParent(c client.Client, serviceType, id string) func() error {
+	if id == "" {
+		return nil
+	}
+	return func() error {
+		var err error
+		switch serviceType {
+		case "application":
+			_, err = c.GetApplication(id)
+		case "compose":
+			_, err = c.GetCompose(id)
+		case "postgres":
+			_, err = c.GetPostgres(id)
+		case "mysql":
+			_, err = c.GetMySQL(id)
+		case "mariadb":
+			_, err = c.GetMariaDB(id)
+		case "mongo":
+			_, err = c.GetMongoDB(id)
+		case "redis":
+			_, err = c.GetRedis(id)
+		}
+		return err
+	}
+}
+
+// environmentParent returns a function reading an environment for
+// deleteChild, or nil when id is empty.
+func environmentParent(c client.Client, id string) func() error {
+	if id == "" {
+		return nil
+	}
+	return func() error {
+		_, err := c.GetEnvironment(id)
+		return err
+	}
+}
+
+// projectParent returns a function reading a project for deleteChild, or nil
+// when id is empty.
+func projectParent(c client.Client, id string) func() error {
+	if id == "" {
+		return nil
+	}
+	return func() error {
+		_, err := c.GetProject(id)
+		return err
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+)
+
+func TestDeleteChild(t *testing.T) {
+	defer func(interval time.Duration) { childDeleteInterval = interval }(childDeleteInterval)
+	childDeleteInterval = time.Millisecond
+
+	serverError := &client.APIError{StatusCode: 500, Status: "500 Internal Server Error"}
+	notFound := fmt.Errorf("%w: gone", client.ErrNotFound)
+	tests := []struct {
+		name        string
+		removeErrs  []error
+		parentErr   error
+		noParent    bool
+		wantCalls   int
+		wantDeleted bool
+	}{
+		{name: "deleted", removeErrs: []error{nil}, wantCalls: 1, wantDeleted: true},
+		{name: "already gone", removeErrs: []error{notFound}, wantCalls: 1, wantDeleted: true},
+		{name: "parent gone", removeErrs: []error{serverError}, parentErr: notFound, wantCalls: 1, wantDeleted: true},
+		{name: "transient failure", removeErrs: []error{serverError, serverError, nil}, wantCalls: 3, wantDeleted: true},
+		{name: "persistent failure", removeErrs: []error{serverError}, wantCalls: childDeleteAttempts},
+		{name: "client error", removeErrs: []error{&client.APIError{StatusCode: 400, Status: "400 Bad Request"}}, wantCalls: 1},
+		{name: "top level", removeErrs: []error{serverError, nil}, noParent: true, wantCalls: 2, wantDeleted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			remove := func() error {
+				err := tt.removeErrs[min(calls, len(tt.removeErrs)-1)]
+				calls++
+				return err
+			}
+			parent := func() error { return tt.parentErr }
+			if tt.noParent {
+				parent = nil
+			}
+
+			err := deleteChild(context.Background(), remove, parent)
+			if (err == nil) != tt.wantDeleted {
+				t.Errorf("deleteChild() = %v, want deleted %v", err, tt.wantDeleted)
+			}
+			if !tt.wantDeleted && !errors.As(err, new(*client.APIError)) {
+				t.Errorf("deleteChild() = %v, want the API error", err)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("remove called %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// TestDeleteChildUnknownParent checks that a child without a parent ID, such
+// as a domain with neither application_id nor compose_id, reports its own
+// delete error instead of taking a failed parent lookup for a deleted parent.
+func TestDeleteChildUnknownParent(t *testing.T) {
+	mock := clientmock.New()
+	mock.GetApplicationFunc = func(id string) (*client.Application, error) {
+		return nil, fmt.Errorf("%w: application %q", client.ErrNotFound, id)
+	}
+	for _, parent := range []func() error{
+		serviceParent(mock, "application", ""),
+		environmentParent(mock, ""),
+		projectParent(mock, ""),
+	} {
+		if parent != nil {
+			t.Error("parent lookup returned for an empty ID")
+		}
+	}
+
+	deleteErr := &client.APIError{StatusCode: 400, Status: "400 Bad Request"}
+	err := deleteChild(context.Background(), func() error { return deleteErr }, serviceParent(mock, "application", ""))
+	if !errors.Is(err, deleteErr) {
+		t.Errorf("deleteChild() = %v, want the delete error", err)
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute is the deletion_protection attribute shared by
+// the database resources.
+func deletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+		Description: "Whether Terraform is prevented from deleting the database. Set to false and apply before destroying or replacing it. Defaults to true.",
+	}
+}
+
+// skipFinalBackupAttribute is the skip_final_backup attribute shared by the
+// database resources that support backups.
+func skipFinalBackupAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "Whether to skip running the database's configured backups once before it is deleted. Defaults to false.",
+	}
+}
+
+// checkDeletionProtection adds an error and returns false when deletion
+// protection is enabled for the resource.
+func checkDeletionProtection(protected types.Bool, resourceType, id string, diags *diag.Diagnostics) bool {
+	if protected.IsNull() || !protected.ValueBool() {
+		return true
+	}
+	diags.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("%s %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying or replacing it.", resourceType, id),
+	)
+	return false
+}
+
+// runFinalBackups runs every backup configured for the database once. It
+// returns false if any of them fail, so the database is kept.
+func runFinalBackups(c client.Client, databaseID, databaseType string, diags *diag.Diagnostics) bool {
+	backups, err := c.GetBackupsByDatabaseID(databaseID, databaseType)
+	if err != nil {
+		diags.AddError("Error reading backups before delete", err.Error())
+		return false
+	}
+	if len(backups) == 0 {
+		diags.AddWarning(
+			"No Final Backup Taken",
+			fmt.Sprintf("No backups are configured for %s database %s, so no final backup was taken before deleting it.", databaseType, databaseID),
+		)
+		return true
+	}
+
+	for _, backup := range backups {
+		if err := c.RunBackup(backup.BackupID, databaseType); err != nil {
+			diags.AddError(
+				"Error running final backup",
+				fmt.Sprintf("Backup %s failed, the database was not deleted: %s. Set skip_final_backup = true to delete it without a backup.", backup.BackupID, err.Error()),
+			)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/waiter"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Polling bounds for waitForDeployment.
+var (
+	deploymentTimeout  = 30 * time.Minute
+	deploymentInterval = 5 * time.Second
+)
+
+// failedDeploymentLogLines is how many lines of build log are included in
+// the diagnostic of a failed deployment.
+const failedDeploymentLogLines = 50
+
+// latestDeploymentID returns the ID of the most recent deployment of a
+// service, or "" when it has none.
+func latestDeploymentID(c client.Client, serviceID, deploymentType string) (string, error) {
+	deployments, err := c.ListDeploymentsByType(serviceID, deploymentType)
+	if err != nil || len(deployments) == 0 {
+		return "", err
+	}
+	return deployments[0].DeploymentID, nil
+}
+
+// waitForDeployment waits for the deployment that follows previousID to
+// finish and returns it. Dokploy queues deployments, so the new one may take
+// a while to appear.
+func waitForDeployment(ctx context.Context, c client.Client, serviceID, deploymentType, previousID string) (*client.Deployment, error) {
+	cfg := waiter.Config{
+		Interval:    deploymentInterval,
+		MaxInterval: 4 * deploymentInterval,
+		Multiplier:  1.5,
+		Jitter:      0.1,
+		Timeout:     deploymentTimeout,
+	}
+	return waiter.Wait(ctx, cfg, func(context.Context) (*client.Deployment, error) {
+		deployments, err := c.ListDeploymentsByType(serviceID, deploymentType)
+		if err != nil {
+			return nil, waiter.Retryable(err)
+		}
+		if len(deployments) == 0 || deployments[0].DeploymentID == previousID {
+			return nil, nil
+		}
+		return &deployments[0], nil
+	}, func(dep *client.Deployment) bool {
+		return dep != nil && dep.Status != "running"
+	})
+}
+
+// addDeploymentFailure reports a failed deployment as an error, including
+// the tail of its build log so the cause is visible without the UI.
+func addDeploymentFailure(diags *diag.Diagnostics, c client.Client, summary string, dep *client.Deployment, serverID string) {
+	detail := fmt.Sprintf("Deployment %s finished with status %q.", dep.DeploymentID, dep.Status)
+	if dep.ErrorMessage != nil && *dep.ErrorMessage != "" {
+		detail += "\n\n" + *dep.ErrorMessage
+	}
+
+	if dep.LogPath != "" {
+		log, err := c.ReadDeploymentLog(dep.LogPath, serverID)
+		switch {
+		case err != nil:
+			detail += fmt.Sprintf("\n\nThe build log could not be read: %s", err)
+		case strings.TrimSpace(log) != "":
+			detail += fmt.Sprintf("\n\nLast %d lines of the build log:\n\n%s", failedDeploymentLogLines, lastLines(log, failedDeploymentLogLines))
+		}
+	}
+
+	diags.AddError(summary, detail)
+}
+
+// lastLines returns at most the last n lines of s, without trailing blank
+// lines.
+func lastLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\r\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
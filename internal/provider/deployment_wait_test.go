@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+)
+
+func TestWaitForDeployment(t *testing.T) {
+	defer func(interval time.Duration) { deploymentInterval = interval }(deploymentInterval)
+	deploymentInterval = time.Millisecond
+
+	// The new deployment is queued behind the previous one, then runs.
+	polls := [][]client.Deployment{
+		{{DeploymentID: "dep-1", Status: "done"}},
+		{{DeploymentID: "dep-2", Status: "running"}, {DeploymentID: "dep-1", Status: "done"}},
+		{{DeploymentID: "dep-2", Status: "done"}, {DeploymentID: "dep-1", Status: "done"}},
+	}
+	mock := clientmock.New()
+	calls := 0
+	mock.ListDeploymentsByTypeFunc = func(id, deploymentType string) ([]client.Deployment, error) {
+		if id != "c1" || deploymentType != "compose" {
+			t.Errorf("ListDeploymentsByType(%q, %q)", id, deploymentType)
+		}
+		result := polls[min(calls, len(polls)-1)]
+		calls++
+		return result, nil
+	}
+
+	dep, err := waitForDeployment(context.Background(), mock, "c1", "compose", "dep-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dep.DeploymentID != "dep-2" || dep.Status != "done" || calls != 3 {
+		t.Errorf("got %+v after %d polls, want dep-2 done after 3", dep, calls)
+	}
+}
+
+func TestLastLines(t *testing.T) {
+	tests := []struct {
+		in   string
+		n    int
+		want string
+	}{
+		{"a\nb\nc\n", 2, "b\nc"},
+		{"a\nb\n\n", 5, "a\nb"},
+		{"a", 1, "a"},
+	}
+	for _, tt := range tests {
+		if got := lastLines(tt.in, tt.n); got != tt.want {
+			t.Errorf("lastLines(%q, %d) = %q, want %q", tt.in, tt.n, got, tt.want)
+		}
+	}
+}
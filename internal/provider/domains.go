@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applicationDomainModel is one entry of dokploy_application's domains.
+type applicationDomainModel struct {
+	Host            types.String `tfsdk:"host"`
+	Path            types.String `tfsdk:"path"`
+	Port            types.Int64  `tfsdk:"port"`
+	HTTPS           types.Bool   `tfsdk:"https"`
+	CertificateType types.String `tfsdk:"certificate_type"`
+}
+
+// composeDomainModel is one entry of dokploy_compose's domains.
+type composeDomainModel struct {
+	ServiceName     types.String `tfsdk:"service_name"`
+	Host            types.String `tfsdk:"host"`
+	Path            types.String `tfsdk:"path"`
+	Port            types.Int64  `tfsdk:"port"`
+	HTTPS           types.Bool   `tfsdk:"https"`
+	CertificateType types.String `tfsdk:"certificate_type"`
+}
+
+// domainsAttribute returns the domains set shared by dokploy_application and
+// dokploy_compose. Compose domains also name the service they route to.
+func domainsAttribute(compose bool) schema.SetNestedAttribute {
+	attributes := map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			Required:    true,
+			Description: "Domain host name (e.g., app.example.com).",
+		},
+		"path": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("/"),
+			Description: "Path prefix routed to the service. Defaults to /.",
+		},
+		"port": schema.Int64Attribute{
+			Required:    true,
+			Description: "Container port the domain routes to.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		},
+		"https": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Enable HTTPS for the domain. Defaults to false.",
+		},
+		"certificate_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "Certificate type: none or letsencrypt. Defaults to letsencrypt when https is true and none otherwise.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("none", "letsencrypt"),
+			},
+		},
+	}
+	if compose {
+		attributes["service_name"] = schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the compose service the domain routes to.",
+		}
+	}
+
+	return schema.SetNestedAttribute{
+		Optional:    true,
+		Description: "Domains routed to the service. When set, Terraform manages the full set of domains and removes any others, so do not combine it with dokploy_domain resources for the same service. Removing the attribute stops managing domains and leaves the existing ones in place.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+// validateDomainCertificateType rejects a certificate on a domain without
+// https, which Dokploy would silently replace with none.
+func validateDomainCertificateType(host types.String, https types.Bool, certType types.String, diags *diag.Diagnostics) {
+	if https.IsUnknown() || certType.IsUnknown() || certType.IsNull() {
+		return
+	}
+	if !https.ValueBool() && certType.ValueString() != "none" {
+		diags.AddAttributeError(
+			path.Root("domains"),
+			"Invalid Domain Certificate",
+			fmt.Sprintf("Domain %s sets certificate_type = %q but https is false. Enable https or remove certificate_type.", host.ValueString(), certType.ValueString()),
+		)
+	}
+}
+
+// domainKey identifies a domain within its application or compose.
+func domainKey(serviceName, host, path string) string {
+	if path == "" {
+		path = "/"
+	}
+	return serviceName + "\x00" + host + "\x00" + path
+}
+
+// domainCertificateType returns the certificate type Dokploy applies to a
+// domain, filling in the default for its https setting.
+func domainCertificateType(d client.Domain) string {
+	if !d.HTTPS {
+		return "none"
+	}
+	if d.CertificateType == "" {
+		return "letsencrypt"
+	}
+	return d.CertificateType
+}
+
+// reconcileDomains creates, updates and deletes domains so that current
+// matches desired. Domains are matched on service name, host and path.
+func reconcileDomains(c client.Client, current, desired []client.Domain) error {
+	existing := make(map[string]client.Domain, len(current))
+	for _, d := range current {
+		existing[domainKey(d.ServiceName, d.Host, d.Path)] = d
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		wanted[domainKey(d.ServiceName, d.Host, d.Path)] = true
+	}
+
+	// Delete first so a host moving between paths does not collide.
+	for _, d := range current {
+		if !wanted[domainKey(d.ServiceName, d.Host, d.Path)] {
+			if err := c.DeleteDomain(d.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, d := range desired {
+		prior, ok := existing[domainKey(d.ServiceName, d.Host, d.Path)]
+		if !ok {
+			if _, err := c.CreateDomain(d); err != nil {
+				return err
+			}
+			continue
+		}
+		if prior.Port == d.Port && prior.HTTPS == d.HTTPS && domainCertificateType(prior) == domainCertificateType(d) {
+			continue
+		}
+		d.ID = prior.ID
+		if _, err := c.UpdateDomain(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// certificateTypeValue keeps certificate_type null when it was not configured
+// and the API reports the default for the domain.
+func certificateTypeValue(configured types.String, d client.Domain) types.String {
+	certType := domainCertificateType(d)
+	if configured.IsNull() && certType == domainCertificateType(client.Domain{HTTPS: d.HTTPS}) {
+		return types.StringNull()
+	}
+	return types.StringValue(certType)
+}
+
+func domainPath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+// applicationDomainsFromModel converts the domains set into API domains.
+func applicationDomainsFromModel(appID string, domains []applicationDomainModel) []client.Domain {
+	result := make([]client.Domain, 0, len(domains))
+	for _, d := range domains {
+		result = append(result, client.Domain{
+			ApplicationID:   appID,
+			Host:            d.Host.ValueString(),
+			Path:            domainPath(d.Path.ValueString()),
+			Port:            d.Port.ValueInt64(),
+			HTTPS:           d.HTTPS.ValueBool(),
+			CertificateType: d.CertificateType.ValueString(),
+		})
+	}
+	return result
+}
+
+// applicationDomainsFromAPI converts API domains into the domains set,
+// keeping unset certificate types from prior null.
+func applicationDomainsFromAPI(prior []applicationDomainModel, domains []client.Domain) []applicationDomainModel {
+	configured := make(map[string]types.String, len(prior))
+	for _, d := range prior {
+		configured[domainKey("", d.Host.ValueString(), d.Path.ValueString())] = d.CertificateType
+	}
+
+	result := make([]applicationDomainModel, 0, len(domains))
+	for _, d := range domains {
+		certType, ok := configured[domainKey("", d.Host, d.Path)]
+		if !ok {
+			certType = types.StringNull()
+		}
+		result = append(result, applicationDomainModel{
+			Host:            types.StringValue(d.Host),
+			Path:            types.StringValue(domainPath(d.Path)),
+			Port:            types.Int64Value(d.Port),
+			HTTPS:           types.BoolValue(d.HTTPS),
+			CertificateType: certificateTypeValue(certType, d),
+		})
+	}
+	return result
+}
+
+// composeDomainsFromModel converts the domains set into API domains.
+func composeDomainsFromModel(composeID string, domains []composeDomainModel) []client.Domain {
+	result := make([]client.Domain, 0, len(domains))
+	for _, d := range domains {
+		result = append(result, client.Domain{
+			ComposeID:       composeID,
+			ServiceName:     d.ServiceName.ValueString(),
+			Host:            d.Host.ValueString(),
+			Path:            domainPath(d.Path.ValueString()),
+			Port:            d.Port.ValueInt64(),
+			HTTPS:           d.HTTPS.ValueBool(),
+			CertificateType: d.CertificateType.ValueString(),
+		})
+	}
+	return result
+}
+
+// composeDomainsFromAPI converts API domains into the domains set, keeping
+// unset certificate types from prior null.
+func composeDomainsFromAPI(prior []composeDomainModel, domains []client.Domain) []composeDomainModel {
+	configured := make(map[string]types.String, len(prior))
+	for _, d := range prior {
+		configured[domainKey(d.ServiceName.ValueString(), d.Host.ValueString(), d.Path.ValueString())] = d.CertificateType
+	}
+
+	result := make([]composeDomainModel, 0, len(domains))
+	for _, d := range domains {
+		certType, ok := configured[domainKey(d.ServiceName, d.Host, d.Path)]
+		if !ok {
+			certType = types.StringNull()
+		}
+		result = append(result, composeDomainModel{
+			ServiceName:     types.StringValue(d.ServiceName),
+			Host:            types.StringValue(d.Host),
+			Path:            types.StringValue(domainPath(d.Path)),
+			Port:            types.Int64Value(d.Port),
+			HTTPS:           types.BoolValue(d.HTTPS),
+			CertificateType: certificateTypeValue(certType, d),
+		})
+	}
+	return result
+}
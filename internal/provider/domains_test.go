@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReconcileDomains(t *testing.T) {
+	current := []client.Domain{
+		{ID: "d1", Host: "keep.example.com", Path: "/", Port: 3000, HTTPS: true, CertificateType: "letsencrypt"},
+		{ID: "d2", Host: "change.example.com", Path: "/", Port: 3000},
+		{ID: "d3", Host: "old.example.com", Path: "/", Port: 3000},
+	}
+	desired := []client.Domain{
+		{Host: "keep.example.com", Path: "/", Port: 3000, HTTPS: true},
+		{Host: "change.example.com", Path: "/", Port: 8080},
+		{Host: "new.example.com", Path: "/api", Port: 3000},
+	}
+
+	mock := clientmock.New()
+	var created, updated, deleted []string
+	mock.CreateDomainFunc = func(d client.Domain) (*client.Domain, error) {
+		created = append(created, d.Host+d.Path)
+		return &d, nil
+	}
+	mock.UpdateDomainFunc = func(d client.Domain) (*client.Domain, error) {
+		updated = append(updated, d.ID)
+		return &d, nil
+	}
+	mock.DeleteDomainFunc = func(id string) error {
+		deleted = append(deleted, id)
+		return nil
+	}
+
+	if err := reconcileDomains(mock, current, desired); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(created)
+	if len(created) != 1 || created[0] != "new.example.com/api" {
+		t.Errorf("created %v, want new.example.com/api", created)
+	}
+	if len(updated) != 1 || updated[0] != "d2" {
+		t.Errorf("updated %v, want d2", updated)
+	}
+	if len(deleted) != 1 || deleted[0] != "d3" {
+		t.Errorf("deleted %v, want d3", deleted)
+	}
+}
+
+func TestApplicationDomainsFromAPI(t *testing.T) {
+	prior := []applicationDomainModel{
+		{Host: types.StringValue("a.example.com"), Path: types.StringValue("/"), CertificateType: types.StringNull()},
+	}
+	domains := []client.Domain{
+		{Host: "a.example.com", Path: "/", Port: 3000, HTTPS: true, CertificateType: "letsencrypt"},
+		{Host: "b.example.com", Port: 80, CertificateType: "none"},
+	}
+
+	got := applicationDomainsFromAPI(prior, domains)
+	if len(got) != 2 {
+		t.Fatalf("got %d domains, want 2", len(got))
+	}
+	if !got[0].CertificateType.IsNull() {
+		t.Errorf("default certificate type = %s, want null", got[0].CertificateType)
+	}
+	if got[1].Path.ValueString() != "/" || !got[1].CertificateType.IsNull() {
+		t.Errorf("second domain = %+v, want path / and null certificate type", got[1])
+	}
+}
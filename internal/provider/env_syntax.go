@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// envValidator warns at plan time about lines of a KEY=VALUE attribute that
+// do not define a variable, which would otherwise disappear silently.
+type envValidator struct{}
+
+func (v envValidator) Description(_ context.Context) string {
+	return "value must be KEY=VALUE lines, optionally quoted or prefixed with export"
+}
+
+func (v envValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v envValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	_, problems := client.ParseEnvFile(req.ConfigValue.ValueString())
+	for _, p := range problems {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Invalid Environment Variable Line",
+			fmt.Sprintf("Line %d: %s. The line does not set a variable; comments must start with #.", p.Line, p.Message))
+	}
+}
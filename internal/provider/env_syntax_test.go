@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEnvValidator(t *testing.T) {
+	tests := []struct {
+		value    types.String
+		warnings int
+	}{
+		{types.StringNull(), 0},
+		{types.StringUnknown(), 0},
+		{types.StringValue("# comment\nexport A=1\nB=\"two words\""), 0},
+		{types.StringValue("A=1\nDATABASE_URL\nC='unterminated"), 2},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("env"), ConfigValue: tt.value}
+		resp := &validator.StringResponse{}
+		envValidator{}.ValidateString(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != tt.warnings {
+			t.Errorf("%v: diags %v, want %d warnings", tt.value, resp.Diagnostics, tt.warnings)
+		}
+	}
+}
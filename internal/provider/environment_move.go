@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// requireReplaceOnProjectChange marks environment_id as requiring replacement
+// when the planned environment belongs to a different project than the
+// current one. Dokploy's move endpoints only move services between
+// environments, and moving to another project's environment fails, so the
+// service is recreated there instead.
+func requireReplaceOnProjectChange(ctx context.Context, c client.Client, kind string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var from, to types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment_id"), &from)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("environment_id"), &to)...)
+	if resp.Diagnostics.HasError() || to.IsUnknown() || from.IsNull() || from.Equal(to) {
+		return
+	}
+
+	fromProject, err := environmentProjectID(c, from.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("environment_id"), "Unable to Read Environment", err.Error())
+		return
+	}
+	toProject, err := environmentProjectID(c, to.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("environment_id"), "Unable to Read Environment", err.Error())
+		return
+	}
+	if fromProject == toProject {
+		return
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("environment_id"))
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("environment_id"),
+		"Moving Between Projects Requires Replacement",
+		fmt.Sprintf("Environment %s belongs to project %s, but the %s is in project %s. Dokploy can only move a %s between environments of the same project, so it will be destroyed and recreated, losing its deployment history.",
+			to.ValueString(), toProject, kind, fromProject, kind),
+	)
+}
+
+func environmentProjectID(c client.Client, environmentID string) (string, error) {
+	env, err := c.GetEnvironment(environmentID)
+	if err != nil {
+		return "", fmt.Errorf("could not read environment %s: %w", environmentID, err)
+	}
+	return env.ProjectID, nil
+}
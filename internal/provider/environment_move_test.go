@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequireReplaceOnProjectChange(t *testing.T) {
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"environment_id": schema.StringAttribute{Required: true},
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"environment_id": tftypes.String}}
+	value := func(id string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"environment_id": tftypes.NewValue(tftypes.String, id)})
+	}
+
+	mock := clientmock.New()
+	mock.GetEnvironmentFunc = func(id string) (*client.Environment, error) {
+		projects := map[string]string{"env-prod": "proj-a", "env-staging": "proj-a", "env-other": "proj-b"}
+		return &client.Environment{ID: id, ProjectID: projects[id]}, nil
+	}
+
+	run := func(from, to string) *resource.ModifyPlanResponse {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: s, Raw: value(from)},
+			Plan:  tfsdk.Plan{Schema: s, Raw: value(to)},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		requireReplaceOnProjectChange(context.Background(), mock, "application", req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+		}
+		return resp
+	}
+
+	if resp := run("env-prod", "env-staging"); len(resp.RequiresReplace) != 0 {
+		t.Errorf("same project: RequiresReplace = %v, want none", resp.RequiresReplace)
+	}
+	if resp := run("env-prod", "env-other"); len(resp.RequiresReplace) != 1 || resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("other project: RequiresReplace = %v, warnings %d, want environment_id and a warning", resp.RequiresReplace, resp.Diagnostics.WarningsCount())
+	}
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &DatabaseCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &DatabaseCredentialsEphemeralResource{}
+
+func NewDatabaseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &DatabaseCredentialsEphemeralResource{}
+}
+
+type DatabaseCredentialsEphemeralResource struct {
+	client client.Client
+}
+
+type DatabaseCredentialsEphemeralResourceModel struct {
+	DatabaseID            types.String `tfsdk:"database_id"`
+	Type                  types.String `tfsdk:"type"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	DatabaseName          types.String `tfsdk:"database_name"`
+	InternalHost          types.String `tfsdk:"internal_host"`
+	InternalPort          types.Int64  `tfsdk:"internal_port"`
+	InternalConnectionURL types.String `tfsdk:"internal_connection_url"`
+	ExternalHost          types.String `tfsdk:"external_host"`
+	ExternalPort          types.Int64  `tfsdk:"external_port"`
+	ExternalConnectionURL types.String `tfsdk:"external_connection_url"`
+}
+
+// databaseCredentials holds the connection details shared by all database types.
+type databaseCredentials struct {
+	scheme       string
+	username     string
+	password     string
+	databaseName string
+	appName      string
+	internalPort int
+	externalPort int
+	serverID     string
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_credentials"
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves connection credentials for a Dokploy managed database without persisting them in state.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the database.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Database type: postgres, mysql, mariadb, mongo, or redis.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("postgres", "mysql", "mariadb", "mongo", "redis"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The database user. Redis uses 'default'.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The database password.",
+			},
+			"database_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The database name. Empty for mongo and redis.",
+			},
+			"internal_host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hostname of the database on the Dokploy network.",
+			},
+			"internal_port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The port of the database on the Dokploy network.",
+			},
+			"internal_connection_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Connection URL for services on the Dokploy network.",
+			},
+			"external_host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public host of the database. Null if no external port is configured.",
+			},
+			"external_port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The external port of the database. Null if not configured.",
+			},
+			"external_connection_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Connection URL for clients outside Dokploy. Null if no external port is configured.",
+			},
+		},
+	}
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DatabaseCredentialsEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds, err := getDatabaseCredentials(r.client, data.Type.ValueString(), data.DatabaseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Database Credentials", err.Error())
+		return
+	}
+
+	data.Username = types.StringValue(creds.username)
+	data.Password = types.StringValue(creds.password)
+	data.DatabaseName = types.StringValue(creds.databaseName)
+	data.InternalHost = types.StringValue(creds.appName)
+	data.InternalPort = types.Int64Value(int64(creds.internalPort))
+	data.InternalConnectionURL = types.StringValue(creds.connectionURL(creds.appName, creds.internalPort))
+
+	data.ExternalHost = types.StringNull()
+	data.ExternalPort = types.Int64Null()
+	data.ExternalConnectionURL = types.StringNull()
+	if creds.externalPort > 0 {
+		host, err := r.externalHost(creds.serverID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Determine External Host", err.Error())
+			return
+		}
+		data.ExternalHost = types.StringValue(host)
+		data.ExternalPort = types.Int64Value(int64(creds.externalPort))
+		data.ExternalConnectionURL = types.StringValue(creds.connectionURL(host, creds.externalPort))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func getDatabaseCredentials(c client.Client, dbType, id string) (*databaseCredentials, error) {
+	switch dbType {
+	case "postgres":
+		db, err := c.GetPostgres(id)
+		if err != nil {
+			return nil, err
+		}
+		return postgresCredentials(db), nil
+	case "mysql":
+		db, err := c.GetMySQL(id)
+		if err != nil {
+			return nil, err
+		}
+		return mysqlCredentials(db), nil
+	case "mariadb":
+		db, err := c.GetMariaDB(id)
+		if err != nil {
+			return nil, err
+		}
+		return mariadbCredentials(db), nil
+	case "mongo":
+		db, err := c.GetMongoDB(id)
+		if err != nil {
+			return nil, err
+		}
+		return mongoCredentials(db), nil
+	case "redis":
+		db, err := c.GetRedis(id)
+		if err != nil {
+			return nil, err
+		}
+		return redisCredentials(db), nil
+	}
+	return nil, fmt.Errorf("unsupported database type: %s", dbType)
+}
+
+// externalHost returns the public address of the server running the database,
+// falling back to the host of the Dokploy instance itself.
+func (r *DatabaseCredentialsEphemeralResource) externalHost(serverID string) (string, error) {
+	if serverID != "" {
+		server, err := r.client.GetServer(serverID)
+		if err != nil {
+			return "", err
+		}
+		return server.IPAddress, nil
+	}
+
+	u, err := url.Parse(r.client.Endpoint())
+	if err != nil {
+		return "", fmt.Errorf("failed to parse provider host: %w", err)
+	}
+	return u.Hostname(), nil
+}
+
+// connectionURL builds the connection URL of the database at host and port.
+// It is the only place connection URLs are built, so dokploy_database, the
+// database resources and this ephemeral resource agree on them.
+func (c *databaseCredentials) connectionURL(host string, port int) string {
+	u := url.URL{
+		Scheme: c.scheme,
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+	}
+	switch {
+	case c.scheme == "redis":
+		// Redis authenticates with the password alone.
+		if c.password != "" {
+			u.User = url.UserPassword("", c.password)
+		}
+	case c.username != "":
+		u.User = url.UserPassword(c.username, c.password)
+	}
+	if c.databaseName != "" {
+		u.Path = "/" + c.databaseName
+	}
+	// Dokploy creates the mongo root user in the admin database.
+	if c.scheme == "mongodb" {
+		u.Path = "/" + c.databaseName
+		u.RawQuery = "authSource=admin"
+	}
+	return u.String()
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDatabaseCredentialsEphemeralResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"dokploy": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseCredentialsEphemeralResourceConfig("tf-acc-dbcreds-project", "test-dbcreds-env", "test-dbcreds-pg"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.username", "testuser"),
+					resource.TestCheckResourceAttr("echo.test", "data.database_name", "testdb"),
+					resource.TestCheckResourceAttr("echo.test", "data.internal_port", "5432"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.internal_host"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.internal_connection_url"),
+					resource.TestCheckNoResourceAttr("echo.test", "data.external_connection_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseCredentialsEphemeralResourceConfig(projectName, envName, pgName string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for database credentials tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
+  name              = "%s"
+  app_name          = "testdbcreds"
+  database_name     = "testdb"
+  database_user     = "testuser"
+  database_password = "test_postgres_password_123"
+  environment_id    = dokploy_environment.test.id
+}
+
+ephemeral "dokploy_database_credentials" "test" {
+  database_id = dokploy_postgres.test.id
+  type        = "postgres"
+}
+
+provider "echo" {
+  data = ephemeral.dokploy_database_credentials.test
+}
+
+resource "echo" "test" {}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, pgName)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ComposeMergeFunction{}
+
+func NewComposeMergeFunction() function.Function {
+	return &ComposeMergeFunction{}
+}
+
+type ComposeMergeFunction struct{}
+
+func (f *ComposeMergeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "compose_merge"
+}
+
+func (f *ComposeMergeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Merges compose files into one",
+		Description: "Merges a base compose file with its overrides the way `docker compose -f base.yml -f override.yml` does, for use as compose_file_content. Files, and the documents of a multi-document file, are applied in order: mappings are merged key by key with later values winning, environment, labels and annotations are merged by name, service volumes and devices by container path, command, entrypoint and healthcheck tests are replaced, and other lists such as ports are concatenated without duplicates. A value tagged `!reset` removes the key and one tagged `!override` replaces it without merging.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "files",
+				ElementType: types.StringType,
+				Description: "Contents of the compose files, base first.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ComposeMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var files []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &files))
+	if resp.Error != nil {
+		return
+	}
+
+	merged, err := mergeComposeFiles(files)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, merged))
+}
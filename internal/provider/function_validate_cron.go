@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ValidateCronFunction{}
+
+func NewValidateCronFunction() function.Function {
+	return &ValidateCronFunction{}
+}
+
+type ValidateCronFunction struct{}
+
+func (f *ValidateCronFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_cron"
+}
+
+func (f *ValidateCronFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Validates a cron expression",
+		Description: "Returns the cron expression unchanged if Dokploy's scheduler accepts it, and fails with an explanation otherwise. Expressions have 5 fields (minute hour day-of-month month day-of-week), 6 with a leading seconds field, or are a macro such as @daily. The day fields accept L, W and # (e.g. L for the last day of the month, 15W, 5L, 1#2).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "expression",
+				Description: "The cron expression to validate.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ValidateCronFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateCron(expression); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, expression))
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/waiter"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// healthCheckModel is the wait_for_healthy attribute.
+type healthCheckModel struct {
+	URL     types.String `tfsdk:"url"`
+	Path    types.String `tfsdk:"path"`
+	Status  types.Int64  `tfsdk:"status"`
+	Timeout types.String `tfsdk:"timeout"`
+}
+
+// Polling bounds for waitForHealthy. Each probe is bounded separately so a
+// hanging request does not use up the whole timeout.
+var (
+	healthCheckInterval     = 5 * time.Second
+	healthCheckProbeTimeout = 10 * time.Second
+	healthCheckClient       = &http.Client{}
+)
+
+func healthCheckAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "After a deployment triggered by deploy_on_create or a digest change finishes, probe the application over HTTP until it answers with the expected status. Apply fails when the deployment fails or the application is not healthy before the timeout, so dependent resources only proceed once traffic is served.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL to probe, e.g. 'https://app.example.com'. Defaults to the first domain of the application.",
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("/"),
+				Description: "Path appended to the URL. Defaults to '/'.",
+			},
+			"status": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(200),
+				Description: "HTTP status code that marks the application healthy. Defaults to 200.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("5m"),
+				Description: "How long to wait for the deployment and the probe together, as a Go duration such as '90s' or '10m'. Defaults to '5m'.",
+			},
+		},
+	}
+}
+
+// validateHealthCheck checks the wait_for_healthy settings at plan time.
+func validateHealthCheck(hc *healthCheckModel) (path.Path, string) {
+	attrPath := path.Root("wait_for_healthy")
+	if !hc.Timeout.IsNull() && !hc.Timeout.IsUnknown() {
+		if d, err := time.ParseDuration(hc.Timeout.ValueString()); err != nil || d <= 0 {
+			return attrPath.AtName("timeout"), fmt.Sprintf("%q is not a positive duration such as '90s' or '10m'.", hc.Timeout.ValueString())
+		}
+	}
+	if !hc.Status.IsNull() && !hc.Status.IsUnknown() && (hc.Status.ValueInt64() < 100 || hc.Status.ValueInt64() > 599) {
+		return attrPath.AtName("status"), fmt.Sprintf("%d is not an HTTP status code.", hc.Status.ValueInt64())
+	}
+	if !hc.URL.IsNull() && !hc.URL.IsUnknown() {
+		u := hc.URL.ValueString()
+		if !strings.HasPrefix(u, "http://") && !strings.HasPrefix(u, "https://") {
+			return attrPath.AtName("url"), fmt.Sprintf("%q must start with http:// or https://.", u)
+		}
+	}
+	return path.Empty(), ""
+}
+
+// healthCheckURL returns the URL to probe: the configured one, or the first
+// domain of the application.
+func healthCheckURL(hc *healthCheckModel, domains []client.Domain) (string, error) {
+	base := hc.URL.ValueString()
+	if base == "" {
+		if len(domains) == 0 {
+			return "", fmt.Errorf("the application has no domain to probe; set wait_for_healthy.url")
+		}
+		d := domains[0]
+		scheme := "http"
+		if d.HTTPS {
+			scheme = "https"
+		}
+		base = scheme + "://" + d.Host + strings.TrimSuffix(d.Path, "/")
+	}
+
+	p := hc.Path.ValueString()
+	if p == "" {
+		p = "/"
+	}
+	return strings.TrimSuffix(base, "/") + "/" + strings.TrimPrefix(p, "/"), nil
+}
+
+// waitForHealthy probes url until it answers with status or ctx is done.
+func waitForHealthy(ctx context.Context, httpClient *http.Client, url string, status int) error {
+	cfg := waiter.Config{
+		Interval:    healthCheckInterval,
+		MaxInterval: 4 * healthCheckInterval,
+		Multiplier:  1.5,
+		Jitter:      0.1,
+	}
+	_, err := waiter.Wait(ctx, cfg, func(ctx context.Context) (int, error) {
+		probeCtx, cancel := context.WithTimeout(ctx, healthCheckProbeTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(probeCtx, http.MethodGet, url, nil)
+		if err != nil {
+			return 0, err
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return 0, waiter.Retryable(err)
+		}
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		if resp.StatusCode != status {
+			return resp.StatusCode, waiter.Retryable(fmt.Errorf("%s answered %s, want %d", url, resp.Status, status))
+		}
+		return resp.StatusCode, nil
+	}, func(int) bool { return true })
+	return err
+}
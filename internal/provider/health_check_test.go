@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHealthCheckURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     types.String
+		path    string
+		domains []client.Domain
+		want    string
+		wantErr bool
+	}{
+		{name: "configured", url: types.StringValue("https://app.example.com/"), path: "/healthz", want: "https://app.example.com/healthz"},
+		{name: "first domain", url: types.StringNull(), path: "/", domains: []client.Domain{{Host: "app.example.com", HTTPS: true}, {Host: "other.example.com"}}, want: "https://app.example.com/"},
+		{name: "domain path", url: types.StringNull(), path: "ready", domains: []client.Domain{{Host: "example.com", Path: "/api/"}}, want: "http://example.com/api/ready"},
+		{name: "no domain", url: types.StringNull(), path: "/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := &healthCheckModel{URL: tt.url, Path: types.StringValue(tt.path)}
+			got, err := healthCheckURL(hc, tt.domains)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("healthCheckURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateHealthCheck(t *testing.T) {
+	valid := healthCheckModel{URL: types.StringNull(), Path: types.StringValue("/"), Status: types.Int64Value(204), Timeout: types.StringValue("90s")}
+	if _, msg := validateHealthCheck(&valid); msg != "" {
+		t.Errorf("valid health check: %s", msg)
+	}
+
+	for name, hc := range map[string]healthCheckModel{
+		"timeout": {Timeout: types.StringValue("5 minutes")},
+		"status":  {Status: types.Int64Value(42)},
+		"url":     {URL: types.StringValue("app.example.com")},
+	} {
+		if attrPath, msg := validateHealthCheck(&hc); msg == "" || attrPath.String() != "wait_for_healthy."+name {
+			t.Errorf("invalid %s: got %s %q", name, attrPath, msg)
+		}
+	}
+}
+
+func TestWaitForHealthy(t *testing.T) {
+	defer func(interval time.Duration) { healthCheckInterval = interval }(healthCheckInterval)
+	healthCheckInterval = time.Millisecond
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/healthz" {
+			t.Errorf("probed %s", r.URL.Path)
+		}
+		// The container is still starting for the first probes.
+		if requests < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	if err := waitForHealthy(context.Background(), srv.Client(), srv.URL+"/healthz", http.StatusNoContent); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 3 {
+		t.Errorf("probed %d times, want 3", requests)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := waitForHealthy(ctx, srv.Client(), srv.URL+"/healthz", http.StatusOK); err == nil {
+		t.Error("waitForHealthy succeeded for a status that never matches")
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// checkMemberPermissionChange fails the plan, on member_id, for permission
+// changes that Dokploy rejects or that would lock the organization out of
+// Terraform: owners always keep full access and cannot be changed, and the
+// member the provider's API key belongs to must keep API access. Lookup
+// failures are left for the apply to report.
+func checkMemberPermissionChange(c client.Client, memberID string, keepsAPIAccess bool, diags *diag.Diagnostics) {
+	member, err := c.GetMemberByID(memberID)
+	if err != nil {
+		return
+	}
+	if member.Role == "owner" {
+		diags.AddAttributeError(
+			path.Root("member_id"),
+			"Owner Permissions Cannot Be Changed",
+			fmt.Sprintf("Member %s (%s) owns the organization. Owners always have full access, and Dokploy rejects permission changes for them.", memberID, member.User.Email),
+		)
+		return
+	}
+	// Admins have API access whatever their permissions say.
+	if keepsAPIAccess || member.Role != "member" {
+		return
+	}
+
+	current, err := c.GetCurrentMember()
+	if err != nil || current.ID != member.ID {
+		return
+	}
+	diags.AddAttributeError(
+		path.Root("member_id"),
+		"Provider Would Lose API Access",
+		fmt.Sprintf("Member %s (%s) is the member the provider's API key belongs to. Revoking can_access_to_api would lock Terraform out of the organization: keep it true, or manage this member with an API key of another member.", memberID, member.User.Email),
+	)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The postgres image runs every script in /docker-entrypoint-initdb.d when it
+// initialises an empty data directory. extensions and init_sql are rendered
+// into one file mount there, so they apply on the first deployment only.
+const (
+	postgresInitMountPath = "/docker-entrypoint-initdb.d/00-terraform-init.sql"
+	postgresInitFilePath  = "00-terraform-init.sql"
+)
+
+var postgresExtensionName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// postgresInitScript renders extensions and init_sql into the init script,
+// extensions first and sorted so the content is stable. It returns "" when
+// neither is set.
+func postgresInitScript(ctx context.Context, extensions types.Set, initSQL types.String, diags *diag.Diagnostics) string {
+	var names []string
+	if !extensions.IsNull() && !extensions.IsUnknown() {
+		diags.Append(extensions.ElementsAs(ctx, &names, false)...)
+	}
+	return postgresExtensionStatements(names) + initSQL.ValueString()
+}
+
+func postgresExtensionStatements(names []string) string {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+	var b strings.Builder
+	for _, name := range sorted {
+		fmt.Fprintf(&b, "CREATE EXTENSION IF NOT EXISTS %q;\n", name)
+	}
+	return b.String()
+}
+
+// findPostgresInitMount returns the managed init script mount, or nil.
+func findPostgresInitMount(c client.Client, postgresID string) (*client.Mount, error) {
+	mounts, err := c.GetMountsByService(postgresID, "postgres")
+	if err != nil {
+		return nil, err
+	}
+	for i := range mounts {
+		if mounts[i].MountPath == postgresInitMountPath {
+			return &mounts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// syncPostgresInitMount creates, updates or removes the init script mount so
+// it holds script.
+func syncPostgresInitMount(c client.Client, postgresID, script string) error {
+	current, err := findPostgresInitMount(c, postgresID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case script == "" && current == nil:
+		return nil
+	case script == "":
+		return c.DeleteMount(current.ID)
+	case current == nil:
+		_, err := c.CreateMount(client.Mount{
+			Type:        "file",
+			MountPath:   postgresInitMountPath,
+			FilePath:    postgresInitFilePath,
+			Content:     script,
+			ServiceID:   postgresID,
+			ServiceType: "postgres",
+		})
+		return err
+	case current.Content != script:
+		current.Content = script
+		current.ServiceType = "postgres"
+		_, err := c.UpdateMount(*current)
+		return err
+	}
+	return nil
+}
+
+// refreshPostgresInit reflects changes made to the init script mount outside
+// Terraform. A removed mount clears both attributes; edited content is shown
+// as init_sql, keeping extensions when their statements are still in place.
+func refreshPostgresInit(ctx context.Context, c client.Client, state *PostgresResourceModel, diags *diag.Diagnostics) {
+	script := postgresInitScript(ctx, state.Extensions, state.InitSQL, diags)
+	if script == "" {
+		return
+	}
+
+	mount, err := findPostgresInitMount(c, state.ID.ValueString())
+	if err != nil {
+		diags.AddWarning("Could not read PostgreSQL init script", err.Error())
+		return
+	}
+	if mount == nil {
+		state.Extensions = types.SetNull(types.StringType)
+		state.InitSQL = types.StringNull()
+		return
+	}
+	if mount.Content == script {
+		return
+	}
+
+	prefix := postgresInitScript(ctx, state.Extensions, types.StringNull(), diags)
+	if prefix == "" || !strings.HasPrefix(mount.Content, prefix) {
+		state.Extensions = types.SetNull(types.StringType)
+		prefix = ""
+	}
+	state.InitSQL = optionalString(strings.TrimPrefix(mount.Content, prefix))
+}
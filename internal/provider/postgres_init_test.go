@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPostgresInitScript(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	extensions := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("vector"), types.StringValue("postgis")})
+
+	got := postgresInitScript(ctx, extensions, types.StringValue("CREATE TABLE t (id int);\n"), &diags)
+	want := "CREATE EXTENSION IF NOT EXISTS \"postgis\";\nCREATE EXTENSION IF NOT EXISTS \"vector\";\nCREATE TABLE t (id int);\n"
+	if got != want {
+		t.Errorf("script = %q, want %q", got, want)
+	}
+	if got := postgresInitScript(ctx, types.SetNull(types.StringType), types.StringNull(), &diags); got != "" {
+		t.Errorf("script without settings = %q, want empty", got)
+	}
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+}
+
+func TestSyncPostgresInitMount(t *testing.T) {
+	existing := client.Mount{ID: "m-1", Type: "file", MountPath: postgresInitMountPath, Content: "SELECT 1;"}
+	tests := []struct {
+		name    string
+		current []client.Mount
+		script  string
+		want    string
+	}{
+		{"creates", nil, "SELECT 2;", "create"},
+		{"updates changed content", []client.Mount{existing}, "SELECT 2;", "update"},
+		{"keeps unchanged content", []client.Mount{existing}, "SELECT 1;", ""},
+		{"removes when unset", []client.Mount{existing}, "", "delete"},
+		{"ignores other mounts", []client.Mount{{ID: "m-2", MountPath: "/data"}}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := clientmock.New()
+			mock.GetMountsByServiceFunc = func(serviceID, serviceType string) ([]client.Mount, error) {
+				return tt.current, nil
+			}
+			var got string
+			mock.CreateMountFunc = func(m client.Mount) (*client.Mount, error) {
+				got = "create"
+				if m.Type != "file" || m.MountPath != postgresInitMountPath || m.ServiceType != "postgres" || m.Content != tt.script {
+					t.Errorf("created %+v", m)
+				}
+				return &m, nil
+			}
+			mock.UpdateMountFunc = func(m client.Mount) (*client.Mount, error) {
+				got = "update"
+				if m.ID != "m-1" || m.Content != tt.script {
+					t.Errorf("updated %+v", m)
+				}
+				return &m, nil
+			}
+			mock.DeleteMountFunc = func(id string) error {
+				got = "delete"
+				return nil
+			}
+
+			if err := syncPostgresInitMount(mock, "pg-1", tt.script); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("action = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefreshPostgresInit(t *testing.T) {
+	ctx := context.Background()
+	extensions := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("vector")})
+	header := "CREATE EXTENSION IF NOT EXISTS \"vector\";\n"
+
+	tests := []struct {
+		name           string
+		mounts         []client.Mount
+		wantExtensions types.Set
+		wantInitSQL    types.String
+	}{
+		{"in sync", []client.Mount{{MountPath: postgresInitMountPath, Content: header + "SELECT 1;"}}, extensions, types.StringValue("SELECT 1;")},
+		{"edited SQL", []client.Mount{{MountPath: postgresInitMountPath, Content: header + "SELECT 2;"}}, extensions, types.StringValue("SELECT 2;")},
+		{"edited extensions", []client.Mount{{MountPath: postgresInitMountPath, Content: "SELECT 1;"}}, types.SetNull(types.StringType), types.StringValue("SELECT 1;")},
+		{"removed", nil, types.SetNull(types.StringType), types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := clientmock.New()
+			mock.GetMountsByServiceFunc = func(serviceID, serviceType string) ([]client.Mount, error) {
+				return tt.mounts, nil
+			}
+			state := PostgresResourceModel{ID: types.StringValue("pg-1"), Extensions: extensions, InitSQL: types.StringValue("SELECT 1;")}
+
+			var diags diag.Diagnostics
+			refreshPostgresInit(ctx, mock, &state, &diags)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !state.Extensions.Equal(tt.wantExtensions) || !state.InitSQL.Equal(tt.wantInitSQL) {
+				t.Errorf("extensions = %v, init_sql = %v, want %v, %v", state.Extensions, state.InitSQL, tt.wantExtensions, tt.wantInitSQL)
+			}
+		})
+	}
+}
@@ -22,21 +22,36 @@ func TestAccProjectResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccProjectResourceConfig("Test Project", "Initial Description"),
+				Config: testAccProjectResourceConfig("tf-acc-project", "Initial Description"),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("dokploy_project.test", "name", "Test Project"),
+					resource.TestCheckResourceAttr("dokploy_project.test", "name", "tf-acc-project"),
 					resource.TestCheckResourceAttr("dokploy_project.test", "description", "Initial Description"),
 					resource.TestCheckResourceAttrSet("dokploy_project.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_project.test", "created_at"),
+					resource.TestCheckResourceAttrSet("dokploy_project.test", "organization_id"),
 				),
 			},
 			// Update and Read testing
 			{
-				Config: testAccProjectResourceConfig("Test Project Updated", "Updated Description"),
+				Config: testAccProjectResourceConfig("tf-acc-project-updated", "Updated Description"),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("dokploy_project.test", "name", "Test Project Updated"),
+					resource.TestCheckResourceAttr("dokploy_project.test", "name", "tf-acc-project-updated"),
 					resource.TestCheckResourceAttr("dokploy_project.test", "description", "Updated Description"),
 				),
 			},
+			// Data source lookup by name
+			{
+				Config: testAccProjectResourceConfig("tf-acc-project-updated", "Updated Description") + `
+data "dokploy_project" "by_name" {
+  name = dokploy_project.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dokploy_project.by_name", "id", "dokploy_project.test", "id"),
+					resource.TestCheckResourceAttrPair("data.dokploy_project.by_name", "created_at", "dokploy_project.test", "created_at"),
+					resource.TestCheckResourceAttrPair("data.dokploy_project.by_name", "organization_id", "dokploy_project.test", "organization_id"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "dokploy_project.test",
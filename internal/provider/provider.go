@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -14,14 +17,19 @@ import (
 
 var _ provider.Provider = &DokployProvider{}
 var _ provider.ProviderWithFunctions = &DokployProvider{}
+var _ provider.ProviderWithEphemeralResources = &DokployProvider{}
 
 type DokployProvider struct {
 	version string
 }
 
 type DokployProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Host                     types.String `tfsdk:"host"`
+	ApiKey                   types.String `tfsdk:"api_key"`
+	Compression              types.Bool   `tfsdk:"compression"`
+	DefaultDescriptionSuffix types.String `tfsdk:"default_description_suffix"`
+	EmitMetricsSummary       types.Bool   `tfsdk:"emit_metrics_summary"`
+	RequireSelfHosted        types.Bool   `tfsdk:"require_self_hosted"`
 }
 
 func (p *DokployProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,6 +49,22 @@ func (p *DokployProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Sensitive:   true,
 				Description: "Your Dokploy API Key",
 			},
+			"compression": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to request gzip-compressed API responses. Defaults to true; disable it if a proxy in front of Dokploy mishandles compressed responses.",
+			},
+			"default_description_suffix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Text appended to the description of every application and compose stack the provider creates or updates, e.g. \"(managed by Terraform, workspace production)\", so Terraform-owned services stand out in the Dokploy UI. The suffix is stripped when reading, so it never shows up in plans.",
+			},
+			"emit_metrics_summary": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to log, at INFO level, a summary of the Dokploy API requests made during each plan or apply: the request count, average and maximum latency, and error rate per endpoint. Useful to find out why applies against a remote instance are slow. Every request is logged at TRACE level regardless. Defaults to false.",
+			},
+			"require_self_hosted": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to fail when the provider is configured, before any change is made, if host is Dokploy Cloud rather than a self-hosted instance. Dokploy Cloud rejects the endpoints that manage the host itself, such as Traefik and server settings, which otherwise surfaces as permission errors partway through an apply. Defaults to false.",
+			},
 		},
 	}
 }
@@ -73,10 +97,47 @@ func (p *DokployProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	// Create client
 	c := client.NewDokployClient(config.Host.ValueString(), config.ApiKey.ValueString())
+	if !config.Compression.IsNull() && !config.Compression.IsUnknown() {
+		c.SetCompression(config.Compression.ValueBool())
+	}
+	if !config.DefaultDescriptionSuffix.IsNull() && !config.DefaultDescriptionSuffix.IsUnknown() {
+		c.SetDescriptionSuffix(config.DefaultDescriptionSuffix.ValueString())
+	}
+	traceRequests(ctx, c)
+	if config.EmitMetricsSummary.ValueBool() {
+		collectMetrics(ctx, c)
+	}
+
+	// Detect the server version up front so feature checks can reuse the
+	// cached value. Failures are ignored; version checks then pass through.
+	_, _ = c.GetVersion()
+
+	if config.RequireSelfHosted.ValueBool() {
+		requireSelfHosted(c, config.Host.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Make client available to resources
 	resp.ResourceData = c
 	resp.DataSourceData = c
+	resp.EphemeralResourceData = c
+}
+
+// requireSelfHosted reports an error unless the instance at host is
+// self-hosted.
+func requireSelfHosted(c client.Client, host string, diags *diag.Diagnostics) {
+	cloud, err := c.IsCloud()
+	if err != nil {
+		diags.AddError("Unable to Check Dokploy Instance",
+			fmt.Sprintf("require_self_hosted is set, but whether %s is self-hosted could not be determined: %s", host, err))
+		return
+	}
+	if cloud {
+		diags.AddError("Self-Hosted Dokploy Required",
+			fmt.Sprintf("require_self_hosted is set, but %s is Dokploy Cloud, which restricts the endpoints that manage the host. Point host at a self-hosted instance or unset require_self_hosted.", host))
+	}
 }
 
 func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -84,6 +145,7 @@ func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewProjectResource,
 		NewEnvironmentResource,
 		NewApplicationResource,
+		NewApplicationConfigResource,
 		NewComposeResource,
 		NewDomainResource,
 		NewEnvironmentVariablesResource,
@@ -94,6 +156,7 @@ func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewRegistryResource,
 		NewDestinationResource,
 		NewBackupResource,
+		NewComposeBackupResource,
 		NewServerResource,
 		NewRedisResource,
 		NewPostgresResource,
@@ -105,8 +168,13 @@ func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewGiteaProviderResource,
 		NewOrganizationResource,
 		NewVolumeBackupResource,
+		NewScheduledTaskResource,
+		NewTraefikMiddlewareResource,
 		NewApiKeyResource,
 		NewUserPermissionsResource,
+		NewProjectPermissionsResource,
+		NewPermissionsPolicyResource,
+		NewEnvironmentBackupPolicyResource,
 		NewAIResource,
 		NewCertificateResource,
 	}
@@ -115,15 +183,22 @@ func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resourc
 func (p *DokployProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewServersDataSource,
+		NewServerSetupScriptDataSource,
+		NewCapacityCheckDataSource,
 		NewGithubProvidersDataSource,
 		NewGitlabProvidersDataSource,
 		NewBitbucketProvidersDataSource,
 		NewGiteaProvidersDataSource,
 		NewBackupFilesDataSource,
 		NewOrganizationsDataSource,
+		NewOrganizationInvitationsDataSource,
 		NewVolumeBackupsDataSource,
+		NewDeploymentsDataSource,
+		NewApplicationRollbacksDataSource,
 		NewUserDataSource,
 		NewUsersDataSource,
+		NewCurrentMemberDataSource,
+		NewMembersDataSource,
 		NewAIsDataSource,
 		NewAIModelsDataSource,
 		NewApplicationDataSource,
@@ -132,11 +207,26 @@ func (p *DokployProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewCertificatesDataSource,
 		NewComposeDataSource,
 		NewComposesDataSource,
+		NewProjectDataSource,
+		NewDomainDataSource,
+		NewDestinationsDataSource,
+		NewVolumeDataSource,
+		NewServiceLinkDataSource,
+		NewVersionDataSource,
+	}
+}
+
+func (p *DokployProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDatabaseCredentialsEphemeralResource,
 	}
 }
 
 func (p *DokployProvider) Functions(_ context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewValidateCronFunction,
+		NewComposeMergeFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
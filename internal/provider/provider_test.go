@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"errors"
 	"os"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/joho/godotenv"
@@ -29,3 +32,26 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("DOKPLOY_API_KEY must be set for acceptance tests")
 	}
 }
+
+func TestRequireSelfHosted(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cloud     bool
+		err       error
+		wantError bool
+	}{
+		"self-hosted":   {cloud: false},
+		"cloud":         {cloud: true, wantError: true},
+		"lookup failed": {err: errors.New("connection refused"), wantError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mock := clientmock.New()
+			mock.IsCloudFunc = func() (bool, error) { return tc.cloud, tc.err }
+
+			var diags diag.Diagnostics
+			requireSelfHosted(mock, "https://app.dokploy.com/api", &diags)
+			if diags.HasError() != tc.wantError {
+				t.Errorf("error %v, want %v: %v", diags.HasError(), tc.wantError, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// traceRequests logs every API request of c at TRACE level, and failed
+// requests at DEBUG level, with the request ID sent to Dokploy.
+func traceRequests(ctx context.Context, c *client.DokployClient) {
+	c.AddRequestHook(func(info client.RequestInfo) {
+		fields := map[string]interface{}{
+			"method":      info.Method,
+			"endpoint":    info.Endpoint,
+			"request_id":  info.RequestID,
+			"status_code": info.StatusCode,
+			"duration_ms": info.Duration.Milliseconds(),
+		}
+		if info.Err != nil {
+			fields["error"] = info.Err.Error()
+			// Not-found answers are expected while reading.
+			if !errors.Is(info.Err, client.ErrNotFound) {
+				tflog.Debug(ctx, "Dokploy API request failed", fields)
+				return
+			}
+		}
+		tflog.Trace(ctx, "Dokploy API request", fields)
+	})
+}
+
+// metricsSummary is the request metrics of a client configured with
+// emit_metrics_summary, with the context to log them to.
+type metricsSummary struct {
+	ctx     context.Context
+	metrics *client.Metrics
+	started time.Time
+}
+
+var (
+	metricsSummariesMu sync.Mutex
+	metricsSummaries   []metricsSummary
+)
+
+// collectMetrics aggregates the API requests of c for LogMetricsSummaries.
+func collectMetrics(ctx context.Context, c *client.DokployClient) {
+	metrics := client.NewMetrics()
+	c.AddRequestHook(metrics.Record)
+
+	metricsSummariesMu.Lock()
+	defer metricsSummariesMu.Unlock()
+	metricsSummaries = append(metricsSummaries, metricsSummary{ctx: ctx, metrics: metrics, started: time.Now()})
+}
+
+// LogMetricsSummaries logs, at INFO level, the request count, latency and
+// error rate per endpoint of every provider configured with
+// emit_metrics_summary. Terraform starts a provider process per operation,
+// so calling it when the provider server stops logs one summary per plan or
+// apply.
+func LogMetricsSummaries() {
+	metricsSummariesMu.Lock()
+	defer metricsSummariesMu.Unlock()
+
+	for _, s := range metricsSummaries {
+		logMetricsSummary(s.ctx, s.metrics.Snapshot(), time.Since(s.started))
+	}
+	metricsSummaries = nil
+}
+
+// logMetricsSummary logs a total line and then one line per endpoint,
+// slowest endpoints first.
+func logMetricsSummary(ctx context.Context, snapshot map[string]client.EndpointMetrics, elapsed time.Duration) {
+	endpoints := make([]string, 0, len(snapshot))
+	var total client.EndpointMetrics
+	for endpoint, m := range snapshot {
+		endpoints = append(endpoints, endpoint)
+		total.Requests += m.Requests
+		total.Errors += m.Errors
+		total.Total += m.Total
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		a, b := snapshot[endpoints[i]], snapshot[endpoints[j]]
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		return endpoints[i] < endpoints[j]
+	})
+
+	tflog.Info(ctx, "Dokploy API metrics summary", map[string]interface{}{
+		"requests":   total.Requests,
+		"errors":     total.Errors,
+		"error_rate": total.ErrorRate(),
+		"endpoints":  len(endpoints),
+		"total_ms":   total.Total.Milliseconds(),
+		"elapsed_ms": elapsed.Milliseconds(),
+	})
+	for _, endpoint := range endpoints {
+		m := snapshot[endpoint]
+		tflog.Info(ctx, "Dokploy API endpoint metrics", map[string]interface{}{
+			"endpoint":   endpoint,
+			"requests":   m.Requests,
+			"errors":     m.Errors,
+			"error_rate": m.ErrorRate(),
+			"avg_ms":     m.Average().Milliseconds(),
+			"max_ms":     m.Max.Milliseconds(),
+			"total_ms":   m.Total.Milliseconds(),
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogMetricsSummary(t *testing.T) {
+	var out bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &out)
+
+	logMetricsSummary(ctx, map[string]client.EndpointMetrics{
+		"application.one":    {Requests: 4, Errors: 1, Total: 400 * time.Millisecond, Max: 250 * time.Millisecond},
+		"application.deploy": {Requests: 1, Total: 2 * time.Second, Max: 2 * time.Second},
+	}, 5*time.Second)
+
+	entries, err := tflogtest.MultilineJSONDecode(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("logged %d entries, want 3: %v", len(entries), entries)
+	}
+	if got := entries[0]; got["requests"] != float64(5) || got["errors"] != float64(1) || got["error_rate"] != 0.2 || got["elapsed_ms"] != float64(5000) {
+		t.Errorf("summary = %v", got)
+	}
+	// Slowest endpoint first.
+	if got := entries[1]; got["endpoint"] != "application.deploy" || got["total_ms"] != float64(2000) {
+		t.Errorf("first endpoint = %v", got)
+	}
+	if got := entries[2]; got["endpoint"] != "application.one" || got["avg_ms"] != float64(100) || got["max_ms"] != float64(250) || got["error_rate"] != 0.25 {
+		t.Errorf("second endpoint = %v", got)
+	}
+}
@@ -3,32 +3,69 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &AIResource{}
 var _ resource.ResourceWithImportState = &AIResource{}
+var _ resource.ResourceWithValidateConfig = &AIResource{}
+var _ resource.ResourceWithModifyPlan = &AIResource{}
+
+// aiPreset describes a well-known AI provider.
+type aiPreset struct {
+	// apiURL is the default api_url, empty when every account has its own.
+	apiURL string
+	// model matches the provider's model names.
+	model *regexp.Regexp
+	// example is a model name shown when model does not match.
+	example string
+}
+
+// aiPresets are the providers the preset attribute accepts.
+var aiPresets = map[string]aiPreset{
+	"openai":     {apiURL: "https://api.openai.com/v1", model: regexp.MustCompile(`^(gpt-|o\d|chatgpt-|text-|davinci|babbage)[A-Za-z0-9.:_-]*$`), example: "gpt-4o"},
+	"anthropic":  {apiURL: "https://api.anthropic.com/v1", model: regexp.MustCompile(`^claude-[a-z0-9.-]+$`), example: "claude-sonnet-4-5"},
+	"ollama":     {apiURL: "http://localhost:11434", model: regexp.MustCompile(`^[a-z0-9._-]+(/[a-z0-9._-]+)?(:[A-Za-z0-9._-]+)?$`), example: "llama3.1:8b"},
+	"openrouter": {apiURL: "https://openrouter.ai/api/v1", model: regexp.MustCompile(`^[a-z0-9._-]+/[A-Za-z0-9.:_-]+$`), example: "anthropic/claude-sonnet-4.5"},
+	// Azure model names are the deployment names chosen in each resource.
+	"azure": {model: regexp.MustCompile(`^[A-Za-z0-9._-]+$`), example: "my-gpt-4o-deployment"},
+}
+
+// aiPresetNames returns the names of aiPresets, sorted.
+func aiPresetNames() []string {
+	names := make([]string, 0, len(aiPresets))
+	for name := range aiPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
 
 func NewAIResource() resource.Resource {
 	return &AIResource{}
 }
 
 type AIResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type AIResourceModel struct {
 	ID             types.String `tfsdk:"id"`
 	Name           types.String `tfsdk:"name"`
+	Preset         types.String `tfsdk:"preset"`
 	ApiURL         types.String `tfsdk:"api_url"`
 	ApiKey         types.String `tfsdk:"api_key"`
 	Model          types.String `tfsdk:"model"`
@@ -56,9 +93,17 @@ func (r *AIResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 				Required:    true,
 				Description: "Display name for the AI provider configuration.",
 			},
+			"preset": schema.StringAttribute{
+				Optional:    true,
+				Description: "Well-known AI provider: " + strings.Join(aiPresetNames(), ", ") + ". Fills in the default api_url of the provider and checks that model is named the way the provider names its models. The preset is not stored in Dokploy, so it is not imported.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(aiPresetNames()...),
+				},
+			},
 			"api_url": schema.StringAttribute{
-				Required:    true,
-				Description: "The API endpoint URL for the AI provider (e.g., https://api.openai.com/v1).",
+				Optional:    true,
+				Computed:    true,
+				Description: "The API endpoint URL for the AI provider (e.g., https://api.openai.com/v1). Defaults to the URL of the preset; required without a preset or with the azure preset, and set it to use a custom gateway.",
 			},
 			"api_key": schema.StringAttribute{
 				Required:    true,
@@ -97,14 +142,61 @@ func (r *AIResource) Configure(_ context.Context, req resource.ConfigureRequest,
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
 }
 
+func (r *AIResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AIResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Preset.IsUnknown() {
+		return
+	}
+
+	if config.Preset.IsNull() {
+		if config.ApiURL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("api_url"), "Missing API URL",
+				"api_url is required unless a preset provides it.")
+		}
+		return
+	}
+
+	preset, ok := aiPresets[config.Preset.ValueString()]
+	if !ok {
+		return
+	}
+	if preset.apiURL == "" && config.ApiURL.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("api_url"), "Missing API URL",
+			fmt.Sprintf("The %s preset has no default api_url, since every account has its own endpoint. Set api_url.", config.Preset.ValueString()))
+	}
+	if !config.Model.IsUnknown() && !config.Model.IsNull() && !preset.model.MatchString(config.Model.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("model"), "Unexpected Model Name",
+			fmt.Sprintf("%q does not look like a %s model name, such as %q. Check the model for typos, or remove preset to use a custom gateway.",
+				config.Model.ValueString(), config.Preset.ValueString(), preset.example))
+	}
+}
+
+func (r *AIResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configURL, preset types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_url"), &configURL)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("preset"), &preset)...)
+	if resp.Diagnostics.HasError() || !configURL.IsNull() || preset.IsNull() || preset.IsUnknown() {
+		return
+	}
+
+	if url := aiPresets[preset.ValueString()].apiURL; url != "" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("api_url"), url)...)
+	}
+}
+
 func (r *AIResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan AIResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -57,6 +62,70 @@ func TestAccAIResource(t *testing.T) {
 	})
 }
 
+func TestAIResourcePresets(t *testing.T) {
+	ctx := context.Background()
+	r := &AIResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	config := func(preset, apiURL types.String, model string) tfsdk.Config {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		plan.Set(ctx, AIResourceModel{
+			ID:             types.StringUnknown(),
+			Name:           types.StringValue("assistant"),
+			Preset:         preset,
+			ApiURL:         apiURL,
+			ApiKey:         types.StringValue("key"),
+			Model:          types.StringValue(model),
+			IsEnabled:      types.BoolValue(true),
+			OrganizationID: types.StringUnknown(),
+			CreatedAt:      types.StringUnknown(),
+		})
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}
+	}
+
+	tests := []struct {
+		name      string
+		preset    types.String
+		apiURL    types.String
+		model     string
+		wantError bool
+		wantURL   string
+	}{
+		{name: "openai default url", preset: types.StringValue("openai"), apiURL: types.StringNull(), model: "gpt-4o", wantURL: "https://api.openai.com/v1"},
+		{name: "custom gateway", preset: types.StringValue("openai"), apiURL: types.StringValue("https://gateway.internal/v1"), model: "gpt-4o", wantURL: "https://gateway.internal/v1"},
+		{name: "openrouter model", preset: types.StringValue("openrouter"), apiURL: types.StringNull(), model: "anthropic/claude-sonnet-4.5", wantURL: "https://openrouter.ai/api/v1"},
+		{name: "anthropic wrong model", preset: types.StringValue("anthropic"), apiURL: types.StringNull(), model: "gpt-4o", wantError: true},
+		{name: "openrouter without vendor", preset: types.StringValue("openrouter"), apiURL: types.StringNull(), model: "gpt-4o", wantError: true},
+		{name: "azure needs url", preset: types.StringValue("azure"), apiURL: types.StringNull(), model: "my-deployment", wantError: true},
+		{name: "no preset needs url", preset: types.StringNull(), apiURL: types.StringNull(), model: "anything", wantError: true},
+		{name: "no preset", preset: types.StringNull(), apiURL: types.StringValue("https://llm.internal"), model: "anything", wantURL: "https://llm.internal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config(tt.preset, tt.apiURL, tt.model)
+			validateResp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: cfg}, validateResp)
+			if validateResp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("diagnostics = %v, want error %v", validateResp.Diagnostics, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: cfg.Raw}
+			modifyResp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Config: cfg, Plan: plan}, modifyResp)
+			var apiURL types.String
+			modifyResp.Plan.GetAttribute(ctx, path.Root("api_url"), &apiURL)
+			if apiURL.ValueString() != tt.wantURL {
+				t.Errorf("api_url = %v, want %q", apiURL, tt.wantURL)
+			}
+		})
+	}
+}
+
 func TestAccAIResourceDisabled(t *testing.T) {
 	host := os.Getenv("DOKPLOY_HOST")
 	apiKey := os.Getenv("DOKPLOY_API_KEY")
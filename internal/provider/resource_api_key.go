@@ -26,7 +26,7 @@ func NewApiKeyResource() resource.Resource {
 }
 
 type ApiKeyResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ApiKeyResourceModel struct {
@@ -161,9 +161,9 @@ func (r *ApiKeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -1,13 +1,22 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,17 +27,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 var _ resource.Resource = &ApplicationResource{}
 var _ resource.ResourceWithImportState = &ApplicationResource{}
+var _ resource.ResourceWithIdentity = &ApplicationResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationResource{}
+var _ resource.ResourceWithUpgradeState = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &ApplicationResource{}
 }
 
 type ApplicationResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ApplicationResourceModel struct {
@@ -93,32 +107,43 @@ type ApplicationResourceModel struct {
 	RegistryUrl types.String `tfsdk:"registry_url"`
 	RegistryId  types.String `tfsdk:"registry_id"`
 
+	// Image digest tracking (source_type = "docker")
+	ResolveDigest types.Bool   `tfsdk:"resolve_digest"`
+	ImageDigest   types.String `tfsdk:"image_digest"`
+
 	// Build type settings
-	BuildType         types.String `tfsdk:"build_type"`
-	DockerfilePath    types.String `tfsdk:"dockerfile_path"`
-	DockerContextPath types.String `tfsdk:"docker_context_path"`
-	DockerBuildStage  types.String `tfsdk:"docker_build_stage"`
-	PublishDirectory  types.String `tfsdk:"publish_directory"`
-	Dockerfile        types.String `tfsdk:"dockerfile"`
-	DropBuildPath     types.String `tfsdk:"drop_build_path"`
-	HerokuVersion     types.String `tfsdk:"heroku_version"`
-	RailpackVersion   types.String `tfsdk:"railpack_version"`
-	IsStaticSpa       types.Bool   `tfsdk:"is_static_spa"`
+	BuildType         types.String           `tfsdk:"build_type"`
+	DockerfilePath    types.String           `tfsdk:"dockerfile_path"`
+	DockerContextPath types.String           `tfsdk:"docker_context_path"`
+	DockerBuildStage  types.String           `tfsdk:"docker_build_stage"`
+	PublishDirectory  types.String           `tfsdk:"publish_directory"`
+	Dockerfile        types.String           `tfsdk:"dockerfile"`
+	DropBuildPath     types.String           `tfsdk:"drop_build_path"`
+	HerokuVersion     types.String           `tfsdk:"heroku_version"`
+	RailpackVersion   types.String           `tfsdk:"railpack_version"`
+	IsStaticSpa       types.Bool             `tfsdk:"is_static_spa"`
+	Build             *applicationBuildModel `tfsdk:"build"`
 
 	// Environment settings
-	Env           types.String `tfsdk:"env"`
-	BuildArgs     types.String `tfsdk:"build_args"`
-	BuildSecrets  types.String `tfsdk:"build_secrets"`
-	CreateEnvFile types.Bool   `tfsdk:"create_env_file"`
+	Env                    types.String `tfsdk:"env"`
+	BuildArgs              types.String `tfsdk:"build_args"`
+	BuildArgsMap           types.Map    `tfsdk:"build_args_map"`
+	BuildSecrets           types.String `tfsdk:"build_secrets"`
+	BuildSecretsMap        types.Map    `tfsdk:"build_secrets_map"`
+	BuildSecretsMapVersion types.Int64  `tfsdk:"build_secrets_map_version"`
+	CreateEnvFile          types.Bool   `tfsdk:"create_env_file"`
 
 	// Runtime configuration
 	AutoDeploy        types.Bool   `tfsdk:"auto_deploy"`
 	Replicas          types.Int64  `tfsdk:"replicas"`
-	MemoryLimit       types.Int64  `tfsdk:"memory_limit"`
-	MemoryReservation types.Int64  `tfsdk:"memory_reservation"`
-	CpuLimit          types.Int64  `tfsdk:"cpu_limit"`
-	CpuReservation    types.Int64  `tfsdk:"cpu_reservation"`
+	MemoryLimit       unitValue    `tfsdk:"memory_limit"`
+	MemoryReservation unitValue    `tfsdk:"memory_reservation"`
+	CpuLimit          unitValue    `tfsdk:"cpu_limit"`
+	CpuReservation    unitValue    `tfsdk:"cpu_reservation"`
 	Command           types.String `tfsdk:"command"`
+	CommandList       types.List   `tfsdk:"command_list"`
+	Entrypoint        types.String `tfsdk:"entrypoint"`
+	EntrypointList    types.List   `tfsdk:"entrypoint_list"`
 	Args              types.String `tfsdk:"args"`
 
 	// Preview deployments
@@ -150,33 +175,87 @@ type ApplicationResourceModel struct {
 	Enabled  types.Bool   `tfsdk:"enabled"`
 
 	// Deployment options
-	DeployOnCreate types.Bool `tfsdk:"deploy_on_create"`
+	DeployOnCreate types.Bool        `tfsdk:"deploy_on_create"`
+	WaitForHealthy *healthCheckModel `tfsdk:"wait_for_healthy"`
 
 	// Application status (computed)
 	ApplicationStatus types.String `tfsdk:"application_status"`
 
+	// Audit metadata (computed)
+	CreatedAt       types.String `tfsdk:"created_at"`
+	DeploymentCount types.Int64  `tfsdk:"deployment_count"`
+	LastDeployedAt  types.String `tfsdk:"last_deployed_at"`
+
+	// Dashboard link (computed)
+	DashboardURL types.String `tfsdk:"dashboard_url"`
+
+	// Webhook token
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	RotateToken  types.String `tfsdk:"rotate_token"`
+
 	// Docker Swarm configuration (stored as JSON strings)
-	HealthCheckSwarm     types.String `tfsdk:"health_check_swarm"`
-	RestartPolicySwarm   types.String `tfsdk:"restart_policy_swarm"`
-	PlacementSwarm       types.String `tfsdk:"placement_swarm"`
-	UpdateConfigSwarm    types.String `tfsdk:"update_config_swarm"`
-	RollbackConfigSwarm  types.String `tfsdk:"rollback_config_swarm"`
-	ModeSwarm            types.String `tfsdk:"mode_swarm"`
-	LabelsSwarm          types.String `tfsdk:"labels_swarm"`
-	NetworkSwarm         types.String `tfsdk:"network_swarm"`
-	StopGracePeriodSwarm types.Int64  `tfsdk:"stop_grace_period_swarm"`
-	EndpointSpecSwarm    types.String `tfsdk:"endpoint_spec_swarm"`
+	HealthCheckSwarm     types.String               `tfsdk:"health_check_swarm"`
+	RestartPolicySwarm   types.String               `tfsdk:"restart_policy_swarm"`
+	PlacementSwarm       types.String               `tfsdk:"placement_swarm"`
+	UpdateConfigSwarm    types.String               `tfsdk:"update_config_swarm"`
+	RollbackConfigSwarm  types.String               `tfsdk:"rollback_config_swarm"`
+	ModeSwarm            types.String               `tfsdk:"mode_swarm"`
+	LabelsSwarm          types.String               `tfsdk:"labels_swarm"`
+	Tags                 types.Map                  `tfsdk:"tags"`
+	NetworkSwarm         types.String               `tfsdk:"network_swarm"`
+	StopGracePeriodSwarm unitValue                  `tfsdk:"stop_grace_period_swarm"`
+	EndpointSpecSwarm    types.String               `tfsdk:"endpoint_spec_swarm"`
+	Ulimits              []applicationUlimitModel   `tfsdk:"ulimits"`
+	Domains              []applicationDomainModel   `tfsdk:"domains"`
+	Ports                []applicationPortModel     `tfsdk:"ports"`
+	Redirects            []applicationRedirectModel `tfsdk:"redirects"`
+	Mounts               []applicationMountModel    `tfsdk:"mounts"`
 
 	// Traefik configuration
 	TraefikConfig types.String `tfsdk:"traefik_config"`
 }
 
+// applicationBuildModel groups the build-type specific settings.
+type applicationBuildModel struct {
+	DockerfilePath    types.String `tfsdk:"dockerfile_path"`
+	DockerContextPath types.String `tfsdk:"docker_context_path"`
+	DockerBuildStage  types.String `tfsdk:"docker_build_stage"`
+	PublishDirectory  types.String `tfsdk:"publish_directory"`
+	IsStaticSpa       types.Bool   `tfsdk:"is_static_spa"`
+	HerokuVersion     types.String `tfsdk:"heroku_version"`
+	RailpackVersion   types.String `tfsdk:"railpack_version"`
+}
+
+// applicationUlimitModel is a container resource limit.
+type applicationUlimitModel struct {
+	Name types.String `tfsdk:"name"`
+	Soft types.Int64  `tfsdk:"soft"`
+	Hard types.Int64  `tfsdk:"hard"`
+}
+
+// applicationBuildAttributeTypes lists the build types each attribute of the
+// build block applies to.
+var applicationBuildAttributeTypes = map[string][]string{
+	"dockerfile_path":     {"dockerfile"},
+	"docker_context_path": {"dockerfile"},
+	"docker_build_stage":  {"dockerfile"},
+	"publish_directory":   {"static", "nixpacks"},
+	"is_static_spa":       {"static"},
+	"heroku_version":      {"heroku_buildpacks"},
+	"railpack_version":    {"railpack"},
+}
+
 func (r *ApplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application"
 }
 
+func (r *ApplicationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	serviceIdentitySchema(resp)
+}
+
 func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a Dokploy application. Supports multiple source types including GitHub, GitLab, Bitbucket, Gitea, custom Git repositories, and Docker images.",
 		Attributes: map[string]schema.Attribute{
 			// Core attributes
@@ -189,7 +268,7 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"environment_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The environment ID this application belongs to. Changing this will move the application to a different environment.",
+				Description: "The environment ID this application belongs to. Changing this will move the application to a different environment of the same project; moving to another project's environment recreates the application.",
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -198,7 +277,7 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"app_name": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "The app name used for Docker container naming. Auto-generated if not specified.",
+				Description: "The app name used for Docker container naming. Auto-generated if not specified. Changing it recreates the application.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -209,14 +288,14 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"server_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Server ID to deploy the application to. If not specified, deploys to the default server.",
+				Description: "Server ID to deploy the application to. If not specified, deploys to the default server. Must refer to a server with server_type 'deploy'.",
 			},
 
 			// Source type
 			"source_type": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "The source type for the application: github, gitlab, bitbucket, gitea, git, docker, or drop.",
+				Description: "The source type for the application: github, gitlab, bitbucket, gitea, git, docker, or drop. Changing it clears the settings of the previous source on the Dokploy side.",
 				Validators: []validator.String{
 					stringvalidator.OneOf("github", "gitlab", "bitbucket", "gitea", "git", "docker", "drop"),
 				},
@@ -257,7 +336,10 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"watch_paths": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
-				Description: "Paths to watch for changes to trigger deployments.",
+				Description: "Paths to watch for changes to trigger deployments, as globs relative to the repository root (e.g. \"src/**\"). Applies to every git source type (github, gitlab, bitbucket, gitea and git). Absolute paths and paths outside the repository are rejected, and a leading \"./\" is dropped before the paths are sent. Removing the attribute clears the paths in Dokploy.",
+				Validators: []validator.List{
+					watchPathsValidator{},
+				},
 			},
 
 			// GitHub provider settings (source_type = "github")
@@ -306,7 +388,7 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"trigger_type": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Trigger type for deployments: 'push' (default) or 'tag'.",
+				Description: "Trigger type for deployments: 'push' (default) or 'tag'. With 'tag', every pushed tag triggers a deployment; Dokploy has no setting to filter tags by pattern.",
 				Validators: []validator.String{
 					stringvalidator.OneOf("push", "tag"),
 				},
@@ -409,6 +491,17 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:    true,
 				Description: "Registry ID from Dokploy registry management.",
 			},
+			"resolve_digest": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Resolve docker_image to its registry digest during plan and redeploy when the digest behind the tag changes. Dokploy has no API for registry lookups, so the digest is read directly from the registry by the machine running Terraform, which needs network access to the registry and uses username and password or the credentials of registry_id. Leave it unset where only the Dokploy server can reach the registry.",
+			},
+			"image_digest": schema.StringAttribute{
+				Computed:    true,
+				Description: "Digest of docker_image at the last apply. Only tracked when resolve_digest is enabled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 
 			// Build type settings
 			"build_type": schema.StringAttribute{
@@ -463,20 +556,87 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 				Description: "Whether the static build is a Single Page Application.",
 			},
+			"build": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Build-type specific settings, validated against build_type. Replaces the top-level dockerfile_path, docker_context_path, docker_build_stage, publish_directory, is_static_spa, heroku_version and railpack_version attributes, which cannot be set alongside it.",
+				Attributes: map[string]schema.Attribute{
+					"dockerfile_path": schema.StringAttribute{
+						Optional:    true,
+						Description: "Path to the Dockerfile (relative to build path). Only for the dockerfile build type.",
+					},
+					"docker_context_path": schema.StringAttribute{
+						Optional:    true,
+						Description: "Docker build context path. Only for the dockerfile build type.",
+					},
+					"docker_build_stage": schema.StringAttribute{
+						Optional:    true,
+						Description: "Target stage for multi-stage Docker builds. Only for the dockerfile build type.",
+					},
+					"publish_directory": schema.StringAttribute{
+						Optional:    true,
+						Description: "Directory to serve after the build. Only for the static and nixpacks build types.",
+					},
+					"is_static_spa": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether the static build is a Single Page Application. Only for the static build type.",
+					},
+					"heroku_version": schema.StringAttribute{
+						Optional:    true,
+						Description: "Heroku builder version (e.g., 24). Only for the heroku_buildpacks build type.",
+					},
+					"railpack_version": schema.StringAttribute{
+						Optional:    true,
+						Description: "Railpack version. Only for the railpack build type.",
+					},
+				},
+			},
 
 			// Environment settings
 			"env": schema.StringAttribute{
 				Optional:    true,
-				Description: "Environment variables in KEY=VALUE format, one per line.",
+				Description: "Environment variables in KEY=VALUE format, one per line. Dokploy has no separate build environment: the nixpacks, railpack, heroku_buildpacks and paketo builders get these variables while building too. dockerfile builds only see build_args while building.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 			"build_args": schema.StringAttribute{
 				Optional:    true,
-				Description: "Build arguments in KEY=VALUE format, one per line.",
+				Description: "Build arguments in KEY=VALUE format, one per line, passed as --build-arg to dockerfile builds only; other build types ignore them and use env instead. Conflicts with build_args_map.",
+				Validators: []validator.String{
+					envValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("build_args_map")),
+				},
+			},
+			"build_args_map": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Build arguments as a map, passed to dockerfile builds only. They are sent to Dokploy sorted by key, so reordering the map causes no diff. Conflicts with build_args.",
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("build_args")),
+				},
 			},
 			"build_secrets": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "Build secrets in KEY=VALUE format, one per line.",
+				Description: "Build secrets in KEY=VALUE format, one per line. Conflicts with build_secrets_map.",
+				Validators: []validator.String{
+					envValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("build_secrets_map")),
+				},
+			},
+			"build_secrets_map": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+				Description: "Build secrets as a map. Write-only: the values are sent to Dokploy sorted by key but never stored in state, so changing them alone causes no diff; bump build_secrets_map_version to send new values. Requires Terraform 1.11 or later. Conflicts with build_secrets.",
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("build_secrets")),
+				},
+			},
+			"build_secrets_map_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Arbitrary number to change whenever build_secrets_map changes, so the new secrets are sent to Dokploy.",
 			},
 			"create_env_file": schema.BoolAttribute{
 				Optional:    true,
@@ -498,25 +658,55 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			"memory_limit": schema.Int64Attribute{
+			"memory_limit": schema.StringAttribute{
+				CustomType:  unitType{kind: unitMemory},
 				Optional:    true,
-				Description: "Memory limit in bytes. Example: 536870912 (512MB).",
+				Description: "Memory limit, e.g. \"512Mi\" or \"1g\" (binary units, as in Docker). Plain numbers are bytes.",
 			},
-			"memory_reservation": schema.Int64Attribute{
+			"memory_reservation": schema.StringAttribute{
+				CustomType:  unitType{kind: unitMemory},
 				Optional:    true,
-				Description: "Memory reservation (soft limit) in bytes.",
+				Description: "Memory reservation (soft limit), e.g. \"256Mi\". Plain numbers are bytes.",
 			},
-			"cpu_limit": schema.Int64Attribute{
+			"cpu_limit": schema.StringAttribute{
+				CustomType:  unitType{kind: unitCPU},
 				Optional:    true,
-				Description: "CPU limit in nanocores. Example: 1000000000 (1 CPU).",
+				Description: "CPU limit as a number of CPUs, e.g. \"1.5\" or \"500m\". Numbers of 1000 or more are nanocores.",
 			},
-			"cpu_reservation": schema.Int64Attribute{
+			"cpu_reservation": schema.StringAttribute{
+				CustomType:  unitType{kind: unitCPU},
 				Optional:    true,
-				Description: "CPU reservation in nanocores.",
+				Description: "CPU reservation as a number of CPUs, e.g. \"0.25\". Numbers of 1000 or more are nanocores.",
 			},
 			"command": schema.StringAttribute{
 				Optional:    true,
 				Description: "Custom command to run (overrides Dockerfile CMD).",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("command_list")),
+				},
+			},
+			"command_list": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Custom command in exec form, e.g. [\"node\", \"server.js\"]. Sent to Dokploy as a JSON array. Conflicts with command.",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("command")),
+				},
+			},
+			"entrypoint": schema.StringAttribute{
+				Optional:    true,
+				Description: "Custom entrypoint (overrides Dockerfile ENTRYPOINT).",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("entrypoint_list")),
+				},
+			},
+			"entrypoint_list": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Custom entrypoint in exec form, e.g. [\"/docker-entrypoint.sh\"]. Sent to Dokploy as a JSON array. Conflicts with entrypoint.",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("entrypoint")),
+				},
 			},
 			"args": schema.StringAttribute{
 				Optional:    true,
@@ -532,15 +722,24 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"preview_env": schema.StringAttribute{
 				Optional:    true,
 				Description: "Environment variables for preview deployments.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 			"preview_build_args": schema.StringAttribute{
 				Optional:    true,
 				Description: "Build arguments for preview deployments.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 			"preview_build_secrets": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
 				Description: "Build secrets for preview deployments in KEY=VALUE format.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 			"preview_labels": schema.ListAttribute{
 				Optional:    true,
@@ -597,13 +796,13 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"rollback_registry_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Registry ID to use for rollback images.",
+				Description: "Registry ID to use for rollback images. Required when rollback_active is true, and must refer to an existing registry.",
 			},
 
 			// Build server configuration
 			"build_server_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Build server ID for remote builds.",
+				Description: "Build server ID for remote builds. Must refer to a server with server_type 'build'.",
 			},
 			"build_registry_id": schema.StringAttribute{
 				Optional:    true,
@@ -630,6 +829,7 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:    true,
 				Description: "Trigger a deployment after creating the application.",
 			},
+			"wait_for_healthy": healthCheckAttribute(),
 
 			// Application status (computed)
 			"application_status": schema.StringAttribute{
@@ -637,6 +837,26 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Current status of the application: idle, running, done, error.",
 			},
 
+			// Audit metadata (computed)
+			"created_at":       createdAtAttribute("application"),
+			"deployment_count": deploymentCountAttribute("application"),
+			"last_deployed_at": lastDeployedAtAttribute("application"),
+			"dashboard_url":    dashboardURLAttribute("application"),
+
+			// Webhook token
+			"refresh_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Webhook refresh token for triggering deployments.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_token": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that replaces refresh_token whenever it changes, invalidating webhook URLs that use the old token.",
+			},
+
 			// Docker Swarm configuration
 			"health_check_swarm": schema.StringAttribute{
 				Optional:    true,
@@ -664,20 +884,53 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"labels_swarm": schema.StringAttribute{
 				Optional:    true,
-				Description: "Labels for Docker Swarm service (JSON format).",
+				Description: "Labels for Docker Swarm service (JSON format). Dokploy runs every application as a Swarm service, even on a single node, and its API has no separate container labels setting.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Organizational tags (e.g. team, cost-center). Stored as Docker Swarm service labels prefixed with 'dokploy.tag.' and filterable in the dokploy_applications data source.",
 			},
 			"network_swarm": schema.StringAttribute{
 				Optional:    true,
 				Description: "Network configuration for Docker Swarm mode (JSON array format).",
 			},
-			"stop_grace_period_swarm": schema.Int64Attribute{
+			"stop_grace_period_swarm": schema.StringAttribute{
+				CustomType:  unitType{kind: unitDuration},
 				Optional:    true,
-				Description: "Stop grace period in nanoseconds for Docker Swarm mode.",
+				Description: "Stop grace period for Docker Swarm mode, e.g. \"30s\". Plain numbers are nanoseconds.",
 			},
 			"endpoint_spec_swarm": schema.StringAttribute{
 				Optional:    true,
 				Description: "Endpoint specification for Docker Swarm mode (JSON format).",
 			},
+			"domains":   domainsAttribute(false),
+			"ports":     portsAttribute(),
+			"redirects": redirectsAttribute(),
+			"mounts":    mountsAttribute(),
+			"ulimits": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Resource limits (ulimits) applied to the application's containers.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the limit: core, cpu, data, fsize, locks, memlock, msgqueue, nice, nofile, nproc, rss, rtprio, rttime, sigpending, or stack.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("core", "cpu", "data", "fsize", "locks", "memlock", "msgqueue", "nice", "nofile", "nproc", "rss", "rtprio", "rttime", "sigpending", "stack"),
+							},
+						},
+						"soft": schema.Int64Attribute{
+							Required:    true,
+							Description: "Soft limit. Must not exceed hard.",
+						},
+						"hard": schema.Int64Attribute{
+							Required:    true,
+							Description: "Hard limit. Use -1 for unlimited.",
+						},
+					},
+				},
+			},
 
 			// Traefik configuration
 			"traefik_config": schema.StringAttribute{
@@ -692,14 +945,267 @@ func (r *ApplicationResource) Configure(_ context.Context, req resource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
 }
 
+func (r *ApplicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplicationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, u := range config.Ulimits {
+		if u.Soft.IsUnknown() || u.Hard.IsUnknown() || u.Hard.ValueInt64() == -1 {
+			continue
+		}
+		if u.Soft.ValueInt64() > u.Hard.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ulimits").AtListIndex(i).AtName("soft"),
+				"Invalid Ulimit",
+				fmt.Sprintf("Soft limit %d for %s exceeds the hard limit %d.", u.Soft.ValueInt64(), u.Name.ValueString(), u.Hard.ValueInt64()),
+			)
+		}
+	}
+
+	for _, d := range config.Domains {
+		validateDomainCertificateType(d.Host, d.HTTPS, d.CertificateType, &resp.Diagnostics)
+	}
+
+	if config.WaitForHealthy != nil {
+		if attrPath, msg := validateHealthCheck(config.WaitForHealthy); msg != "" {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid Health Check", msg)
+		}
+	}
+
+	// Dokploy pushes rollback images to a registry, so rollbacks need one.
+	if config.RollbackActive.ValueBool() && config.RollbackRegistryId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rollback_registry_id"),
+			"Missing Rollback Registry",
+			"rollback_registry_id must be set when rollback_active is true, since Dokploy stores rollback images in that registry.",
+		)
+	}
+
+	// Only the dockerfile builder passes build arguments on; buildpack
+	// builders get env as their build environment instead.
+	if buildType := config.BuildType.ValueString(); buildType != "" && buildType != "dockerfile" {
+		for _, name := range []string{"build_args", "build_args_map"} {
+			var value attr.Value
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+			if value == nil || value.IsNull() {
+				continue
+			}
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(name),
+				"Build Arguments Are Ignored",
+				fmt.Sprintf("Dokploy only passes build arguments to dockerfile builds; the %s builder ignores %s. Variables in env are available both while building and at run time, so set build-time variables there.", buildType, name),
+			)
+		}
+	}
+
+	if config.Build == nil {
+		return
+	}
+
+	topLevel := map[string]attr.Value{
+		"dockerfile_path":     config.DockerfilePath,
+		"docker_context_path": config.DockerContextPath,
+		"docker_build_stage":  config.DockerBuildStage,
+		"publish_directory":   config.PublishDirectory,
+		"is_static_spa":       config.IsStaticSpa,
+		"heroku_version":      config.HerokuVersion,
+		"railpack_version":    config.RailpackVersion,
+	}
+	build := map[string]attr.Value{
+		"dockerfile_path":     config.Build.DockerfilePath,
+		"docker_context_path": config.Build.DockerContextPath,
+		"docker_build_stage":  config.Build.DockerBuildStage,
+		"publish_directory":   config.Build.PublishDirectory,
+		"is_static_spa":       config.Build.IsStaticSpa,
+		"heroku_version":      config.Build.HerokuVersion,
+		"railpack_version":    config.Build.RailpackVersion,
+	}
+
+	names := make([]string, 0, len(build))
+	for name := range build {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !topLevel[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Conflicting Build Settings",
+				fmt.Sprintf("%s cannot be set together with the build block. Move it into build.", name),
+			)
+		}
+	}
+
+	if config.BuildType.IsUnknown() {
+		return
+	}
+	buildType := "nixpacks"
+	if !config.BuildType.IsNull() {
+		buildType = config.BuildType.ValueString()
+	}
+
+	for _, name := range names {
+		if build[name].IsNull() {
+			continue
+		}
+		allowed := applicationBuildAttributeTypes[name]
+		supported := false
+		for _, t := range allowed {
+			if t == buildType {
+				supported = true
+			}
+		}
+		if !supported {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("build").AtName(name),
+				"Invalid Build Setting",
+				fmt.Sprintf("build.%s only applies to the %s build type, but build_type is %q.", name, strings.Join(allowed, " and "), buildType),
+			)
+		}
+	}
+}
+
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ApplicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mirror the build block into the top-level computed attributes so they
+	// match what is sent to the API.
+	if build := plan.Build; build != nil {
+		mirrors := map[string]attr.Value{
+			"dockerfile_path":     build.DockerfilePath,
+			"docker_context_path": build.DockerContextPath,
+			"heroku_version":      build.HerokuVersion,
+			"railpack_version":    build.RailpackVersion,
+			"is_static_spa":       build.IsStaticSpa,
+		}
+		for name, value := range mirrors {
+			if !value.IsNull() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+			}
+		}
+	}
+
+	// A new rotate_token value replaces the webhook token on apply.
+	if !req.State.Raw.IsNull() && !plan.RotateToken.IsNull() {
+		var rotateToken types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotate_token"), &rotateToken)...)
+		if !plan.RotateToken.Equal(rotateToken) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("refresh_token"), types.StringUnknown())...)
+		}
+	}
+
+	requireReplaceOnRename(ctx, "application", "app_name", req, resp)
+
+	if r.client == nil {
+		return
+	}
+
+	requireReplaceOnProjectChange(ctx, r.client, "application", req, resp)
+	r.checkRollbackRegistry(&plan, &resp.Diagnostics)
+	checkServerType(r.client, path.Root("server_id"), plan.ServerID, "deploy", &resp.Diagnostics)
+	checkServerType(r.client, path.Root("build_server_id"), plan.BuildServerId, "build", &resp.Diagnostics)
+
+	if !plan.ResolveDigest.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("image_digest"), types.StringNull())...)
+		return
+	}
+	if plan.DockerImage.IsUnknown() || plan.DockerImage.IsNull() || plan.Username.IsUnknown() || plan.Password.IsUnknown() || plan.RegistryId.IsUnknown() {
+		return
+	}
+
+	digest, err := r.resolveImageDigest(&plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("resolve_digest"),
+			"Unable to Resolve Image Digest",
+			fmt.Sprintf("Could not resolve the digest of %q from the machine running Terraform, keeping the previous value: %s", plan.DockerImage.ValueString(), err.Error()),
+		)
+		if plan.ImageDigest.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("image_digest"), types.StringNull())...)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("image_digest"), types.StringValue(digest))...)
+}
+
+// checkRollbackRegistry reports an error when rollbacks are enabled with a
+// rollback_registry_id that does not exist.
+func (r *ApplicationResource) checkRollbackRegistry(plan *ApplicationResourceModel, diags *diag.Diagnostics) {
+	if !plan.RollbackActive.ValueBool() || plan.RollbackRegistryId.IsUnknown() || plan.RollbackRegistryId.ValueString() == "" {
+		return
+	}
+	_, err := r.client.GetRegistry(plan.RollbackRegistryId.ValueString())
+	switch {
+	case errors.Is(err, client.ErrNotFound):
+		diags.AddAttributeError(
+			path.Root("rollback_registry_id"),
+			"Rollback Registry Not Found",
+			fmt.Sprintf("Registry %q does not exist. Set rollback_registry_id to the ID of a dokploy_registry.", plan.RollbackRegistryId.ValueString()),
+		)
+	case err != nil:
+		diags.AddAttributeWarning(
+			path.Root("rollback_registry_id"),
+			"Unable to Verify Rollback Registry",
+			fmt.Sprintf("Could not check that registry %q exists: %s", plan.RollbackRegistryId.ValueString(), err.Error()),
+		)
+	}
+}
+
+// resolveImageDigest looks up the registry digest of the planned docker_image
+// from the Terraform host, using credentials from the application or its
+// Dokploy registry.
+func (r *ApplicationResource) resolveImageDigest(plan *ApplicationResourceModel) (string, error) {
+	username := plan.Username.ValueString()
+	password := plan.Password.ValueString()
+
+	if username == "" && plan.RegistryId.ValueString() != "" {
+		registry, err := r.client.GetRegistry(plan.RegistryId.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("failed to read registry credentials: %w", err)
+		}
+		username = registry.Username
+		password = registry.Password
+	}
+
+	return r.client.ResolveImageDigest(plan.DockerImage.ValueString(), username, password)
+}
+
+// finalizeImageDigest resolves image_digest at apply time when it could not be
+// determined during plan, e.g. because docker_image depended on another resource.
+func (r *ApplicationResource) finalizeImageDigest(plan *ApplicationResourceModel) {
+	if !plan.ImageDigest.IsUnknown() {
+		return
+	}
+	plan.ImageDigest = types.StringNull()
+	if plan.ResolveDigest.ValueBool() && plan.DockerImage.ValueString() != "" {
+		if digest, err := r.resolveImageDigest(plan); err == nil {
+			plan.ImageDigest = types.StringValue(digest)
+		}
+	}
+}
+
 func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ApplicationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -734,7 +1240,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// 2. Update general settings (sourceType, autoDeploy, replicas, etc.)
-	if err := r.updateGeneralSettings(createdApp.ID, &plan); err != nil {
+	if err := r.updateGeneralSettings(createdApp.ID, &plan, nil); err != nil {
 		resp.Diagnostics.AddError("Error updating application general settings", err.Error())
 		return
 	}
@@ -753,8 +1259,11 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	// 5. Save environment variables if provided
-	if err := r.saveEnvironment(createdApp.ID, &plan); err != nil {
+	// 5. Save environment variables if provided. Write-only build secrets
+	// are only available from the configuration.
+	var buildSecrets types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("build_secrets_map"), &buildSecrets)...)
+	if err := r.saveEnvironment(ctx, createdApp.ID, &plan, buildSecrets, &resp.Diagnostics); err != nil {
 		resp.Diagnostics.AddError("Error saving environment", err.Error())
 		return
 	}
@@ -767,8 +1276,19 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
-	// 7. Read back the final state
-	finalApp, err := r.client.GetApplication(createdApp.ID)
+	// 7. Reconcile domains, ports, redirects and mounts if they are managed here
+	if !r.syncDomains(createdApp.ID, &plan, &resp.Diagnostics) {
+		return
+	}
+	if !r.syncChildren(createdApp.ID, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	// 8. Read back the final state once the API has populated it
+	finalApp, err := waitForConsistentRead(ctx,
+		func() (*client.Application, error) { return r.client.GetApplication(createdApp.ID) },
+		func(app *client.Application) bool { return app.AppName != "" && app.SourceType != "" },
+	)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading application after create", err.Error())
 		return
@@ -787,16 +1307,24 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
-	// 8. Deploy if requested
+	r.finalizeImageDigest(&plan)
+
+	// 9. Deploy if requested
 	if !plan.DeployOnCreate.IsNull() && plan.DeployOnCreate.ValueBool() {
 		err := r.client.DeployApplication(createdApp.ID, plan.ServerID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddWarning("Deployment Trigger Failed", fmt.Sprintf("Application created but deployment failed to trigger: %s", err.Error()))
+		} else {
+			r.awaitHealthy(ctx, &plan, "", finalApp.Domains, &resp.Diagnostics)
 		}
 	}
 
+	readDeploymentActivity(r.client, plan.ID.ValueString(), "application", &plan.DeploymentCount, &plan.LastDeployedAt, &resp.Diagnostics)
+	readDashboardURL(r.client, "application", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -817,8 +1345,17 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	// Update state with values from API
+	// Update state with values from API. A freshly imported state holds
+	// only the ID, so it takes everything the application has configured.
+	imported := state.Name.IsNull()
 	readApplicationIntoState(&state, app)
+	if imported {
+		hydrateImportedApplication(&state, app)
+	}
+	if state.Domains != nil {
+		state.Domains = applicationDomainsFromAPI(state.Domains, app.Domains)
+	}
+	readApplicationChildren(&state, app)
 
 	// Read traefik config separately (not part of application response)
 	traefikConfig, err := r.client.ReadTraefikConfig(state.ID.ValueString())
@@ -831,8 +1368,12 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		state.TraefikConfig = types.StringNull()
 	}
 
+	readDeploymentActivity(r.client, state.ID.ValueString(), "application", &state.DeploymentCount, &state.LastDeployedAt, &resp.Diagnostics)
+	readDashboardURL(r.client, "application", state.ID.ValueString(), state.EnvironmentID.ValueString(), &state.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -858,8 +1399,16 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
-	// 1. Update general settings
-	if err := r.updateGeneralSettings(appID, &plan); err != nil {
+	// 1. Update general settings. Removed tags and ulimits are sent as empty
+	// sets and removed optional settings as nulls so they are cleared.
+	general := plan
+	if general.Tags.IsNull() && !state.Tags.IsNull() {
+		general.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	if general.Ulimits == nil && state.Ulimits != nil {
+		general.Ulimits = []applicationUlimitModel{}
+	}
+	if err := r.updateGeneralSettings(appID, &general, clearedApplicationFields(&plan, &state)); err != nil {
 		resp.Diagnostics.AddError("Error updating application general settings", err.Error())
 		return
 	}
@@ -879,8 +1428,11 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// 4. Update environment if changed
-	if err := r.saveEnvironment(appID, &plan); err != nil {
+	// 4. Update environment if changed. Write-only build secrets are only
+	// available from the configuration.
+	var buildSecrets types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("build_secrets_map"), &buildSecrets)...)
+	if err := r.saveEnvironment(ctx, appID, &plan, buildSecrets, &resp.Diagnostics); err != nil {
 		resp.Diagnostics.AddError("Error saving environment", err.Error())
 		return
 	}
@@ -899,7 +1451,23 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
-	// 6. Read back the final state
+	// 6. Reconcile domains, ports, redirects and mounts if they are managed here
+	if !r.syncDomains(appID, &plan, &resp.Diagnostics) {
+		return
+	}
+	if !r.syncChildren(appID, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	// 7. Rotate the webhook token when rotate_token changes
+	if !plan.RotateToken.IsNull() && !plan.RotateToken.Equal(state.RotateToken) {
+		if err := r.client.RefreshApplicationToken(appID); err != nil {
+			resp.Diagnostics.AddError("Error rotating application webhook token", err.Error())
+			return
+		}
+	}
+
+	// 8. Read back the final state
 	finalApp, err := r.client.GetApplication(appID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading application after update", err.Error())
@@ -919,8 +1487,32 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		plan.TraefikConfig = types.StringNull()
 	}
 
+	r.finalizeImageDigest(&plan)
+
+	// 9. Redeploy when the digest behind the image tag has moved
+	if plan.ResolveDigest.ValueBool() && !state.ImageDigest.IsNull() && !plan.ImageDigest.IsNull() &&
+		plan.ImageDigest.ValueString() != state.ImageDigest.ValueString() {
+		var previousID string
+		if plan.WaitForHealthy != nil {
+			previousID, err = latestDeploymentID(r.client, appID, "application")
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading application deployments", err.Error())
+				return
+			}
+		}
+		if err := r.client.DeployApplication(appID, plan.ServerID.ValueString()); err != nil {
+			resp.Diagnostics.AddWarning("Deployment Trigger Failed", fmt.Sprintf("Image digest changed but deployment failed to trigger: %s", err.Error()))
+		} else {
+			r.awaitHealthy(ctx, &plan, previousID, finalApp.Domains, &resp.Diagnostics)
+		}
+	}
+
+	readDeploymentActivity(r.client, plan.ID.ValueString(), "application", &plan.DeploymentCount, &plan.LastDeployedAt, &resp.Diagnostics)
+	readDashboardURL(r.client, "application", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -931,20 +1523,228 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteApplication(state.ID.ValueString())
+	err := deleteChild(ctx, func() error { return r.client.DeleteApplication(state.ID.ValueString()) }, environmentParent(r.client, state.EnvironmentID.ValueString()))
 	if err != nil {
-		errStr := strings.ToLower(err.Error())
-		if strings.Contains(errStr, "not found") || strings.Contains(errStr, "not_found") || strings.Contains(errStr, "404") {
-			// Resource already deleted, that's fine
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting application", err.Error())
 		return
 	}
 }
 
+// awaitHealthy waits for the deployment that follows previousID and then
+// probes the application until it serves traffic, when wait_for_healthy is
+// set. Both steps share the configured timeout.
+func (r *ApplicationResource) awaitHealthy(ctx context.Context, plan *ApplicationResourceModel, previousID string, domains []client.Domain, diags *diag.Diagnostics) {
+	hc := plan.WaitForHealthy
+	if hc == nil {
+		return
+	}
+	timeout, err := time.ParseDuration(hc.Timeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("wait_for_healthy").AtName("timeout"), "Invalid Health Check", err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dep, err := waitForDeployment(ctx, r.client, plan.ID.ValueString(), "application", previousID)
+	if err != nil {
+		diags.AddError("Error waiting for application deployment", fmt.Sprintf("The deployment did not finish within %s: %s", timeout, err))
+		return
+	}
+	if dep.Status != "done" {
+		addDeploymentFailure(diags, r.client, "Application Deployment Failed", dep, plan.ServerID.ValueString())
+		return
+	}
+
+	url, err := healthCheckURL(hc, domains)
+	if err != nil {
+		diags.AddAttributeError(path.Root("wait_for_healthy").AtName("url"), "Application Not Healthy", err.Error())
+		return
+	}
+	if err := waitForHealthy(ctx, healthCheckClient, url, int(hc.Status.ValueInt64())); err != nil {
+		diags.AddError("Application Not Healthy", fmt.Sprintf("%s did not answer with status %d within %s after the deployment finished: %s", url, hc.Status.ValueInt64(), timeout, err))
+	}
+}
+
+// syncDomains reconciles the application's domains with the domains set and
+// reads them back into plan. It does nothing when domains are not managed.
+func (r *ApplicationResource) syncDomains(appID string, plan *ApplicationResourceModel, diags *diag.Diagnostics) bool {
+	if plan.Domains == nil {
+		return true
+	}
+	current, err := r.client.GetDomainsByApplication(appID)
+	if err != nil {
+		diags.AddError("Error reading application domains", err.Error())
+		return false
+	}
+	if err := reconcileDomains(r.client, current, applicationDomainsFromModel(appID, plan.Domains)); err != nil {
+		diags.AddError("Error updating application domains", err.Error())
+		return false
+	}
+	current, err = r.client.GetDomainsByApplication(appID)
+	if err != nil {
+		diags.AddError("Error reading application domains", err.Error())
+		return false
+	}
+	plan.Domains = applicationDomainsFromAPI(plan.Domains, current)
+	return true
+}
+
+// syncChildren reconciles the application's ports, redirects and mounts with
+// the sets that are managed here and reads them back into plan.
+func (r *ApplicationResource) syncChildren(appID string, plan *ApplicationResourceModel, diags *diag.Diagnostics) bool {
+	if plan.Ports == nil && plan.Redirects == nil && plan.Mounts == nil {
+		return true
+	}
+	app, err := r.client.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Error reading application", err.Error())
+		return false
+	}
+
+	if plan.Ports != nil {
+		if err := reconcilePorts(r.client, app.Ports, applicationPortsFromModel(appID, plan.Ports)); err != nil {
+			diags.AddError("Error updating application ports", err.Error())
+			return false
+		}
+	}
+	if plan.Redirects != nil {
+		if err := reconcileRedirects(r.client, app.Redirects, applicationRedirectsFromModel(appID, plan.Redirects)); err != nil {
+			diags.AddError("Error updating application redirects", err.Error())
+			return false
+		}
+	}
+	if plan.Mounts != nil {
+		if err := reconcileMounts(r.client, app.Mounts, applicationMountsFromModel(appID, plan.Mounts)); err != nil {
+			diags.AddError("Error updating application mounts", err.Error())
+			return false
+		}
+	}
+
+	app, err = r.client.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Error reading application", err.Error())
+		return false
+	}
+	readApplicationChildren(plan, app)
+	return true
+}
+
+// readApplicationChildren refreshes the ports, redirects and mounts that are
+// managed on the application.
+func readApplicationChildren(m *ApplicationResourceModel, app *client.Application) {
+	if m.Ports != nil {
+		m.Ports = applicationPortsFromAPI(app.Ports)
+	}
+	if m.Redirects != nil {
+		m.Redirects = applicationRedirectsFromAPI(app.Redirects)
+	}
+	if m.Mounts != nil {
+		m.Mounts = applicationMountsFromAPI(app.Mounts)
+	}
+}
+
+// UpgradeState converts the resource limits and stop grace period, which were
+// numbers before schema version 1, to their string form.
+func (r *ApplicationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeApplicationStateV0},
+	}
+}
+
+func upgradeApplicationStateV0(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	decoder.UseNumber()
+	var raw map[string]interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Application State", err.Error())
+		return
+	}
+
+	for _, key := range []string{"memory_limit", "memory_reservation", "cpu_limit", "cpu_reservation", "stop_grace_period_swarm"} {
+		if n, ok := raw[key].(json.Number); ok {
+			raw[key] = n.String()
+		}
+	}
+
+	upgraded, err := json.Marshal(raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Application State", err.Error())
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
+// ImportState accepts a raw application ID, an appName, or a path of the form
+// "project-name/environment-name/app-name".
 func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Imports by identity carry the application ID itself.
+	if req.ID == "" {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	importID := req.ID
+
+	if strings.Contains(importID, "/") {
+		parts := strings.Split(importID, "/")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected an application ID or \"project-name/environment-name/app-name\", got: %q", importID),
+			)
+			return
+		}
+
+		app, err := r.client.FindApplicationByPath(parts[0], parts[1], parts[2])
+		if err != nil {
+			resp.Diagnostics.AddError("Error resolving application for import", err.Error())
+			return
+		}
+		importID = app.ID
+	} else if _, err := r.client.GetApplication(importID); err != nil {
+		// Only an ID that matches no application is tried as an appName,
+		// which needs the full application list.
+		if !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError("Error resolving application for import", err.Error())
+			return
+		}
+		app, err := r.client.FindApplicationByAppName(importID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resolving application for import",
+				fmt.Sprintf("No application has the ID or appName %q: %s", importID, err),
+			)
+			return
+		}
+		importID = app.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
+}
+
+// hydrateImportedApplication fills in the settings that readApplicationIntoState
+// only refreshes once they are in state, so that the first plan after an
+// import only shows real differences from the configuration.
+func hydrateImportedApplication(state *ApplicationResourceModel, app *client.Application) {
+	state.Env = optionalString(app.Env)
+	state.BuildArgs = optionalString(app.BuildArgs)
+	if len(app.Domains) > 0 {
+		state.Domains = []applicationDomainModel{}
+	}
+	if len(app.Ports) > 0 {
+		state.Ports = []applicationPortModel{}
+	}
+	if len(app.Redirects) > 0 {
+		state.Redirects = []applicationRedirectModel{}
+	}
+	if len(app.Mounts) > 0 {
+		state.Mounts = []applicationMountModel{}
+	}
 }
 
 // Helper functions
@@ -965,16 +1765,20 @@ func inferSourceType(plan *ApplicationResourceModel) types.String {
 	if !plan.GiteaId.IsNull() && !plan.GiteaId.IsUnknown() && plan.GiteaId.ValueString() != "" {
 		return types.StringValue("gitea")
 	}
+	if !plan.Dockerfile.IsNull() && !plan.Dockerfile.IsUnknown() && plan.Dockerfile.ValueString() != "" {
+		return types.StringValue("drop")
+	}
 	return types.StringValue("github")
 }
 
-func (r *ApplicationResource) updateGeneralSettings(appID string, plan *ApplicationResourceModel) error {
+func (r *ApplicationResource) updateGeneralSettings(appID string, plan *ApplicationResourceModel, clear []client.ApplicationField) error {
 	generalApp := client.Application{
 		ID:         appID,
 		Name:       plan.Name.ValueString(),
 		AppName:    plan.AppName.ValueString(),
 		SourceType: plan.SourceType.ValueString(),
 		AutoDeploy: plan.AutoDeploy.ValueBool(),
+		Clear:      clear,
 	}
 
 	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
@@ -983,20 +1787,35 @@ func (r *ApplicationResource) updateGeneralSettings(appID string, plan *Applicat
 	if !plan.Replicas.IsNull() && !plan.Replicas.IsUnknown() {
 		generalApp.Replicas = int(plan.Replicas.ValueInt64())
 	}
-	if !plan.MemoryLimit.IsNull() && !plan.MemoryLimit.IsUnknown() {
-		generalApp.MemoryLimit = json.Number(fmt.Sprintf("%d", plan.MemoryLimit.ValueInt64()))
+	for _, limit := range []struct {
+		value  unitValue
+		target *json.Number
+	}{
+		{plan.MemoryLimit, &generalApp.MemoryLimit},
+		{plan.MemoryReservation, &generalApp.MemoryReservation},
+		{plan.CpuLimit, &generalApp.CpuLimit},
+		{plan.CpuReservation, &generalApp.CpuReservation},
+	} {
+		if limit.value.IsNull() || limit.value.IsUnknown() {
+			continue
+		}
+		n, err := limit.value.Int64()
+		if err != nil {
+			return err
+		}
+		*limit.target = json.Number(strconv.FormatInt(n, 10))
 	}
-	if !plan.MemoryReservation.IsNull() && !plan.MemoryReservation.IsUnknown() {
-		generalApp.MemoryReservation = json.Number(fmt.Sprintf("%d", plan.MemoryReservation.ValueInt64()))
+	if !plan.Command.IsNull() && !plan.Command.IsUnknown() {
+		generalApp.Command = plan.Command.ValueString()
 	}
-	if !plan.CpuLimit.IsNull() && !plan.CpuLimit.IsUnknown() {
-		generalApp.CpuLimit = json.Number(fmt.Sprintf("%d", plan.CpuLimit.ValueInt64()))
+	if command, ok := execFormFromPlan(plan.CommandList); ok {
+		generalApp.Command = command
 	}
-	if !plan.CpuReservation.IsNull() && !plan.CpuReservation.IsUnknown() {
-		generalApp.CpuReservation = json.Number(fmt.Sprintf("%d", plan.CpuReservation.ValueInt64()))
+	if !plan.Entrypoint.IsNull() && !plan.Entrypoint.IsUnknown() {
+		generalApp.EntryPoint = plan.Entrypoint.ValueString()
 	}
-	if !plan.Command.IsNull() && !plan.Command.IsUnknown() {
-		generalApp.Command = plan.Command.ValueString()
+	if entrypoint, ok := execFormFromPlan(plan.EntrypointList); ok {
+		generalApp.EntryPoint = entrypoint
 	}
 	if !plan.Args.IsNull() && !plan.Args.IsUnknown() {
 		generalApp.Args = plan.Args.ValueString()
@@ -1107,6 +1926,19 @@ func (r *ApplicationResource) updateGeneralSettings(appID string, plan *Applicat
 		}
 		generalApp.LabelsSwarm = m
 	}
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
+		labels := generalApp.LabelsSwarm
+		if labels == nil {
+			// labelsSwarm replaces every swarm label, so without labels_swarm
+			// the tags are merged into the labels set in the Dokploy UI.
+			app, err := r.client.GetApplication(appID)
+			if err != nil {
+				return fmt.Errorf("failed to read swarm labels: %w", err)
+			}
+			labels = app.LabelsSwarm
+		}
+		generalApp.LabelsSwarm = withApplicationTags(labels, plan.Tags)
+	}
 	if !plan.NetworkSwarm.IsNull() && !plan.NetworkSwarm.IsUnknown() {
 		var arr []map[string]interface{}
 		if err := json.Unmarshal([]byte(plan.NetworkSwarm.ValueString()), &arr); err != nil {
@@ -1115,9 +1947,22 @@ func (r *ApplicationResource) updateGeneralSettings(appID string, plan *Applicat
 		generalApp.NetworkSwarm = arr
 	}
 	if !plan.StopGracePeriodSwarm.IsNull() && !plan.StopGracePeriodSwarm.IsUnknown() {
-		val := plan.StopGracePeriodSwarm.ValueInt64()
+		val, err := plan.StopGracePeriodSwarm.Int64()
+		if err != nil {
+			return err
+		}
 		generalApp.StopGracePeriodSwarm = &val
 	}
+	if plan.Ulimits != nil {
+		generalApp.UlimitsSwarm = []client.Ulimit{}
+		for _, u := range plan.Ulimits {
+			generalApp.UlimitsSwarm = append(generalApp.UlimitsSwarm, client.Ulimit{
+				Name: u.Name.ValueString(),
+				Soft: u.Soft.ValueInt64(),
+				Hard: u.Hard.ValueInt64(),
+			})
+		}
+	}
 	if !plan.EndpointSpecSwarm.IsNull() && !plan.EndpointSpecSwarm.IsUnknown() {
 		var m map[string]interface{}
 		if err := json.Unmarshal([]byte(plan.EndpointSpecSwarm.ValueString()), &m); err != nil {
@@ -1131,18 +1976,74 @@ func (r *ApplicationResource) updateGeneralSettings(appID string, plan *Applicat
 }
 
 func (r *ApplicationResource) saveBuildType(appID string, plan *ApplicationResourceModel) error {
-	return r.client.SaveBuildType(
-		appID,
-		plan.BuildType.ValueString(),
-		plan.DockerfilePath.ValueString(),
-		plan.DockerContextPath.ValueString(),
-		plan.DockerBuildStage.ValueString(),
-		plan.PublishDirectory.ValueString(),
-	)
+	input := client.SaveBuildTypeInput{
+		ApplicationID:     appID,
+		BuildType:         plan.BuildType.ValueString(),
+		Dockerfile:        plan.DockerfilePath.ValueString(),
+		DockerContextPath: plan.DockerContextPath.ValueString(),
+		DockerBuildStage:  plan.DockerBuildStage.ValueString(),
+		PublishDirectory:  plan.PublishDirectory.ValueString(),
+		HerokuVersion:     plan.HerokuVersion.ValueString(),
+		RailpackVersion:   plan.RailpackVersion.ValueString(),
+	}
+	if !plan.IsStaticSpa.IsNull() && !plan.IsStaticSpa.IsUnknown() {
+		isStaticSpa := plan.IsStaticSpa.ValueBool()
+		input.IsStaticSpa = &isStaticSpa
+	}
+
+	// The build block takes precedence over the top-level attributes.
+	if build := plan.Build; build != nil {
+		if !build.DockerfilePath.IsNull() {
+			input.Dockerfile = build.DockerfilePath.ValueString()
+		}
+		if !build.DockerContextPath.IsNull() {
+			input.DockerContextPath = build.DockerContextPath.ValueString()
+		}
+		input.DockerBuildStage = build.DockerBuildStage.ValueString()
+		input.PublishDirectory = build.PublishDirectory.ValueString()
+		if !build.HerokuVersion.IsNull() {
+			input.HerokuVersion = build.HerokuVersion.ValueString()
+		}
+		if !build.RailpackVersion.IsNull() {
+			input.RailpackVersion = build.RailpackVersion.ValueString()
+		}
+		if !build.IsStaticSpa.IsNull() {
+			isStaticSpa := build.IsStaticSpa.ValueBool()
+			input.IsStaticSpa = &isStaticSpa
+		}
+	}
+
+	return r.client.SaveBuildType(input)
+}
+
+// readApplicationBuild refreshes the attributes set in the build block.
+func readApplicationBuild(build *applicationBuildModel, app *client.Application) {
+	if !build.DockerfilePath.IsNull() {
+		build.DockerfilePath = types.StringValue(app.DockerfilePath)
+	}
+	if !build.DockerContextPath.IsNull() {
+		build.DockerContextPath = types.StringValue(app.DockerContextPath)
+	}
+	if !build.DockerBuildStage.IsNull() {
+		build.DockerBuildStage = types.StringValue(app.DockerBuildStage)
+	}
+	if !build.PublishDirectory.IsNull() {
+		build.PublishDirectory = types.StringValue(app.PublishDirectory)
+	}
+	if !build.IsStaticSpa.IsNull() {
+		build.IsStaticSpa = types.BoolValue(app.IsStaticSpa)
+	}
+	if !build.HerokuVersion.IsNull() {
+		build.HerokuVersion = types.StringValue(app.HerokuVersion)
+	}
+	if !build.RailpackVersion.IsNull() {
+		build.RailpackVersion = types.StringValue(app.RailpackVersion)
+	}
 }
 
 func (r *ApplicationResource) saveSourceProvider(appID string, plan *ApplicationResourceModel) error {
 	sourceType := plan.SourceType.ValueString()
+	watchPaths := watchPathsFromPlan(plan.WatchPaths)
 
 	switch sourceType {
 	case "github":
@@ -1172,6 +2073,7 @@ func (r *ApplicationResource) saveSourceProvider(appID string, plan *Application
 			GithubId:         plan.GithubId.ValueString(),
 			EnableSubmodules: plan.EnableSubmodules.ValueBool(),
 			TriggerType:      plan.TriggerType.ValueString(),
+			WatchPaths:       watchPaths,
 		}
 		return r.client.SaveGithubProvider(input)
 
@@ -1186,6 +2088,7 @@ func (r *ApplicationResource) saveSourceProvider(appID string, plan *Application
 			GitlabBuildPath:     plan.GitlabBuildPath.ValueString(),
 			GitlabPathNamespace: plan.GitlabPathNamespace.ValueString(),
 			EnableSubmodules:    plan.EnableSubmodules.ValueBool(),
+			WatchPaths:          watchPaths,
 		}
 		return r.client.SaveGitlabProvider(input)
 
@@ -1198,6 +2101,7 @@ func (r *ApplicationResource) saveSourceProvider(appID string, plan *Application
 			BitbucketBranch:     plan.BitbucketBranch.ValueString(),
 			BitbucketBuildPath:  plan.BitbucketBuildPath.ValueString(),
 			EnableSubmodules:    plan.EnableSubmodules.ValueBool(),
+			WatchPaths:          watchPaths,
 		}
 		return r.client.SaveBitbucketProvider(input)
 
@@ -1210,6 +2114,7 @@ func (r *ApplicationResource) saveSourceProvider(appID string, plan *Application
 			GiteaBranch:      plan.GiteaBranch.ValueString(),
 			GiteaBuildPath:   plan.GiteaBuildPath.ValueString(),
 			EnableSubmodules: plan.EnableSubmodules.ValueBool(),
+			WatchPaths:       watchPaths,
 		}
 		return r.client.SaveGiteaProvider(input)
 
@@ -1221,6 +2126,7 @@ func (r *ApplicationResource) saveSourceProvider(appID string, plan *Application
 			CustomGitBuildPath: plan.CustomGitBuildPath.ValueString(),
 			CustomGitSSHKeyId:  plan.CustomGitSSHKeyID.ValueString(),
 			EnableSubmodules:   plan.EnableSubmodules.ValueBool(),
+			WatchPaths:         watchPaths,
 		}
 		return r.client.SaveGitProvider(input)
 
@@ -1234,26 +2140,47 @@ func (r *ApplicationResource) saveSourceProvider(appID string, plan *Application
 			RegistryId:    plan.RegistryId.ValueString(),
 		}
 		return r.client.SaveDockerProvider(input)
+
+	case "drop":
+		input := client.SaveDropProviderInput{
+			ApplicationID: appID,
+			Dockerfile:    plan.Dockerfile.ValueString(),
+			DropBuildPath: plan.DropBuildPath.ValueString(),
+		}
+		return r.client.SaveDropProvider(input)
 	}
 
 	return nil
 }
 
-func (r *ApplicationResource) saveEnvironment(appID string, plan *ApplicationResourceModel) error {
+// saveEnvironment saves env, build arguments and build secrets.
+// buildSecrets is the write-only build_secrets_map from the configuration.
+func (r *ApplicationResource) saveEnvironment(ctx context.Context, appID string, plan *ApplicationResourceModel, buildSecrets types.Map, diags *diag.Diagnostics) error {
 	// Only save if at least one env field is set or create_env_file is explicitly configured
 	if (plan.Env.IsNull() || plan.Env.IsUnknown()) &&
 		(plan.BuildArgs.IsNull() || plan.BuildArgs.IsUnknown()) &&
+		(plan.BuildArgsMap.IsNull() || plan.BuildArgsMap.IsUnknown()) &&
 		(plan.BuildSecrets.IsNull() || plan.BuildSecrets.IsUnknown()) &&
+		(buildSecrets.IsNull() || buildSecrets.IsUnknown()) &&
 		(plan.CreateEnvFile.IsNull() || plan.CreateEnvFile.IsUnknown()) {
 		return nil
 	}
 
+	buildArgsValue := plan.BuildArgs.ValueString()
+	if !plan.BuildArgsMap.IsNull() && !plan.BuildArgsMap.IsUnknown() {
+		buildArgsValue = envFromMap(ctx, plan.BuildArgsMap, diags)
+	}
+	buildSecretsValue := plan.BuildSecrets.ValueString()
+	if !buildSecrets.IsNull() && !buildSecrets.IsUnknown() {
+		buildSecretsValue = envFromMap(ctx, buildSecrets, diags)
+	}
+
 	createEnvFile := plan.CreateEnvFile.ValueBool()
 	input := client.SaveEnvironmentInput{
 		ApplicationID: appID,
 		Env:           plan.Env.ValueString(),
-		BuildArgs:     plan.BuildArgs.ValueString(),
-		BuildSecrets:  plan.BuildSecrets.ValueString(),
+		BuildArgs:     buildArgsValue,
+		BuildSecrets:  buildSecretsValue,
 		CreateEnvFile: &createEnvFile,
 	}
 	return r.client.SaveEnvironment(input)
@@ -1471,18 +2398,12 @@ func updatePlanFromApplication(plan *ApplicationResourceModel, app *client.Appli
 		}
 	}
 
-	// Parse WatchPaths from JSON string to types.List
-	if app.WatchPaths != "" {
-		var watchPaths []string
-		if err := json.Unmarshal([]byte(app.WatchPaths), &watchPaths); err == nil {
-			if listVal, diag := types.ListValueFrom(context.Background(), types.StringType, watchPaths); !diag.HasError() {
-				plan.WatchPaths = listVal
-			}
-		}
-	}
+	plan.WatchPaths = watchPathsValue(plan.WatchPaths, app.WatchPaths)
 
 	// Application status (computed)
 	plan.ApplicationStatus = types.StringValue(app.ApplicationStatus)
+	plan.CreatedAt = optionalString(app.CreatedAt)
+	plan.RefreshToken = refreshTokenValue(app.RefreshToken)
 
 	// Docker Swarm fields - convert maps to JSON strings
 	if app.HealthCheckSwarm != nil {
@@ -1515,18 +2436,25 @@ func updatePlanFromApplication(plan *ApplicationResourceModel, app *client.Appli
 			plan.ModeSwarm = types.StringValue(string(jsonBytes))
 		}
 	}
-	if app.LabelsSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.LabelsSwarm); err == nil {
+	labels, tags := splitApplicationTags(app.LabelsSwarm)
+	if labels != nil && (len(labels) > 0 || !plan.LabelsSwarm.IsNull()) {
+		if jsonBytes, err := json.Marshal(labels); err == nil {
 			plan.LabelsSwarm = types.StringValue(string(jsonBytes))
 		}
 	}
+	if len(tags) > 0 || !plan.Tags.IsNull() {
+		plan.Tags = types.MapValueMust(types.StringType, tags)
+	}
 	if app.NetworkSwarm != nil {
 		if jsonBytes, err := json.Marshal(app.NetworkSwarm); err == nil {
 			plan.NetworkSwarm = types.StringValue(string(jsonBytes))
 		}
 	}
 	if app.StopGracePeriodSwarm != nil {
-		plan.StopGracePeriodSwarm = types.Int64Value(*app.StopGracePeriodSwarm)
+		plan.StopGracePeriodSwarm = unitInt64Value(unitDuration, *app.StopGracePeriodSwarm)
+	}
+	if len(app.UlimitsSwarm) > 0 || plan.Ulimits != nil {
+		plan.Ulimits = ulimitsFromAPI(app.UlimitsSwarm)
 	}
 	if app.EndpointSpecSwarm != nil {
 		if jsonBytes, err := json.Marshal(app.EndpointSpecSwarm); err == nil {
@@ -1572,15 +2500,7 @@ func readApplicationIntoState(state *ApplicationResourceModel, app *client.Appli
 	}
 	state.EnableSubmodules = types.BoolValue(app.EnableSubmodules)
 	state.CleanCache = types.BoolValue(app.CleanCache)
-	// Parse WatchPaths from JSON string to types.List
-	if app.WatchPaths != "" {
-		var watchPaths []string
-		if err := json.Unmarshal([]byte(app.WatchPaths), &watchPaths); err == nil {
-			if listVal, diags := types.ListValueFrom(context.Background(), types.StringType, watchPaths); !diags.HasError() {
-				state.WatchPaths = listVal
-			}
-		}
-	}
+	state.WatchPaths = watchPathsValue(state.WatchPaths, app.WatchPaths)
 
 	// GitHub provider fields - populate both legacy and new field names
 	if app.Repository != "" {
@@ -1699,11 +2619,15 @@ func readApplicationIntoState(state *ApplicationResourceModel, app *client.Appli
 	if app.DockerContextPath != "" {
 		state.DockerContextPath = types.StringValue(app.DockerContextPath)
 	}
-	if app.DockerBuildStage != "" {
-		state.DockerBuildStage = types.StringValue(app.DockerBuildStage)
-	}
-	if app.PublishDirectory != "" {
-		state.PublishDirectory = types.StringValue(app.PublishDirectory)
+	if state.Build != nil {
+		readApplicationBuild(state.Build, app)
+	} else {
+		if app.DockerBuildStage != "" {
+			state.DockerBuildStage = types.StringValue(app.DockerBuildStage)
+		}
+		if app.PublishDirectory != "" {
+			state.PublishDirectory = types.StringValue(app.PublishDirectory)
+		}
 	}
 	// Always set computed fields from API
 	state.HerokuVersion = types.StringValue(app.HerokuVersion)
@@ -1721,6 +2645,9 @@ func readApplicationIntoState(state *ApplicationResourceModel, app *client.Appli
 			state.BuildArgs = types.StringValue(app.BuildArgs)
 		}
 	}
+	if !state.BuildArgsMap.IsNull() {
+		state.BuildArgsMap = stringMapValue(client.ParseEnv(app.BuildArgs))
+	}
 	state.CreateEnvFile = types.BoolValue(app.CreateEnvFile)
 
 	// Runtime configuration
@@ -1730,26 +2657,37 @@ func readApplicationIntoState(state *ApplicationResourceModel, app *client.Appli
 	}
 	if app.MemoryLimit != "" {
 		if val, err := app.MemoryLimit.Int64(); err == nil {
-			state.MemoryLimit = types.Int64Value(val)
+			state.MemoryLimit = unitInt64Value(unitMemory, val)
 		}
 	}
 	if app.MemoryReservation != "" {
 		if val, err := app.MemoryReservation.Int64(); err == nil {
-			state.MemoryReservation = types.Int64Value(val)
+			state.MemoryReservation = unitInt64Value(unitMemory, val)
 		}
 	}
 	if app.CpuLimit != "" {
 		if val, err := app.CpuLimit.Int64(); err == nil {
-			state.CpuLimit = types.Int64Value(val)
+			state.CpuLimit = unitInt64Value(unitCPU, val)
 		}
 	}
 	if app.CpuReservation != "" {
 		if val, err := app.CpuReservation.Int64(); err == nil {
-			state.CpuReservation = types.Int64Value(val)
+			state.CpuReservation = unitInt64Value(unitCPU, val)
 		}
 	}
 	if app.Command != "" {
-		state.Command = types.StringValue(app.Command)
+		if list, ok := execFormValue(state.CommandList, app.Command); ok {
+			state.CommandList = list
+		} else {
+			state.Command = types.StringValue(app.Command)
+		}
+	}
+	if app.EntryPoint != "" {
+		if list, ok := execFormValue(state.EntrypointList, app.EntryPoint); ok {
+			state.EntrypointList = list
+		} else {
+			state.Entrypoint = types.StringValue(app.EntryPoint)
+		}
 	}
 	if app.Args != "" {
 		state.Args = types.StringValue(app.Args)
@@ -1823,6 +2761,8 @@ func readApplicationIntoState(state *ApplicationResourceModel, app *client.Appli
 
 	// Application status (computed)
 	state.ApplicationStatus = types.StringValue(app.ApplicationStatus)
+	state.CreatedAt = optionalString(app.CreatedAt)
+	state.RefreshToken = refreshTokenValue(app.RefreshToken)
 
 	// Docker Swarm fields - convert maps to JSON strings
 	if app.HealthCheckSwarm != nil {
@@ -1855,18 +2795,25 @@ func readApplicationIntoState(state *ApplicationResourceModel, app *client.Appli
 			state.ModeSwarm = types.StringValue(string(jsonBytes))
 		}
 	}
-	if app.LabelsSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.LabelsSwarm); err == nil {
+	labels, tags := splitApplicationTags(app.LabelsSwarm)
+	if labels != nil && (len(labels) > 0 || !state.LabelsSwarm.IsNull()) {
+		if jsonBytes, err := json.Marshal(labels); err == nil {
 			state.LabelsSwarm = types.StringValue(string(jsonBytes))
 		}
 	}
+	if len(tags) > 0 || !state.Tags.IsNull() {
+		state.Tags = types.MapValueMust(types.StringType, tags)
+	}
 	if app.NetworkSwarm != nil {
 		if jsonBytes, err := json.Marshal(app.NetworkSwarm); err == nil {
 			state.NetworkSwarm = types.StringValue(string(jsonBytes))
 		}
 	}
 	if app.StopGracePeriodSwarm != nil {
-		state.StopGracePeriodSwarm = types.Int64Value(*app.StopGracePeriodSwarm)
+		state.StopGracePeriodSwarm = unitInt64Value(unitDuration, *app.StopGracePeriodSwarm)
+	}
+	if len(app.UlimitsSwarm) > 0 || state.Ulimits != nil {
+		state.Ulimits = ulimitsFromAPI(app.UlimitsSwarm)
 	}
 	if app.EndpointSpecSwarm != nil {
 		if jsonBytes, err := json.Marshal(app.EndpointSpecSwarm); err == nil {
@@ -1874,3 +2821,142 @@ func readApplicationIntoState(state *ApplicationResourceModel, app *client.Appli
 		}
 	}
 }
+
+// clearedApplicationFields returns the optional settings that are set in
+// state but removed from the plan, and the settings of the previous source
+// provider when source_type changes.
+func clearedApplicationFields(plan, state *ApplicationResourceModel) []client.ApplicationField {
+	fields := clearedSettings(
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldDescription, plan.Description, state.Description},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldCommand, commandValue(plan.Command, plan.CommandList), commandValue(state.Command, state.CommandList)},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldEntrypoint, commandValue(plan.Entrypoint, plan.EntrypointList), commandValue(state.Entrypoint, state.EntrypointList)},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldArgs, plan.Args, state.Args},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldTitle, plan.Title, state.Title},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldSubtitle, plan.Subtitle, state.Subtitle},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldMemoryLimit, plan.MemoryLimit.StringValue, state.MemoryLimit.StringValue},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldMemoryReservation, plan.MemoryReservation.StringValue, state.MemoryReservation.StringValue},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldCPULimit, plan.CpuLimit.StringValue, state.CpuLimit.StringValue},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldCPUReservation, plan.CpuReservation.StringValue, state.CpuReservation.StringValue},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldPreviewEnv, plan.PreviewEnv, state.PreviewEnv},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldPreviewBuildArgs, plan.PreviewBuildArgs, state.PreviewBuildArgs},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldPreviewWildcard, plan.PreviewWildcard, state.PreviewWildcard},
+		clearedSetting[client.ApplicationField]{client.ApplicationFieldPreviewPath, plan.PreviewPath, state.PreviewPath},
+	)
+	return append(fields, staleSourceFields(plan.SourceType, state.SourceType)...)
+}
+
+// staleSourceFields returns the settings of the previous source provider
+// when source_type changes, so Dokploy does not keep e.g. a GitHub
+// repository on an application that now deploys a docker image.
+func staleSourceFields(plan, state types.String) []client.ApplicationField {
+	if plan.IsUnknown() || plan.IsNull() || state.IsNull() || plan.Equal(state) {
+		return nil
+	}
+	return client.SourceProviderFields[state.ValueString()]
+}
+
+// commandValue returns the command or entrypoint that is sent to Dokploy,
+// preferring the exec form list over the string.
+func commandValue(command types.String, list types.List) types.String {
+	if list.IsUnknown() {
+		return types.StringUnknown()
+	}
+	if encoded, ok := execFormFromPlan(list); ok {
+		return types.StringValue(encoded)
+	}
+	return command
+}
+
+// execFormFromPlan JSON-encodes a command_list or entrypoint_list value the
+// way Dokploy stores exec-form commands. It reports false when the list is
+// not set.
+func execFormFromPlan(list types.List) (string, bool) {
+	if list.IsNull() || list.IsUnknown() {
+		return "", false
+	}
+	args := make([]string, 0, len(list.Elements()))
+	for _, elem := range list.Elements() {
+		if s, ok := elem.(types.String); ok {
+			args = append(args, s.ValueString())
+		}
+	}
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
+
+// execFormValue decodes an exec-form command read from Dokploy into the list
+// attribute. It only applies when the list form is in use, so values managed
+// through the string attribute are left as they are.
+func execFormValue(prior types.List, value string) (types.List, bool) {
+	if prior.IsNull() {
+		return prior, false
+	}
+	var args []string
+	if err := json.Unmarshal([]byte(value), &args); err != nil {
+		return prior, false
+	}
+	elems := make([]attr.Value, 0, len(args))
+	for _, arg := range args {
+		elems = append(elems, types.StringValue(arg))
+	}
+	return types.ListValueMust(types.StringType, elems), true
+}
+
+// refreshTokenValue returns the webhook token, or null when the API omits it.
+func refreshTokenValue(token string) types.String {
+	if token == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(token)
+}
+
+// ulimitsFromAPI converts swarm ulimits to their model form.
+func ulimitsFromAPI(ulimits []client.Ulimit) []applicationUlimitModel {
+	result := make([]applicationUlimitModel, 0, len(ulimits))
+	for _, u := range ulimits {
+		result = append(result, applicationUlimitModel{
+			Name: types.StringValue(u.Name),
+			Soft: types.Int64Value(u.Soft),
+			Hard: types.Int64Value(u.Hard),
+		})
+	}
+	return result
+}
+
+// applicationTagLabelPrefix namespaces tags within the swarm service labels.
+const applicationTagLabelPrefix = "dokploy.tag."
+
+// withApplicationTags returns labelsSwarm with its tag labels replaced by
+// tags. Labels without the tag prefix are kept.
+func withApplicationTags(labelsSwarm map[string]interface{}, tags types.Map) map[string]interface{} {
+	labels, _ := splitApplicationTags(labelsSwarm)
+	if labels == nil {
+		labels = map[string]interface{}{}
+	}
+	for k, v := range tags.Elements() {
+		if str, ok := v.(types.String); ok {
+			labels[applicationTagLabelPrefix+k] = str.ValueString()
+		}
+	}
+	return labels
+}
+
+// splitApplicationTags separates tag labels from the remaining swarm labels.
+func splitApplicationTags(labelsSwarm map[string]interface{}) (map[string]interface{}, map[string]attr.Value) {
+	tags := map[string]attr.Value{}
+	if labelsSwarm == nil {
+		return nil, tags
+	}
+	labels := map[string]interface{}{}
+	for k, v := range labelsSwarm {
+		if name, ok := strings.CutPrefix(k, applicationTagLabelPrefix); ok {
+			tags[name] = types.StringValue(fmt.Sprint(v))
+			continue
+		}
+		labels[k] = v
+	}
+	return labels, tags
+}
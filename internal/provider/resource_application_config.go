@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ApplicationConfigResource{}
+var _ resource.ResourceWithImportState = &ApplicationConfigResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationConfigResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationConfigResource{}
+
+func NewApplicationConfigResource() resource.Resource {
+	return &ApplicationConfigResource{}
+}
+
+// ApplicationConfigResource overrides part of the settings of an application
+// managed elsewhere, e.g. per-environment variables, replicas and domains on
+// top of an application defined once in a shared module. It never creates or
+// deletes the application itself.
+type ApplicationConfigResource struct {
+	client client.Client
+}
+
+type ApplicationConfigResourceModel struct {
+	ID               types.String             `tfsdk:"id"`
+	ApplicationID    types.String             `tfsdk:"application_id"`
+	Env              types.Map                `tfsdk:"env"`
+	Replicas         types.Int64              `tfsdk:"replicas"`
+	Domains          []applicationDomainModel `tfsdk:"domains"`
+	RestoreOnDestroy types.Bool               `tfsdk:"restore_on_destroy"`
+	OriginalEnv      types.Map                `tfsdk:"original_env"`
+	OriginalReplicas types.Int64              `tfsdk:"original_replicas"`
+}
+
+func (r *ApplicationConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_config"
+}
+
+func (r *ApplicationConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	domains := domainsAttribute(false)
+	domains.Description = "Domains added to the application. Only the domains listed here are managed: other domains of the application are left alone, and the listed ones are removed when they are dropped from the set or the resource is destroyed. A listed domain that already exists on the application is taken over."
+
+	resp.Schema = schema.Schema{
+		Description: "Overrides environment variables, replicas and domains of an existing application without managing its lifecycle, so one application definition can be specialised per environment. The attributes set here win over the application's own values: leave them unset on the dokploy_application, or list them in its lifecycle ignore_changes, otherwise the two resources overwrite each other on every apply. Use at most one dokploy_application_config per application.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Same as application_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The application to override settings of. Changing it replaces the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"env": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Environment variables set on the application. Each key replaces the application's value for that key; keys not listed keep the application's value. Removing a key restores the value it replaced.",
+			},
+			"replicas": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of replicas to run, replacing the application's setting. Removing it restores the replicas the application had before.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"domains": domains,
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether destroying the resource restores the environment variables and replicas it replaced and removes its domains. Set to false to leave the application as configured. Defaults to true.",
+			},
+			"original_env": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Values the application had for the keys of env before they were overridden. Keys that did not exist are absent and are deleted on restore.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"original_replicas": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Replicas the application had before replicas was overridden.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *ApplicationConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplicationConfigResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, d := range config.Domains {
+		validateDomainCertificateType(d.Host, d.HTTPS, d.CertificateType, &resp.Diagnostics)
+	}
+}
+
+// ModifyPlan marks the recorded originals unknown when the set of overridden
+// settings changes, since applying records or restores them.
+func (r *ApplicationConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ApplicationConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Env.IsUnknown() || plan.Env.IsNull() != state.Env.IsNull() || !sameKeys(plan.Env, state.Env) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("original_env"), types.MapUnknown(types.StringType))...)
+	}
+	if plan.Replicas.IsUnknown() || plan.Replicas.IsNull() != state.Replicas.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("original_replicas"), types.Int64Unknown())...)
+	}
+}
+
+// sameKeys reports whether two string maps hold the same keys.
+func sameKeys(a, b types.Map) bool {
+	ak, bk := a.Elements(), b.Elements()
+	if len(ak) != len(bk) {
+		return false
+	}
+	for k := range ak {
+		if _, ok := bk[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *ApplicationConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ApplicationConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior := ApplicationConfigResourceModel{
+		Env:              types.MapNull(types.StringType),
+		Replicas:         types.Int64Null(),
+		OriginalEnv:      types.MapNull(types.StringType),
+		OriginalReplicas: types.Int64Null(),
+	}
+	applyApplicationConfig(ctx, r.client, &plan, &prior, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ApplicationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ApplicationConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ApplicationConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.client.GetApplication(state.ApplicationID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading application", err.Error())
+		return
+	}
+
+	// Only the overridden keys and domains are read back, so changes to the
+	// rest of the application do not show up as drift here.
+	if !state.Env.IsNull() {
+		var overrides map[string]string
+		resp.Diagnostics.Append(state.Env.ElementsAs(ctx, &overrides, false)...)
+		current := client.ParseEnv(app.Env)
+		env := make(map[string]string, len(overrides))
+		for k := range overrides {
+			if v, ok := current[k]; ok {
+				env[k] = v
+			}
+		}
+		var diags diag.Diagnostics
+		state.Env, diags = types.MapValueFrom(ctx, types.StringType, env)
+		resp.Diagnostics.Append(diags...)
+	}
+	if !state.Replicas.IsNull() {
+		state.Replicas = types.Int64Value(int64(app.Replicas))
+	}
+	if state.Domains != nil {
+		state.Domains = applicationDomainsFromAPI(state.Domains, ownedDomains(app.Domains, state.Domains, nil))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ApplicationConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ApplicationConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyApplicationConfig(ctx, r.client, &plan, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ApplicationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ApplicationConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ApplicationConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !state.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	// Restoring is applying a configuration that overrides nothing.
+	empty := ApplicationConfigResourceModel{
+		ApplicationID: state.ApplicationID,
+		Env:           types.MapNull(types.StringType),
+		Replicas:      types.Int64Null(),
+	}
+	var diags diag.Diagnostics
+	applyApplicationConfig(ctx, r.client, &empty, &state, &diags)
+	for _, d := range diags.Errors() {
+		if strings.Contains(d.Detail(), "Not Found") || strings.Contains(d.Detail(), "404") {
+			return
+		}
+	}
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ApplicationConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: application_id. Nothing is overridden yet after import,
+	// so the originals are recorded when attributes are first set.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
+}
+
+// applyApplicationConfig moves the application from the overrides in prior to
+// those in plan. Newly overridden settings have their current value recorded
+// in the originals, and settings no longer overridden get it back. The
+// originals and the read-back domains are stored in plan.
+func applyApplicationConfig(ctx context.Context, c client.Client, plan, prior *ApplicationConfigResourceModel, diags *diag.Diagnostics) {
+	appID := plan.ApplicationID.ValueString()
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Error reading application", err.Error())
+		return
+	}
+
+	desired, previous, originals := map[string]string{}, map[string]string{}, map[string]string{}
+	for _, m := range []struct {
+		value  types.Map
+		target *map[string]string
+	}{{plan.Env, &desired}, {prior.Env, &previous}, {prior.OriginalEnv, &originals}} {
+		if !m.value.IsNull() && !m.value.IsUnknown() {
+			diags.Append(m.value.ElementsAs(ctx, m.target, false)...)
+		}
+	}
+	if diags.HasError() {
+		return
+	}
+
+	current := client.ParseEnv(app.Env)
+	for k := range desired {
+		if _, overridden := previous[k]; overridden {
+			continue
+		}
+		if v, ok := current[k]; ok {
+			originals[k] = v
+		}
+	}
+	err = c.UpdateApplicationEnv(appID, func(env map[string]string) {
+		for k := range previous {
+			if _, ok := desired[k]; ok {
+				continue
+			}
+			if v, ok := originals[k]; ok {
+				env[k] = v
+			} else {
+				delete(env, k)
+			}
+			delete(originals, k)
+		}
+		for k, v := range desired {
+			env[k] = v
+		}
+	}, nil)
+	if err != nil {
+		diags.AddError("Error updating application environment", err.Error())
+		return
+	}
+	if len(desired) == 0 && len(originals) == 0 {
+		plan.OriginalEnv = types.MapNull(types.StringType)
+	} else {
+		var d diag.Diagnostics
+		plan.OriginalEnv, d = types.MapValueFrom(ctx, types.StringType, originals)
+		diags.Append(d...)
+	}
+
+	replicas, originalReplicas := int64(0), prior.OriginalReplicas
+	switch {
+	case !plan.Replicas.IsNull():
+		if prior.Replicas.IsNull() {
+			originalReplicas = types.Int64Value(int64(app.Replicas))
+		}
+		replicas = plan.Replicas.ValueInt64()
+	case !prior.Replicas.IsNull():
+		if !originalReplicas.IsNull() && originalReplicas.ValueInt64() > 0 {
+			replicas = originalReplicas.ValueInt64()
+		}
+		originalReplicas = types.Int64Null()
+	}
+	plan.OriginalReplicas = originalReplicas
+	if replicas > 0 && replicas != int64(app.Replicas) {
+		// application.update always sends autoDeploy, so keep the current one.
+		if _, err := c.UpdateApplication(client.Application{ID: appID, Replicas: int(replicas), AutoDeploy: app.AutoDeploy}); err != nil {
+			diags.AddError("Error updating application replicas", err.Error())
+			return
+		}
+	}
+
+	if plan.Domains == nil && prior.Domains == nil {
+		return
+	}
+	if err := reconcileDomains(c, ownedDomains(app.Domains, prior.Domains, plan.Domains), applicationDomainsFromModel(appID, plan.Domains)); err != nil {
+		diags.AddError("Error updating application domains", err.Error())
+		return
+	}
+	if plan.Domains == nil {
+		return
+	}
+	domains, err := c.GetDomainsByApplication(appID)
+	if err != nil {
+		diags.AddError("Error reading application domains", err.Error())
+		return
+	}
+	plan.Domains = applicationDomainsFromAPI(plan.Domains, ownedDomains(domains, plan.Domains, nil))
+}
+
+// ownedDomains returns the domains of the application that appear in one of
+// the given sets, matched on host and path like reconcileDomains.
+func ownedDomains(domains []client.Domain, sets ...[]applicationDomainModel) []client.Domain {
+	owned := make(map[string]bool)
+	for _, set := range sets {
+		for _, d := range set {
+			owned[domainKey("", d.Host.ValueString(), d.Path.ValueString())] = true
+		}
+	}
+
+	var result []client.Domain
+	for _, d := range domains {
+		if owned[domainKey("", d.Host, d.Path)] {
+			result = append(result, d)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return domainKey("", result[i].Host, result[i].Path) < domainKey("", result[j].Host, result[j].Path)
+	})
+	return result
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestApplyApplicationConfig(t *testing.T) {
+	ctx := context.Background()
+	app := &client.Application{
+		ID:         "app-1",
+		Env:        "# shared\nLOG_LEVEL=info\nPORT=3000",
+		Replicas:   1,
+		AutoDeploy: true,
+		Domains:    []client.Domain{{ID: "d-1", Host: "app.example.com", Path: "/", Port: 3000}},
+	}
+
+	mock := clientmock.New()
+	mock.GetApplicationFunc = func(id string) (*client.Application, error) {
+		copied := *app
+		return &copied, nil
+	}
+	mock.UpdateApplicationEnvFunc = func(appID string, updateFn func(map[string]string), createEnvFile *bool) error {
+		f, _ := client.ParseEnvFile(app.Env)
+		env := f.Map()
+		updateFn(env)
+		f.Apply(env)
+		app.Env = f.String()
+		return nil
+	}
+	mock.UpdateApplicationFunc = func(a client.Application) (*client.Application, error) {
+		if !a.AutoDeploy {
+			t.Error("autoDeploy was reset")
+		}
+		app.Replicas = a.Replicas
+		return app, nil
+	}
+	mock.CreateDomainFunc = func(d client.Domain) (*client.Domain, error) {
+		d.ID = "d-2"
+		app.Domains = append(app.Domains, d)
+		return &d, nil
+	}
+	mock.DeleteDomainFunc = func(id string) error {
+		var kept []client.Domain
+		for _, d := range app.Domains {
+			if d.ID != id {
+				kept = append(kept, d)
+			}
+		}
+		app.Domains = kept
+		return nil
+	}
+	mock.GetDomainsByApplicationFunc = func(appID string) ([]client.Domain, error) {
+		return app.Domains, nil
+	}
+
+	var diags diag.Diagnostics
+	plan := ApplicationConfigResourceModel{
+		ApplicationID: types.StringValue("app-1"),
+		Env:           mustStringMap(map[string]string{"LOG_LEVEL": "debug", "FEATURE_X": "on"}),
+		Replicas:      types.Int64Value(3),
+		Domains: []applicationDomainModel{{
+			Host:            types.StringValue("staging.example.com"),
+			Path:            types.StringValue("/"),
+			Port:            types.Int64Value(3000),
+			HTTPS:           types.BoolValue(false),
+			CertificateType: types.StringNull(),
+		}},
+	}
+	prior := ApplicationConfigResourceModel{
+		Env:              types.MapNull(types.StringType),
+		Replicas:         types.Int64Null(),
+		OriginalEnv:      types.MapNull(types.StringType),
+		OriginalReplicas: types.Int64Null(),
+	}
+	applyApplicationConfig(ctx, mock, &plan, &prior, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if want := "# shared\nLOG_LEVEL=debug\nPORT=3000\nFEATURE_X=on"; app.Env != want {
+		t.Errorf("env = %q, want %q", app.Env, want)
+	}
+	if !plan.OriginalEnv.Equal(mustStringMap(map[string]string{"LOG_LEVEL": "info"})) {
+		t.Errorf("original_env = %v, want only the replaced LOG_LEVEL", plan.OriginalEnv)
+	}
+	if app.Replicas != 3 || plan.OriginalReplicas.ValueInt64() != 1 {
+		t.Errorf("replicas = %d, original_replicas = %v", app.Replicas, plan.OriginalReplicas)
+	}
+	if len(app.Domains) != 2 || len(plan.Domains) != 1 || plan.Domains[0].Host.ValueString() != "staging.example.com" {
+		t.Errorf("domains = %+v, state = %+v; want the application's domain kept and only the added one in state", app.Domains, plan.Domains)
+	}
+
+	// Overriding nothing, as on destroy, restores the application.
+	restored := ApplicationConfigResourceModel{
+		ApplicationID: types.StringValue("app-1"),
+		Env:           types.MapNull(types.StringType),
+		Replicas:      types.Int64Null(),
+	}
+	applyApplicationConfig(ctx, mock, &restored, &plan, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if want := "# shared\nLOG_LEVEL=info\nPORT=3000"; app.Env != want {
+		t.Errorf("restored env = %q, want %q", app.Env, want)
+	}
+	if app.Replicas != 1 {
+		t.Errorf("restored replicas = %d, want 1", app.Replicas)
+	}
+	if len(app.Domains) != 1 || app.Domains[0].ID != "d-1" {
+		t.Errorf("restored domains = %+v, want only the application's own", app.Domains)
+	}
+	if !restored.OriginalEnv.IsNull() || !restored.OriginalReplicas.IsNull() {
+		t.Errorf("originals = %v, %v, want null", restored.OriginalEnv, restored.OriginalReplicas)
+	}
+}
+
+func mustStringMap(m map[string]string) types.Map {
+	v, diags := types.MapValueFrom(context.Background(), types.StringType, m)
+	if diags.HasError() {
+		panic(diags)
+	}
+	return v
+}
+
+func TestApplicationBuildArgsWarning(t *testing.T) {
+	ctx := context.Background()
+	r := &ApplicationResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		buildType   string
+		wantWarning bool
+	}{
+		{buildType: "dockerfile"},
+		{buildType: "nixpacks", wantWarning: true},
+		{buildType: "railpack", wantWarning: true},
+	}
+	for _, tt := range tests {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		plan.SetAttribute(ctx, path.Root("name"), "web")
+		plan.SetAttribute(ctx, path.Root("build_type"), tt.buildType)
+		plan.SetAttribute(ctx, path.Root("build_args"), "NODE_VERSION=20")
+
+		resp := &fwresource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected error: %v", tt.buildType, resp.Diagnostics)
+		}
+		if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+			t.Errorf("%s: warnings %v, want warning %v", tt.buildType, resp.Diagnostics.Warnings(), tt.wantWarning)
+		}
+	}
+}
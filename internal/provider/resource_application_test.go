@@ -1,10 +1,23 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -22,7 +35,7 @@ func TestAccApplicationResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccApplicationResourceConfig("test-app-project", "test-app-env", "test-app", "nginx:latest", "Test App", 1),
+				Config: testAccApplicationResourceConfig("tf-acc-app-project", "test-app-env", "test-app", "nginx:latest", "Test App", 1),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-app"),
 					resource.TestCheckResourceAttr("dokploy_application.test", "source_type", "docker"),
@@ -35,7 +48,7 @@ func TestAccApplicationResource(t *testing.T) {
 			},
 			// Update and Read testing - change name, docker_image, title, and replicas
 			{
-				Config: testAccApplicationResourceConfig("test-app-project", "test-app-env", "test-app-updated", "nginx:alpine", "Updated App", 2),
+				Config: testAccApplicationResourceConfig("tf-acc-app-project", "test-app-env", "test-app-updated", "nginx:alpine", "Updated App", 2),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-app-updated"),
 					resource.TestCheckResourceAttr("dokploy_application.test", "source_type", "docker"),
@@ -56,6 +69,19 @@ func TestAccApplicationResource(t *testing.T) {
 					"title",            // Not returned by API on import
 				},
 			},
+			// ImportState testing by project/environment/app path
+			{
+				ResourceName:      "dokploy_application.test",
+				ImportState:       true,
+				ImportStateId:     "test-app-project/test-app-env/test-app-updated",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"branch", "owner", "repository", "github_id",
+					"dockerfile_path", "docker_context_path", "docker_build_stage",
+					"deploy_on_create", // Not returned by API
+					"title",            // Not returned by API on import
+				},
+			},
 		},
 	})
 }
@@ -74,7 +100,7 @@ func TestAccApplicationResourceWithGit(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing with Git
 			{
-				Config: testAccApplicationResourceWithGitConfig("test-app-git-project", "test-app-git-env", "test-git-app", "main"),
+				Config: testAccApplicationResourceWithGitConfig("tf-acc-app-git-project", "test-app-git-env", "test-git-app", "main"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-git-app"),
 					resource.TestCheckResourceAttr("dokploy_application.test", "custom_git_url", "https://github.com/dokploy/dokploy"),
@@ -84,7 +110,7 @@ func TestAccApplicationResourceWithGit(t *testing.T) {
 			},
 			// Update testing - change name and branch
 			{
-				Config: testAccApplicationResourceWithGitConfig("test-app-git-project", "test-app-git-env", "test-git-app-updated", "canary"),
+				Config: testAccApplicationResourceWithGitConfig("tf-acc-app-git-project", "test-app-git-env", "test-git-app-updated", "canary"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-git-app-updated"),
 					resource.TestCheckResourceAttr("dokploy_application.test", "custom_git_branch", "canary"),
@@ -176,7 +202,7 @@ func TestAccApplicationResourceInferDockerType(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create without explicit source_type - should infer "docker" from docker_image
 			{
-				Config: testAccApplicationResourceInferDockerConfig("test-infer-docker-project", "test-infer-docker-env", "test-infer-docker-app"),
+				Config: testAccApplicationResourceInferDockerConfig("tf-acc-infer-docker-project", "test-infer-docker-env", "test-infer-docker-app"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-infer-docker-app"),
 					resource.TestCheckResourceAttr("dokploy_application.test", "source_type", "docker"),
@@ -228,7 +254,7 @@ func TestAccApplicationResourceInferGitType(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create without explicit source_type - should infer "git" from custom_git_url
 			{
-				Config: testAccApplicationResourceInferGitConfig("test-infer-git-project", "test-infer-git-env", "test-infer-git-app"),
+				Config: testAccApplicationResourceInferGitConfig("tf-acc-infer-git-project", "test-infer-git-env", "test-infer-git-app"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-infer-git-app"),
 					resource.TestCheckResourceAttr("dokploy_application.test", "source_type", "git"),
@@ -282,7 +308,7 @@ func TestAccApplicationResourceExtendedSettings(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with extended settings
 			{
-				Config: testAccApplicationResourceExtendedConfig("test-extended-project", "test-extended-env", "test-extended-app", "Initial description", 1, 256, 128),
+				Config: testAccApplicationResourceExtendedConfig("tf-acc-extended-project", "test-extended-env", "test-extended-app", "Initial description", 1, 256, 128),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-extended-app"),
 					resource.TestCheckResourceAttr("dokploy_application.test", "description", "Initial description"),
@@ -294,7 +320,7 @@ func TestAccApplicationResourceExtendedSettings(t *testing.T) {
 			},
 			// Update extended settings
 			{
-				Config: testAccApplicationResourceExtendedConfig("test-extended-project", "test-extended-env", "test-extended-app", "Updated description", 2, 512, 256),
+				Config: testAccApplicationResourceExtendedConfig("tf-acc-extended-project", "test-extended-env", "test-extended-app", "Updated description", 2, 512, 256),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "description", "Updated description"),
 					resource.TestCheckResourceAttr("dokploy_application.test", "replicas", "2"),
@@ -338,6 +364,67 @@ resource "dokploy_application" "test" {
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, description, replicas, memLimit, memReserve)
 }
 
+// TestAccApplicationResourceHumanUnits tests resource limits written with
+// units, and that the canonical values read back do not cause a diff.
+func TestAccApplicationResourceHumanUnits(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceUnitsConfig(`"512Mi"`, `"0.5"`, `"30s"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "memory_limit", "512Mi"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "cpu_limit", "0.5"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "stop_grace_period_swarm", "30s"),
+				),
+			},
+			// The same limits in API units plan no changes.
+			{
+				Config:   testAccApplicationResourceUnitsConfig("536870912", "500000000", "30000000000"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceUnitsConfig(memLimit, cpuLimit, stopGracePeriod string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "tf-acc-units-project"
+  description = "Test project for resource unit tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-units-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id          = dokploy_environment.test.id
+  name                    = "test-units-app"
+  source_type             = "docker"
+  docker_image            = "nginx:latest"
+  memory_limit            = %s
+  cpu_limit               = %s
+  stop_grace_period_swarm = %s
+  auto_deploy             = false
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), memLimit, cpuLimit, stopGracePeriod)
+}
+
 // TestAccApplicationResourceTraefikConfig tests the traefik_config attribute.
 func TestAccApplicationResourceTraefikConfig(t *testing.T) {
 	host := os.Getenv("DOKPLOY_HOST")
@@ -353,7 +440,7 @@ func TestAccApplicationResourceTraefikConfig(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with traefik_config
 			{
-				Config: testAccApplicationResourceTraefikConfig("test-traefik-project", "test-traefik-env", "test-traefik-app", "# Custom Traefik config\nhttp:\n  routers:\n    test:\n      rule: Host(`test.example.com`)"),
+				Config: testAccApplicationResourceTraefikConfig("tf-acc-traefik-project", "test-traefik-env", "test-traefik-app", "# Custom Traefik config\nhttp:\n  routers:\n    test:\n      rule: Host(`test.example.com`)"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-traefik-app"),
 					resource.TestCheckResourceAttrSet("dokploy_application.test", "traefik_config"),
@@ -361,7 +448,7 @@ func TestAccApplicationResourceTraefikConfig(t *testing.T) {
 			},
 			// Update traefik_config
 			{
-				Config: testAccApplicationResourceTraefikConfig("test-traefik-project", "test-traefik-env", "test-traefik-app", "# Updated config\nhttp:\n  routers:\n    updated:\n      rule: Host(`updated.example.com`)"),
+				Config: testAccApplicationResourceTraefikConfig("tf-acc-traefik-project", "test-traefik-env", "test-traefik-app", "# Updated config\nhttp:\n  routers:\n    updated:\n      rule: Host(`updated.example.com`)"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("dokploy_application.test", "traefik_config"),
 				),
@@ -414,7 +501,7 @@ func TestAccApplicationResourceMoveEnvironment(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create in first environment
 			{
-				Config: testAccApplicationResourceMoveEnvConfig("test-move-project", "env-1", "env-2", "test-move-app", "env-1"),
+				Config: testAccApplicationResourceMoveEnvConfig("tf-acc-move-project", "env-1", "env-2", "test-move-app", "env-1"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-move-app"),
 					resource.TestCheckResourceAttrPair("dokploy_application.test", "environment_id", "dokploy_environment.env1", "id"),
@@ -422,7 +509,7 @@ func TestAccApplicationResourceMoveEnvironment(t *testing.T) {
 			},
 			// Move to second environment
 			{
-				Config: testAccApplicationResourceMoveEnvConfig("test-move-project", "env-1", "env-2", "test-move-app", "env-2"),
+				Config: testAccApplicationResourceMoveEnvConfig("tf-acc-move-project", "env-1", "env-2", "test-move-app", "env-2"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_application.test", "name", "test-move-app"),
 					resource.TestCheckResourceAttrPair("dokploy_application.test", "environment_id", "dokploy_environment.env2", "id"),
@@ -481,7 +568,7 @@ func TestAccApplicationDataSource(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccApplicationDataSourceConfig("test-ds-app-project", "test-ds-app-env", "test-ds-app"),
+				Config: testAccApplicationDataSourceConfig("tf-acc-ds-app-project", "test-ds-app-env", "test-ds-app"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.dokploy_application.test", "id", "dokploy_application.test", "id"),
 					resource.TestCheckResourceAttr("data.dokploy_application.test", "name", "test-ds-app"),
@@ -537,7 +624,7 @@ func TestAccApplicationsDataSource(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccApplicationsDataSourceConfig("test-ds-apps-project", "test-ds-apps-env", "test-ds-app-1", "test-ds-app-2"),
+				Config: testAccApplicationsDataSourceConfig("tf-acc-ds-apps-project", "test-ds-apps-env", "test-ds-app-1", "test-ds-app-2"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.dokploy_applications.all", "applications.#"),
 					resource.TestCheckResourceAttrSet("data.dokploy_applications.by_env", "applications.#"),
@@ -588,3 +675,912 @@ data "dokploy_applications" "by_env" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, app1Name, app2Name)
 }
+
+// TestAccApplicationResourceDropSource tests deploying from inline Dockerfile content.
+func TestAccApplicationResourceDropSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceDropConfig("tf-acc-drop-project", "test-drop-env", "test-drop-app", "FROM nginx:alpine"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "source_type", "drop"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "dockerfile", "FROM nginx:alpine\n"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "drop_build_path", "/"),
+				),
+			},
+			{
+				Config: testAccApplicationResourceDropConfig("tf-acc-drop-project", "test-drop-env", "test-drop-app", "FROM nginx:latest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "dockerfile", "FROM nginx:latest\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceDropConfig(projectName, envName, appName, dockerfile string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for drop source tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_application" "test" {
+  environment_id  = dokploy_environment.test.id
+  name            = "%s"
+  source_type     = "drop"
+  build_type      = "dockerfile"
+  drop_build_path = "/"
+  dockerfile      = <<-EOT
+    %s
+  EOT
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, dockerfile)
+}
+
+// TestAccApplicationResourceResolveDigest tests digest tracking for docker images.
+func TestAccApplicationResourceResolveDigest(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceResolveDigestConfig("tf-acc-digest-project", "test-digest-env", "test-digest-app", "nginx:1.27-alpine"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "resolve_digest", "true"),
+					resource.TestMatchResourceAttr("dokploy_application.test", "image_digest", regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)),
+				),
+			},
+			// Re-planning the same tag must not produce a diff
+			{
+				Config:   testAccApplicationResourceResolveDigestConfig("tf-acc-digest-project", "test-digest-env", "test-digest-app", "nginx:1.27-alpine"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceResolveDigestConfig(projectName, envName, appName, dockerImage string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for digest tracking tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "%s"
+  source_type    = "docker"
+  docker_image   = "%s"
+  resolve_digest = true
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, dockerImage)
+}
+
+// TestAccApplicationResourceTags tests tagging applications and filtering by tag.
+func TestAccApplicationResourceTags(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceTagsConfig("tf-acc-tags-project", "test-tags-env", "test-tags-app", "payments"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "tags.team", "payments"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "tags.cost-center", "cc-1234"),
+				),
+			},
+			{
+				Config: testAccApplicationResourceTagsConfig("tf-acc-tags-project", "test-tags-env", "test-tags-app", "platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "tags.team", "platform"),
+					resource.TestCheckResourceAttr("data.dokploy_applications.by_tag", "applications.#", "1"),
+					resource.TestCheckResourceAttr("data.dokploy_applications.by_tag", "applications.0.tags.team", "platform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceTagsConfig(projectName, envName, appName, team string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "%s"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "%s"
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+
+  tags = {
+    team        = "%s"
+    cost-center = "cc-1234"
+  }
+}
+
+data "dokploy_applications" "by_tag" {
+  environment_id = dokploy_environment.test.id
+  tags = {
+    team = "platform"
+  }
+  depends_on = [dokploy_application.test]
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, team)
+}
+
+// TestAccApplicationResourceBuildBlock tests the build block and its build_type validation.
+func TestAccApplicationResourceBuildBlock(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Settings that do not apply to the build type are rejected
+			{
+				Config:      testAccApplicationResourceBuildConfig("nixpacks", `heroku_version = "24"`),
+				ExpectError: regexp.MustCompile("Invalid Build Setting"),
+			},
+			{
+				Config: testAccApplicationResourceBuildConfig("dockerfile", `
+    dockerfile_path    = "./docker/Dockerfile"
+    docker_build_stage = "runtime"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "build.dockerfile_path", "./docker/Dockerfile"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "build.docker_build_stage", "runtime"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "dockerfile_path", "./docker/Dockerfile"),
+				),
+			},
+			{
+				Config: testAccApplicationResourceBuildConfig("static", `
+    publish_directory = "dist"
+    is_static_spa     = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "build_type", "static"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "build.publish_directory", "dist"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "is_static_spa", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceBuildConfig(buildType, build string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-app-build-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-app-build-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id    = dokploy_environment.test.id
+  name              = "test-app-build"
+  source_type       = "git"
+  custom_git_url    = "https://github.com/dokploy/dokploy"
+  custom_git_branch = "main"
+  build_type        = "%s"
+
+  build = {
+    %s
+  }
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), buildType, build)
+}
+
+func TestAccApplicationResourceUlimits(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationResourceUlimitsConfig(`{ name = "nofile", soft = 65536, hard = 1024 }`),
+				ExpectError: regexp.MustCompile("Invalid Ulimit"),
+			},
+			{
+				Config: testAccApplicationResourceUlimitsConfig(`{ name = "nofile", soft = 65536, hard = 65536 }, { name = "memlock", soft = -1, hard = -1 }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "ulimits.#", "2"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "ulimits.0.name", "nofile"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "ulimits.0.soft", "65536"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "ulimits.1.hard", "-1"),
+				),
+			},
+			{
+				Config: testAccApplicationResourceUlimitsConfig(`{ name = "nproc", soft = 4096, hard = 8192 }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "ulimits.#", "1"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "ulimits.0.name", "nproc"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceUlimitsConfig(ulimits string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-ulimits-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-ulimits-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-ulimits-app"
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+
+  ulimits = [%s]
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), ulimits)
+}
+
+func TestAccApplicationResourceDomains(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationResourceDomainsConfig(`{ host = "tf-domains.example.com", port = 80, certificate_type = "letsencrypt" }`),
+				ExpectError: regexp.MustCompile("Invalid Domain Certificate"),
+			},
+			{
+				Config: testAccApplicationResourceDomainsConfig(`{ host = "tf-domains.example.com", port = 80 }, { host = "tf-domains.example.com", path = "/api", port = 8080 }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "domains.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("dokploy_application.test", "domains.*", map[string]string{
+						"host": "tf-domains.example.com",
+						"path": "/api",
+						"port": "8080",
+					}),
+				),
+			},
+			{
+				Config: testAccApplicationResourceDomainsConfig(`{ host = "tf-domains-2.example.com", port = 80, https = true }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "domains.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("dokploy_application.test", "domains.*", map[string]string{
+						"host":  "tf-domains-2.example.com",
+						"path":  "/",
+						"https": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceDomainsConfig(domains string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-domains-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-domains-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-domains-app"
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+
+  domains = [%s]
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), domains)
+}
+
+func TestAccApplicationResourceChildren(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceChildrenConfig(
+					`{ published_port = 18080, target_port = 80 }, { published_port = 15353, target_port = 53, protocol = "udp" }`,
+					`{ regex = "^/old/(.*)", replacement = "/new/$${1}" }`,
+					`{ type = "volume", volume_name = "tf-children-data", mount_path = "/data" }`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "ports.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("dokploy_application.test", "ports.*", map[string]string{
+						"published_port": "15353",
+						"protocol":       "udp",
+						"publish_mode":   "ingress",
+					}),
+					resource.TestCheckResourceAttr("dokploy_application.test", "redirects.#", "1"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "mounts.#", "1"),
+				),
+			},
+			// Change one port, drop the other and replace the redirect and mount.
+			{
+				Config: testAccApplicationResourceChildrenConfig(
+					`{ published_port = 18080, target_port = 8080 }`,
+					`{ regex = "^/docs", replacement = "/help", permanent = false }`,
+					`{ type = "file", file_path = "/etc/app.conf", mount_path = "/etc/app.conf", content = "key=value" }`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "ports.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("dokploy_application.test", "ports.*", map[string]string{
+						"published_port": "18080",
+						"target_port":    "8080",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("dokploy_application.test", "redirects.*", map[string]string{
+						"regex":     "^/docs",
+						"permanent": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("dokploy_application.test", "mounts.*", map[string]string{
+						"type":    "file",
+						"content": "key=value",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceChildrenConfig(ports, redirects, mounts string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-children-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-children-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-children-app"
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+
+  ports     = [%s]
+  redirects = [%s]
+  mounts    = [%s]
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), ports, redirects, mounts)
+}
+
+func TestAccApplicationResourceRotateToken(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	var token string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceRotateTokenConfig("2024-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("dokploy_application.test", "refresh_token", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("refresh_token is empty")
+						}
+						token = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccApplicationResourceRotateTokenConfig("2024-02"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("dokploy_application.test", "refresh_token", func(value string) error {
+						if value == "" || value == token {
+							return fmt.Errorf("refresh_token was not rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceRotateTokenConfig(rotateToken string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-rotate-token-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-rotate-token-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-rotate-token-app"
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+  rotate_token   = "%s"
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), rotateToken)
+}
+
+func TestAccApplicationResourceWatchPaths(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceWatchPathsConfig(`watch_paths = ["apps/api/**", "package.json"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "watch_paths.#", "2"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "watch_paths.0", "apps/api/**"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "watch_paths.1", "package.json"),
+				),
+			},
+			{
+				Config: testAccApplicationResourceWatchPathsConfig(`watch_paths = ["apps/web/**"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "watch_paths.#", "1"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "watch_paths.0", "apps/web/**"),
+				),
+			},
+			// Removing the attribute clears the paths in Dokploy.
+			{
+				Config: testAccApplicationResourceWatchPathsConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dokploy_application.test", "watch_paths.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceWatchPathsConfig(watchPaths string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-watch-paths-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-watch-paths-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id    = dokploy_environment.test.id
+  name              = "test-watch-paths-app"
+  source_type       = "git"
+  build_type        = "nixpacks"
+  custom_git_url    = "https://github.com/dokploy/dokploy"
+  custom_git_branch = "canary"
+  %s
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), watchPaths)
+}
+
+func TestAccApplicationResourceExecForm(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceExecFormConfig(`
+  entrypoint_list = ["/bin/sh", "-c"]
+  command_list    = ["echo \"hello world\" && sleep infinity"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "entrypoint_list.#", "2"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "entrypoint_list.1", "-c"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "command_list.#", "1"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "command_list.0", `echo "hello world" && sleep infinity`),
+					resource.TestCheckNoResourceAttr("dokploy_application.test", "command"),
+					resource.TestCheckNoResourceAttr("dokploy_application.test", "entrypoint"),
+				),
+			},
+			{
+				Config: testAccApplicationResourceExecFormConfig(`
+  command      = "sleep infinity"
+  command_list = ["sleep", "infinity"]`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceExecFormConfig(commands string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-exec-form-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-exec-form-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-exec-form-app"
+  source_type    = "docker"
+  docker_image   = "alpine:3.20"
+%s
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), commands)
+}
+
+func TestExecForm(t *testing.T) {
+	list := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sh"), types.StringValue("-c"), types.StringValue(`echo "hi"`)})
+
+	encoded, ok := execFormFromPlan(list)
+	if !ok || encoded != `["sh","-c","echo \"hi\""]` {
+		t.Fatalf("execFormFromPlan = %q, %v", encoded, ok)
+	}
+	if _, ok := execFormFromPlan(types.ListNull(types.StringType)); ok {
+		t.Error("execFormFromPlan(null) reported a value")
+	}
+
+	if got, ok := execFormValue(list, encoded); !ok || !got.Equal(list) {
+		t.Errorf("execFormValue(list, %q) = %s, %v", encoded, got, ok)
+	}
+	if _, ok := execFormValue(types.ListNull(types.StringType), encoded); ok {
+		t.Error("execFormValue decoded a value managed through the string attribute")
+	}
+	if _, ok := execFormValue(list, "sleep infinity"); ok {
+		t.Error("execFormValue decoded a shell-form command")
+	}
+}
+
+func TestAccApplicationResourceBuildArgsMap(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceBuildArgsMapConfig("1.0.0", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "build_args_map.%", "2"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "build_args_map.VERSION", "1.0.0"),
+					resource.TestCheckNoResourceAttr("dokploy_application.test", "build_secrets_map.%"),
+				),
+			},
+			{
+				Config: testAccApplicationResourceBuildArgsMapConfig("1.1.0", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "build_args_map.VERSION", "1.1.0"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "build_secrets_map_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceBuildArgsMapConfig(version string, secretsVersion int) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-build-args-map-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-build-args-map-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id    = dokploy_environment.test.id
+  name              = "test-build-args-map-app"
+  source_type       = "git"
+  build_type        = "dockerfile"
+  custom_git_url    = "https://github.com/dokploy/dokploy"
+  custom_git_branch = "canary"
+
+  build_args_map = {
+    VERSION  = "%s"
+    NODE_ENV = "production"
+  }
+  build_secrets_map = {
+    NPM_TOKEN = "npm-token-%d"
+  }
+  build_secrets_map_version = %d
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), version, secretsVersion, secretsVersion)
+}
+
+func TestApplicationSaveEnvironmentMaps(t *testing.T) {
+	mock := clientmock.New()
+	var saved client.SaveEnvironmentInput
+	mock.SaveEnvironmentFunc = func(input client.SaveEnvironmentInput) error {
+		saved = input
+		return nil
+	}
+	r := &ApplicationResource{client: mock}
+
+	plan := ApplicationResourceModel{
+		BuildArgsMap: stringMapValue(map[string]string{"VERSION": "1.0.0", "NODE_ENV": "production"}),
+		BuildSecrets: types.StringNull(),
+	}
+	secrets := stringMapValue(map[string]string{"NPM_TOKEN": "t", "GH_TOKEN": "g"})
+	var diags diag.Diagnostics
+	if err := r.saveEnvironment(context.Background(), "app-1", &plan, secrets, &diags); err != nil {
+		t.Fatal(err)
+	}
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if saved.BuildArgs != "NODE_ENV=production\nVERSION=1.0.0" {
+		t.Errorf("BuildArgs = %q", saved.BuildArgs)
+	}
+	if saved.BuildSecrets != "GH_TOKEN=g\nNPM_TOKEN=t" {
+		t.Errorf("BuildSecrets = %q", saved.BuildSecrets)
+	}
+}
+
+func TestHydrateImportedApplication(t *testing.T) {
+	app := &client.Application{
+		Name:    "web",
+		Env:     "APP_ENV=production",
+		Domains: []client.Domain{{Host: "web.example.com", Path: "/", Port: 3000, HTTPS: true}},
+		Ports:   []client.Port{{PublishedPort: 8080, TargetPort: 80}},
+	}
+
+	// An imported state holds only the ID.
+	state := ApplicationResourceModel{ID: types.StringValue("app-1")}
+	readApplicationIntoState(&state, app)
+	hydrateImportedApplication(&state, app)
+	state.Domains = applicationDomainsFromAPI(state.Domains, app.Domains)
+	readApplicationChildren(&state, app)
+
+	if state.Env.ValueString() != "APP_ENV=production" {
+		t.Errorf("env = %v", state.Env)
+	}
+	if !state.BuildArgs.IsNull() {
+		t.Errorf("build_args = %v, want null", state.BuildArgs)
+	}
+	if len(state.Domains) != 1 || state.Domains[0].Host.ValueString() != "web.example.com" {
+		t.Errorf("domains = %v", state.Domains)
+	}
+	if len(state.Ports) != 1 || state.Ports[0].PublishedPort.ValueInt64() != 8080 {
+		t.Errorf("ports = %v", state.Ports)
+	}
+	if state.Redirects != nil || state.Mounts != nil {
+		t.Errorf("unused children should stay null, got redirects %v, mounts %v", state.Redirects, state.Mounts)
+	}
+}
+
+func TestApplicationTagsKeepSwarmLabels(t *testing.T) {
+	mock := clientmock.New()
+	mock.GetApplicationFunc = func(id string) (*client.Application, error) {
+		return &client.Application{ID: id, LabelsSwarm: map[string]interface{}{
+			"com.example.team": "payments",
+			"dokploy.tag.env":  "staging",
+			"dokploy.tag.old":  "yes",
+		}}, nil
+	}
+	var sent map[string]interface{}
+	mock.UpdateApplicationGeneralFunc = func(app client.Application) (*client.Application, error) {
+		sent = app.LabelsSwarm
+		return &app, nil
+	}
+	r := &ApplicationResource{client: mock}
+
+	plan := ApplicationResourceModel{
+		Tags: types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("production")}),
+	}
+	if err := r.updateGeneralSettings("app-1", &plan, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"com.example.team": "payments", "dokploy.tag.env": "production"}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("labelsSwarm = %v, want %v", sent, want)
+	}
+
+	// Removed tags are sent as an empty map and only drop the tag labels.
+	plan.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	if err := r.updateGeneralSettings("app-1", &plan, nil); err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]interface{}{"com.example.team": "payments"}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("labelsSwarm after removing tags = %v, want %v", sent, want)
+	}
+}
+
+func TestApplicationImportState(t *testing.T) {
+	ctx := context.Background()
+	mock := clientmock.New()
+	mock.GetApplicationFunc = func(id string) (*client.Application, error) {
+		switch id {
+		case "app-1":
+			return &client.Application{ID: id}, nil
+		case "broken":
+			return nil, errors.New("500 Internal Server Error")
+		}
+		return nil, fmt.Errorf("%w: application %s", client.ErrNotFound, id)
+	}
+	lookups := 0
+	mock.FindApplicationByAppNameFunc = func(appName string) (*client.Application, error) {
+		lookups++
+		if appName == "web-x1y2" {
+			return &client.Application{ID: "app-2", AppName: appName}, nil
+		}
+		return nil, client.ErrNotFound
+	}
+	r := &ApplicationResource{client: mock}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	importID := func(id string) (string, diag.Diagnostics) {
+		resp := &fwresource.ImportStateResponse{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, resp)
+		var got types.String
+		resp.State.GetAttribute(ctx, path.Root("id"), &got)
+		return got.ValueString(), resp.Diagnostics
+	}
+
+	if got, diags := importID("app-1"); diags.HasError() || got != "app-1" || lookups != 0 {
+		t.Errorf("import by ID = %q, %v, appName lookups = %d", got, diags, lookups)
+	}
+	if got, diags := importID("web-x1y2"); diags.HasError() || got != "app-2" {
+		t.Errorf("import by appName = %q, %v", got, diags)
+	}
+	if _, diags := importID("missing"); !diags.HasError() {
+		t.Error("import of an unknown application succeeded")
+	}
+	lookups = 0
+	if _, diags := importID("broken"); !diags.HasError() || lookups != 0 {
+		t.Errorf("import with a failing lookup: diagnostics = %v, appName lookups = %d", diags, lookups)
+	}
+}
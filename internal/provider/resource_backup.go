@@ -7,6 +7,7 @@ import (
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,13 +22,14 @@ import (
 
 var _ resource.Resource = &BackupResource{}
 var _ resource.ResourceWithImportState = &BackupResource{}
+var _ resource.ResourceWithModifyPlan = &BackupResource{}
 
 func NewBackupResource() resource.Resource {
 	return &BackupResource{}
 }
 
 type BackupResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type BackupResourceModel struct {
@@ -41,8 +43,13 @@ type BackupResourceModel struct {
 	Schedule        types.String `tfsdk:"schedule"`
 	Enabled         types.Bool   `tfsdk:"enabled"`
 	Prefix          types.String `tfsdk:"prefix"`
+	ResolvedPrefix  types.String `tfsdk:"resolved_prefix"`
 	Database        types.String `tfsdk:"database"`
 	KeepLatestCount types.Int64  `tfsdk:"keep_latest_count"`
+	RunOnCreate     types.Bool   `tfsdk:"run_on_create"`
+	RunTrigger      types.String `tfsdk:"run_trigger"`
+	LastRunStatus   types.String `tfsdk:"last_run_status"`
+	LastRunAt       types.String `tfsdk:"last_run_at"`
 }
 
 func (r *BackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,7 +75,7 @@ func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("database"),
-				Description: "Type of backup: 'database' for database backups or 'compose' for compose service backups.",
+				Description: "Type of backup: 'database' for database backups or 'compose' for compose service backups. Prefer dokploy_compose_backup for compose services, which validates the service name and credentials.",
 				Validators: []validator.String{
 					stringvalidator.OneOf("database", "compose"),
 				},
@@ -107,6 +114,9 @@ func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"schedule": schema.StringAttribute{
 				Required:    true,
 				Description: "Cron schedule for backups (e.g., '0 2 * * *' for daily at 2 AM).",
+				Validators: []validator.String{
+					cronValidator{},
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:    true,
@@ -116,7 +126,14 @@ func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"prefix": schema.StringAttribute{
 				Required:    true,
-				Description: "Prefix for backup files.",
+				Description: "Prefix for backup files. May contain {{project}}, {{environment}} and {{service}}, which the provider replaces with the names of the backed up database or compose stack, its environment and its project, e.g. \"{{project}}/{{environment}}/{{service}}\".",
+				Validators: []validator.String{
+					backupPrefixValidator{},
+				},
+			},
+			"resolved_prefix": schema.StringAttribute{
+				Computed:    true,
+				Description: "Prefix sent to Dokploy, with the tokens of prefix expanded.",
 			},
 			"database": schema.StringAttribute{
 				Required:    true,
@@ -128,6 +145,24 @@ func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Default:     int64default.StaticInt64(30),
 				Description: "Number of recent backups to keep (older ones are deleted).",
 			},
+			"run_on_create": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to run the backup once right after it is created. Defaults to false.",
+			},
+			"run_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that runs the backup once whenever it changes, e.g. a migration version. Useful for taking a backup before a schema migration.",
+			},
+			"last_run_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the most recent run: running, done, or error. Null if the backup has never run.",
+			},
+			"last_run_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the most recent run. Null if the backup has never run.",
+			},
 		},
 	}
 }
@@ -136,9 +171,9 @@ func (r *BackupResource) Configure(_ context.Context, req resource.ConfigureRequ
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -179,11 +214,15 @@ func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	if !r.resolvePrefix(&plan, &resp.Diagnostics) {
+		return
+	}
+
 	backup := client.Backup{
 		DestinationID:   plan.DestinationID.ValueString(),
 		Schedule:        plan.Schedule.ValueString(),
 		Enabled:         plan.Enabled.ValueBool(),
-		Prefix:          plan.Prefix.ValueString(),
+		Prefix:          plan.ResolvedPrefix.ValueString(),
 		Database:        plan.Database.ValueString(),
 		KeepLatestCount: int(plan.KeepLatestCount.ValueInt64()),
 		BackupType:      backupType,
@@ -223,7 +262,7 @@ func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.ID = types.StringValue(createdBackup.BackupID)
 	plan.Schedule = types.StringValue(createdBackup.Schedule)
 	plan.Enabled = types.BoolValue(createdBackup.Enabled)
-	plan.Prefix = types.StringValue(createdBackup.Prefix)
+	setBackupPrefix(&plan, createdBackup.Prefix)
 	plan.Database = types.StringValue(createdBackup.Database)
 	plan.KeepLatestCount = types.Int64Value(int64(createdBackup.KeepLatestCount))
 	plan.BackupType = types.StringValue(createdBackup.BackupType)
@@ -232,6 +271,16 @@ func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest,
 		plan.ServiceName = types.StringValue(createdBackup.ServiceName)
 	}
 
+	if plan.RunOnCreate.ValueBool() {
+		if err := r.client.RunBackup(createdBackup.BackupID, backupRunType(&plan)); err != nil {
+			resp.Diagnostics.AddError("Error running backup", err.Error())
+		}
+	}
+
+	if err := r.readLastRun(&plan); err != nil {
+		resp.Diagnostics.AddError("Error reading backup runs", err.Error())
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -257,7 +306,7 @@ func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.DestinationID = types.StringValue(backup.DestinationID)
 	state.Schedule = types.StringValue(backup.Schedule)
 	state.Enabled = types.BoolValue(backup.Enabled)
-	state.Prefix = types.StringValue(backup.Prefix)
+	setBackupPrefix(&state, backup.Prefix)
 	state.Database = types.StringValue(backup.Database)
 	state.KeepLatestCount = types.Int64Value(int64(backup.KeepLatestCount))
 	state.BackupType = types.StringValue(backup.BackupType)
@@ -287,14 +336,21 @@ func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, res
 		}
 	}
 
+	if err := r.readLastRun(&state); err != nil {
+		resp.Diagnostics.AddError("Error reading backup runs", err.Error())
+		return
+	}
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan BackupResourceModel
+	var plan, state BackupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -304,12 +360,16 @@ func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		backupType = "database"
 	}
 
+	if !r.resolvePrefix(&plan, &resp.Diagnostics) {
+		return
+	}
+
 	backup := client.Backup{
 		BackupID:        plan.ID.ValueString(),
 		DestinationID:   plan.DestinationID.ValueString(),
 		Schedule:        plan.Schedule.ValueString(),
 		Enabled:         plan.Enabled.ValueBool(),
-		Prefix:          plan.Prefix.ValueString(),
+		Prefix:          plan.ResolvedPrefix.ValueString(),
 		Database:        plan.Database.ValueString(),
 		KeepLatestCount: int(plan.KeepLatestCount.ValueInt64()),
 	}
@@ -334,7 +394,7 @@ func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	plan.Schedule = types.StringValue(updatedBackup.Schedule)
 	plan.Enabled = types.BoolValue(updatedBackup.Enabled)
-	plan.Prefix = types.StringValue(updatedBackup.Prefix)
+	setBackupPrefix(&plan, updatedBackup.Prefix)
 	plan.Database = types.StringValue(updatedBackup.Database)
 	plan.KeepLatestCount = types.Int64Value(int64(updatedBackup.KeepLatestCount))
 
@@ -342,10 +402,40 @@ func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		plan.ServiceName = types.StringValue(updatedBackup.ServiceName)
 	}
 
+	if !plan.RunTrigger.IsNull() && !plan.RunTrigger.Equal(state.RunTrigger) {
+		if err := r.client.RunBackup(plan.ID.ValueString(), backupRunType(&plan)); err != nil {
+			resp.Diagnostics.AddError("Error running backup", err.Error())
+		}
+	}
+
+	if err := r.readLastRun(&plan); err != nil {
+		resp.Diagnostics.AddError("Error reading backup runs", err.Error())
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r *BackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan BackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Expand the prefix on every plan, so renaming the project, environment
+	// or service moves future backups to the new layout.
+	resolved, err := resolveBackupPrefix(r.client, &plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("prefix"), "Error Expanding Backup Prefix", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_prefix"), resolved)...)
+}
+
 func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state BackupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -354,11 +444,12 @@ func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteBackup(state.ID.ValueString())
+	serviceType, serviceID := state.DatabaseType.ValueString(), state.DatabaseID.ValueString()
+	if state.BackupType.ValueString() == "compose" {
+		serviceType, serviceID = "compose", state.ComposeID.ValueString()
+	}
+	err := deleteChild(ctx, func() error { return r.client.DeleteBackup(state.ID.ValueString()) }, serviceParent(r.client, serviceType, serviceID))
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting backup", err.Error())
 		return
 	}
@@ -366,4 +457,62 @@ func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *BackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("run_on_create"), false)...)
+}
+
+// resolvePrefix expands the prefix of m when it was unknown during plan,
+// e.g. because the backed up service is created in the same apply.
+func (r *BackupResource) resolvePrefix(m *BackupResourceModel, diags *diag.Diagnostics) bool {
+	if !m.ResolvedPrefix.IsUnknown() && !m.ResolvedPrefix.IsNull() {
+		return true
+	}
+	resolved, err := resolveBackupPrefix(r.client, m)
+	if err != nil {
+		diags.AddAttributeError(path.Root("prefix"), "Error Expanding Backup Prefix", err.Error())
+		return false
+	}
+	m.ResolvedPrefix = resolved
+	return true
+}
+
+// setBackupPrefix stores the prefix Dokploy returned. A templated prefix is
+// kept as configured; resolved_prefix shows its expansion.
+func setBackupPrefix(m *BackupResourceModel, prefix string) {
+	m.ResolvedPrefix = types.StringValue(prefix)
+	if !hasBackupPrefixTokens(m.Prefix.ValueString()) {
+		m.Prefix = types.StringValue(prefix)
+	}
+}
+
+// backupRunType returns the type RunBackup expects for the backup: the
+// database type for database backups, or compose.
+func backupRunType(m *BackupResourceModel) string {
+	if m.BackupType.ValueString() == "compose" {
+		return "compose"
+	}
+	return m.DatabaseType.ValueString()
+}
+
+// readLastRun sets the last run fields from the most recent deployment of the
+// backup.
+func (r *BackupResource) readLastRun(m *BackupResourceModel) error {
+	deployments, err := r.client.ListDeploymentsByType(m.ID.ValueString(), "backup")
+	if err != nil {
+		return err
+	}
+
+	m.LastRunStatus = types.StringNull()
+	m.LastRunAt = types.StringNull()
+	if len(deployments) == 0 {
+		return nil
+	}
+
+	last := deployments[0]
+	m.LastRunStatus = types.StringValue(last.Status)
+	if last.StartedAt != nil && *last.StartedAt != "" {
+		m.LastRunAt = types.StringValue(*last.StartedAt)
+	} else {
+		m.LastRunAt = types.StringValue(last.CreatedAt)
+	}
+	return nil
 }
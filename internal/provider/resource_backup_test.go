@@ -5,6 +5,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -22,7 +25,7 @@ func TestAccBackupResource_Database(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccBackupResourceConfig_Database("test-backup-project", "test-backup-env", "test-backup-db", "testbkapp", "testbkdb", "testbkuser", "test-backup-dest", "0 2 * * *", true, "db-backup"),
+				Config: testAccBackupResourceConfig_Database("tf-acc-backup-project", "test-backup-env", "test-backup-db", "testbkapp", "testbkdb", "testbkuser", "tf-acc-backup-dest", "0 2 * * *", true, "db-backup"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_backup.test", "schedule", "0 2 * * *"),
 					resource.TestCheckResourceAttr("dokploy_backup.test", "enabled", "true"),
@@ -37,7 +40,7 @@ func TestAccBackupResource_Database(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccBackupResourceConfig_Database("test-backup-project", "test-backup-env", "test-backup-db", "testbkapp", "testbkdb", "testbkuser", "test-backup-dest", "0 3 * * *", false, "updated-backup"),
+				Config: testAccBackupResourceConfig_Database("tf-acc-backup-project", "test-backup-env", "test-backup-db", "testbkapp", "testbkdb", "testbkuser", "tf-acc-backup-dest", "0 3 * * *", false, "updated-backup"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_backup.test", "schedule", "0 3 * * *"),
 					resource.TestCheckResourceAttr("dokploy_backup.test", "enabled", "false"),
@@ -68,7 +71,7 @@ func TestAccBackupResource_Compose(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing for compose backup
 			{
-				Config: testAccBackupResourceConfig_Compose("test-compose-backup-project", "test-compose-backup-env", "test-compose-backup-dest", "0 4 * * *", true, "compose-backup"),
+				Config: testAccBackupResourceConfig_Compose("tf-acc-compose-backup-project", "test-compose-backup-env", "tf-acc-compose-backup-dest", "0 4 * * *", true, "compose-backup"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_backup.test_compose", "schedule", "0 4 * * *"),
 					resource.TestCheckResourceAttr("dokploy_backup.test_compose", "enabled", "true"),
@@ -84,7 +87,7 @@ func TestAccBackupResource_Compose(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccBackupResourceConfig_Compose("test-compose-backup-project", "test-compose-backup-env", "test-compose-backup-dest", "0 5 * * *", false, "updated-compose-backup"),
+				Config: testAccBackupResourceConfig_Compose("tf-acc-compose-backup-project", "test-compose-backup-env", "tf-acc-compose-backup-dest", "0 5 * * *", false, "updated-compose-backup"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_backup.test_compose", "schedule", "0 5 * * *"),
 					resource.TestCheckResourceAttr("dokploy_backup.test_compose", "enabled", "false"),
@@ -119,6 +122,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_name     = "%s"
@@ -212,3 +217,129 @@ resource "dokploy_backup" "test_compose" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, destName, schedule, enabled, prefix)
 }
+
+func TestAccBackupResource_RunTrigger(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupResourceConfig_RunTrigger("tf-acc-backup-run-project", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_backup.test", "run_on_create", "true"),
+					resource.TestCheckResourceAttrSet("dokploy_backup.test", "last_run_status"),
+					resource.TestCheckResourceAttrSet("dokploy_backup.test", "last_run_at"),
+				),
+			},
+			{
+				Config: testAccBackupResourceConfig_RunTrigger("tf-acc-backup-run-project", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_backup.test", "run_trigger", "v2"),
+					resource.TestCheckResourceAttrSet("dokploy_backup.test", "last_run_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBackupResourceConfig_RunTrigger(projectName, trigger string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "%s"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test"
+}
+
+resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
+  name              = "test-backup-run-db"
+  app_name          = "testbkrunapp"
+  database_name     = "testbkrundb"
+  database_user     = "testbkrunuser"
+  database_password = "test_password_123"
+  environment_id    = dokploy_environment.test.id
+}
+
+resource "dokploy_destination" "test" {
+  name              = "tf-acc-backup-run-dest"
+  storage_provider  = "s3"
+  access_key        = "test-access-key"
+  secret_access_key = "test-secret-key"
+  bucket            = "test-backups"
+  region            = "us-east-1"
+  endpoint          = "https://s3.amazonaws.com"
+}
+
+resource "dokploy_backup" "test" {
+  destination_id = dokploy_destination.test.id
+  database_id    = dokploy_postgres.test.id
+  database_type  = "postgres"
+  schedule       = "0 2 * * *"
+  prefix         = "pre-migration"
+  database       = "testbkrundb"
+  run_on_create  = true
+  run_trigger    = "%s"
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, trigger)
+}
+
+func TestBackupRunType(t *testing.T) {
+	database := BackupResourceModel{BackupType: types.StringValue("database"), DatabaseType: types.StringValue("mysql")}
+	if got := backupRunType(&database); got != "mysql" {
+		t.Errorf("backupRunType(database) = %q, want mysql", got)
+	}
+	compose := BackupResourceModel{BackupType: types.StringValue("compose"), DatabaseType: types.StringValue("postgres")}
+	if got := backupRunType(&compose); got != "compose" {
+		t.Errorf("backupRunType(compose) = %q, want compose", got)
+	}
+}
+
+func TestBackupReadLastRun(t *testing.T) {
+	mock := clientmock.New()
+	started := "2024-05-01T10:00:00Z"
+	mock.ListDeploymentsByTypeFunc = func(id, deploymentType string) ([]client.Deployment, error) {
+		if deploymentType != "backup" {
+			t.Errorf("deploymentType = %q, want backup", deploymentType)
+		}
+		if id == "never-run" {
+			return nil, nil
+		}
+		return []client.Deployment{
+			{Status: "done", CreatedAt: "2024-05-01T09:59:59Z", StartedAt: &started},
+			{Status: "error", CreatedAt: "2024-04-30T10:00:00Z"},
+		}, nil
+	}
+	r := &BackupResource{client: mock}
+
+	m := BackupResourceModel{ID: types.StringValue("b1")}
+	if err := r.readLastRun(&m); err != nil {
+		t.Fatal(err)
+	}
+	if m.LastRunStatus.ValueString() != "done" || m.LastRunAt.ValueString() != started {
+		t.Errorf("last run = %s at %s, want done at %s", m.LastRunStatus, m.LastRunAt, started)
+	}
+
+	m = BackupResourceModel{ID: types.StringValue("never-run")}
+	if err := r.readLastRun(&m); err != nil {
+		t.Fatal(err)
+	}
+	if !m.LastRunStatus.IsNull() || !m.LastRunAt.IsNull() {
+		t.Errorf("expected null last run, got %s at %s", m.LastRunStatus, m.LastRunAt)
+	}
+}
@@ -21,7 +21,7 @@ func NewBitbucketProviderResource() resource.Resource {
 }
 
 type BitbucketProviderResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type BitbucketProviderResourceModel struct {
@@ -112,9 +112,9 @@ func (r *BitbucketProviderResource) Configure(_ context.Context, req resource.Co
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
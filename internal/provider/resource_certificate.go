@@ -23,7 +23,7 @@ func NewCertificateResource() resource.Resource {
 }
 
 type CertificateResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type CertificateResourceModel struct {
@@ -105,9 +105,9 @@ func (r *CertificateResource) Configure(_ context.Context, req resource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.DokployClient)
+	c, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = c
@@ -3,10 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,17 +21,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
 )
 
 var _ resource.Resource = &ComposeResource{}
 var _ resource.ResourceWithImportState = &ComposeResource{}
+var _ resource.ResourceWithIdentity = &ComposeResource{}
+var _ resource.ResourceWithModifyPlan = &ComposeResource{}
+var _ resource.ResourceWithValidateConfig = &ComposeResource{}
 
 func NewComposeResource() resource.Resource {
 	return &ComposeResource{}
 }
 
 type ComposeResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ComposeResourceModel struct {
@@ -42,6 +50,8 @@ type ComposeResourceModel struct {
 	ComposeFileContent types.String `tfsdk:"compose_file_content"`
 	ComposePath        types.String `tfsdk:"compose_path"`
 	ComposeType        types.String `tfsdk:"compose_type"`
+	ValidateCompose    types.Bool   `tfsdk:"validate_compose"`
+	ServiceOverrides   types.Map    `tfsdk:"service_overrides"`
 
 	// Source configuration
 	SourceType types.String `tfsdk:"source_type"`
@@ -100,16 +110,50 @@ type ComposeResourceModel struct {
 	// Computed status
 	ComposeStatus types.String `tfsdk:"compose_status"`
 	RefreshToken  types.String `tfsdk:"refresh_token"`
+	RotateToken   types.String `tfsdk:"rotate_token"`
+	WebhookURL    types.String `tfsdk:"webhook_url"`
 	CreatedAt     types.String `tfsdk:"created_at"`
 
+	// Deployment counters (computed)
+	DeploymentCount types.Int64  `tfsdk:"deployment_count"`
+	LastDeployedAt  types.String `tfsdk:"last_deployed_at"`
+
+	// Dashboard link (computed)
+	DashboardURL types.String `tfsdk:"dashboard_url"`
+
 	// Deployment options
-	DeployOnCreate types.Bool `tfsdk:"deploy_on_create"`
+	DeployOnCreate    types.Bool `tfsdk:"deploy_on_create"`
+	RedeployOn        types.List `tfsdk:"redeploy_on"`
+	WaitForDeployment types.Bool `tfsdk:"wait_for_deployment"`
+
+	Domains []composeDomainModel `tfsdk:"domains"`
+
+	// Stack deploy details (compose_type = "stack")
+	StackServices []composeStackServiceModel `tfsdk:"stack_services"`
+	StackNetworks types.List                 `tfsdk:"stack_networks"`
+
+	// Container states per service (computed)
+	ServiceStatus types.Map `tfsdk:"service_status"`
+}
+
+// composeStackServiceModel is a swarm service created by a stack deploy.
+type composeStackServiceModel struct {
+	Name            types.String `tfsdk:"name"`
+	ServiceName     types.String `tfsdk:"service_name"`
+	Image           types.String `tfsdk:"image"`
+	Mode            types.String `tfsdk:"mode"`
+	RunningReplicas types.Int64  `tfsdk:"running_replicas"`
+	DesiredReplicas types.Int64  `tfsdk:"desired_replicas"`
 }
 
 func (r *ComposeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_compose"
 }
 
+func (r *ComposeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	serviceIdentitySchema(resp)
+}
+
 func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Dokploy compose stack. Supports multiple source types including GitHub, GitLab, Bitbucket, Gitea, custom Git repositories, and raw compose file content.",
@@ -124,7 +168,7 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 			"environment_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The environment ID this compose stack belongs to. Can be changed to move the compose to a different environment.",
+				Description: "The environment ID this compose stack belongs to. Can be changed to move the compose to a different environment of the same project; moving to another project's environment recreates the compose stack.",
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -133,7 +177,7 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"app_name": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "The app name used for Docker service naming. Auto-generated if not specified.",
+				Description: "The app name used for Docker service naming. Auto-generated if not specified. Changing it recreates the compose stack.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -160,6 +204,41 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"validate_compose": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Validate compose_file_content during plan so malformed compose files fail before anything is created.",
+			},
+			"service_overrides": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Replicas and resources per service, keyed by service name, merged into the deploy section of the services in compose_file_content before it is sent to Dokploy. Lets environments share one compose file. Requires compose_file_content.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"replicas": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Number of containers to run.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"cpu_limit": schema.StringAttribute{
+							Optional:    true,
+							Description: "CPU limit, e.g. \"0.5\".",
+						},
+						"memory_limit": schema.StringAttribute{
+							Optional:    true,
+							Description: "Memory limit, e.g. \"512M\".",
+						},
+						"cpu_reservation": schema.StringAttribute{
+							Optional:    true,
+							Description: "Reserved CPUs, e.g. \"0.25\".",
+						},
+						"memory_reservation": schema.StringAttribute{
+							Optional:    true,
+							Description: "Reserved memory, e.g. \"256M\".",
+						},
+					},
+				},
+			},
 			"compose_path": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -247,7 +326,7 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"trigger_type": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Trigger type for deployments: 'push' (default) or 'tag'.",
+				Description: "Trigger type for deployments: 'push' (default) or 'tag'. With 'tag', every pushed tag triggers a deployment; Dokploy has no setting to filter tags by pattern.",
 				Validators: []validator.String{
 					stringvalidator.OneOf("push", "tag"),
 				},
@@ -332,6 +411,9 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"env": schema.StringAttribute{
 				Optional:    true,
 				Description: "Environment variables in KEY=VALUE format, one per line.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 
 			// Runtime configuration
@@ -348,7 +430,7 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 			"suffix": schema.StringAttribute{
 				Optional:    true,
-				Description: "Suffix to add to service names.",
+				Description: "Suffix to add to service names. Changing it recreates the compose stack, since volume and network names change with it.",
 			},
 			"randomize": schema.BoolAttribute{
 				Optional:    true,
@@ -371,7 +453,10 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"watch_paths": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
-				Description: "Paths to watch for changes to trigger deployments.",
+				Description: "Paths to watch for changes to trigger deployments, as globs relative to the repository root (e.g. \"src/**\"). Absolute paths and paths outside the repository are rejected, and a leading \"./\" is dropped before the paths are sent.",
+				Validators: []validator.List{
+					watchPathsValidator{},
+				},
 			},
 
 			// Computed status fields
@@ -390,6 +475,18 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotate_token": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that replaces refresh_token whenever it changes, invalidating webhook URLs that use the old token.",
+			},
+			"webhook_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Deploy webhook URL of the stack, for git providers without a Dokploy integration. Contains refresh_token, so it changes when the token is rotated.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp when the compose stack was created.",
@@ -397,12 +494,70 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deployment_count": deploymentCountAttribute("compose stack"),
+			"last_deployed_at": lastDeployedAtAttribute("compose stack"),
+			"dashboard_url":    dashboardURLAttribute("compose stack"),
+
+			"domains": domainsAttribute(true),
+
+			// Stack deploy details
+			"stack_services": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Swarm services of the deployed stack, sorted by name. Only set when compose_type is 'stack'.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Full swarm service name (e.g., myapp-abc123_web).",
+						},
+						"service_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service name from the compose file (e.g., web).",
+						},
+						"image": schema.StringAttribute{
+							Computed:    true,
+							Description: "Image the service runs.",
+						},
+						"mode": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service mode: replicated or global.",
+						},
+						"running_replicas": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of running tasks.",
+						},
+						"desired_replicas": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of tasks the service should run.",
+						},
+					},
+				},
+			},
+			"stack_networks": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Networks the stack deploy creates or attaches to, derived from the compose file. Only set when compose_type is 'stack'.",
+			},
+			"service_status": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "State of the containers of each service, keyed by the service name from the compose file: running when at least one of its containers is running, otherwise the Docker state of its containers, such as exited or restarting. Services without containers, for example before the first deployment, are absent.",
+			},
 
 			// Deployment options
 			"deploy_on_create": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Trigger a deployment after creating the compose stack.",
 			},
+			"redeploy_on": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values, typically content hashes such as sha256(templatefile(...)) of files mounted into the stack. Whenever the list changes, the stack is redeployed so it picks up the new files.",
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait for deployments triggered by deploy_on_create or redeploy_on to finish. A failed deployment is reported as an error that includes the last lines of its build log.",
+			},
 		},
 	}
 }
@@ -411,14 +566,80 @@ func (r *ComposeResource) Configure(_ context.Context, req resource.ConfigureReq
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
 }
 
+func (r *ComposeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ComposeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, d := range config.Domains {
+		validateDomainCertificateType(d.Host, d.HTTPS, d.CertificateType, &resp.Diagnostics)
+	}
+	if !config.ServiceOverrides.IsNull() && config.ComposeFileContent.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("service_overrides"),
+			"Missing Compose File Content",
+			"service_overrides are merged into compose_file_content, so they cannot be used with compose files read from a repository.",
+		)
+	}
+}
+
+func (r *ComposeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ComposeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client != nil {
+		requireReplaceOnProjectChange(ctx, r.client, "compose stack", req, resp)
+	}
+	requireReplaceOnRename(ctx, "compose stack", "app_name", req, resp)
+	requireReplaceOnRename(ctx, "compose stack", "suffix", req, resp)
+
+	// A new rotate_token value replaces the webhook token on apply.
+	if !req.State.Raw.IsNull() && !plan.RotateToken.IsNull() {
+		var rotateToken types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotate_token"), &rotateToken)...)
+		if !plan.RotateToken.Equal(rotateToken) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("refresh_token"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("webhook_url"), types.StringUnknown())...)
+		}
+	}
+
+	if plan.ComposeFileContent.IsNull() || plan.ComposeFileContent.IsUnknown() {
+		return
+	}
+	// Report overrides of services the compose file does not define.
+	composeFileContent(ctx, &plan, &resp.Diagnostics)
+
+	if !plan.ValidateCompose.ValueBool() {
+		return
+	}
+
+	if err := validateComposeFile(plan.ComposeFileContent.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("compose_file_content"),
+			"Invalid Compose File",
+			err.Error(),
+		)
+	}
+}
+
 func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ComposeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -440,12 +661,14 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		watchPaths = normalizeWatchPaths(watchPaths)
 	}
 
 	comp := client.Compose{
 		Name:              plan.Name.ValueString(),
 		EnvironmentID:     plan.EnvironmentID.ValueString(),
-		ComposeFile:       plan.ComposeFileContent.ValueString(),
+		Description:       plan.Description.ValueString(),
+		ComposeFile:       composeFileContent(ctx, &plan, &resp.Diagnostics),
 		SourceType:        plan.SourceType.ValueString(),
 		CustomGitUrl:      plan.CustomGitUrl.ValueString(),
 		CustomGitBranch:   plan.CustomGitBranch.ValueString(),
@@ -534,25 +757,49 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 		comp.GiteaBuildPath = plan.GiteaBuildPath.ValueString()
 	}
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	createdComp, err := r.client.CreateCompose(comp)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating compose", err.Error())
 		return
 	}
 
-	// Update plan from created compose
+	// Update plan from the compose once the API has populated it
 	plan.ID = types.StringValue(createdComp.ID)
-	readComposeIntoState(ctx, &plan, createdComp, &resp.Diagnostics)
+	finalComp, err := waitForConsistentRead(ctx,
+		func() (*client.Compose, error) { return r.client.GetCompose(createdComp.ID) },
+		func(comp *client.Compose) bool { return comp.AppName != "" && comp.SourceType != "" },
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading compose after create", err.Error())
+		return
+	}
+	readComposeIntoState(ctx, &plan, finalComp, &resp.Diagnostics)
+	plan.WebhookURL = composeWebhookURL(r.client.Endpoint(), plan.RefreshToken)
+
+	if !r.syncDomains(createdComp.ID, &plan, &resp.Diagnostics) {
+		return
+	}
 
 	if !plan.DeployOnCreate.IsNull() && plan.DeployOnCreate.ValueBool() {
 		err := r.client.DeployCompose(createdComp.ID, plan.ServerID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddWarning("Deployment Trigger Failed", fmt.Sprintf("Compose stack created but deployment failed to trigger: %s", err.Error()))
+		} else {
+			r.awaitDeployment(ctx, &plan, "", &resp.Diagnostics)
 		}
 	}
 
+	r.readStack(&plan, &resp.Diagnostics)
+	r.readServiceStatus(&plan, &resp.Diagnostics)
+	readDeploymentActivity(r.client, plan.ID.ValueString(), "compose", &plan.DeploymentCount, &plan.LastDeployedAt, &resp.Diagnostics)
+	readDashboardURL(r.client, "compose", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -573,10 +820,22 @@ func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// A freshly imported state holds only the ID, so it also takes the
+	// domains the stack has.
+	imported := state.Name.IsNull()
 	readComposeIntoState(ctx, &state, comp, &resp.Diagnostics)
+	state.WebhookURL = composeWebhookURL(r.client.Endpoint(), state.RefreshToken)
+	if state.Domains != nil || (imported && len(comp.Domains) > 0) {
+		state.Domains = composeDomainsFromAPI(state.Domains, comp.Domains)
+	}
+	r.readStack(&state, &resp.Diagnostics)
+	r.readServiceStatus(&state, &resp.Diagnostics)
+	readDeploymentActivity(r.client, state.ID.ValueString(), "compose", &state.DeploymentCount, &state.LastDeployedAt, &resp.Diagnostics)
+	readDashboardURL(r.client, "compose", state.ID.ValueString(), state.EnvironmentID.ValueString(), &state.DashboardURL, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -594,6 +853,15 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Rotate the webhook token first, so the stack read back below already
+	// has the new one.
+	if !plan.RotateToken.IsNull() && !plan.RotateToken.Equal(state.RotateToken) {
+		if err := r.client.RefreshComposeToken(plan.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error rotating compose webhook token", err.Error())
+			return
+		}
+	}
+
 	environmentChanged := !plan.EnvironmentID.Equal(state.EnvironmentID)
 
 	// Check if environment_id changed - use compose.move API
@@ -607,6 +875,7 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 		// Check if only environment_id changed - if so, skip the update call
 		onlyEnvironmentChanged := plan.Name.Equal(state.Name) &&
 			plan.ComposeFileContent.Equal(state.ComposeFileContent) &&
+			plan.ServiceOverrides.Equal(state.ServiceOverrides) &&
 			plan.SourceType.Equal(state.SourceType) &&
 			plan.CustomGitUrl.Equal(state.CustomGitUrl) &&
 			plan.CustomGitBranch.Equal(state.CustomGitBranch) &&
@@ -619,13 +888,24 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 			plan.Randomize.Equal(state.Randomize) &&
 			plan.IsolatedDeployment.Equal(state.IsolatedDeployment) &&
 			plan.IsolatedDeploymentsVolume.Equal(state.IsolatedDeploymentsVolume) &&
-			plan.WatchPaths.Equal(state.WatchPaths)
+			plan.WatchPaths.Equal(state.WatchPaths) &&
+			plan.RotateToken.Equal(state.RotateToken)
 
 		if onlyEnvironmentChanged {
 			// MoveCompose is sufficient; use returned data to update state
 			readComposeIntoState(ctx, &plan, movedComp, &resp.Diagnostics)
+			plan.WebhookURL = composeWebhookURL(r.client.Endpoint(), plan.RefreshToken)
+			if !r.syncDomains(plan.ID.ValueString(), &plan, &resp.Diagnostics) {
+				return
+			}
+			r.readStack(&plan, &resp.Diagnostics)
+			r.readServiceStatus(&plan, &resp.Diagnostics)
+			r.redeployOnChange(ctx, &plan, &state, &resp.Diagnostics)
+			readDeploymentActivity(r.client, plan.ID.ValueString(), "compose", &plan.DeploymentCount, &plan.LastDeployedAt, &resp.Diagnostics)
+			readDashboardURL(r.client, "compose", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
 			diags = resp.State.Set(ctx, plan)
 			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 			return
 		}
 	}
@@ -638,13 +918,15 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		watchPaths = normalizeWatchPaths(watchPaths)
 	}
 
 	comp := client.Compose{
 		ID:                plan.ID.ValueString(),
 		Name:              plan.Name.ValueString(),
 		EnvironmentID:     plan.EnvironmentID.ValueString(),
-		ComposeFile:       plan.ComposeFileContent.ValueString(),
+		Description:       plan.Description.ValueString(),
+		ComposeFile:       composeFileContent(ctx, &plan, &resp.Diagnostics),
 		SourceType:        plan.SourceType.ValueString(),
 		CustomGitUrl:      plan.CustomGitUrl.ValueString(),
 		CustomGitBranch:   plan.CustomGitBranch.ValueString(),
@@ -659,6 +941,10 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 		IsolatedDeployment:        plan.IsolatedDeployment.ValueBool(),
 		IsolatedDeploymentsVolume: plan.IsolatedDeploymentsVolume.ValueBool(),
 		WatchPaths:                watchPaths,
+		Clear: clearedSettings(
+			clearedSetting[client.ComposeField]{client.ComposeFieldDescription, plan.Description, state.Description},
+			clearedSetting[client.ComposeField]{client.ComposeFieldCommand, plan.Command, state.Command},
+		),
 	}
 
 	// GitHub fields
@@ -723,6 +1009,9 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 		comp.GiteaBranch = plan.GiteaBranch.ValueString()
 	}
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	updatedComp, err := r.client.UpdateCompose(comp)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating compose", err.Error())
@@ -730,9 +1019,68 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	readComposeIntoState(ctx, &plan, updatedComp, &resp.Diagnostics)
+	plan.WebhookURL = composeWebhookURL(r.client.Endpoint(), plan.RefreshToken)
+
+	if !r.syncDomains(plan.ID.ValueString(), &plan, &resp.Diagnostics) {
+		return
+	}
+	r.readStack(&plan, &resp.Diagnostics)
+	r.readServiceStatus(&plan, &resp.Diagnostics)
+	r.redeployOnChange(ctx, &plan, &state, &resp.Diagnostics)
+	readDeploymentActivity(r.client, plan.ID.ValueString(), "compose", &plan.DeploymentCount, &plan.LastDeployedAt, &resp.Diagnostics)
+	readDashboardURL(r.client, "compose", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+// redeployOnChange redeploys the stack when redeploy_on changed. On failure
+// the prior redeploy_on is kept so the next apply tries again.
+func (r *ComposeResource) redeployOnChange(ctx context.Context, plan, state *ComposeResourceModel, diags *diag.Diagnostics) {
+	if plan.RedeployOn.IsNull() || plan.RedeployOn.Equal(state.RedeployOn) {
+		return
+	}
+
+	var previousID string
+	if plan.WaitForDeployment.ValueBool() {
+		var err error
+		previousID, err = latestDeploymentID(r.client, plan.ID.ValueString(), "compose")
+		if err != nil {
+			diags.AddError("Error reading compose deployments", err.Error())
+			plan.RedeployOn = state.RedeployOn
+			return
+		}
+	}
+
+	if err := r.client.RedeployCompose(plan.ID.ValueString()); err != nil {
+		diags.AddError("Error redeploying compose", err.Error())
+		plan.RedeployOn = state.RedeployOn
+		return
+	}
+	if !r.awaitDeployment(ctx, plan, previousID, diags) {
+		plan.RedeployOn = state.RedeployOn
+	}
+}
+
+// awaitDeployment waits for the deployment that follows previousID when
+// wait_for_deployment is set, and reports whether it succeeded. A failed
+// deployment is reported with the tail of its build log.
+func (r *ComposeResource) awaitDeployment(ctx context.Context, plan *ComposeResourceModel, previousID string, diags *diag.Diagnostics) bool {
+	if !plan.WaitForDeployment.ValueBool() {
+		return true
+	}
+
+	dep, err := waitForDeployment(ctx, r.client, plan.ID.ValueString(), "compose", previousID)
+	if err != nil {
+		diags.AddError("Error waiting for compose deployment", err.Error())
+		return false
+	}
+	if dep.Status != "done" {
+		addDeploymentFailure(diags, r.client, "Compose Deployment Failed", dep, plan.ServerID.ValueString())
+		return false
+	}
+	return true
 }
 
 func (r *ComposeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -743,24 +1091,244 @@ func (r *ComposeResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteCompose(state.ID.ValueString())
+	err := deleteChild(ctx, func() error { return r.client.DeleteCompose(state.ID.ValueString()) }, environmentParent(r.client, state.EnvironmentID.ValueString()))
 	if err != nil {
-		errStr := strings.ToLower(err.Error())
-		if strings.Contains(errStr, "not found") || strings.Contains(errStr, "not_found") || strings.Contains(errStr, "404") {
-			// Resource already deleted, that's fine
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting compose", err.Error())
 		return
 	}
 }
 
 func (r *ComposeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Imports by identity carry the compose ID itself.
+	if req.ID == "" {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	importID := req.ID
+	if comp, err := r.client.FindComposeByAppName(importID); err == nil {
+		importID = comp.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
 
 // Helper functions
 
+// syncDomains reconciles the compose's domains with the domains set and reads
+// them back into plan. It does nothing when domains are not managed.
+func (r *ComposeResource) syncDomains(composeID string, plan *ComposeResourceModel, diags *diag.Diagnostics) bool {
+	if plan.Domains == nil {
+		return true
+	}
+	current, err := r.client.GetDomainsByCompose(composeID)
+	if err != nil {
+		diags.AddError("Error reading compose domains", err.Error())
+		return false
+	}
+	if err := reconcileDomains(r.client, current, composeDomainsFromModel(composeID, plan.Domains)); err != nil {
+		diags.AddError("Error updating compose domains", err.Error())
+		return false
+	}
+	current, err = r.client.GetDomainsByCompose(composeID)
+	if err != nil {
+		diags.AddError("Error reading compose domains", err.Error())
+		return false
+	}
+	plan.Domains = composeDomainsFromAPI(plan.Domains, current)
+	return true
+}
+
+// readStack sets the stack deploy details of a compose_type = "stack"
+// compose. Failing to list swarm services is reported as a warning, since the
+// stack may not be deployed yet.
+func (r *ComposeResource) readStack(state *ComposeResourceModel, diags *diag.Diagnostics) {
+	state.StackServices = nil
+	state.StackNetworks = types.ListNull(types.StringType)
+	if state.ComposeType.ValueString() != "stack" {
+		return
+	}
+
+	stack := state.AppName.ValueString()
+	networks, err := composeStackNetworks(stack, state.ComposeFileContent.ValueString())
+	if err != nil {
+		diags.AddWarning("Unable to Read Stack Networks", err.Error())
+	} else {
+		values := make([]attr.Value, 0, len(networks))
+		for _, n := range networks {
+			values = append(values, types.StringValue(n))
+		}
+		state.StackNetworks = types.ListValueMust(types.StringType, values)
+	}
+
+	services, err := r.client.ListSwarmServices(state.ServerID.ValueString())
+	if err != nil {
+		diags.AddWarning("Unable to Read Stack Services", err.Error())
+		return
+	}
+	state.StackServices = stackServicesFromSwarm(stack, services)
+}
+
+// readServiceStatus sets the container state of each service of the stack.
+func (r *ComposeResource) readServiceStatus(state *ComposeResourceModel, diags *diag.Diagnostics) {
+	state.ServiceStatus = types.MapNull(types.StringType)
+
+	appName, composeType := state.AppName.ValueString(), state.ComposeType.ValueString()
+	containers, err := r.client.ListComposeContainers(appName, composeType, state.ServerID.ValueString())
+	if err != nil {
+		diags.AddWarning("Unable to Read Service Status", err.Error())
+		return
+	}
+
+	values := map[string]attr.Value{}
+	for service, status := range composeServiceStatus(appName, composeType, containers) {
+		values[service] = types.StringValue(status)
+	}
+	state.ServiceStatus = types.MapValueMust(types.StringType, values)
+}
+
+// composeServiceStatus returns the state of the containers of each service
+// of the stack appName. A service is running when any of its containers is,
+// which also covers the stopped tasks swarm keeps of a stack's services;
+// otherwise it takes the state of its first container by name.
+func composeServiceStatus(appName, composeType string, containers []client.Container) map[string]string {
+	sorted := append([]client.Container(nil), containers...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	result := map[string]string{}
+	for _, c := range sorted {
+		service, ok := composeContainerService(appName, composeType, c.Name)
+		if !ok {
+			continue
+		}
+		if prior, seen := result[service]; !seen || (prior != "running" && c.State == "running") {
+			result[service] = c.State
+		}
+	}
+	return result
+}
+
+// composeContainerService returns the compose service of a container:
+// containers are named <appName>-<service>-<index> by docker compose and
+// <appName>_<service>.<slot>.<task> by stack deploys.
+func composeContainerService(appName, composeType, name string) (string, bool) {
+	name = strings.TrimPrefix(name, "/")
+	if composeType == "stack" {
+		rest, ok := strings.CutPrefix(name, appName+"_")
+		if !ok {
+			return "", false
+		}
+		service, _, _ := strings.Cut(rest, ".")
+		return service, service != ""
+	}
+
+	rest, ok := strings.CutPrefix(name, appName+"-")
+	if !ok {
+		return "", false
+	}
+	i := strings.LastIndex(rest, "-")
+	if i <= 0 {
+		return "", false
+	}
+	if _, err := strconv.Atoi(rest[i+1:]); err != nil {
+		return "", false
+	}
+	return rest[:i], true
+}
+
+// stackServicesFromSwarm returns the swarm services that belong to a stack,
+// sorted by name. Stack services are named <stack>_<service>.
+func stackServicesFromSwarm(stack string, services []client.SwarmService) []composeStackServiceModel {
+	result := []composeStackServiceModel{}
+	for _, svc := range services {
+		serviceName, ok := strings.CutPrefix(svc.Name, stack+"_")
+		if !ok {
+			continue
+		}
+		running, desired := parseSwarmReplicas(svc.Replicas)
+		result = append(result, composeStackServiceModel{
+			Name:            types.StringValue(svc.Name),
+			ServiceName:     types.StringValue(serviceName),
+			Image:           types.StringValue(svc.Image),
+			Mode:            types.StringValue(svc.Mode),
+			RunningReplicas: types.Int64Value(running),
+			DesiredReplicas: types.Int64Value(desired),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name.ValueString() < result[j].Name.ValueString()
+	})
+	return result
+}
+
+// parseSwarmReplicas parses the replicas column of docker service ls, e.g.
+// "2/3" or "1/1 (max 1 per node)".
+func parseSwarmReplicas(replicas string) (running, desired int64) {
+	if _, err := fmt.Sscanf(replicas, "%d/%d", &running, &desired); err != nil {
+		return 0, 0
+	}
+	return running, desired
+}
+
+// composeStackNetworks returns the networks docker stack deploy creates or
+// attaches to for a compose file, sorted. Declared networks are prefixed
+// with the stack name unless they are external or explicitly named, and the
+// stack's default network is included when a service does not list any.
+func composeStackNetworks(stack, content string) ([]string, error) {
+	if content == "" {
+		return []string{}, nil
+	}
+
+	var doc struct {
+		Services map[string]struct {
+			Networks interface{} `yaml:"networks"`
+		} `yaml:"services"`
+		Networks map[string]*struct {
+			Name     string      `yaml:"name"`
+			External interface{} `yaml:"external"`
+		} `yaml:"networks"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("compose file is not valid YAML: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for key, network := range doc.Networks {
+		name := stack + "_" + key
+		if network != nil {
+			switch external := network.External.(type) {
+			case bool:
+				if external {
+					name = key
+				}
+			case map[string]interface{}:
+				// Legacy form: external: { name: foo }
+				name = key
+				if n, ok := external["name"].(string); ok && n != "" {
+					name = n
+				}
+			}
+			if network.Name != "" {
+				name = network.Name
+			}
+		}
+		seen[name] = true
+	}
+	for _, svc := range doc.Services {
+		if svc.Networks == nil {
+			seen[stack+"_default"] = true
+			break
+		}
+	}
+
+	networks := make([]string, 0, len(seen))
+	for name := range seen {
+		networks = append(networks, name)
+	}
+	sort.Strings(networks)
+	return networks, nil
+}
+
 func inferComposeSourceType(plan *ComposeResourceModel) types.String {
 	if !plan.ComposeFileContent.IsNull() && !plan.ComposeFileContent.IsUnknown() && plan.ComposeFileContent.ValueString() != "" {
 		return types.StringValue("raw")
@@ -780,6 +1348,70 @@ func inferComposeSourceType(plan *ComposeResourceModel) types.String {
 	return types.StringValue("github")
 }
 
+// composeTopLevelKeys are the top-level keys allowed by the compose specification.
+var composeTopLevelKeys = map[string]bool{
+	"version":  true,
+	"name":     true,
+	"include":  true,
+	"services": true,
+	"networks": true,
+	"volumes":  true,
+	"configs":  true,
+	"secrets":  true,
+}
+
+// validateComposeFile performs structural checks on a docker-compose document.
+func validateComposeFile(content string) error {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return fmt.Errorf("compose file is not valid YAML: %w", err)
+	}
+	if doc == nil {
+		return fmt.Errorf("compose file is empty")
+	}
+
+	var unknown []string
+	for key := range doc {
+		if !composeTopLevelKeys[key] && !strings.HasPrefix(key, "x-") {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown top-level keys: %s", strings.Join(unknown, ", "))
+	}
+
+	// Services can also come from other compose files pulled in with include.
+	services, ok := doc["services"].(map[string]interface{})
+	if !ok || len(services) == 0 {
+		if includes, ok := doc["include"].([]interface{}); ok && len(includes) > 0 {
+			return nil
+		}
+		return fmt.Errorf("compose file must define at least one service under 'services' or include other compose files under 'include'")
+	}
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		svc, ok := services[name].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("service %q must be a mapping", name)
+		}
+		_, hasImage := svc["image"]
+		_, hasBuild := svc["build"]
+		_, hasExtends := svc["extends"]
+		if !hasImage && !hasBuild && !hasExtends {
+			return fmt.Errorf("service %q must specify 'image' or 'build'", name)
+		}
+	}
+
+	return nil
+}
+
 func readComposeIntoState(ctx context.Context, state *ComposeResourceModel, comp *client.Compose, diags *diag.Diagnostics) {
 	state.Name = types.StringValue(comp.Name)
 
@@ -800,7 +1432,9 @@ func readComposeIntoState(ctx context.Context, state *ComposeResourceModel, comp
 
 	// Compose file
 	if comp.ComposeFile != "" {
-		state.ComposeFileContent = types.StringValue(comp.ComposeFile)
+		if !composeFileMatches(ctx, state, comp.ComposeFile) {
+			state.ComposeFileContent = types.StringValue(comp.ComposeFile)
+		}
 	} else if state.ComposeFileContent.IsUnknown() {
 		state.ComposeFileContent = types.StringNull()
 	}
@@ -930,15 +1564,7 @@ func readComposeIntoState(ctx context.Context, state *ComposeResourceModel, comp
 	state.IsolatedDeployment = types.BoolValue(comp.IsolatedDeployment)
 	state.IsolatedDeploymentsVolume = types.BoolValue(comp.IsolatedDeploymentsVolume)
 
-	// WatchPaths - convert []string to types.List
-	if len(comp.WatchPaths) > 0 {
-		watchPathsList, d := types.ListValueFrom(ctx, types.StringType, comp.WatchPaths)
-		diags.Append(d...)
-		state.WatchPaths = watchPathsList
-	} else {
-		// Set to null when API returns empty array to handle drift properly
-		state.WatchPaths = types.ListNull(types.StringType)
-	}
+	state.WatchPaths = watchPathsValue(state.WatchPaths, comp.WatchPaths)
 
 	// Computed status fields
 	if comp.ComposeStatus != "" {
@@ -957,3 +1583,12 @@ func readComposeIntoState(ctx context.Context, state *ComposeResourceModel, comp
 		state.CreatedAt = types.StringNull()
 	}
 }
+
+// composeWebhookURL returns the deploy webhook URL Dokploy serves for a
+// compose stack with the given refresh token, next to the API at endpoint.
+func composeWebhookURL(endpoint string, token types.String) types.String {
+	if token.IsNull() || token.IsUnknown() {
+		return types.StringNull()
+	}
+	return types.StringValue(strings.TrimSuffix(endpoint, "/") + "/deploy/compose/" + token.ValueString())
+}
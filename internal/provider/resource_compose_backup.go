@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+var _ resource.Resource = &ComposeBackupResource{}
+var _ resource.ResourceWithImportState = &ComposeBackupResource{}
+var _ resource.ResourceWithValidateConfig = &ComposeBackupResource{}
+
+func NewComposeBackupResource() resource.Resource {
+	return &ComposeBackupResource{}
+}
+
+type ComposeBackupResource struct {
+	client client.Client
+}
+
+type ComposeBackupResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ComposeID            types.String `tfsdk:"compose_id"`
+	ServiceName          types.String `tfsdk:"service_name"`
+	DatabaseType         types.String `tfsdk:"database_type"`
+	DestinationID        types.String `tfsdk:"destination_id"`
+	Schedule             types.String `tfsdk:"schedule"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Prefix               types.String `tfsdk:"prefix"`
+	Database             types.String `tfsdk:"database"`
+	KeepLatestCount      types.Int64  `tfsdk:"keep_latest_count"`
+	DatabaseUser         types.String `tfsdk:"database_user"`
+	DatabasePassword     types.String `tfsdk:"database_password"`
+	DatabaseRootPassword types.String `tfsdk:"database_root_password"`
+}
+
+func (r *ComposeBackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_backup"
+}
+
+func (r *ComposeBackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages automated backups of a database running as a service inside a Dokploy compose.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for the backup.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compose_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the compose that runs the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the database service in the compose file. Must match a key under 'services'.",
+			},
+			"database_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of database the service runs: postgres, mysql, mariadb, or mongo.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("postgres", "mysql", "mariadb", "mongo"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the backup destination (S3, MinIO, etc.).",
+			},
+			"schedule": schema.StringAttribute{
+				Required:    true,
+				Description: "Cron schedule for backups (e.g., '0 2 * * *' for daily at 2 AM).",
+				Validators: []validator.String{
+					cronValidator{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the backup schedule is enabled.",
+			},
+			"prefix": schema.StringAttribute{
+				Required:    true,
+				Description: "Prefix for backup files.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the database to back up.",
+			},
+			"keep_latest_count": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Description: "Number of recent backups to keep (older ones are deleted).",
+			},
+			"database_user": schema.StringAttribute{
+				Optional:    true,
+				Description: "User to connect as. Required for postgres, mariadb, and mongo.",
+			},
+			"database_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password for database_user. Required for mariadb and mongo.",
+			},
+			"database_root_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Root password. Required for mysql.",
+			},
+		},
+	}
+}
+
+func (r *ComposeBackupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// composeBackupCredentialAttributes lists the credential attributes each
+// database type needs for Dokploy to dump it.
+var composeBackupCredentialAttributes = map[string][]string{
+	"postgres": {"database_user"},
+	"mysql":    {"database_root_password"},
+	"mariadb":  {"database_user", "database_password"},
+	"mongo":    {"database_user", "database_password"},
+}
+
+func (r *ComposeBackupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ComposeBackupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DatabaseType.IsNull() || config.DatabaseType.IsUnknown() {
+		return
+	}
+	databaseType := config.DatabaseType.ValueString()
+
+	values := map[string]types.String{
+		"database_user":          config.DatabaseUser,
+		"database_password":      config.DatabasePassword,
+		"database_root_password": config.DatabaseRootPassword,
+	}
+	for _, attr := range composeBackupCredentialAttributes[databaseType] {
+		if values[attr].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Missing Backup Credentials",
+				fmt.Sprintf("%s is required when database_type is %q.", attr, databaseType),
+			)
+		}
+	}
+}
+
+func (r *ComposeBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ComposeBackupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateServiceName(plan.ComposeID.ValueString(), plan.ServiceName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup := composeBackupFromModel(plan)
+	backup.BackupType = "compose"
+	backup.ComposeID = plan.ComposeID.ValueString()
+
+	createdBackup, err := r.client.CreateBackup(backup)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating compose backup", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(createdBackup.BackupID)
+	readComposeBackupIntoModel(&plan, createdBackup)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ComposeBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ComposeBackupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.GetBackup(state.ID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading compose backup", err.Error())
+		return
+	}
+
+	if backup.BackupType != "" && backup.BackupType != "compose" {
+		resp.Diagnostics.AddError(
+			"Unexpected Backup Type",
+			fmt.Sprintf("Backup %s is a %q backup. Use the dokploy_backup resource to manage it.", state.ID.ValueString(), backup.BackupType),
+		)
+		return
+	}
+
+	state.ComposeID = types.StringValue(backup.ComposeID)
+	state.DestinationID = types.StringValue(backup.DestinationID)
+	if backup.DatabaseType != "" {
+		state.DatabaseType = types.StringValue(backup.DatabaseType)
+	}
+	readComposeBackupIntoModel(&state, backup)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ComposeBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ComposeBackupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateServiceName(plan.ComposeID.ValueString(), plan.ServiceName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup := composeBackupFromModel(plan)
+	backup.BackupID = plan.ID.ValueString()
+
+	updatedBackup, err := r.client.UpdateBackup(backup)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating compose backup", err.Error())
+		return
+	}
+
+	readComposeBackupIntoModel(&plan, updatedBackup)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ComposeBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ComposeBackupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteChild(ctx, func() error { return r.client.DeleteBackup(state.ID.ValueString()) }, serviceParent(r.client, "compose", state.ComposeID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting compose backup", err.Error())
+		return
+	}
+}
+
+func (r *ComposeBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateServiceName checks that serviceName is defined in the compose's
+// file. Composes sourced from git have no file content in Dokploy until they
+// are deployed, so they are not checked.
+func (r *ComposeBackupResource) validateServiceName(composeID, serviceName string, diags *diag.Diagnostics) {
+	comp, err := r.client.GetCompose(composeID)
+	if err != nil {
+		diags.AddError("Error reading compose", err.Error())
+		return
+	}
+	if comp.ComposeFile == "" {
+		return
+	}
+
+	services, err := composeServiceNames(comp.ComposeFile)
+	if err != nil {
+		diags.AddError("Error parsing compose file", err.Error())
+		return
+	}
+	for _, name := range services {
+		if name == serviceName {
+			return
+		}
+	}
+	diags.AddAttributeError(
+		path.Root("service_name"),
+		"Unknown Compose Service",
+		fmt.Sprintf("Service %q is not defined in the compose file. Available services: %s.", serviceName, strings.Join(services, ", ")),
+	)
+}
+
+// composeServiceNames returns the sorted service names of a compose file.
+func composeServiceNames(content string) ([]string, error) {
+	var doc struct {
+		Services map[string]interface{} `yaml:"services"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("compose file is not valid YAML: %w", err)
+	}
+
+	names := make([]string, 0, len(doc.Services))
+	for name := range doc.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func composeBackupFromModel(plan ComposeBackupResourceModel) client.Backup {
+	creds := &client.BackupCredentials{
+		DatabaseUser:         plan.DatabaseUser.ValueString(),
+		DatabasePassword:     plan.DatabasePassword.ValueString(),
+		DatabaseRootPassword: plan.DatabaseRootPassword.ValueString(),
+	}
+
+	metadata := &client.BackupMetadata{}
+	switch plan.DatabaseType.ValueString() {
+	case "postgres":
+		metadata.Postgres = creds
+	case "mysql":
+		metadata.MySQL = creds
+	case "mariadb":
+		metadata.MariaDB = creds
+	case "mongo":
+		metadata.Mongo = creds
+	}
+
+	return client.Backup{
+		DestinationID:   plan.DestinationID.ValueString(),
+		Schedule:        plan.Schedule.ValueString(),
+		Enabled:         plan.Enabled.ValueBool(),
+		Prefix:          plan.Prefix.ValueString(),
+		Database:        plan.Database.ValueString(),
+		KeepLatestCount: int(plan.KeepLatestCount.ValueInt64()),
+		DatabaseType:    plan.DatabaseType.ValueString(),
+		ServiceName:     plan.ServiceName.ValueString(),
+		Metadata:        metadata,
+	}
+}
+
+// readComposeBackupIntoModel copies the fields the API echoes back. The
+// credentials are kept from configuration since they are not returned
+// reliably.
+func readComposeBackupIntoModel(model *ComposeBackupResourceModel, backup *client.Backup) {
+	model.Schedule = types.StringValue(backup.Schedule)
+	model.Enabled = types.BoolValue(backup.Enabled)
+	model.Prefix = types.StringValue(backup.Prefix)
+	model.Database = types.StringValue(backup.Database)
+	model.KeepLatestCount = types.Int64Value(int64(backup.KeepLatestCount))
+	if backup.ServiceName != "" {
+		model.ServiceName = types.StringValue(backup.ServiceName)
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccComposeBackupResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown service name is rejected
+			{
+				Config:      testAccComposeBackupResourceConfig("cache", "0 4 * * *"),
+				ExpectError: regexp.MustCompile("Unknown Compose Service"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccComposeBackupResourceConfig("db", "0 4 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_compose_backup.test", "service_name", "db"),
+					resource.TestCheckResourceAttr("dokploy_compose_backup.test", "database_type", "postgres"),
+					resource.TestCheckResourceAttr("dokploy_compose_backup.test", "schedule", "0 4 * * *"),
+					resource.TestCheckResourceAttrSet("dokploy_compose_backup.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_compose_backup.test", "compose_id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccComposeBackupResourceConfig("db", "0 5 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_compose_backup.test", "schedule", "0 5 * * *"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "dokploy_compose_backup.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"database_user"},
+			},
+		},
+	})
+}
+
+func TestComposeServiceNames(t *testing.T) {
+	names, err := composeServiceNames(`
+services:
+  web:
+    image: nginx
+  db:
+    image: postgres:16
+volumes:
+  data:
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []string{"db", "web"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+
+	if _, err := composeServiceNames("services: ["); err == nil {
+		t.Error("expected an error for invalid YAML")
+	}
+}
+
+func testAccComposeBackupResourceConfig(serviceName, schedule string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-compose-backup-resource"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-compose-backup-env"
+}
+
+resource "dokploy_compose" "test" {
+  name           = "tf-acc-compose-backup-resource"
+  environment_id = dokploy_environment.test.id
+  source_type    = "raw"
+  compose_file_content = <<-EOT
+services:
+  db:
+    image: postgres:16
+    environment:
+      POSTGRES_PASSWORD: testpass123
+      POSTGRES_DB: testdb
+EOT
+}
+
+resource "dokploy_destination" "test" {
+  name              = "tf-acc-compose-backup-dest"
+  storage_provider  = "s3"
+  access_key        = "test-access-key"
+  secret_access_key = "test-secret-key"
+  bucket            = "test-compose-backups"
+  region            = "us-east-1"
+  endpoint          = "https://s3.amazonaws.com"
+}
+
+resource "dokploy_compose_backup" "test" {
+  compose_id     = dokploy_compose.test.id
+  service_name   = "%s"
+  database_type  = "postgres"
+  database       = "testdb"
+  database_user  = "postgres"
+  destination_id = dokploy_destination.test.id
+  schedule       = "%s"
+  prefix         = "compose-db"
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), serviceName, schedule)
+}
@@ -1,10 +1,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
-
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -38,7 +52,7 @@ services:
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccComposeResourceConfig("test-compose-project", "test-env", "test-compose", composeContentV1, false),
+				Config: testAccComposeResourceConfig("tf-acc-compose-project", "test-env", "test-compose", composeContentV1, false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_compose.test", "name", "test-compose"),
 					resource.TestCheckResourceAttrSet("dokploy_compose.test", "id"),
@@ -48,7 +62,7 @@ services:
 			},
 			// Update and Read testing - change name and compose_file_content
 			{
-				Config: testAccComposeResourceConfig("test-compose-project", "test-env", "test-compose-updated", composeContentV2, false),
+				Config: testAccComposeResourceConfig("tf-acc-compose-project", "test-env", "test-compose-updated", composeContentV2, false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_compose.test", "name", "test-compose-updated"),
 				),
@@ -113,7 +127,7 @@ services:
 		Steps: []resource.TestStep{
 			// Create without explicit source_type - should infer "raw" from compose_file_content
 			{
-				Config: testAccComposeResourceInferRawConfig("test-compose-infer-raw", "test-env-infer-raw", "test-infer-raw", composeContent),
+				Config: testAccComposeResourceInferRawConfig("tf-acc-compose-infer-raw", "test-env-infer-raw", "test-infer-raw", composeContent),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_compose.test", "name", "test-infer-raw"),
 					resource.TestCheckResourceAttr("dokploy_compose.test", "source_type", "raw"),
@@ -166,7 +180,7 @@ func TestAccComposeResourceInferGitType(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create without explicit source_type - should infer "git" from custom_git_url
 			{
-				Config: testAccComposeResourceInferGitConfig("test-compose-infer-git", "test-env-infer-git", "test-infer-git"),
+				Config: testAccComposeResourceInferGitConfig("tf-acc-compose-infer-git", "test-env-infer-git", "test-infer-git"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_compose.test", "name", "test-infer-git"),
 					resource.TestCheckResourceAttr("dokploy_compose.test", "source_type", "git"),
@@ -227,7 +241,7 @@ services:
 		Steps: []resource.TestStep{
 			// Create with extended settings
 			{
-				Config: testAccComposeResourceExtendedConfig("test-compose-ext-project", "test-compose-ext-env", "test-compose-ext", composeContent, "Test compose description", "ENV_VAR=value1"),
+				Config: testAccComposeResourceExtendedConfig("tf-acc-compose-ext-project", "test-compose-ext-env", "test-compose-ext", composeContent, "Test compose description", "ENV_VAR=value1"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_compose.test", "name", "test-compose-ext"),
 					resource.TestCheckResourceAttr("dokploy_compose.test", "description", "Test compose description"),
@@ -237,7 +251,7 @@ services:
 			},
 			// Update extended settings
 			{
-				Config: testAccComposeResourceExtendedConfig("test-compose-ext-project", "test-compose-ext-env", "test-compose-ext-updated", composeContent, "Updated compose description", "ENV_VAR=value2"),
+				Config: testAccComposeResourceExtendedConfig("tf-acc-compose-ext-project", "test-compose-ext-env", "test-compose-ext-updated", composeContent, "Updated compose description", "ENV_VAR=value2"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_compose.test", "name", "test-compose-ext-updated"),
 					resource.TestCheckResourceAttr("dokploy_compose.test", "description", "Updated compose description"),
@@ -277,3 +291,494 @@ EOF
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, composeName, description, composeContent, env)
 }
+
+// TestAccComposeResourceValidateCompose tests that malformed compose files fail at plan time.
+func TestAccComposeResourceValidateCompose(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Service without image or build
+			{
+				Config: testAccComposeResourceValidateConfig("tf-acc-compose-validate", "test-env-validate", "test-validate", `services:
+  web:
+    ports:
+      - "80:80"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Compose File"),
+			},
+			// Malformed YAML
+			{
+				Config: testAccComposeResourceValidateConfig("tf-acc-compose-validate", "test-env-validate", "test-validate", `services:
+  web:
+  image: [nginx`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("not valid YAML"),
+			},
+			// Valid compose file
+			{
+				Config: testAccComposeResourceValidateConfig("tf-acc-compose-validate", "test-env-validate", "test-validate", `services:
+  web:
+    image: nginx:latest`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_compose.test", "validate_compose", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComposeResourceValidateConfig(projectName, envName, composeName, composeContent string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for compose validation tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_compose" "test" {
+  environment_id   = dokploy_environment.test.id
+  name             = "%s"
+  source_type      = "raw"
+  validate_compose = true
+  compose_file_content = <<EOF
+%s
+EOF
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, composeName, composeContent)
+}
+
+func TestValidateComposeFile(t *testing.T) {
+	tests := []struct {
+		name, content, wantErr string
+	}{
+		{name: "service", content: "services:\n  web:\n    image: nginx\n"},
+		{name: "include only", content: "include:\n  - ./web/compose.yml\n  - path: ./db/compose.yml\n"},
+		{name: "include and services", content: "include: [./db/compose.yml]\nservices:\n  web:\n    build: .\n"},
+		{name: "empty", content: "", wantErr: "compose file is empty"},
+		{name: "no services", content: "volumes:\n  data: {}\n", wantErr: "at least one service"},
+		{name: "empty include", content: "include: []\n", wantErr: "at least one service"},
+		{name: "unknown key", content: "servcies: {}\n", wantErr: "unknown top-level keys: servcies"},
+		{name: "service without image", content: "services:\n  web:\n    ports: ['80:80']\n", wantErr: `service "web" must specify 'image' or 'build'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateComposeFile(tt.content)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateComposeFile() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateComposeFile() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestComposeStackNetworks(t *testing.T) {
+	content := `
+services:
+  web:
+    image: nginx
+    networks: [frontend, dokploy-network]
+  worker:
+    image: worker
+networks:
+  frontend:
+  dokploy-network:
+    external: true
+  shared:
+    name: shared-net
+`
+	got, err := composeStackNetworks("blog-a1b2c3", content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"blog-a1b2c3_default", "blog-a1b2c3_frontend", "dokploy-network", "shared-net"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("networks = %v, want %v", got, want)
+	}
+
+	if _, err := composeStackNetworks("blog", "services: ["); err == nil {
+		t.Error("expected an error for invalid YAML")
+	}
+}
+
+func TestStackServicesFromSwarm(t *testing.T) {
+	services := []client.SwarmService{
+		{Name: "blog-a1b2c3_web", Mode: "replicated", Replicas: "2/3", Image: "nginx:alpine"},
+		{Name: "other_web", Mode: "replicated", Replicas: "1/1", Image: "nginx"},
+		{Name: "blog-a1b2c3_agent", Mode: "global", Replicas: "1/1 (max 1 per node)", Image: "agent"},
+	}
+
+	got := stackServicesFromSwarm("blog-a1b2c3", services)
+	if len(got) != 2 {
+		t.Fatalf("got %d services, want 2", len(got))
+	}
+	if got[0].ServiceName.ValueString() != "agent" || got[0].DesiredReplicas.ValueInt64() != 1 {
+		t.Errorf("first service = %+v, want agent with 1 replica", got[0])
+	}
+	if got[1].ServiceName.ValueString() != "web" || got[1].RunningReplicas.ValueInt64() != 2 || got[1].DesiredReplicas.ValueInt64() != 3 {
+		t.Errorf("second service = %+v, want web with 2/3 replicas", got[1])
+	}
+}
+
+func TestComposeServiceStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		composeType string
+		containers  []client.Container
+		want        map[string]string
+	}{
+		{
+			name:        "docker compose",
+			composeType: "docker-compose",
+			containers: []client.Container{
+				{Name: "shop-a1b2c3-web-1", State: "running"},
+				{Name: "shop-a1b2c3-web-2", State: "exited"},
+				{Name: "shop-a1b2c3-db-migrate-1", State: "exited"},
+				{Name: "shop-a1b2c3-beta-web-1", State: "running"},
+				{Name: "shop-a1b2c3-worker-1", State: "restarting"},
+			},
+			want: map[string]string{"web": "running", "db-migrate": "exited", "beta-web": "running", "worker": "restarting"},
+		},
+		{
+			name:        "stack with failed tasks",
+			composeType: "stack",
+			containers: []client.Container{
+				{Name: "shop-a1b2c3_web.1.abc", State: "exited"},
+				{Name: "shop-a1b2c3_web.1.def", State: "running"},
+				{Name: "shop-a1b2c3_worker.1.ghi", State: "exited"},
+				{Name: "other_web.1.jkl", State: "running"},
+			},
+			want: map[string]string{"web": "running", "worker": "exited"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := composeServiceStatus("shop-a1b2c3", tt.composeType, tt.containers)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("composeServiceStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComposeRedeployOnChange(t *testing.T) {
+	mock := clientmock.New()
+	var redeployed []string
+	mock.RedeployComposeFunc = func(id string) error {
+		redeployed = append(redeployed, id)
+		if id == "broken" {
+			return fmt.Errorf("redeploy failed")
+		}
+		return nil
+	}
+	r := &ComposeResource{client: mock}
+
+	v1 := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("hash-1")})
+	v2 := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("hash-2")})
+
+	tests := []struct {
+		name         string
+		id           string
+		prior, plan  types.List
+		wantRedeploy bool
+		wantError    bool
+	}{
+		{name: "unchanged", id: "c1", prior: v1, plan: v1},
+		{name: "removed", id: "c1", prior: v1, plan: types.ListNull(types.StringType)},
+		{name: "changed", id: "c1", prior: v1, plan: v2, wantRedeploy: true},
+		{name: "added", id: "c1", prior: types.ListNull(types.StringType), plan: v1, wantRedeploy: true},
+		{name: "failed", id: "broken", prior: v1, plan: v2, wantRedeploy: true, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redeployed = nil
+			plan := ComposeResourceModel{ID: types.StringValue(tt.id), RedeployOn: tt.plan}
+			state := ComposeResourceModel{ID: types.StringValue(tt.id), RedeployOn: tt.prior}
+			var diags diag.Diagnostics
+
+			r.redeployOnChange(context.Background(), &plan, &state, &diags)
+
+			if got := len(redeployed) == 1; got != tt.wantRedeploy {
+				t.Errorf("redeployed = %v, want %v", redeployed, tt.wantRedeploy)
+			}
+			if diags.HasError() != tt.wantError {
+				t.Errorf("diagnostics = %v, want error %v", diags, tt.wantError)
+			}
+			if tt.wantError && !plan.RedeployOn.Equal(tt.prior) {
+				t.Errorf("redeploy_on = %s after failure, want prior %s", plan.RedeployOn, tt.prior)
+			}
+		})
+	}
+}
+
+func TestComposeImportByAppName(t *testing.T) {
+	ctx := context.Background()
+	mock := clientmock.New()
+	mock.FindComposeByAppNameFunc = func(appName string) (*client.Compose, error) {
+		if appName == "stack-a1b2c3" {
+			return &client.Compose{ID: "compose-1", AppName: appName}, nil
+		}
+		return nil, fmt.Errorf("%w: compose with appName %q", client.ErrNotFound, appName)
+	}
+	r := &ComposeResource{client: mock}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for importID, want := range map[string]string{
+		"stack-a1b2c3": "compose-1",
+		"compose-2":    "compose-2",
+	} {
+		resp := &fwresource.ImportStateResponse{State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: importID}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+		var id types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		if id.ValueString() != want {
+			t.Errorf("importing %q set id %q, want %q", importID, id.ValueString(), want)
+		}
+	}
+}
+
+func TestComposeReadAfterImport(t *testing.T) {
+	ctx := context.Background()
+	mock := clientmock.New()
+	mock.GetComposeFunc = func(id string) (*client.Compose, error) {
+		return &client.Compose{
+			ID:          id,
+			Name:        "shop",
+			ComposePath: "./deploy/compose.yml",
+			ComposeType: "docker-compose",
+			SourceType:  "git",
+			Env:         "TAG=1.2.3",
+			Randomize:   true,
+			WatchPaths:  []string{"deploy/**"},
+			Domains:     []client.Domain{{Host: "shop.example.com", Path: "/", Port: 80, ServiceName: "web"}},
+		}, nil
+	}
+	r := &ComposeResource{client: mock}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	importResp := &fwresource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "compose-1"}, importResp)
+	readResp := &fwresource.ReadResponse{State: importResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+
+	var state ComposeResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+	if state.ComposePath.ValueString() != "./deploy/compose.yml" || state.Env.ValueString() != "TAG=1.2.3" ||
+		!state.Randomize.ValueBool() || len(state.WatchPaths.Elements()) != 1 {
+		t.Errorf("imported state was not hydrated: %+v", state)
+	}
+	if len(state.Domains) != 1 || state.Domains[0].ServiceName.ValueString() != "web" {
+		t.Errorf("domains = %v", state.Domains)
+	}
+}
+
+func TestAccComposeResourceRedeployOn(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComposeResourceRedeployOnConfig("worker_processes 1;"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_compose.test", "redeploy_on.#", "1"),
+				),
+			},
+			{
+				Config: testAccComposeResourceRedeployOnConfig("worker_processes 2;"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("dokploy_compose.test", "redeploy_on.0", "dokploy_mount.config", "content_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComposeResourceRedeployOnConfig(config string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+locals {
+  nginx_conf = <<-EOT
+    %s
+    events {}
+  EOT
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-compose-redeploy-on-project"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-compose-redeploy-on-env"
+}
+
+resource "dokploy_compose" "test" {
+  environment_id       = dokploy_environment.test.id
+  name                 = "test-compose-redeploy-on"
+  source_type          = "raw"
+  compose_file_content = <<-EOT
+    services:
+      web:
+        image: nginx:alpine
+        volumes:
+          - ../files/nginx.conf:/etc/nginx/nginx.conf:ro
+  EOT
+
+  redeploy_on = [sha256(local.nginx_conf)]
+}
+
+resource "dokploy_mount" "config" {
+  service_id   = dokploy_compose.test.id
+  service_type = "compose"
+  type         = "file"
+  file_path    = "nginx.conf"
+  mount_path   = "/etc/nginx/nginx.conf"
+  content      = local.nginx_conf
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), config)
+}
+
+func TestComposeRedeployOnChangeReportsFailedDeployment(t *testing.T) {
+	defer func(interval time.Duration) { deploymentInterval = interval }(deploymentInterval)
+	deploymentInterval = time.Millisecond
+
+	mock := clientmock.New()
+	deployments := []client.Deployment{{DeploymentID: "dep-1", Status: "done"}}
+	mock.ListDeploymentsByTypeFunc = func(id, deploymentType string) ([]client.Deployment, error) {
+		return deployments, nil
+	}
+	mock.RedeployComposeFunc = func(id string) error {
+		message := "exit code 1"
+		deployments = append([]client.Deployment{{DeploymentID: "dep-2", Status: "error", LogPath: "/logs/dep-2.log", ErrorMessage: &message}}, deployments...)
+		return nil
+	}
+	var log strings.Builder
+	for i := 1; i <= 80; i++ {
+		fmt.Fprintf(&log, "step %d\n", i)
+	}
+	mock.ReadDeploymentLogFunc = func(logPath, serverID string) (string, error) {
+		if logPath != "/logs/dep-2.log" || serverID != "srv-1" {
+			t.Errorf("ReadDeploymentLog(%q, %q)", logPath, serverID)
+		}
+		return log.String(), nil
+	}
+	r := &ComposeResource{client: mock}
+
+	prior := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("hash-1")})
+	plan := ComposeResourceModel{
+		ID:                types.StringValue("c1"),
+		ServerID:          types.StringValue("srv-1"),
+		RedeployOn:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("hash-2")}),
+		WaitForDeployment: types.BoolValue(true),
+	}
+	state := ComposeResourceModel{ID: types.StringValue("c1"), RedeployOn: prior}
+	var diags diag.Diagnostics
+
+	r.redeployOnChange(context.Background(), &plan, &state, &diags)
+
+	if !diags.HasError() {
+		t.Fatal("expected an error for the failed deployment")
+	}
+	detail := diags.Errors()[0].Detail()
+	for _, want := range []string{"dep-2", "exit code 1", "step 31\n", "step 80"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail does not contain %q:\n%s", want, detail)
+		}
+	}
+	if strings.Contains(detail, "step 30\n") {
+		t.Errorf("detail contains more than %d log lines:\n%s", failedDeploymentLogLines, detail)
+	}
+	if !plan.RedeployOn.Equal(prior) {
+		t.Errorf("redeploy_on = %s after failure, want prior %s", plan.RedeployOn, prior)
+	}
+}
+
+func TestComposeRotateToken(t *testing.T) {
+	ctx := context.Background()
+	r := &ComposeResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	state.SetAttribute(ctx, path.Root("id"), "compose-1")
+	state.SetAttribute(ctx, path.Root("rotate_token"), "2024-01")
+	state.SetAttribute(ctx, path.Root("refresh_token"), "old-token")
+	state.SetAttribute(ctx, path.Root("webhook_url"), composeWebhookURL("https://dokploy.example.com/api", types.StringValue("old-token")))
+
+	for rotateToken, wantRotation := range map[string]bool{"2024-01": false, "2024-02": true} {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw.Copy()}
+		plan.SetAttribute(ctx, path.Root("rotate_token"), rotateToken)
+
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: state, Plan: plan, Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+		for _, name := range []string{"refresh_token", "webhook_url"} {
+			var value types.String
+			resp.Plan.GetAttribute(ctx, path.Root(name), &value)
+			if value.IsUnknown() != wantRotation {
+				t.Errorf("rotate_token %s: %s = %s, want unknown %v", rotateToken, name, value, wantRotation)
+			}
+		}
+	}
+
+	if got := composeWebhookURL("https://dokploy.example.com/api/", types.StringValue("tok")); got.ValueString() != "https://dokploy.example.com/api/deploy/compose/tok" {
+		t.Errorf("composeWebhookURL() = %s", got)
+	}
+	if got := composeWebhookURL("https://dokploy.example.com/api", types.StringNull()); !got.IsNull() {
+		t.Errorf("composeWebhookURL(null) = %s, want null", got)
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/url"
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
@@ -23,7 +22,7 @@ func NewDatabaseResource() resource.Resource {
 }
 
 type DatabaseResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type DatabaseResourceModel struct {
@@ -40,6 +39,7 @@ type DatabaseResourceModel struct {
 	AppName                  types.String `tfsdk:"app_name"`
 	InternalConnectionString types.String `tfsdk:"internal_connection_string"`
 	ExternalConnectionString types.String `tfsdk:"external_connection_string"`
+	DashboardURL             types.String `tfsdk:"dashboard_url"`
 }
 
 func (r *DatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -112,6 +112,7 @@ func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:  true,
 				Sensitive: true,
 			},
+			"dashboard_url": dashboardURLAttribute("database"),
 		},
 	}
 }
@@ -120,9 +121,9 @@ func (r *DatabaseResource) Configure(_ context.Context, req resource.ConfigureRe
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -174,6 +175,7 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 	)
 	plan.InternalConnectionString = types.StringValue(internalConnStr)
 	plan.ExternalConnectionString = types.StringValue(externalConnStr)
+	readDashboardURL(r.client, plan.Type.ValueString(), plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -226,6 +228,7 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	)
 	state.InternalConnectionString = types.StringValue(internalConnStr)
 	state.ExternalConnectionString = types.StringValue(externalConnStr)
+	readDashboardURL(r.client, state.Type.ValueString(), state.ID.ValueString(), state.EnvironmentID.ValueString(), &state.DashboardURL, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -292,26 +295,20 @@ func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), dbType)...)
 }
 
-func buildConnectionStrings(dbType, username, password, dbName string, internalPort, externalPort int64, appName, serverIP string) (internalConnStr, externalConnStr string) {
-	encodedPassword := url.QueryEscape(password)
+// databaseURLSchemes maps dokploy_database types to their URL schemes.
+var databaseURLSchemes = map[string]string{
+	"postgres": "postgresql",
+	"mysql":    "mysql",
+	"mariadb":  "mariadb",
+	"mongo":    "mongodb",
+	"redis":    "redis",
+}
 
-	switch dbType {
-	case "postgres":
-		internalConnStr = fmt.Sprintf("postgresql://%s:%s@%s:%d/%s", username, encodedPassword, appName, internalPort, dbName)
-		externalConnStr = fmt.Sprintf("postgresql://%s:%s@%s:%d/%s", username, encodedPassword, serverIP, externalPort, dbName)
-	case "mysql":
-		internalConnStr = fmt.Sprintf("mysql://%s:%s@%s:%d/%s", username, encodedPassword, appName, internalPort, dbName)
-		externalConnStr = fmt.Sprintf("mysql://%s:%s@%s:%d/%s", username, encodedPassword, serverIP, externalPort, dbName)
-	case "mariadb":
-		internalConnStr = fmt.Sprintf("mariadb://%s:%s@%s:%d/%s", username, encodedPassword, appName, internalPort, dbName)
-		externalConnStr = fmt.Sprintf("mariadb://%s:%s@%s:%d/%s", username, encodedPassword, serverIP, externalPort, dbName)
-	case "mongo":
-		internalConnStr = fmt.Sprintf("mongodb://%s:%s@%s:%d/%s", username, encodedPassword, appName, internalPort, dbName)
-		externalConnStr = fmt.Sprintf("mongodb://%s:%s@%s:%d/%s", username, encodedPassword, serverIP, externalPort, dbName)
-	case "redis":
-		internalConnStr = fmt.Sprintf("redis://:%s@%s:%d", encodedPassword, appName, internalPort)
-		externalConnStr = fmt.Sprintf("redis://:%s@%s:%d", encodedPassword, serverIP, externalPort)
+func buildConnectionStrings(dbType, username, password, dbName string, internalPort, externalPort int64, appName, serverIP string) (internalConnStr, externalConnStr string) {
+	scheme, ok := databaseURLSchemes[dbType]
+	if !ok {
+		return "", ""
 	}
-
-	return internalConnStr, externalConnStr
+	creds := databaseCredentials{scheme: scheme, username: username, password: password, databaseName: dbName}
+	return creds.connectionURL(appName, int(internalPort)), creds.connectionURL(serverIP, int(externalPort))
 }
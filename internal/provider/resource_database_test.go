@@ -22,7 +22,7 @@ func TestAccDatabaseResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccDatabaseResourceConfig("test-db-project", "test-db-env", "test-postgres-db", "postgres", "16"),
+				Config: testAccDatabaseResourceConfig("tf-acc-db-project", "test-db-env", "test-postgres-db", "postgres", "16"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_database.test", "name", "test-postgres-db"),
 					resource.TestCheckResourceAttr("dokploy_database.test", "type", "postgres"),
@@ -58,7 +58,7 @@ func TestAccDatabaseResourceMySQL(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccDatabaseResourceConfig("test-db-mysql-project", "test-db-mysql-env", "test-mysql-db", "mysql", "8"),
+				Config: testAccDatabaseResourceConfig("tf-acc-db-mysql-project", "test-db-mysql-env", "test-mysql-db", "mysql", "8"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_database.test", "name", "test-mysql-db"),
 					resource.TestCheckResourceAttr("dokploy_database.test", "type", "mysql"),
@@ -84,7 +84,7 @@ func TestAccDatabaseResourceMongoDB(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccDatabaseResourceConfig("test-db-mongo-project", "test-db-mongo-env", "test-mongo-db", "mongo", "7"),
+				Config: testAccDatabaseResourceConfig("tf-acc-db-mongo-project", "test-db-mongo-env", "test-mongo-db", "mongo", "7"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_database.test", "name", "test-mongo-db"),
 					resource.TestCheckResourceAttr("dokploy_database.test", "type", "mongo"),
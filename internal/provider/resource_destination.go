@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,24 +18,27 @@ import (
 
 var _ resource.Resource = &DestinationResource{}
 var _ resource.ResourceWithImportState = &DestinationResource{}
+var _ resource.ResourceWithValidateConfig = &DestinationResource{}
+var _ resource.ResourceWithModifyPlan = &DestinationResource{}
 
 func NewDestinationResource() resource.Resource {
 	return &DestinationResource{}
 }
 
 type DestinationResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type DestinationResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	StorageProvider types.String `tfsdk:"storage_provider"`
-	AccessKey       types.String `tfsdk:"access_key"`
-	SecretAccessKey types.String `tfsdk:"secret_access_key"`
-	Bucket          types.String `tfsdk:"bucket"`
-	Region          types.String `tfsdk:"region"`
-	Endpoint        types.String `tfsdk:"endpoint"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	StorageProvider   types.String `tfsdk:"storage_provider"`
+	AccessKey         types.String `tfsdk:"access_key"`
+	SecretAccessKey   types.String `tfsdk:"secret_access_key"`
+	Bucket            types.String `tfsdk:"bucket"`
+	Region            types.String `tfsdk:"region"`
+	Endpoint          types.String `tfsdk:"endpoint"`
+	CanonicalEndpoint types.String `tfsdk:"canonical_endpoint"`
 }
 
 func (r *DestinationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,7 +62,7 @@ func (r *DestinationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"storage_provider": schema.StringAttribute{
 				Required:    true,
-				Description: "Storage provider type (e.g., 's3', 'minio')",
+				Description: "Storage provider type (e.g., 's3', 'minio'). 's3'/'aws', 'r2'/'cloudflare', 'backblaze'/'b2' and 'minio' are checked for the settings they need.",
 			},
 			"access_key": schema.StringAttribute{
 				Required:    true,
@@ -73,12 +78,16 @@ func (r *DestinationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Bucket name for storing backups",
 			},
 			"region": schema.StringAttribute{
-				Required:    true,
-				Description: "Region where the bucket is located",
+				Optional:    true,
+				Description: "Region where the bucket is located. Required for AWS S3 and Backblaze",
 			},
 			"endpoint": schema.StringAttribute{
-				Required:    true,
-				Description: "Endpoint URL for the storage provider",
+				Optional:    true,
+				Description: "Endpoint URL for the storage provider, without the bucket name. Required for MinIO and Cloudflare R2; derived from the region for AWS S3 and Backblaze when not set",
+			},
+			"canonical_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Endpoint backups are uploaded to: the configured endpoint with a scheme and without a trailing slash, or the one derived from the region",
 			},
 		},
 	}
@@ -88,14 +97,36 @@ func (r *DestinationResource) Configure(_ context.Context, req resource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
 }
 
+func (r *DestinationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DestinationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateDestination(&config, &resp.Diagnostics)
+}
+
+func (r *DestinationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DestinationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.StorageProvider.IsUnknown() || plan.Region.IsUnknown() || plan.Endpoint.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("canonical_endpoint"), optionalString(canonicalDestinationEndpoint(&plan)))...)
+}
+
 func (r *DestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DestinationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -111,7 +142,7 @@ func (r *DestinationResource) Create(ctx context.Context, req resource.CreateReq
 		SecretAccessKey: plan.SecretAccessKey.ValueString(),
 		Bucket:          plan.Bucket.ValueString(),
 		Region:          plan.Region.ValueString(),
-		Endpoint:        plan.Endpoint.ValueString(),
+		Endpoint:        destinationEndpoint(&plan),
 	}
 
 	createdDest, err := r.client.CreateDestination(dest)
@@ -125,8 +156,7 @@ func (r *DestinationResource) Create(ctx context.Context, req resource.CreateReq
 	plan.StorageProvider = types.StringValue(createdDest.Provider)
 	plan.AccessKey = types.StringValue(createdDest.AccessKey)
 	plan.Bucket = types.StringValue(createdDest.Bucket)
-	plan.Region = types.StringValue(createdDest.Region)
-	plan.Endpoint = types.StringValue(createdDest.Endpoint)
+	setDestinationLocation(&plan, createdDest)
 	// Don't update secret_access_key from response as it's not returned
 
 	diags = resp.State.Set(ctx, plan)
@@ -155,8 +185,7 @@ func (r *DestinationResource) Read(ctx context.Context, req resource.ReadRequest
 	state.StorageProvider = types.StringValue(dest.Provider)
 	state.AccessKey = types.StringValue(dest.AccessKey)
 	state.Bucket = types.StringValue(dest.Bucket)
-	state.Region = types.StringValue(dest.Region)
-	state.Endpoint = types.StringValue(dest.Endpoint)
+	setDestinationLocation(&state, dest)
 	// Don't update secret_access_key from API response
 
 	diags = resp.State.Set(ctx, state)
@@ -179,7 +208,7 @@ func (r *DestinationResource) Update(ctx context.Context, req resource.UpdateReq
 		SecretAccessKey: plan.SecretAccessKey.ValueString(),
 		Bucket:          plan.Bucket.ValueString(),
 		Region:          plan.Region.ValueString(),
-		Endpoint:        plan.Endpoint.ValueString(),
+		Endpoint:        destinationEndpoint(&plan),
 	}
 
 	updatedDest, err := r.client.UpdateDestination(dest)
@@ -192,8 +221,7 @@ func (r *DestinationResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.StorageProvider = types.StringValue(updatedDest.Provider)
 	plan.AccessKey = types.StringValue(updatedDest.AccessKey)
 	plan.Bucket = types.StringValue(updatedDest.Bucket)
-	plan.Region = types.StringValue(updatedDest.Region)
-	plan.Endpoint = types.StringValue(updatedDest.Endpoint)
+	setDestinationLocation(&plan, updatedDest)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -220,3 +248,120 @@ func (r *DestinationResource) Delete(ctx context.Context, req resource.DeleteReq
 func (r *DestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// Storage provider families with specific requirements.
+const (
+	destinationAWS       = "aws"
+	destinationR2        = "r2"
+	destinationBackblaze = "backblaze"
+	destinationMinIO     = "minio"
+)
+
+// destinationFamily returns the provider family of a storage_provider value,
+// or "" for providers without specific requirements.
+func destinationFamily(provider string) string {
+	switch strings.ToLower(provider) {
+	case "s3", "aws":
+		return destinationAWS
+	case "r2", "cloudflare":
+		return destinationR2
+	case "backblaze", "b2":
+		return destinationBackblaze
+	case "minio":
+		return destinationMinIO
+	}
+	return ""
+}
+
+// canonicalDestinationEndpoint returns the endpoint backups are uploaded to:
+// the configured endpoint with a scheme and without a trailing slash, or the
+// one derived from the region for AWS S3 and Backblaze. It returns "" when
+// neither applies.
+func canonicalDestinationEndpoint(m *DestinationResourceModel) string {
+	if endpoint := m.Endpoint.ValueString(); endpoint != "" {
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		return strings.TrimSuffix(endpoint, "/")
+	}
+	region := m.Region.ValueString()
+	if region == "" {
+		return ""
+	}
+	switch destinationFamily(m.StorageProvider.ValueString()) {
+	case destinationAWS:
+		return fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	case destinationBackblaze:
+		return fmt.Sprintf("https://s3.%s.backblazeb2.com", region)
+	}
+	return ""
+}
+
+// destinationEndpoint returns the endpoint sent to Dokploy: the configured one,
+// or the one derived from the region.
+func destinationEndpoint(m *DestinationResourceModel) string {
+	if !m.Endpoint.IsNull() {
+		return m.Endpoint.ValueString()
+	}
+	return canonicalDestinationEndpoint(m)
+}
+
+// setDestinationLocation copies the region and endpoint of dest into m. An
+// endpoint that was derived from the region stays null.
+func setDestinationLocation(m *DestinationResourceModel, dest *client.Destination) {
+	m.Region = optionalString(dest.Region)
+	if !m.Endpoint.IsNull() || dest.Endpoint != canonicalDestinationEndpoint(m) {
+		m.Endpoint = optionalString(dest.Endpoint)
+	}
+	m.CanonicalEndpoint = optionalString(canonicalDestinationEndpoint(m))
+}
+
+// validateDestination checks that the settings required by the storage
+// provider are set and that the endpoint is usable. Unknown values are
+// skipped.
+func validateDestination(m *DestinationResourceModel, diags *diag.Diagnostics) {
+	if m.StorageProvider.IsUnknown() || m.Region.IsUnknown() || m.Endpoint.IsUnknown() {
+		return
+	}
+	provider := m.StorageProvider.ValueString()
+	family := destinationFamily(provider)
+	region := m.Region.ValueString()
+	endpoint := m.Endpoint.ValueString()
+
+	switch family {
+	case destinationAWS:
+		if region == "" {
+			diags.AddAttributeError(path.Root("region"), "Missing Region", fmt.Sprintf("region is required for storage_provider %q, e.g. \"us-east-1\".", provider))
+		}
+	case destinationBackblaze:
+		if region == "" && endpoint == "" {
+			diags.AddAttributeError(path.Root("region"), "Missing Region", fmt.Sprintf("region is required for storage_provider %q, e.g. \"us-west-004\", unless endpoint is set.", provider))
+		}
+	case destinationR2, destinationMinIO:
+		if endpoint == "" {
+			example := "https://minio.example.com:9000"
+			if family == destinationR2 {
+				example = "https://<account_id>.r2.cloudflarestorage.com"
+			}
+			diags.AddAttributeError(path.Root("endpoint"), "Missing Endpoint", fmt.Sprintf("endpoint is required for storage_provider %q, e.g. %q.", provider, example))
+		}
+	}
+
+	if endpoint == "" {
+		return
+	}
+	u, err := url.Parse(canonicalDestinationEndpoint(m))
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		diags.AddAttributeError(path.Root("endpoint"), "Invalid Endpoint", fmt.Sprintf("endpoint %q must be an http or https URL such as \"https://s3.example.com\".", endpoint))
+		return
+	}
+	if u.Path != "" || u.RawQuery != "" {
+		diags.AddAttributeError(path.Root("endpoint"), "Invalid Endpoint", fmt.Sprintf("endpoint %q must not include a path or query; set the bucket with the bucket attribute.", endpoint))
+	}
+	if bucket := m.Bucket.ValueString(); !m.Bucket.IsUnknown() && bucket != "" && strings.HasPrefix(u.Hostname(), bucket+".") {
+		diags.AddAttributeError(path.Root("endpoint"), "Bucket in Endpoint", fmt.Sprintf("endpoint %q includes the bucket %q as a subdomain. Use the service endpoint without the bucket; Dokploy addresses the bucket itself.", endpoint, bucket))
+	}
+	if family == destinationR2 && !strings.HasSuffix(u.Hostname(), ".r2.cloudflarestorage.com") {
+		diags.AddAttributeError(path.Root("endpoint"), "Invalid R2 Endpoint", fmt.Sprintf("endpoint %q is not a Cloudflare R2 endpoint; expected https://<account_id>.r2.cloudflarestorage.com.", endpoint))
+	}
+}
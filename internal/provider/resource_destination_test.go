@@ -5,6 +5,8 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -38,22 +40,23 @@ func TestAccDestinationResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccDestinationResourceConfig("test-destination", "s3", minioAccessKey, minioSecretKey, "test-backup-bucket", "us-east-1", minioEndpoint),
+				Config: testAccDestinationResourceConfig("tf-acc-destination", "s3", minioAccessKey, minioSecretKey, "test-backup-bucket", "us-east-1", minioEndpoint),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("dokploy_destination.test", "name", "test-destination"),
+					resource.TestCheckResourceAttr("dokploy_destination.test", "name", "tf-acc-destination"),
 					resource.TestCheckResourceAttr("dokploy_destination.test", "storage_provider", "s3"),
 					resource.TestCheckResourceAttr("dokploy_destination.test", "access_key", minioAccessKey),
 					resource.TestCheckResourceAttr("dokploy_destination.test", "bucket", "test-backup-bucket"),
 					resource.TestCheckResourceAttr("dokploy_destination.test", "region", "us-east-1"),
 					resource.TestCheckResourceAttr("dokploy_destination.test", "endpoint", minioEndpoint),
+					resource.TestCheckResourceAttrSet("dokploy_destination.test", "canonical_endpoint"),
 					resource.TestCheckResourceAttrSet("dokploy_destination.test", "id"),
 				),
 			},
 			// Update and Read testing
 			{
-				Config: testAccDestinationResourceConfig("test-destination-updated", "s3", minioAccessKey, minioSecretKey, "test-backup-bucket-2", "us-west-2", minioEndpoint),
+				Config: testAccDestinationResourceConfig("tf-acc-destination-updated", "s3", minioAccessKey, minioSecretKey, "test-backup-bucket-2", "us-west-2", minioEndpoint),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("dokploy_destination.test", "name", "test-destination-updated"),
+					resource.TestCheckResourceAttr("dokploy_destination.test", "name", "tf-acc-destination-updated"),
 					resource.TestCheckResourceAttr("dokploy_destination.test", "bucket", "test-backup-bucket-2"),
 					resource.TestCheckResourceAttr("dokploy_destination.test", "region", "us-west-2"),
 				),
@@ -87,3 +90,72 @@ resource "dokploy_destination" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), name, provider, accessKey, secretKey, bucket, region, endpoint)
 }
+
+func testDestinationModel(provider, region, endpoint string) *DestinationResourceModel {
+	m := &DestinationResourceModel{
+		StorageProvider: types.StringValue(provider),
+		Bucket:          types.StringValue("backups"),
+		Region:          types.StringNull(),
+		Endpoint:        types.StringNull(),
+	}
+	if region != "" {
+		m.Region = types.StringValue(region)
+	}
+	if endpoint != "" {
+		m.Endpoint = types.StringValue(endpoint)
+	}
+	return m
+}
+
+func TestCanonicalDestinationEndpoint(t *testing.T) {
+	tests := []struct {
+		provider, region, endpoint, want string
+	}{
+		{"s3", "eu-west-1", "", "https://s3.eu-west-1.amazonaws.com"},
+		{"AWS", "us-east-1", "", "https://s3.us-east-1.amazonaws.com"},
+		{"backblaze", "us-west-004", "", "https://s3.us-west-004.backblazeb2.com"},
+		{"minio", "", "minio.internal:9000/", "https://minio.internal:9000"},
+		{"minio", "", "http://minio.internal:9000", "http://minio.internal:9000"},
+		{"r2", "auto", "", ""},
+	}
+	for _, tt := range tests {
+		got := canonicalDestinationEndpoint(testDestinationModel(tt.provider, tt.region, tt.endpoint))
+		if got != tt.want {
+			t.Errorf("canonicalDestinationEndpoint(%q, %q, %q) = %q, want %q", tt.provider, tt.region, tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+func TestValidateDestination(t *testing.T) {
+	tests := []struct {
+		name, provider, region, endpoint string
+		wantError                        string
+	}{
+		{name: "aws", provider: "s3", region: "us-east-1"},
+		{name: "aws without region", provider: "s3", wantError: "Missing Region"},
+		{name: "backblaze with endpoint", provider: "backblaze", endpoint: "https://s3.us-west-004.backblazeb2.com"},
+		{name: "backblaze without region", provider: "b2", wantError: "Missing Region"},
+		{name: "minio without endpoint", provider: "minio", wantError: "Missing Endpoint"},
+		{name: "minio", provider: "minio", endpoint: "http://minio:9000"},
+		{name: "r2", provider: "cloudflare", region: "auto", endpoint: "https://abc123.r2.cloudflarestorage.com"},
+		{name: "r2 wrong host", provider: "r2", endpoint: "https://s3.amazonaws.com", wantError: "Invalid R2 Endpoint"},
+		{name: "endpoint with path", provider: "minio", endpoint: "https://minio.example.com/backups", wantError: "Invalid Endpoint"},
+		{name: "endpoint with bucket", provider: "s3", region: "us-east-1", endpoint: "https://backups.s3.amazonaws.com", wantError: "Bucket in Endpoint"},
+		{name: "other provider", provider: "Wasabi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateDestination(testDestinationModel(tt.provider, tt.region, tt.endpoint), &diags)
+			if tt.wantError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if len(diags.Errors()) != 1 || diags.Errors()[0].Summary() != tt.wantError {
+				t.Errorf("got %v, want a single %q error", diags, tt.wantError)
+			}
+		})
+	}
+}
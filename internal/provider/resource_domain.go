@@ -6,37 +6,48 @@ import (
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &DomainResource{}
 var _ resource.ResourceWithImportState = &DomainResource{}
+var _ resource.ResourceWithValidateConfig = &DomainResource{}
 
 func NewDomainResource() resource.Resource {
 	return &DomainResource{}
 }
 
 type DomainResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type DomainResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	ApplicationID     types.String `tfsdk:"application_id"`
-	ComposeID         types.String `tfsdk:"compose_id"`
-	ServiceName       types.String `tfsdk:"service_name"`
-	Host              types.String `tfsdk:"host"`
-	Path              types.String `tfsdk:"path"`
-	Port              types.Int64  `tfsdk:"port"`
-	HTTPS             types.Bool   `tfsdk:"https"`
-	CertificateType   types.String `tfsdk:"certificate_type"`
-	GenerateTraefikMe types.Bool   `tfsdk:"generate_traefik_me"`
-	RedeployOnUpdate  types.Bool   `tfsdk:"redeploy_on_update"`
+	ID                 types.String `tfsdk:"id"`
+	ApplicationID      types.String `tfsdk:"application_id"`
+	ComposeID          types.String `tfsdk:"compose_id"`
+	ServiceName        types.String `tfsdk:"service_name"`
+	Host               types.String `tfsdk:"host"`
+	Path               types.String `tfsdk:"path"`
+	Port               types.Int64  `tfsdk:"port"`
+	HTTPS              types.Bool   `tfsdk:"https"`
+	CertificateType    types.String `tfsdk:"certificate_type"`
+	CustomCertResolver types.String `tfsdk:"custom_cert_resolver"`
+	StripPath          types.Bool   `tfsdk:"strip_path"`
+	InternalPath       types.String `tfsdk:"internal_path"`
+	UniqueConfigKey    types.Int64  `tfsdk:"unique_config_key"`
+	GenerateTraefikMe  types.Bool   `tfsdk:"generate_traefik_me"`
+	RedeployOnUpdate   types.Bool   `tfsdk:"redeploy_on_update"`
 }
 
 func (r *DomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,8 +80,9 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed: true,
 			},
 			"host": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Host name of the domain. A wildcard such as '*.example.com' needs certificate_type 'custom' with a DNS challenge resolver, or https disabled.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
@@ -92,7 +104,33 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"certificate_type": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Certificate type: 'none', 'letsencrypt'. Defaults to 'letsencrypt' when https is true.",
+				Description: "Certificate type: 'none', 'letsencrypt' or 'custom'. Defaults to 'letsencrypt' when https is true.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "letsencrypt", "custom"),
+				},
+			},
+			"custom_cert_resolver": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the Traefik certificate resolver to use when certificate_type is 'custom'.",
+			},
+			"strip_path": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Strip path from the request before forwarding it to the service, so a service mounted at '/api' receives '/users' instead of '/api/users'. Defaults to false.",
+			},
+			"internal_path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("/"),
+				Description: "Path on the service that requests are forwarded to, e.g. '/app'. Defaults to '/'.",
+			},
+			"unique_config_key": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Key Dokploy uses to name the Traefik router and service of the domain.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"generate_traefik_me": schema.BoolAttribute{
 				Optional:    true,
@@ -110,14 +148,39 @@ func (r *DomainResource) Configure(_ context.Context, req resource.ConfigureRequ
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
 }
 
+func (r *DomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DomainResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.CertificateType.ValueString() == "custom" && config.CustomCertResolver.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("custom_cert_resolver"),
+			"Missing Certificate Resolver",
+			"custom_cert_resolver is required when certificate_type is 'custom'.",
+		)
+	}
+
+	if config.Host.IsNull() || config.Host.IsUnknown() || config.HTTPS.IsUnknown() || config.CertificateType.IsUnknown() {
+		return
+	}
+	// https and certificate_type default to true and letsencrypt on create.
+	https := config.HTTPS.IsNull() || config.HTTPS.ValueBool()
+	if msg := wildcardHostError(config.Host.ValueString(), https, config.CertificateType.ValueString()); msg != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("host"), "Invalid Wildcard Host", msg)
+	}
+}
+
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DomainResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -175,25 +238,29 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	domain := client.Domain{
-		ApplicationID:   plan.ApplicationID.ValueString(),
-		ComposeID:       plan.ComposeID.ValueString(),
-		ServiceName:     plan.ServiceName.ValueString(),
-		Host:            plan.Host.ValueString(),
-		Path:            plan.Path.ValueString(),
-		Port:            plan.Port.ValueInt64(),
-		HTTPS:           plan.HTTPS.ValueBool(),
-		CertificateType: plan.CertificateType.ValueString(),
+		ApplicationID:      plan.ApplicationID.ValueString(),
+		ComposeID:          plan.ComposeID.ValueString(),
+		ServiceName:        plan.ServiceName.ValueString(),
+		Host:               plan.Host.ValueString(),
+		Path:               plan.Path.ValueString(),
+		Port:               plan.Port.ValueInt64(),
+		HTTPS:              plan.HTTPS.ValueBool(),
+		CertificateType:    plan.CertificateType.ValueString(),
+		CustomCertResolver: plan.CustomCertResolver.ValueString(),
+		StripPath:          plan.StripPath.ValueBool(),
+		InternalPath:       plan.InternalPath.ValueString(),
 	}
 
 	createdDomain, err := r.client.CreateDomain(domain)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating domain", err.Error())
+		addDomainError(&resp.Diagnostics, "Error creating domain", err)
 		return
 	}
 
 	plan.ID = types.StringValue(createdDomain.ID)
 	plan.ServiceName = types.StringValue(createdDomain.ServiceName)
 	plan.CertificateType = types.StringValue(createdDomain.CertificateType)
+	plan.UniqueConfigKey = types.Int64Value(createdDomain.UniqueConfigKey)
 
 	// Trigger Redeploy if requested
 	if !plan.RedeployOnUpdate.IsNull() && plan.RedeployOnUpdate.ValueBool() {
@@ -216,46 +283,35 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	var domains []client.Domain
-	var err error
-	if !state.ApplicationID.IsNull() {
-		domains, err = r.client.GetDomainsByApplication(state.ApplicationID.ValueString())
-	} else {
-		domains, err = r.client.GetDomainsByCompose(state.ComposeID.ValueString())
-	}
-
+	d, err := r.client.GetDomain(state.ID.ValueString())
 	if err != nil {
 		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading domains", err.Error())
+		resp.Diagnostics.AddError("Error reading domain", err.Error())
 		return
 	}
 
-	found := false
-	for _, d := range domains {
-		if d.ID == state.ID.ValueString() {
-			state.Host = types.StringValue(d.Host)
-			state.Path = types.StringValue(d.Path)
-			state.Port = types.Int64Value(d.Port)
-			state.HTTPS = types.BoolValue(d.HTTPS)
-			state.ServiceName = types.StringValue(d.ServiceName)
-			state.CertificateType = types.StringValue(d.CertificateType)
-			if d.ApplicationID != "" {
-				state.ApplicationID = types.StringValue(d.ApplicationID)
-			}
-			if d.ComposeID != "" {
-				state.ComposeID = types.StringValue(d.ComposeID)
-			}
-			found = true
-			break
-		}
+	state.Host = types.StringValue(d.Host)
+	state.Path = types.StringValue(d.Path)
+	state.Port = types.Int64Value(d.Port)
+	state.HTTPS = types.BoolValue(d.HTTPS)
+	state.ServiceName = types.StringValue(d.ServiceName)
+	state.CertificateType = types.StringValue(d.CertificateType)
+	state.CustomCertResolver = optionalString(d.CustomCertResolver)
+	state.StripPath = types.BoolValue(d.StripPath)
+	state.InternalPath = types.StringValue(d.InternalPath)
+	if d.InternalPath == "" {
+		// Dokploy versions before internal paths route to the service root.
+		state.InternalPath = types.StringValue("/")
 	}
-
-	if !found {
-		resp.State.RemoveResource(ctx)
-		return
+	state.UniqueConfigKey = types.Int64Value(d.UniqueConfigKey)
+	if d.ApplicationID != "" {
+		state.ApplicationID = types.StringValue(d.ApplicationID)
+	}
+	if d.ComposeID != "" {
+		state.ComposeID = types.StringValue(d.ComposeID)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -271,20 +327,23 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	domain := client.Domain{
-		ID:              plan.ID.ValueString(),
-		ApplicationID:   plan.ApplicationID.ValueString(),
-		ComposeID:       plan.ComposeID.ValueString(),
-		ServiceName:     plan.ServiceName.ValueString(),
-		Host:            plan.Host.ValueString(),
-		Path:            plan.Path.ValueString(),
-		Port:            plan.Port.ValueInt64(),
-		HTTPS:           plan.HTTPS.ValueBool(),
-		CertificateType: plan.CertificateType.ValueString(),
+		ID:                 plan.ID.ValueString(),
+		ApplicationID:      plan.ApplicationID.ValueString(),
+		ComposeID:          plan.ComposeID.ValueString(),
+		ServiceName:        plan.ServiceName.ValueString(),
+		Host:               plan.Host.ValueString(),
+		Path:               plan.Path.ValueString(),
+		Port:               plan.Port.ValueInt64(),
+		HTTPS:              plan.HTTPS.ValueBool(),
+		CertificateType:    plan.CertificateType.ValueString(),
+		CustomCertResolver: plan.CustomCertResolver.ValueString(),
+		StripPath:          plan.StripPath.ValueBool(),
+		InternalPath:       plan.InternalPath.ValueString(),
 	}
 
 	updatedDomain, err := r.client.UpdateDomain(domain)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating domain", err.Error())
+		addDomainError(&resp.Diagnostics, "Error updating domain", err)
 		return
 	}
 
@@ -294,6 +353,9 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.HTTPS = types.BoolValue(updatedDomain.HTTPS)
 	plan.ServiceName = types.StringValue(updatedDomain.ServiceName)
 	plan.CertificateType = types.StringValue(updatedDomain.CertificateType)
+	if updatedDomain.UniqueConfigKey != 0 {
+		plan.UniqueConfigKey = types.Int64Value(updatedDomain.UniqueConfigKey)
+	}
 
 	// Trigger Redeploy if requested
 	if !plan.RedeployOnUpdate.IsNull() && plan.RedeployOnUpdate.ValueBool() {
@@ -316,57 +378,35 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteDomain(state.ID.ValueString())
+	serviceType, serviceID := "application", state.ApplicationID.ValueString()
+	if serviceID == "" {
+		serviceType, serviceID = "compose", state.ComposeID.ValueString()
+	}
+	err := deleteChild(ctx, func() error { return r.client.DeleteDomain(state.ID.ValueString()) }, serviceParent(r.client, serviceType, serviceID))
 	if err != nil {
-		errStr := strings.ToLower(err.Error())
-		if strings.Contains(errStr, "not found") || strings.Contains(errStr, "not_found") || strings.Contains(errStr, "404") {
-			// Resource already deleted, that's fine
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting domain", err.Error())
 		return
 	}
 }
 
 func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: <id> or <type>:<parent-id>:<id>
-	// Where type is "application" or "compose"
-	importID := req.ID
-
-	// Just set the ID and let the Read function fail if we can't find it
-	// The Read function will need to be updated to handle this case
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
-
-	// We can't determine application_id or compose_id from just the domain ID
-	// The user should import using format: application:<app-id>:<domain-id> or compose:<compose-id>:<domain-id>
-	// For now, set dummy values and let Read try to find it
-	// Actually, this won't work. We need a different approach.
-
-	// Try to parse the import ID
-	var parentType, parentID, domainID string
-
-	parts := strings.Split(importID, ":")
-	if len(parts) == 3 {
-		// Format: application:app-id:domain-id or compose:compose-id:domain-id
-		parentType = parts[0]
-		parentID = parts[1]
-		domainID = parts[2]
-	} else if len(parts) == 1 {
-		// Just domain ID - we need to search for it
-		// This is not ideal but we'll return an error asking for proper format
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			fmt.Sprintf("Please use format 'application:<app-id>:<domain-id>' or 'compose:<compose-id>:<domain-id>'. Got: %s", importID),
-		)
+	// Import format: <id> or <type>:<parent-id>:<id>, where type is
+	// "application" or "compose". Read resolves the parent from the domain
+	// itself, so the parent prefix is optional.
+	parts := strings.Split(req.ID, ":")
+	if len(parts) == 1 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 		return
-	} else {
+	}
+	if len(parts) != 3 {
 		resp.Diagnostics.AddError(
 			"Invalid import ID format",
-			fmt.Sprintf("Expected format 'application:<app-id>:<domain-id>' or 'compose:<compose-id>:<domain-id>'. Got: %s", importID),
+			fmt.Sprintf("Expected format '<domain-id>', 'application:<app-id>:<domain-id>' or 'compose:<compose-id>:<domain-id>'. Got: %s", req.ID),
 		)
 		return
 	}
 
+	parentType, parentID, domainID := parts[0], parts[1], parts[2]
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), domainID)...)
 
 	switch parentType {
@@ -382,3 +422,30 @@ func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportSta
 		return
 	}
 }
+
+// wildcardHostError returns why host cannot be used with the given HTTPS and
+// certificate settings, or "" when it can. Let's Encrypt certificates are
+// requested with an HTTP challenge, which cannot issue wildcard certificates.
+func wildcardHostError(host string, https bool, certificateType string) string {
+	if !strings.Contains(host, "*") {
+		return ""
+	}
+	if !strings.HasPrefix(host, "*.") || strings.Count(host, "*") > 1 {
+		return fmt.Sprintf("The wildcard in %q must be the whole leftmost label, e.g. \"*.example.com\".", host)
+	}
+	if https && (certificateType == "" || certificateType == "letsencrypt") {
+		return fmt.Sprintf("Let's Encrypt HTTP challenges cannot issue a certificate for %q. Set certificate_type to 'custom' with a custom_cert_resolver that uses a DNS challenge, or set https to false.", host)
+	}
+	return ""
+}
+
+// addDomainError reports err on the host attribute when Dokploy rejected the
+// host itself, e.g. because it is malformed or already in use.
+func addDomainError(diags *diag.Diagnostics, summary string, err error) {
+	msg := strings.ToLower(err.Error())
+	if strings.Contains(msg, "host") || strings.Contains(msg, "already exists") || strings.Contains(msg, "already in use") || strings.Contains(msg, "wildcard") {
+		diags.AddAttributeError(path.Root("host"), summary, err.Error())
+		return
+	}
+	diags.AddError(summary, err.Error())
+}
@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -23,7 +26,7 @@ func TestAccDomainResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccDomainResourceConfig("test-domain-project", "test-domain-env", "test-domain-app", "example.com", 3000),
+				Config: testAccDomainResourceConfig("tf-acc-domain-project", "test-domain-env", "test-domain-app", "example.com", 3000),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_domain.test", "host", "example.com"),
 					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "3000"),
@@ -33,7 +36,7 @@ func TestAccDomainResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccDomainResourceConfig("test-domain-project", "test-domain-env", "test-domain-app", "updated.example.com", 8080),
+				Config: testAccDomainResourceConfig("tf-acc-domain-project", "test-domain-env", "test-domain-app", "updated.example.com", 8080),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_domain.test", "host", "updated.example.com"),
 					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "8080"),
@@ -75,7 +78,7 @@ func TestAccDomainResourceWithTraefikMe(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing with Traefik.me
 			{
-				Config: testAccDomainResourceWithTraefikMeConfig("test-traefik-project", "test-traefik-env", "test-traefik-app", 3000, true),
+				Config: testAccDomainResourceWithTraefikMeConfig("tf-acc-traefik-project", "test-traefik-env", "test-traefik-app", 3000, true),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_domain.test", "generate_traefik_me", "true"),
 					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "3000"),
@@ -85,7 +88,7 @@ func TestAccDomainResourceWithTraefikMe(t *testing.T) {
 			},
 			// Update testing - change port and https
 			{
-				Config: testAccDomainResourceWithTraefikMeConfig("test-traefik-project", "test-traefik-env", "test-traefik-app", 8080, false),
+				Config: testAccDomainResourceWithTraefikMeConfig("tf-acc-traefik-project", "test-traefik-env", "test-traefik-app", 8080, false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_domain.test", "generate_traefik_me", "true"),
 					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "8080"),
@@ -205,7 +208,7 @@ services:
 		Steps: []resource.TestStep{
 			// Create domain attached to compose
 			{
-				Config: testAccDomainResourceWithComposeConfig("test-domain-compose-project", "test-domain-compose-env", "test-domain-compose", composeContent, "compose.example.com", 80),
+				Config: testAccDomainResourceWithComposeConfig("tf-acc-domain-compose-project", "test-domain-compose-env", "test-domain-compose", composeContent, "compose.example.com", 80),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_domain.test", "host", "compose.example.com"),
 					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "80"),
@@ -215,7 +218,7 @@ services:
 			},
 			// Update domain
 			{
-				Config: testAccDomainResourceWithComposeConfig("test-domain-compose-project", "test-domain-compose-env", "test-domain-compose", composeContent, "updated-compose.example.com", 8080),
+				Config: testAccDomainResourceWithComposeConfig("tf-acc-domain-compose-project", "test-domain-compose-env", "test-domain-compose", composeContent, "updated-compose.example.com", 8080),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_domain.test", "host", "updated-compose.example.com"),
 					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "8080"),
@@ -298,7 +301,7 @@ services:
 		Steps: []resource.TestStep{
 			// Create traefik.me domain attached to compose
 			{
-				Config: testAccDomainResourceWithComposeTraefikMeConfig("test-compose-traefik-project", "test-compose-traefik-env", "test-compose-traefik", composeContent, 80),
+				Config: testAccDomainResourceWithComposeTraefikMeConfig("tf-acc-compose-traefik-project", "test-compose-traefik-env", "test-compose-traefik", composeContent, 80),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_domain.test", "generate_traefik_me", "true"),
 					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "80"),
@@ -345,3 +348,113 @@ resource "dokploy_domain" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, composeName, composeContent, port)
 }
+
+func TestAccDomainResourceStripPath(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainResourceStripPathConfig(true, "/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_domain.test", "path", "/api"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "strip_path", "true"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "internal_path", "/"),
+					resource.TestCheckResourceAttrSet("dokploy_domain.test", "unique_config_key"),
+				),
+			},
+			{
+				Config: testAccDomainResourceStripPathConfig(false, "/v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_domain.test", "strip_path", "false"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "internal_path", "/v1"),
+				),
+			},
+			{
+				ResourceName:            "dokploy_domain.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"generate_traefik_me", "redeploy_on_update"},
+			},
+		},
+	})
+}
+
+func testAccDomainResourceStripPathConfig(stripPath bool, internalPath string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-domain-strip-path"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "tf-acc-domain-strip-path"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "tf-acc-domain-strip-path"
+  build_type     = "nixpacks"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_domain" "test" {
+  application_id = dokploy_application.test.id
+  host           = "strip-path.example.com"
+  path           = "/api"
+  port           = 80
+  strip_path     = %t
+  internal_path  = "%s"
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), stripPath, internalPath)
+}
+
+func TestWildcardHostError(t *testing.T) {
+	tests := []struct {
+		host            string
+		https           bool
+		certificateType string
+		wantErr         bool
+	}{
+		{"app.example.com", true, "letsencrypt", false},
+		{"*.example.com", true, "custom", false},
+		{"*.example.com", false, "none", false},
+		{"*.example.com", true, "letsencrypt", true},
+		{"*.example.com", true, "", true},
+		{"app.*.example.com", false, "none", true},
+		{"*.*.example.com", true, "custom", true},
+	}
+	for _, tt := range tests {
+		got := wildcardHostError(tt.host, tt.https, tt.certificateType)
+		if (got != "") != tt.wantErr {
+			t.Errorf("wildcardHostError(%q, %t, %q) = %q, want error %t", tt.host, tt.https, tt.certificateType, got, tt.wantErr)
+		}
+	}
+}
+
+func TestAddDomainError(t *testing.T) {
+	var diags diag.Diagnostics
+	addDomainError(&diags, "Error creating domain", errors.New("API error (status 400): Domain already exists"))
+	if d, ok := diags[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(path.Root("host")) {
+		t.Errorf("expected error on host, got %v", diags[0])
+	}
+
+	diags = nil
+	addDomainError(&diags, "Error creating domain", errors.New("API error (status 500): connection refused"))
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Errorf("expected error without path, got %v", diags[0])
+	}
+}
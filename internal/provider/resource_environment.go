@@ -2,15 +2,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,14 +29,17 @@ func NewEnvironmentResource() resource.Resource {
 }
 
 type EnvironmentResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type EnvironmentResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	ProjectID   types.String `tfsdk:"project_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID           types.String `tfsdk:"id"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Env          types.String `tfsdk:"env"`
+	EnvMap       types.Map    `tfsdk:"env_map"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
 }
 
 func (r *EnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +68,30 @@ func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional: true,
 				Computed: true,
 			},
+			"env": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Shared environment variables in KEY=VALUE format, one per line. Available to all services in the environment. Conflicts with env_map. Do not combine with a dokploy_environment_variables resource for the same environment: both write the environment's variables and overwrite each other on every apply.",
+				Validators: []validator.String{
+					envValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("env_map")),
+				},
+			},
+			"env_map": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Shared environment variables as a map. Available to all services in the environment. Conflicts with env. Do not combine with a dokploy_environment_variables resource for the same environment: both write the environment's variables and overwrite each other on every apply.",
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("env")),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to delete the environment even when it still contains applications, compose stacks or databases, which Dokploy deletes along with it. Defaults to false, which refuses to delete a non-empty environment.",
+			},
 		},
 	}
 }
@@ -66,9 +100,9 @@ func (r *EnvironmentResource) Configure(_ context.Context, req resource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -82,6 +116,10 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if !requireDokployVersion(r.client, minVersionEnvironments, "dokploy_environment", &resp.Diagnostics) {
+		return
+	}
+
 	env, err := r.client.CreateEnvironment(plan.ProjectID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
 		// Handle "Already exists" logic
@@ -117,6 +155,17 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 		plan.Description = types.StringValue(env.Description)
 	}
 
+	envStr, ok := environmentEnvFromModel(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ok {
+		if err := r.client.SaveEnvironmentEnv(plan.ID.ValueString(), envStr); err != nil {
+			resp.Diagnostics.AddError("Error saving environment env", err.Error())
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -141,6 +190,13 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 		if env.ID == state.ID.ValueString() {
 			state.Name = types.StringValue(env.Name)
 			state.Description = types.StringValue(env.Description)
+			// Only track env in whichever form the configuration manages it
+			if !state.EnvMap.IsNull() {
+				state.EnvMap, diags = types.MapValueFrom(ctx, types.StringType, client.ParseEnv(env.Env))
+				resp.Diagnostics.Append(diags...)
+			} else if !state.Env.IsNull() {
+				state.Env = types.StringValue(env.Env)
+			}
 			found = true
 			break
 		}
@@ -156,9 +212,11 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan EnvironmentResourceModel
+	var plan, state EnvironmentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -179,6 +237,21 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.Name = types.StringValue(updatedEnv.Name)
 	plan.Description = types.StringValue(updatedEnv.Description)
 
+	envStr, ok := environmentEnvFromModel(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Clear the env when it was previously managed and has been removed
+	if !ok && (!state.Env.IsNull() || !state.EnvMap.IsNull()) {
+		envStr, ok = "", true
+	}
+	if ok {
+		if err := r.client.SaveEnvironmentEnv(plan.ID.ValueString(), envStr); err != nil {
+			resp.Diagnostics.AddError("Error saving environment env", err.Error())
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -191,7 +264,26 @@ func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteEnvironment(state.ID.ValueString())
+	if !state.ForceDestroy.ValueBool() {
+		env, err := r.client.GetEnvironment(state.ID.ValueString())
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading environment before deletion", err.Error())
+			return
+		}
+		if services := environmentServices(env); len(services) > 0 {
+			resp.Diagnostics.AddError(
+				"Environment is not empty",
+				fmt.Sprintf("Environment %s still contains:\n\n  - %s\n\nDeleting it would delete these services and their data. Remove them first, or set force_destroy = true and apply before destroying the environment.",
+					state.ID.ValueString(), strings.Join(services, "\n  - ")),
+			)
+			return
+		}
+	}
+
+	err := deleteChild(ctx, func() error { return r.client.DeleteEnvironment(state.ID.ValueString()) }, projectParent(r.client, state.ProjectID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting environment", err.Error())
 		return
@@ -214,4 +306,61 @@ func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.Impo
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), environmentID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_destroy"), false)...)
+}
+
+// environmentServices describes the services in an environment, e.g.
+// `postgres "main-db"`, in a stable order.
+func environmentServices(env *client.Environment) []string {
+	var services []string
+	for _, app := range env.Applications {
+		services = append(services, fmt.Sprintf("application %q", app.Name))
+	}
+	for _, comp := range env.Compose {
+		services = append(services, fmt.Sprintf("compose %q", comp.Name))
+	}
+	for kind, dbs := range map[string][]client.Database{
+		"postgres": env.Postgres,
+		"mysql":    env.Mysql,
+		"mariadb":  env.Mariadb,
+		"mongo":    env.Mongo,
+		"redis":    env.Redis,
+	} {
+		for _, db := range dbs {
+			services = append(services, fmt.Sprintf("%s %q", kind, db.Name))
+		}
+	}
+	sort.Strings(services)
+	return services
+}
+
+// environmentEnvFromModel renders env or env_map into the KEY=VALUE format
+// used by the API. The boolean is false when neither attribute is set.
+func environmentEnvFromModel(ctx context.Context, m *EnvironmentResourceModel, diags *diag.Diagnostics) (string, bool) {
+	if !m.Env.IsNull() && !m.Env.IsUnknown() {
+		return m.Env.ValueString(), true
+	}
+	if m.EnvMap.IsNull() || m.EnvMap.IsUnknown() {
+		return "", false
+	}
+	return envFromMap(ctx, m.EnvMap, diags), true
+}
+
+// envFromMap renders a map of strings into the KEY=VALUE format used by the
+// API, sorted by key so the result does not depend on map order.
+func envFromMap(ctx context.Context, m types.Map, diags *diag.Diagnostics) string {
+	envMap := make(map[string]string)
+	diags.Append(m.ElementsAs(ctx, &envMap, false)...)
+
+	keys := make([]string, 0, len(envMap))
+	for k := range envMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, k := range keys {
+		lines = append(lines, client.FormatEnvLine(k, envMap[k]))
+	}
+	return strings.Join(lines, "\n")
 }
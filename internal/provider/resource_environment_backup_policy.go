@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &EnvironmentBackupPolicyResource{}
+var _ resource.ResourceWithImportState = &EnvironmentBackupPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &EnvironmentBackupPolicyResource{}
+
+// backupPolicyDatabaseTypes are the database types Dokploy can back up.
+var backupPolicyDatabaseTypes = []string{"postgres", "mysql", "mariadb", "mongo"}
+
+func NewEnvironmentBackupPolicyResource() resource.Resource {
+	return &EnvironmentBackupPolicyResource{}
+}
+
+type EnvironmentBackupPolicyResource struct {
+	client client.Client
+}
+
+type EnvironmentBackupPolicyResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	EnvironmentID   types.String `tfsdk:"environment_id"`
+	DestinationID   types.String `tfsdk:"destination_id"`
+	Schedule        types.String `tfsdk:"schedule"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Prefix          types.String `tfsdk:"prefix"`
+	KeepLatestCount types.Int64  `tfsdk:"keep_latest_count"`
+	DatabaseTypes   types.Set    `tfsdk:"database_types"`
+	DatabaseNames   types.Map    `tfsdk:"database_names"`
+	Backups         types.Map    `tfsdk:"backups"`
+}
+
+func (r *EnvironmentBackupPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_backup_policy"
+}
+
+func (r *EnvironmentBackupPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	defaultTypes := make([]attr.Value, 0, len(backupPolicyDatabaseTypes))
+	for _, t := range backupPolicyDatabaseTypes {
+		defaultTypes = append(defaultTypes, types.StringValue(t))
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Backs up every database in an environment with a shared schedule, destination and retention. " +
+			"One Dokploy backup is managed per database. The environment's databases are looked up on every plan, so databases added later get a backup on the next apply and the backups of removed databases are deleted. " +
+			"Redis databases are not supported by Dokploy backups and are skipped.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the policy. Equal to environment_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the environment whose databases are backed up.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the backup destination.",
+			},
+			"schedule": schema.StringAttribute{
+				Required:    true,
+				Description: "Cron expression for the backup schedule.",
+				Validators: []validator.String{
+					cronValidator{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the backup schedules are enabled.",
+			},
+			"prefix": schema.StringAttribute{
+				Required:    true,
+				Description: "Prefix for backup files. Dokploy stores each database's files under its app name, so the prefix can be shared.",
+			},
+			"keep_latest_count": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Description: "Number of recent backups to keep per database (older ones are deleted).",
+			},
+			"database_types": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, defaultTypes)),
+				Description: "Database types to back up: postgres, mysql, mariadb and/or mongo. Defaults to all of them.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(backupPolicyDatabaseTypes...)),
+				},
+			},
+			"database_names": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Name of the database to dump, keyed by database ID. Overrides the database name Dokploy stores for the service. " +
+					"Dokploy does not store a database name for MongoDB, so MongoDB databases are only backed up when listed here.",
+			},
+			"backups": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the managed backups, keyed by database ID.",
+			},
+		},
+	}
+}
+
+func (r *EnvironmentBackupPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan marks the managed backups as unknown when databases were added to
+// or removed from the environment since the last refresh.
+func (r *EnvironmentBackupPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state EnvironmentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.DatabaseTypes.IsUnknown() || plan.DatabaseNames.IsUnknown() {
+		return
+	}
+
+	env, err := r.client.GetEnvironment(plan.EnvironmentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading environment", err.Error())
+		return
+	}
+
+	targets := backupPolicyTargets(env, setStrings(plan.DatabaseTypes), mapStrings(plan.DatabaseNames))
+	current := mapStrings(state.Backups)
+	if len(targets) == len(current) {
+		same := true
+		for _, target := range targets {
+			if _, ok := current[target.id]; !ok {
+				same = false
+				break
+			}
+		}
+		if same {
+			return
+		}
+	}
+
+	plan.Backups = types.MapUnknown(types.StringType)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *EnvironmentBackupPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EnvironmentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.EnvironmentID
+	if err := r.apply(&plan, nil); err != nil {
+		resp.Diagnostics.AddError("Error creating environment backup policy", err.Error())
+	}
+	// Save the backups created so far even on error so they are not orphaned.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *EnvironmentBackupPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EnvironmentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, err := r.client.GetEnvironment(state.EnvironmentID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading environment", err.Error())
+		return
+	}
+
+	// After import there is nothing tracked yet, so adopt the first database
+	// backup of every database in the environment.
+	if state.Backups.IsNull() {
+		if err := r.adopt(&state, env); err != nil {
+			resp.Diagnostics.AddError("Error reading backups", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	current := mapStrings(state.Backups)
+	databaseIDs := make([]string, 0, len(current))
+	for databaseID := range current {
+		databaseIDs = append(databaseIDs, databaseID)
+	}
+	sort.Strings(databaseIDs)
+
+	backups := map[string]string{}
+	drifted := false
+	for _, databaseID := range databaseIDs {
+		backup, err := r.client.GetBackup(current[databaseID])
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				continue
+			}
+			resp.Diagnostics.AddError("Error reading backup", err.Error())
+			return
+		}
+		backups[databaseID] = backup.BackupID
+
+		// Report the first backup that was changed outside Terraform, so the
+		// next apply brings every backup back in line with the policy.
+		if !drifted && !backupMatchesPolicy(backup, &state) {
+			drifted = true
+			setPolicyFromBackup(&state, backup)
+		}
+	}
+
+	state.ID = state.EnvironmentID
+	state.Backups = stringMapValue(backups)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *EnvironmentBackupPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EnvironmentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if err := r.apply(&plan, mapStrings(state.Backups)); err != nil {
+		resp.Diagnostics.AddError("Error updating environment backup policy", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *EnvironmentBackupPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EnvironmentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, backupID := range mapStrings(state.Backups) {
+		if err := r.client.DeleteBackup(backupID); err != nil && !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError("Error deleting backup", err.Error())
+			return
+		}
+	}
+}
+
+func (r *EnvironmentBackupPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("environment_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// apply creates or updates a backup for every database the policy targets and
+// deletes the prior backups of databases it no longer targets. plan.Backups is
+// set to the backups that exist afterwards, also when an error is returned.
+func (r *EnvironmentBackupPolicyResource) apply(plan *EnvironmentBackupPolicyResourceModel, prior map[string]string) error {
+	backups := map[string]string{}
+	for databaseID, backupID := range prior {
+		backups[databaseID] = backupID
+	}
+	defer func() { plan.Backups = stringMapValue(backups) }()
+
+	env, err := r.client.GetEnvironment(plan.EnvironmentID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	targets := backupPolicyTargets(env, setStrings(plan.DatabaseTypes), mapStrings(plan.DatabaseNames))
+	targeted := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		targeted[target.id] = true
+		backup := target.backup(plan)
+
+		if backupID, ok := prior[target.id]; ok {
+			backup.BackupID = backupID
+			_, err := r.client.UpdateBackup(backup)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, client.ErrNotFound) {
+				return fmt.Errorf("updating backup of database %s: %w", target.id, err)
+			}
+			// The backup was deleted outside Terraform, so recreate it.
+			delete(backups, target.id)
+			backup.BackupID = ""
+		}
+
+		created, err := r.client.CreateBackup(backup)
+		if err != nil {
+			return fmt.Errorf("creating backup of database %s: %w", target.id, err)
+		}
+		backups[target.id] = created.BackupID
+	}
+
+	for databaseID, backupID := range prior {
+		if targeted[databaseID] {
+			continue
+		}
+		if err := r.client.DeleteBackup(backupID); err != nil && !errors.Is(err, client.ErrNotFound) {
+			return fmt.Errorf("deleting backup of database %s: %w", databaseID, err)
+		}
+		delete(backups, databaseID)
+	}
+	return nil
+}
+
+// adopt fills an imported policy from the existing database backups in the
+// environment, taking the shared settings from the first one found.
+func (r *EnvironmentBackupPolicyResource) adopt(state *EnvironmentBackupPolicyResourceModel, env *client.Environment) error {
+	// MongoDB databases have no stored name; list them so they are checked
+	// too, and take the name from their backup.
+	names := map[string]string{}
+	for _, db := range env.Mongo {
+		names[databaseServiceID(db)] = ""
+	}
+
+	backups := map[string]string{}
+	adopted := map[string]string{}
+	for _, target := range backupPolicyTargets(env, backupPolicyDatabaseTypes, names) {
+		existing, err := r.client.GetBackupsByDatabaseID(target.id, target.databaseType)
+		if err != nil {
+			return err
+		}
+		for _, backup := range existing {
+			if backup.BackupType != "" && backup.BackupType != "database" {
+				continue
+			}
+			if len(backups) == 0 {
+				setPolicyFromBackup(state, &backup)
+			}
+			backups[target.id] = backup.BackupID
+			if target.databaseType == "mongo" {
+				adopted[target.id] = backup.Database
+			}
+			break
+		}
+	}
+
+	defaultTypes := append([]string{}, backupPolicyDatabaseTypes...)
+	state.ID = state.EnvironmentID
+	state.DatabaseTypes = stringSet(defaultTypes)
+	state.DatabaseNames = types.MapNull(types.StringType)
+	if len(adopted) > 0 {
+		state.DatabaseNames = stringMapValue(adopted)
+	}
+	state.Backups = stringMapValue(backups)
+	if state.Enabled.IsNull() {
+		state.Enabled = types.BoolValue(true)
+	}
+	if state.KeepLatestCount.IsNull() {
+		state.KeepLatestCount = types.Int64Value(30)
+	}
+	return nil
+}
+
+// backupPolicyTarget is a database in the environment that the policy backs up.
+type backupPolicyTarget struct {
+	id           string
+	databaseType string
+	database     string
+}
+
+// backup returns the Dokploy backup for the target with the policy's shared
+// settings.
+func (t backupPolicyTarget) backup(plan *EnvironmentBackupPolicyResourceModel) client.Backup {
+	backup := client.Backup{
+		DestinationID:   plan.DestinationID.ValueString(),
+		Schedule:        plan.Schedule.ValueString(),
+		Enabled:         plan.Enabled.ValueBool(),
+		Prefix:          plan.Prefix.ValueString(),
+		Database:        t.database,
+		KeepLatestCount: int(plan.KeepLatestCount.ValueInt64()),
+		BackupType:      "database",
+		DatabaseType:    t.databaseType,
+	}
+	switch t.databaseType {
+	case "postgres":
+		backup.PostgresID = t.id
+	case "mysql":
+		backup.MysqlID = t.id
+	case "mariadb":
+		backup.MariadbID = t.id
+	case "mongo":
+		backup.MongoID = t.id
+	}
+	return backup
+}
+
+// backupPolicyTargets returns the databases of the environment with one of the
+// given types, sorted by ID. names overrides the database name to dump;
+// databases without a name, such as MongoDB ones, are skipped unless listed.
+func backupPolicyTargets(env *client.Environment, databaseTypes []string, names map[string]string) []backupPolicyTarget {
+	byType := map[string][]client.Database{
+		"postgres": env.Postgres,
+		"mysql":    env.Mysql,
+		"mariadb":  env.Mariadb,
+		"mongo":    env.Mongo,
+	}
+
+	targets := []backupPolicyTarget{}
+	for _, databaseType := range databaseTypes {
+		for _, db := range byType[databaseType] {
+			id := databaseServiceID(db)
+			if id == "" {
+				continue
+			}
+			database, ok := names[id]
+			if !ok {
+				database = db.DatabaseName
+			}
+			if !ok && database == "" {
+				continue
+			}
+			targets = append(targets, backupPolicyTarget{id: id, databaseType: databaseType, database: database})
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].id < targets[j].id })
+	return targets
+}
+
+func backupMatchesPolicy(backup *client.Backup, policy *EnvironmentBackupPolicyResourceModel) bool {
+	return backup.DestinationID == policy.DestinationID.ValueString() &&
+		backup.Schedule == policy.Schedule.ValueString() &&
+		backup.Enabled == policy.Enabled.ValueBool() &&
+		backup.Prefix == policy.Prefix.ValueString() &&
+		int64(backup.KeepLatestCount) == policy.KeepLatestCount.ValueInt64()
+}
+
+func setPolicyFromBackup(policy *EnvironmentBackupPolicyResourceModel, backup *client.Backup) {
+	policy.DestinationID = types.StringValue(backup.DestinationID)
+	policy.Schedule = types.StringValue(backup.Schedule)
+	policy.Enabled = types.BoolValue(backup.Enabled)
+	policy.Prefix = types.StringValue(backup.Prefix)
+	policy.KeepLatestCount = types.Int64Value(int64(backup.KeepLatestCount))
+}
+
+func mapStrings(m types.Map) map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+	values := map[string]string{}
+	m.ElementsAs(context.Background(), &values, false)
+	return values
+}
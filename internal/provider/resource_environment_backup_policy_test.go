@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnvironmentBackupPolicyResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBackupPolicyResourceConfig("0 2 * * *", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_environment_backup_policy.test", "schedule", "0 2 * * *"),
+					resource.TestCheckResourceAttr("dokploy_environment_backup_policy.test", "keep_latest_count", "30"),
+					resource.TestCheckResourceAttr("dokploy_environment_backup_policy.test", "backups.%", "1"),
+					resource.TestCheckResourceAttrSet("dokploy_environment_backup_policy.test", "id"),
+				),
+			},
+			// Adding a database to the environment adds a backup for it.
+			{
+				Config: testAccEnvironmentBackupPolicyResourceConfig("0 3 * * *", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_environment_backup_policy.test", "schedule", "0 3 * * *"),
+					resource.TestCheckResourceAttr("dokploy_environment_backup_policy.test", "backups.%", "2"),
+				),
+			},
+			{
+				ResourceName:      "dokploy_environment_backup_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEnvironmentBackupPolicyResourceConfig(schedule string, withMysql bool) string {
+	mysql := ""
+	dependsOn := "dokploy_postgres.test"
+	if withMysql {
+		dependsOn += ", dokploy_mysql.test"
+		mysql = `
+resource "dokploy_mysql" "test" {
+  deletion_protection = false
+
+  name                   = "tf-backup-policy-mysql"
+  app_name               = "tf-backup-policy-mysql"
+  database_name          = "app"
+  database_user          = "app"
+  database_password      = "test_password_123"
+  database_root_password = "test_root_password_123"
+  environment_id         = dokploy_environment.test.id
+}
+`
+	}
+
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-backup-policy"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "tf-acc-backup-policy"
+}
+
+resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
+  name              = "tf-backup-policy-pg"
+  app_name          = "tf-backup-policy-pg"
+  database_name     = "app"
+  database_user     = "app"
+  database_password = "test_password_123"
+  environment_id    = dokploy_environment.test.id
+}
+%s
+resource "dokploy_destination" "test" {
+  name              = "tf-acc-backup-policy"
+  storage_provider  = "s3"
+  access_key        = "test-access-key"
+  secret_access_key = "test-secret-key"
+  bucket            = "test-backups"
+  region            = "us-east-1"
+  endpoint          = "https://s3.amazonaws.com"
+}
+
+resource "dokploy_environment_backup_policy" "test" {
+  environment_id = dokploy_environment.test.id
+  destination_id = dokploy_destination.test.id
+  schedule       = "%s"
+  prefix         = "nightly"
+
+  depends_on = [%s]
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), mysql, schedule, dependsOn)
+}
+
+func TestBackupPolicyTargets(t *testing.T) {
+	env := &client.Environment{
+		Postgres: []client.Database{{PostgresID: "pg-2", DatabaseName: "app"}, {PostgresID: "pg-1", DatabaseName: "billing"}},
+		Mysql:    []client.Database{{MysqlID: "my-1", DatabaseName: "shop"}},
+		Mongo:    []client.Database{{MongoID: "mo-1"}, {MongoID: "mo-2"}},
+		Redis:    []client.Database{{RedisID: "re-1"}},
+	}
+
+	targets := backupPolicyTargets(env, backupPolicyDatabaseTypes, map[string]string{"mo-2": "events", "my-1": "shop_v2"})
+	want := []backupPolicyTarget{
+		{id: "mo-2", databaseType: "mongo", database: "events"},
+		{id: "my-1", databaseType: "mysql", database: "shop_v2"},
+		{id: "pg-1", databaseType: "postgres", database: "billing"},
+		{id: "pg-2", databaseType: "postgres", database: "app"},
+	}
+	if fmt.Sprint(targets) != fmt.Sprint(want) {
+		t.Errorf("backupPolicyTargets() = %v, want %v", targets, want)
+	}
+
+	targets = backupPolicyTargets(env, []string{"mysql"}, nil)
+	if len(targets) != 1 || targets[0].id != "my-1" {
+		t.Errorf("backupPolicyTargets(mysql) = %v, want only my-1", targets)
+	}
+}
+
+func TestEnvironmentBackupPolicyApply(t *testing.T) {
+	mock := clientmock.New()
+	mock.GetEnvironmentFunc = func(id string) (*client.Environment, error) {
+		return &client.Environment{
+			ID:       id,
+			Postgres: []client.Database{{PostgresID: "pg-1", DatabaseName: "app"}, {PostgresID: "pg-new", DatabaseName: "new"}},
+		}, nil
+	}
+	var updated, created, deleted []string
+	mock.UpdateBackupFunc = func(backup client.Backup) (*client.Backup, error) {
+		if backup.Schedule != "0 4 * * *" || backup.DestinationID != "dest-1" {
+			t.Errorf("UpdateBackup got schedule %q, destination %q", backup.Schedule, backup.DestinationID)
+		}
+		updated = append(updated, backup.BackupID)
+		return &backup, nil
+	}
+	mock.CreateBackupFunc = func(backup client.Backup) (*client.Backup, error) {
+		if backup.PostgresID != "pg-new" || backup.Database != "new" || backup.DatabaseType != "postgres" {
+			t.Errorf("CreateBackup got %+v", backup)
+		}
+		created = append(created, backup.PostgresID)
+		backup.BackupID = "b-new"
+		return &backup, nil
+	}
+	mock.DeleteBackupFunc = func(id string) error {
+		deleted = append(deleted, id)
+		return nil
+	}
+	r := &EnvironmentBackupPolicyResource{client: mock}
+
+	plan := EnvironmentBackupPolicyResourceModel{
+		EnvironmentID:   types.StringValue("env-1"),
+		DestinationID:   types.StringValue("dest-1"),
+		Schedule:        types.StringValue("0 4 * * *"),
+		Enabled:         types.BoolValue(true),
+		Prefix:          types.StringValue("nightly"),
+		KeepLatestCount: types.Int64Value(30),
+		DatabaseTypes:   stringSet(backupPolicyDatabaseTypes),
+		DatabaseNames:   types.MapNull(types.StringType),
+	}
+	if err := r.apply(&plan, map[string]string{"pg-1": "b-1", "pg-gone": "b-gone"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(updated) != "[b-1]" || fmt.Sprint(created) != "[pg-new]" || fmt.Sprint(deleted) != "[b-gone]" {
+		t.Errorf("updated %v, created %v, deleted %v", updated, created, deleted)
+	}
+	backups := mapStrings(plan.Backups)
+	if len(backups) != 2 || backups["pg-1"] != "b-1" || backups["pg-new"] != "b-new" {
+		t.Errorf("backups = %v", backups)
+	}
+}
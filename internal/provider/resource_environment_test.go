@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -23,7 +31,7 @@ func TestAccEnvironmentResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccEnvironmentResourceConfig("test-env-project", "staging"),
+				Config: testAccEnvironmentResourceConfig("tf-acc-env-project", "staging"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_environment.test", "name", "staging"),
 					resource.TestCheckResourceAttrSet("dokploy_environment.test", "id"),
@@ -32,7 +40,7 @@ func TestAccEnvironmentResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccEnvironmentResourceConfig("test-env-project", "production"),
+				Config: testAccEnvironmentResourceConfig("tf-acc-env-project", "production"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_environment.test", "name", "production"),
 				),
@@ -93,7 +101,7 @@ func TestAccEnvironmentResourceWithDescription(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with description
 			{
-				Config: testAccEnvironmentResourceWithDescConfig("test-env-desc-project", "dev-env", "Development environment"),
+				Config: testAccEnvironmentResourceWithDescConfig("tf-acc-env-desc-project", "dev-env", "Development environment"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_environment.test", "name", "dev-env"),
 					resource.TestCheckResourceAttr("dokploy_environment.test", "description", "Development environment"),
@@ -102,7 +110,7 @@ func TestAccEnvironmentResourceWithDescription(t *testing.T) {
 			},
 			// Update description
 			{
-				Config: testAccEnvironmentResourceWithDescConfig("test-env-desc-project", "dev-env", "Updated development environment"),
+				Config: testAccEnvironmentResourceWithDescConfig("tf-acc-env-desc-project", "dev-env", "Updated development environment"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_environment.test", "name", "dev-env"),
 					resource.TestCheckResourceAttr("dokploy_environment.test", "description", "Updated development environment"),
@@ -131,3 +139,111 @@ resource "dokploy_environment" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, description)
 }
+
+func TestAccEnvironmentResourceWithEnv(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with env_map
+			{
+				Config: testAccEnvironmentResourceWithEnvConfig("tf-acc-env-vars-project", "env-vars", "info"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_environment.test", "env_map.%", "2"),
+					resource.TestCheckResourceAttr("dokploy_environment.test", "env_map.LOG_LEVEL", "info"),
+					resource.TestCheckResourceAttr("dokploy_environment.test", "env_map.REGION", "eu-west-1"),
+				),
+			},
+			// Update env_map
+			{
+				Config: testAccEnvironmentResourceWithEnvConfig("tf-acc-env-vars-project", "env-vars", "debug"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_environment.test", "env_map.LOG_LEVEL", "debug"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentResourceWithEnvConfig(projectName, envName, logLevel string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for environment env"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+
+  env_map = {
+    LOG_LEVEL = "%s"
+    REGION    = "eu-west-1"
+  }
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, logLevel)
+}
+
+func TestEnvironmentDeleteRefusesNonEmpty(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp fwresource.SchemaResponse
+	(&EnvironmentResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	run := func(forceDestroy bool, env *client.Environment) (diag.Diagnostics, bool) {
+		mock := clientmock.New()
+		mock.GetEnvironmentFunc = func(id string) (*client.Environment, error) { return env, nil }
+		deleted := false
+		mock.DeleteEnvironmentFunc = func(id string) error {
+			deleted = true
+			return nil
+		}
+
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		diags := state.Set(ctx, &EnvironmentResourceModel{
+			ID:           types.StringValue("env-1"),
+			ProjectID:    types.StringValue("proj-1"),
+			Name:         types.StringValue("staging"),
+			Description:  types.StringValue(""),
+			Env:          types.StringNull(),
+			EnvMap:       types.MapNull(types.StringType),
+			ForceDestroy: types.BoolValue(forceDestroy),
+		})
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		resp := &fwresource.DeleteResponse{}
+		(&EnvironmentResource{client: mock}).Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+		return resp.Diagnostics, deleted
+	}
+
+	full := &client.Environment{
+		Applications: []client.Application{{Name: "web"}},
+		Postgres:     []client.Database{{Name: "main-db"}},
+	}
+	diags, deleted := run(false, full)
+	if deleted || !diags.HasError() {
+		t.Fatalf("non-empty environment: deleted = %v, diags %v", deleted, diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, `application "web"`) || !strings.Contains(detail, `postgres "main-db"`) {
+		t.Errorf("error does not list the services: %s", detail)
+	}
+
+	if diags, deleted := run(true, full); !deleted || diags.HasError() {
+		t.Errorf("force_destroy: deleted = %v, diags %v", deleted, diags)
+	}
+	if diags, deleted := run(false, &client.Environment{}); !deleted || diags.HasError() {
+		t.Errorf("empty environment: deleted = %v, diags %v", deleted, diags)
+	}
+}
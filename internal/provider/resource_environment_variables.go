@@ -2,33 +2,57 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &EnvironmentVariablesResource{}
 var _ resource.ResourceWithImportState = &EnvironmentVariablesResource{}
+var _ resource.ResourceWithValidateConfig = &EnvironmentVariablesResource{}
+var _ resource.ResourceWithModifyPlan = &EnvironmentVariablesResource{}
 
 func NewEnvironmentVariablesResource() resource.Resource {
 	return &EnvironmentVariablesResource{}
 }
 
 type EnvironmentVariablesResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type EnvironmentVariablesResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	ApplicationID types.String `tfsdk:"application_id"`
-	Variables     types.Map    `tfsdk:"variables"`
-	CreateEnvFile types.Bool   `tfsdk:"create_env_file"`
+	ID              types.String `tfsdk:"id"`
+	ApplicationID   types.String `tfsdk:"application_id"`
+	EnvironmentID   types.String `tfsdk:"environment_id"`
+	Variables       types.Map    `tfsdk:"variables"`
+	ValuesWO        types.Map    `tfsdk:"values_wo"`
+	ValuesWOVersion types.Int64  `tfsdk:"values_wo_version"`
+	ValuesFrom      types.Map    `tfsdk:"values_from"`
+	SecretKeys      types.Set    `tfsdk:"secret_keys"`
+	CreateEnvFile   types.Bool   `tfsdk:"create_env_file"`
+}
+
+// variableSourceModel references a credential of a Dokploy database.
+type variableSourceModel struct {
+	Type       types.String `tfsdk:"type"`
+	DatabaseID types.String `tfsdk:"database_id"`
+	Field      types.String `tfsdk:"field"`
 }
 
 func (r *EnvironmentVariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,18 +61,78 @@ func (r *EnvironmentVariablesResource) Metadata(_ context.Context, req resource.
 
 func (r *EnvironmentVariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages all environment variables for a Dokploy application as a single resource.",
+		Description: "Manages all environment variables for a Dokploy application, or the shared variables of an environment, as a single resource.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
 			"application_id": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "The application to manage variables for. Exactly one of application_id or environment_id must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("environment_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The environment to manage shared variables for. Environment variables cascade to every service in the environment. Do not combine with env or env_map on the dokploy_environment: both write the environment's variables and overwrite each other on every apply.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"variables": schema.MapAttribute{
-				Required:    true,
+				Optional:    true,
 				ElementType: types.StringType,
 				Sensitive:   true,
+				Description: "Variables stored in state. Variables named in values_wo or values_from must not be set here.",
+			},
+			"values_wo": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+				Description: "Secret variables. Write-only: the values are sent to Dokploy but never stored in state, so changing them alone causes no diff; bump values_wo_version to send new values. Requires Terraform 1.11 or later.",
+			},
+			"values_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Arbitrary number to change whenever values_wo changes, so the new values are sent to Dokploy.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("values_wo")),
+				},
+			},
+			"values_from": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Variables set from the credentials of a Dokploy database, keyed by variable name. The credential is looked up when the variables are written and never stored in state; when it changes in Dokploy, the next plan writes it again.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "Database type: postgres, mysql, mariadb, mongo, or redis.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("postgres", "mysql", "mariadb", "mongo", "redis"),
+							},
+						},
+						"database_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the database.",
+						},
+						"field": schema.StringAttribute{
+							Required:    true,
+							Description: "The credential to use: username, password, database_name, internal_host, internal_port, or internal_connection_url.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(variableSourceFields...),
+							},
+						},
+					},
+				},
+			},
+			"secret_keys": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the variables set from values_wo and values_from. Their values are left out of variables.",
 			},
 			"create_env_file": schema.BoolAttribute{
 				Optional: true,
@@ -63,9 +147,9 @@ func (r *EnvironmentVariablesResource) Configure(_ context.Context, req resource
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -79,25 +163,27 @@ func (r *EnvironmentVariablesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	envMap := make(map[string]string)
-	diags = plan.Variables.ElementsAs(ctx, &envMap, false)
-	resp.Diagnostics.Append(diags...)
+	if !plan.EnvironmentID.IsNull() && !requireDokployVersion(r.client, minVersionEnvironments, "environment_id", &resp.Diagnostics) {
+		return
+	}
+
+	envMap := r.desiredEnv(ctx, &plan, req.Config.GetAttribute, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.UpdateApplicationEnv(plan.ApplicationID.ValueString(), func(m map[string]string) {
+	err := r.updateEnv(&plan, func(m map[string]string) {
 		for k, v := range envMap {
 			m[k] = v
 		}
-	}, plan.CreateEnvFile.ValueBoolPointer())
+	})
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating environment variables", err.Error())
 		return
 	}
 
-	plan.ID = plan.ApplicationID
+	plan.ID = types.StringValue(plan.targetID())
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -111,19 +197,22 @@ func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	app, err := r.client.GetApplication(state.ApplicationID.ValueString())
+	env, err := r.readEnv(&state)
 	if err != nil {
 		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading application", err.Error())
+		resp.Diagnostics.AddError("Error reading environment variables", err.Error())
 		return
 	}
 
-	envMap := client.ParseEnv(app.Env)
-	state.Variables, diags = types.MapValueFrom(ctx, types.StringType, envMap)
-	resp.Diagnostics.Append(diags...)
+	envMap := client.ParseEnv(env)
+	r.refreshSecrets(ctx, &state, envMap, &resp.Diagnostics)
+	if len(envMap) > 0 || !state.Variables.IsNull() {
+		state.Variables, diags = types.MapValueFrom(ctx, types.StringType, envMap)
+		resp.Diagnostics.Append(diags...)
+	}
 
 	// The CreateEnvFile attribute is not stored in the API, so we keep the configured value.
 	// If it's not configured, Terraform will use the default.
@@ -142,14 +231,12 @@ func (r *EnvironmentVariablesResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	envMap := make(map[string]string)
-	diags = plan.Variables.ElementsAs(ctx, &envMap, false)
-	resp.Diagnostics.Append(diags...)
+	envMap := r.desiredEnv(ctx, &plan, req.Config.GetAttribute, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.UpdateApplicationEnv(plan.ApplicationID.ValueString(), func(m map[string]string) {
+	err := r.updateEnv(&plan, func(m map[string]string) {
 		// Clear existing vars and set new ones
 		for k := range m {
 			delete(m, k)
@@ -157,14 +244,14 @@ func (r *EnvironmentVariablesResource) Update(ctx context.Context, req resource.
 		for k, v := range envMap {
 			m[k] = v
 		}
-	}, plan.CreateEnvFile.ValueBoolPointer())
+	})
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating environment variables", err.Error())
 		return
 	}
 
-	plan.ID = plan.ApplicationID
+	plan.ID = types.StringValue(plan.targetID())
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -178,11 +265,11 @@ func (r *EnvironmentVariablesResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	err := r.client.UpdateApplicationEnv(state.ApplicationID.ValueString(), func(m map[string]string) {
+	err := r.updateEnv(&state, func(m map[string]string) {
 		for k := range m {
 			delete(m, k)
 		}
-	}, state.CreateEnvFile.ValueBoolPointer())
+	})
 
 	if err != nil {
 		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
@@ -193,11 +280,244 @@ func (r *EnvironmentVariablesResource) Delete(ctx context.Context, req resource.
 	}
 }
 
+func (r *EnvironmentVariablesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A variable can only get its value from one place.
+	sources := map[string]string{}
+	for _, source := range []struct {
+		name   string
+		values types.Map
+	}{
+		{"variables", config.Variables},
+		{"values_wo", config.ValuesWO},
+		{"values_from", config.ValuesFrom},
+	} {
+		for key := range source.values.Elements() {
+			if other, ok := sources[key]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(source.name).AtMapKey(key),
+					"Duplicate Variable",
+					fmt.Sprintf("Variable %s is set in both %s and %s. Set it in one of them.", key, other, source.name),
+				)
+				continue
+			}
+			sources[key] = source.name
+		}
+	}
+}
+
+func (r *EnvironmentVariablesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var valuesWO, valuesFrom types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("values_wo"), &valuesWO)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("values_from"), &valuesFrom)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if valuesWO.IsUnknown() || valuesFrom.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_keys"), types.SetUnknown(types.StringType))...)
+		return
+	}
+	secretKeys := secretKeysOf(valuesWO, valuesFrom)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_keys"), secretKeys)...)
+}
+
 func (r *EnvironmentVariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// The import ID is the application_id
+	// Import format: application_id, or environment:environment_id for shared environment variables
+	if environmentID, ok := strings.CutPrefix(req.ID, "environment:"); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), environmentID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentID)...)
+		return
+	}
+
 	applicationID := req.ID
 
 	// Set both id and application_id to the same value
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), applicationID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationID)...)
 }
+
+// targetID returns the ID of the application or environment owning the variables.
+func (m *EnvironmentVariablesResourceModel) targetID() string {
+	if !m.EnvironmentID.IsNull() && m.EnvironmentID.ValueString() != "" {
+		return m.EnvironmentID.ValueString()
+	}
+	return m.ApplicationID.ValueString()
+}
+
+func (r *EnvironmentVariablesResource) readEnv(m *EnvironmentVariablesResourceModel) (string, error) {
+	if !m.EnvironmentID.IsNull() && m.EnvironmentID.ValueString() != "" {
+		env, err := r.client.GetEnvironment(m.EnvironmentID.ValueString())
+		if err != nil {
+			return "", err
+		}
+		return env.Env, nil
+	}
+
+	app, err := r.client.GetApplication(m.ApplicationID.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return app.Env, nil
+}
+
+func (r *EnvironmentVariablesResource) updateEnv(m *EnvironmentVariablesResourceModel, updateFn func(envMap map[string]string)) error {
+	if !m.EnvironmentID.IsNull() && m.EnvironmentID.ValueString() != "" {
+		return r.client.UpdateEnvironmentEnv(m.EnvironmentID.ValueString(), updateFn)
+	}
+	return r.client.UpdateApplicationEnv(m.ApplicationID.ValueString(), updateFn, m.CreateEnvFile.ValueBoolPointer())
+}
+
+// variableSourceFields are the database credentials values_from can refer to.
+var variableSourceFields = []string{"username", "password", "database_name", "internal_host", "internal_port", "internal_connection_url"}
+
+// secretKeysOf returns the names of the variables set in values_wo and
+// values_from, or null when there are none.
+func secretKeysOf(valuesWO, valuesFrom types.Map) types.Set {
+	var keys []attr.Value
+	for _, values := range []types.Map{valuesWO, valuesFrom} {
+		for key := range values.Elements() {
+			keys = append(keys, types.StringValue(key))
+		}
+	}
+	if len(keys) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return types.SetValueMust(types.StringType, keys)
+}
+
+// desiredEnv returns the variables to write: the variables map, the
+// write-only values_wo read from getConfig, and the resolved values_from.
+func (r *EnvironmentVariablesResource) desiredEnv(ctx context.Context, m *EnvironmentVariablesResourceModel, getConfig func(context.Context, path.Path, interface{}) diag.Diagnostics, diags *diag.Diagnostics) map[string]string {
+	envMap := make(map[string]string)
+	if !m.Variables.IsNull() {
+		diags.Append(m.Variables.ElementsAs(ctx, &envMap, false)...)
+	}
+
+	var valuesWO types.Map
+	diags.Append(getConfig(ctx, path.Root("values_wo"), &valuesWO)...)
+	if !valuesWO.IsNull() {
+		secrets := make(map[string]string)
+		diags.Append(valuesWO.ElementsAs(ctx, &secrets, false)...)
+		for k, v := range secrets {
+			envMap[k] = v
+		}
+	}
+
+	resolved, err := r.resolveValuesFrom(ctx, m.ValuesFrom, diags)
+	if err != nil {
+		diags.AddAttributeError(path.Root("values_from"), "Unable to Resolve Variable", err.Error())
+	}
+	for k, v := range resolved {
+		envMap[k] = v
+	}
+	return envMap
+}
+
+// resolveValuesFrom looks up the credentials values_from refers to.
+func (r *EnvironmentVariablesResource) resolveValuesFrom(ctx context.Context, valuesFrom types.Map, diags *diag.Diagnostics) (map[string]string, error) {
+	if valuesFrom.IsNull() {
+		return nil, nil
+	}
+	sources := make(map[string]variableSourceModel)
+	diags.Append(valuesFrom.ElementsAs(ctx, &sources, false)...)
+
+	keys := make([]string, 0, len(sources))
+	for key := range sources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resolved := make(map[string]string, len(sources))
+	for _, key := range keys {
+		value, err := resolveVariableSource(r.client, sources[key])
+		if err != nil {
+			return nil, fmt.Errorf("variable %s: %w", key, err)
+		}
+		resolved[key] = value
+	}
+	return resolved, nil
+}
+
+// resolveVariableSource returns the database credential source refers to.
+func resolveVariableSource(c client.Client, source variableSourceModel) (string, error) {
+	creds, err := getDatabaseCredentials(c, source.Type.ValueString(), source.DatabaseID.ValueString())
+	if err != nil {
+		return "", err
+	}
+	switch source.Field.ValueString() {
+	case "username":
+		return creds.username, nil
+	case "password":
+		return creds.password, nil
+	case "database_name":
+		return creds.databaseName, nil
+	case "internal_host":
+		return creds.appName, nil
+	case "internal_port":
+		return strconv.Itoa(creds.internalPort), nil
+	case "internal_connection_url":
+		return creds.connectionURL(creds.appName, creds.internalPort), nil
+	}
+	return "", fmt.Errorf("unsupported field: %s", source.Field.ValueString())
+}
+
+// refreshSecrets removes the secret variables from envMap, so their values
+// stay out of state, and plans their rewrite when they drifted: a secret
+// that is gone from Dokploy is dropped from secret_keys, and a values_from
+// entry whose credential no longer matches is dropped from values_from.
+func (r *EnvironmentVariablesResource) refreshSecrets(ctx context.Context, state *EnvironmentVariablesResourceModel, envMap map[string]string, diags *diag.Diagnostics) {
+	if state.SecretKeys.IsNull() || state.SecretKeys.IsUnknown() {
+		return
+	}
+	var secretKeys []string
+	diags.Append(state.SecretKeys.ElementsAs(ctx, &secretKeys, false)...)
+
+	sources := make(map[string]variableSourceModel)
+	if !state.ValuesFrom.IsNull() {
+		diags.Append(state.ValuesFrom.ElementsAs(ctx, &sources, false)...)
+	}
+
+	present := make([]attr.Value, 0, len(secretKeys))
+	for _, key := range secretKeys {
+		value, ok := envMap[key]
+		delete(envMap, key)
+		if !ok {
+			continue
+		}
+		present = append(present, types.StringValue(key))
+
+		source, ok := sources[key]
+		if !ok {
+			continue
+		}
+		resolved, err := resolveVariableSource(r.client, source)
+		switch {
+		case err != nil && !errors.Is(err, client.ErrNotFound):
+			diags.AddAttributeWarning(
+				path.Root("values_from").AtMapKey(key),
+				"Unable to Verify Variable",
+				fmt.Sprintf("Could not look up the credential of variable %s: %s", key, err.Error()),
+			)
+		case err != nil || resolved != value:
+			delete(sources, key)
+		}
+	}
+
+	state.SecretKeys = types.SetValueMust(types.StringType, present)
+	if !state.ValuesFrom.IsNull() {
+		valuesFrom, d := types.MapValueFrom(ctx, state.ValuesFrom.ElementType(ctx), sources)
+		diags.Append(d...)
+		state.ValuesFrom = valuesFrom
+	}
+}
@@ -21,7 +21,7 @@ func NewGiteaProviderResource() resource.Resource {
 }
 
 type GiteaProviderResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type GiteaProviderResourceModel struct {
@@ -140,9 +140,9 @@ func (r *GiteaProviderResource) Configure(_ context.Context, req resource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -21,7 +21,7 @@ func NewGitlabProviderResource() resource.Resource {
 }
 
 type GitlabProviderResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type GitlabProviderResourceModel struct {
@@ -129,9 +129,9 @@ func (r *GitlabProviderResource) Configure(_ context.Context, req resource.Confi
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
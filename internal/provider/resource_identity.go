@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serviceIdentityModel is the resource identity of applications, compose
+// stacks and databases: the Dokploy ID, which stays the same when the
+// resource moves between modules or environments.
+type serviceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// serviceIdentitySchema sets the identity schema shared by services.
+func serviceIdentitySchema(resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Dokploy ID of the resource.",
+			},
+		},
+	}
+}
+
+// setServiceIdentity records id as the identity of a service. identity is
+// nil when Terraform is too old to support resource identities.
+func setServiceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil || id.IsNull() || id.IsUnknown() {
+		return nil
+	}
+	return identity.Set(ctx, serviceIdentityModel{ID: id})
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestServiceIdentitySchemas checks that every service resource exposes
+// its ID as a resource identity.
+func TestServiceIdentitySchemas(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+	resp, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{
+		"dokploy_application",
+		"dokploy_compose",
+		"dokploy_postgres",
+		"dokploy_mysql",
+		"dokploy_mariadb",
+		"dokploy_mongo",
+		"dokploy_redis",
+	} {
+		s, ok := resp.IdentitySchemas[name]
+		if !ok {
+			t.Errorf("%s has no identity schema", name)
+			continue
+		}
+		if len(s.IdentityAttributes) != 1 || s.IdentityAttributes[0].Name != "id" || !s.IdentityAttributes[0].RequiredForImport {
+			t.Errorf("%s identity = %+v, want a single required id", name, s.IdentityAttributes)
+		}
+	}
+}
+
+func TestAccComposeResourceIdentity(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComposeResourceConfig("tf-acc-identity-project", "test-env", "test-identity", "services:\n  web:\n    image: nginx:alpine", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("dokploy_compose.test", tfjsonpath.New("id")),
+				},
+			},
+		},
+	})
+}
@@ -22,10 +22,12 @@ func TestAccMariaDBResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccMariaDBResourceConfig("test-mariadb-project", "test-mariadb-env", "test-mariadb", "testmariadbapp", "testdb", "testuser"),
+				Config: testAccMariaDBResourceConfig("tf-acc-mariadb-project", "test-mariadb-env", "test-mariadb", "testmariadbapp", "testdb", "testuser"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mariadb.test", "name", "test-mariadb"),
 					resource.TestCheckResourceAttrSet("dokploy_mariadb.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_mariadb.test", "internal_host"),
+					resource.TestCheckResourceAttr("dokploy_mariadb.test", "internal_port", "3306"),
 					resource.TestCheckResourceAttrSet("dokploy_mariadb.test", "environment_id"),
 					resource.TestCheckResourceAttr("dokploy_mariadb.test", "database_name", "testdb"),
 					resource.TestCheckResourceAttr("dokploy_mariadb.test", "database_user", "testuser"),
@@ -33,7 +35,7 @@ func TestAccMariaDBResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccMariaDBResourceConfigWithDescription("test-mariadb-project", "test-mariadb-env", "test-mariadb-updated", "testmariadbapp", "testdb", "testuser", "Updated MariaDB instance"),
+				Config: testAccMariaDBResourceConfigWithDescription("tf-acc-mariadb-project", "test-mariadb-env", "test-mariadb-updated", "testmariadbapp", "testdb", "testuser", "Updated MariaDB instance"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mariadb.test", "name", "test-mariadb-updated"),
 					resource.TestCheckResourceAttr("dokploy_mariadb.test", "description", "Updated MariaDB instance"),
@@ -44,7 +46,7 @@ func TestAccMariaDBResource(t *testing.T) {
 				ResourceName:            "dokploy_mariadb.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "database_root_password", "app_name"},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "database_root_password", "app_name", "deletion_protection"},
 			},
 		},
 	})
@@ -68,6 +70,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_mariadb" "test" {
+  deletion_protection = false
+
   name                   = "%s"
   app_name               = "%s"
   database_name          = "%s"
@@ -97,6 +101,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_mariadb" "test" {
+  deletion_protection = false
+
   name                   = "%s"
   app_name               = "%s"
   database_name          = "%s"
@@ -108,3 +114,67 @@ resource "dokploy_mariadb" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, mariadbName, appName, dbName, dbUser, description)
 }
+
+// TestAccMariaDBResourceClearSettings tests that removing optional settings from
+// the configuration clears them on the MariaDB instance.
+func TestAccMariaDBResourceClearSettings(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with every optional setting
+			{
+				Config: testAccMariaDBResourceSettingsConfig("tf-acc-mariadb-clear-project", "test-mariadb-clear-env", testAccDatabaseSettings("mariadbd --max-connections=200", 43307)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_mariadb.test", "command", "mariadbd --max-connections=200"),
+					resource.TestCheckResourceAttr("dokploy_mariadb.test", "env", "TZ=UTC"),
+					resource.TestCheckResourceAttr("dokploy_mariadb.test", "cpu_limit", "0.5"),
+					resource.TestCheckResourceAttr("dokploy_mariadb.test", "external_port", "43307"),
+				),
+			},
+			// Remove the settings again
+			{
+				Config: testAccMariaDBResourceSettingsConfig("tf-acc-mariadb-clear-project", "test-mariadb-clear-env", ""),
+				Check:  testAccCheckDatabaseSettingsCleared("dokploy_mariadb.test"),
+			},
+		},
+	})
+}
+
+func testAccMariaDBResourceSettingsConfig(projectName, envName, settings string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for MariaDB settings tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_mariadb" "test" {
+  deletion_protection = false
+
+  name                   = "test-mariadb-clear"
+  app_name               = "testmariadbclear"
+  database_name          = "testdb"
+  database_user          = "testuser"
+  database_password      = "test_mariadb_password_123"
+  database_root_password = "test_mariadb_root_password_123"
+  environment_id         = dokploy_environment.test.id
+%s}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, settings)
+}
@@ -13,46 +13,61 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &MongoDBResource{}
 var _ resource.ResourceWithImportState = &MongoDBResource{}
+var _ resource.ResourceWithIdentity = &MongoDBResource{}
 
 func NewMongoDBResource() resource.Resource {
 	return &MongoDBResource{}
 }
 
 type MongoDBResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type MongoDBResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	AppName           types.String `tfsdk:"app_name"`
-	Description       types.String `tfsdk:"description"`
-	DatabaseUser      types.String `tfsdk:"database_user"`
-	DatabasePassword  types.String `tfsdk:"database_password"`
-	ReplicaSets       types.Bool   `tfsdk:"replica_sets"`
-	DockerImage       types.String `tfsdk:"docker_image"`
-	Command           types.String `tfsdk:"command"`
-	Env               types.String `tfsdk:"env"`
-	MemoryReservation types.String `tfsdk:"memory_reservation"`
-	MemoryLimit       types.String `tfsdk:"memory_limit"`
-	CPUReservation    types.String `tfsdk:"cpu_reservation"`
-	CPULimit          types.String `tfsdk:"cpu_limit"`
-	ExternalPort      types.Int64  `tfsdk:"external_port"`
-	EnvironmentID     types.String `tfsdk:"environment_id"`
-	ApplicationStatus types.String `tfsdk:"application_status"`
-	Replicas          types.Int64  `tfsdk:"replicas"`
-	ServerID          types.String `tfsdk:"server_id"`
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	AppName               types.String `tfsdk:"app_name"`
+	Description           types.String `tfsdk:"description"`
+	DatabaseUser          types.String `tfsdk:"database_user"`
+	DatabasePassword      types.String `tfsdk:"database_password"`
+	ReplicaSets           types.Bool   `tfsdk:"replica_sets"`
+	DockerImage           types.String `tfsdk:"docker_image"`
+	Command               types.String `tfsdk:"command"`
+	Env                   types.String `tfsdk:"env"`
+	MemoryReservation     types.String `tfsdk:"memory_reservation"`
+	MemoryLimit           types.String `tfsdk:"memory_limit"`
+	CPUReservation        types.String `tfsdk:"cpu_reservation"`
+	CPULimit              types.String `tfsdk:"cpu_limit"`
+	ExternalPort          types.Int64  `tfsdk:"external_port"`
+	EnvironmentID         types.String `tfsdk:"environment_id"`
+	ApplicationStatus     types.String `tfsdk:"application_status"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	DashboardURL          types.String `tfsdk:"dashboard_url"`
+	Replicas              types.Int64  `tfsdk:"replicas"`
+	ServerID              types.String `tfsdk:"server_id"`
+	DeletionProtection    types.Bool   `tfsdk:"deletion_protection"`
+	ApplyImmediately      types.Bool   `tfsdk:"apply_immediately"`
+	BackupBeforeUpgrade   types.Bool   `tfsdk:"backup_before_upgrade"`
+	SkipFinalBackup       types.Bool   `tfsdk:"skip_final_backup"`
+	InternalHost          types.String `tfsdk:"internal_host"`
+	InternalPort          types.Int64  `tfsdk:"internal_port"`
+	InternalConnectionURL types.String `tfsdk:"internal_connection_url"`
 }
 
 func (r *MongoDBResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mongo"
 }
 
+func (r *MongoDBResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	serviceIdentitySchema(resp)
+}
+
 func (r *MongoDBResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a MongoDB database instance in Dokploy.",
@@ -112,6 +127,9 @@ func (r *MongoDBResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"env": schema.StringAttribute{
 				Optional:    true,
 				Description: "Environment variables for the container.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 			"memory_reservation": schema.StringAttribute{
 				Optional:    true,
@@ -147,6 +165,8 @@ func (r *MongoDBResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_at":    createdAtAttribute("MongoDB database"),
+			"dashboard_url": dashboardURLAttribute("MongoDB database"),
 			"replicas": schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
@@ -162,6 +182,13 @@ func (r *MongoDBResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"internal_host":           internalHostAttribute(),
+			"internal_port":           internalPortAttribute(),
+			"internal_connection_url": internalConnectionURLAttribute(),
+			"deletion_protection":     deletionProtectionAttribute(),
+			"apply_immediately":       applyImmediatelyAttribute(),
+			"backup_before_upgrade":   backupBeforeUpgradeAttribute(),
+			"skip_final_backup":       skipFinalBackupAttribute(),
 		},
 	}
 }
@@ -170,9 +197,9 @@ func (r *MongoDBResource) Configure(_ context.Context, req resource.ConfigureReq
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.DokployClient)
+	c, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = c
@@ -243,8 +270,11 @@ func (r *MongoDBResource) Create(ctx context.Context, req resource.CreateRequest
 	// Set state from created resource
 	r.mapMongoDBToState(&plan, createdMongo)
 
+	readDashboardURL(r.client, "mongo", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *MongoDBResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -273,8 +303,11 @@ func (r *MongoDBResource) Read(ctx context.Context, req resource.ReadRequest, re
 		state.AppName = appNamePrefix
 	}
 
+	readDashboardURL(r.client, "mongo", state.ID.ValueString(), state.EnvironmentID.ValueString(), &state.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *MongoDBResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -285,6 +318,16 @@ func (r *MongoDBResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	var priorImage types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("docker_image"), &priorImage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	upgrade := imageUpgrade(priorImage, plan.DockerImage, plan.ApplyImmediately)
+	if upgrade && plan.BackupBeforeUpgrade.ValueBool() && !runUpgradeBackups(r.client, plan.ID.ValueString(), "mongo", &resp.Diagnostics) {
+		return
+	}
+
 	mongo := client.MongoDB{
 		MongoID:           plan.ID.ValueString(),
 		Name:              plan.Name.ValueString(),
@@ -300,6 +343,12 @@ func (r *MongoDBResource) Update(ctx context.Context, req resource.UpdateRequest
 		CPULimit:          plan.CPULimit.ValueString(),
 		ExternalPort:      int(plan.ExternalPort.ValueInt64()),
 		Replicas:          int(plan.Replicas.ValueInt64()),
+		Clear: clearedDatabaseFields(
+			plan.Description, plan.Command, plan.Env,
+			plan.MemoryReservation, plan.MemoryLimit,
+			plan.CPUReservation, plan.CPULimit,
+			plan.ExternalPort,
+		),
 	}
 
 	_, err := r.client.UpdateMongoDB(mongo)
@@ -308,6 +357,10 @@ func (r *MongoDBResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if upgrade && redeployDatabase(ctx, r.client, plan.ID.ValueString(), "mongo", &resp.Diagnostics) == "" {
+		return
+	}
+
 	// Fetch updated state
 	updatedMongo, err := r.client.GetMongoDB(plan.ID.ValueString())
 	if err != nil {
@@ -320,8 +373,11 @@ func (r *MongoDBResource) Update(ctx context.Context, req resource.UpdateRequest
 	r.mapMongoDBToState(&plan, updatedMongo)
 	plan.AppName = appNamePrefix
 
+	readDashboardURL(r.client, "mongo", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *MongoDBResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -332,18 +388,26 @@ func (r *MongoDBResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteMongoDB(state.ID.ValueString())
+	if !checkDeletionProtection(state.DeletionProtection, "dokploy_mongo", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+	if !state.SkipFinalBackup.ValueBool() && !runFinalBackups(r.client, state.ID.ValueString(), "mongo", &resp.Diagnostics) {
+		return
+	}
+
+	err := deleteChild(ctx, func() error { return r.client.DeleteMongoDB(state.ID.ValueString()) }, environmentParent(r.client, state.EnvironmentID.ValueString()))
 	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting MongoDB instance", err.Error())
 		return
 	}
 }
 
 func (r *MongoDBResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("apply_immediately"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backup_before_upgrade"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_final_backup"), false)...)
 }
 
 func (r *MongoDBResource) mapMongoDBToState(state *MongoDBResourceModel, mongo *client.MongoDB) {
@@ -351,6 +415,7 @@ func (r *MongoDBResource) mapMongoDBToState(state *MongoDBResourceModel, mongo *
 	state.Name = types.StringValue(mongo.Name)
 	state.EnvironmentID = types.StringValue(mongo.EnvironmentID)
 	state.ApplicationStatus = types.StringValue(mongo.ApplicationStatus)
+	state.CreatedAt = optionalString(mongo.CreatedAt)
 	state.DatabaseUser = types.StringValue(mongo.DatabaseUser)
 	state.ReplicaSets = types.BoolValue(mongo.ReplicaSets)
 
@@ -391,4 +456,6 @@ func (r *MongoDBResource) mapMongoDBToState(state *MongoDBResourceModel, mongo *
 	if !state.ServerID.IsNull() || mongo.ServerID != "" {
 		state.ServerID = types.StringValue(mongo.ServerID)
 	}
+
+	state.InternalHost, state.InternalPort, state.InternalConnectionURL = internalConnection(mongoCredentials(mongo), state.DatabasePassword)
 }
@@ -22,10 +22,12 @@ func TestAccMongoDBResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccMongoDBResourceConfig("test-mongo-project", "test-mongo-env", "test-mongo", "testmongoapp", "testuser"),
+				Config: testAccMongoDBResourceConfig("tf-acc-mongo-project", "test-mongo-env", "test-mongo", "testmongoapp", "testuser"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mongo.test", "name", "test-mongo"),
 					resource.TestCheckResourceAttrSet("dokploy_mongo.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_mongo.test", "internal_host"),
+					resource.TestCheckResourceAttr("dokploy_mongo.test", "internal_port", "27017"),
 					resource.TestCheckResourceAttrSet("dokploy_mongo.test", "environment_id"),
 					resource.TestCheckResourceAttr("dokploy_mongo.test", "database_user", "testuser"),
 					resource.TestCheckResourceAttr("dokploy_mongo.test", "replica_sets", "false"),
@@ -33,7 +35,7 @@ func TestAccMongoDBResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccMongoDBResourceConfigWithDescription("test-mongo-project", "test-mongo-env", "test-mongo-updated", "testmongoapp", "testuser", "Updated MongoDB instance"),
+				Config: testAccMongoDBResourceConfigWithDescription("tf-acc-mongo-project", "test-mongo-env", "test-mongo-updated", "testmongoapp", "testuser", "Updated MongoDB instance"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mongo.test", "name", "test-mongo-updated"),
 					resource.TestCheckResourceAttr("dokploy_mongo.test", "description", "Updated MongoDB instance"),
@@ -44,7 +46,7 @@ func TestAccMongoDBResource(t *testing.T) {
 				ResourceName:            "dokploy_mongo.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "app_name"},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "app_name", "deletion_protection"},
 			},
 		},
 	})
@@ -68,6 +70,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_mongo" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_user     = "%s"
@@ -95,6 +99,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_mongo" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_user     = "%s"
@@ -120,7 +126,7 @@ func TestAccMongoDBResourceWithReplicaSets(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with replica sets
 			{
-				Config: testAccMongoDBResourceWithReplicaSetsConfig("test-mongo-rs-project", "test-mongo-rs-env", "test-mongo-rs", "testmongors", "testuser"),
+				Config: testAccMongoDBResourceWithReplicaSetsConfig("tf-acc-mongo-rs-project", "test-mongo-rs-env", "test-mongo-rs", "testmongors", "testuser"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mongo.test", "name", "test-mongo-rs"),
 					resource.TestCheckResourceAttr("dokploy_mongo.test", "replica_sets", "true"),
@@ -149,6 +155,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_mongo" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_user     = "%s"
@@ -158,3 +166,65 @@ resource "dokploy_mongo" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, mongoName, appName, dbUser)
 }
+
+// TestAccMongoDBResourceClearSettings tests that removing optional settings from
+// the configuration clears them on the MongoDB instance.
+func TestAccMongoDBResourceClearSettings(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with every optional setting
+			{
+				Config: testAccMongoDBResourceSettingsConfig("tf-acc-mongo-clear-project", "test-mongo-clear-env", testAccDatabaseSettings("mongod --quiet", 47017)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_mongo.test", "command", "mongod --quiet"),
+					resource.TestCheckResourceAttr("dokploy_mongo.test", "env", "TZ=UTC"),
+					resource.TestCheckResourceAttr("dokploy_mongo.test", "cpu_limit", "0.5"),
+					resource.TestCheckResourceAttr("dokploy_mongo.test", "external_port", "47017"),
+				),
+			},
+			// Remove the settings again
+			{
+				Config: testAccMongoDBResourceSettingsConfig("tf-acc-mongo-clear-project", "test-mongo-clear-env", ""),
+				Check:  testAccCheckDatabaseSettingsCleared("dokploy_mongo.test"),
+			},
+		},
+	})
+}
+
+func testAccMongoDBResourceSettingsConfig(projectName, envName, settings string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for MongoDB settings tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_mongo" "test" {
+  deletion_protection = false
+
+  name              = "test-mongo-clear"
+  app_name          = "testmongoclear"
+  database_user     = "testuser"
+  database_password = "test_mongo_password_123"
+  environment_id    = dokploy_environment.test.id
+%s}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, settings)
+}
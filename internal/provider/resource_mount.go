@@ -2,39 +2,46 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &MountResource{}
 var _ resource.ResourceWithImportState = &MountResource{}
+var _ resource.ResourceWithModifyPlan = &MountResource{}
 
 func NewMountResource() resource.Resource {
 	return &MountResource{}
 }
 
 type MountResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type MountResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Type        types.String `tfsdk:"type"`
-	HostPath    types.String `tfsdk:"host_path"`
-	VolumeName  types.String `tfsdk:"volume_name"`
-	Content     types.String `tfsdk:"content"`
-	MountPath   types.String `tfsdk:"mount_path"`
-	ServiceType types.String `tfsdk:"service_type"`
-	FilePath    types.String `tfsdk:"file_path"`
-	ServiceID   types.String `tfsdk:"service_id"`
+	ID               types.String `tfsdk:"id"`
+	Type             types.String `tfsdk:"type"`
+	HostPath         types.String `tfsdk:"host_path"`
+	VolumeName       types.String `tfsdk:"volume_name"`
+	Content          types.String `tfsdk:"content"`
+	SensitiveContent types.String `tfsdk:"sensitive_content"`
+	ContentSHA256    types.String `tfsdk:"content_sha256"`
+	MountPath        types.String `tfsdk:"mount_path"`
+	ServiceType      types.String `tfsdk:"service_type"`
+	FilePath         types.String `tfsdk:"file_path"`
+	ServiceID        types.String `tfsdk:"service_id"`
 }
 
 func (r *MountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,9 +72,20 @@ func (r *MountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Volume name for volume mounts.",
 			},
 			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content for file mounts. Changes made outside Terraform show up as a diff; use sensitive_content to hide the value instead.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("sensitive_content")),
+				},
+			},
+			"sensitive_content": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "Content for file mounts.",
+				Description: "Content for file mounts that is hidden from plan output. Conflicts with content.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hex-encoded SHA-256 hash of the file mount content, as read back from Dokploy.",
 			},
 			"mount_path": schema.StringAttribute{
 				Required:    true,
@@ -98,11 +116,11 @@ func (r *MountResource) Configure(_ context.Context, req resource.ConfigureReque
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.DokployClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -121,7 +139,7 @@ func (r *MountResource) Create(ctx context.Context, req resource.CreateRequest,
 		Type:        plan.Type.ValueString(),
 		HostPath:    plan.HostPath.ValueString(),
 		VolumeName:  plan.VolumeName.ValueString(),
-		Content:     plan.Content.ValueString(),
+		Content:     plan.content().ValueString(),
 		MountPath:   plan.MountPath.ValueString(),
 		ServiceType: plan.ServiceType.ValueString(),
 		FilePath:    plan.FilePath.ValueString(),
@@ -135,6 +153,7 @@ func (r *MountResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	plan.ID = types.StringValue(createdMount.ID)
+	plan.ContentSHA256 = mountContentHash(plan.content())
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -177,8 +196,8 @@ func (r *MountResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.FilePath = types.StringNull()
 	}
 
-	// Don't update Content from API as it might not be returned consistently
-	// Keep the value from state if it was set
+	// Always read content back so edits made in the Dokploy UI show up as drift.
+	state.setContent(mount.Content)
 
 	// Derive ServiceID from the appropriate foreign key based on ServiceType
 	switch mount.ServiceType {
@@ -224,8 +243,8 @@ func (r *MountResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !plan.VolumeName.IsNull() {
 		mount.VolumeName = plan.VolumeName.ValueString()
 	}
-	if !plan.Content.IsNull() {
-		mount.Content = plan.Content.ValueString()
+	if content := plan.content(); !content.IsNull() {
+		mount.Content = content.ValueString()
 	}
 	if !plan.FilePath.IsNull() {
 		mount.FilePath = plan.FilePath.ValueString()
@@ -260,6 +279,8 @@ func (r *MountResource) Update(ctx context.Context, req resource.UpdateRequest,
 		plan.FilePath = types.StringNull()
 	}
 
+	plan.ContentSHA256 = mountContentHash(plan.content())
+
 	// Derive ServiceID from the appropriate foreign key based on ServiceType
 	switch updatedMount.ServiceType {
 	case "application":
@@ -282,6 +303,22 @@ func (r *MountResource) Update(ctx context.Context, req resource.UpdateRequest,
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r *MountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan MountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The hash follows the planned content, so a content change also shows
+	// up as a change to content_sha256.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), mountContentHash(plan.content()))...)
+}
+
 func (r *MountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state MountResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -290,7 +327,7 @@ func (r *MountResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteMount(state.ID.ValueString())
+	err := deleteChild(ctx, func() error { return r.client.DeleteMount(state.ID.ValueString()) }, serviceParent(r.client, state.ServiceType.ValueString(), state.ServiceID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting mount", err.Error())
 		return
@@ -300,3 +337,37 @@ func (r *MountResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *MountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// content returns the file content from whichever of content or
+// sensitive_content is set.
+func (m MountResourceModel) content() types.String {
+	if !m.SensitiveContent.IsNull() {
+		return m.SensitiveContent
+	}
+	return m.Content
+}
+
+// setContent stores content read from Dokploy in the attribute the
+// configuration uses, defaulting to content.
+func (m *MountResourceModel) setContent(content string) {
+	m.ContentSHA256 = mountContentHash(types.StringValue(content))
+	switch {
+	case !m.SensitiveContent.IsNull():
+		m.SensitiveContent = types.StringValue(content)
+	case content != "" || !m.Content.IsNull():
+		m.Content = types.StringValue(content)
+	}
+}
+
+// mountContentHash returns the hex-encoded SHA-256 of the content, null when
+// there is no content and unknown while the content is unknown.
+func mountContentHash(content types.String) types.String {
+	if content.IsUnknown() {
+		return types.StringUnknown()
+	}
+	if content.ValueString() == "" {
+		return types.StringNull()
+	}
+	sum := sha256.Sum256([]byte(content.ValueString()))
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -22,7 +23,7 @@ func TestAccMountResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing - volume mount
 			{
-				Config: testAccMountResourceVolumeConfig("test-mount-project", "test-mount-env", "test-mount-app", "test-data"),
+				Config: testAccMountResourceVolumeConfig("tf-acc-mount-project", "test-mount-env", "test-mount-app", "test-data"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mount.test", "type", "volume"),
 					resource.TestCheckResourceAttr("dokploy_mount.test", "volume_name", "test-data"),
@@ -33,7 +34,7 @@ func TestAccMountResource(t *testing.T) {
 			},
 			// Update testing - change volume name and mount path
 			{
-				Config: testAccMountResourceVolumeConfig("test-mount-project", "test-mount-env", "test-mount-app", "updated-data"),
+				Config: testAccMountResourceVolumeConfig("tf-acc-mount-project", "test-mount-env", "test-mount-app", "updated-data"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mount.test", "type", "volume"),
 					resource.TestCheckResourceAttr("dokploy_mount.test", "volume_name", "updated-data"),
@@ -64,7 +65,7 @@ func TestAccMountResourceBind(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing - bind mount
 			{
-				Config: testAccMountResourceBindConfig("test-bind-mount-project", "test-bind-mount-env", "test-bind-mount-app", "/host/path", "/container/path"),
+				Config: testAccMountResourceBindConfig("tf-acc-bind-mount-project", "test-bind-mount-env", "test-bind-mount-app", "/host/path", "/container/path"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mount.test", "type", "bind"),
 					resource.TestCheckResourceAttr("dokploy_mount.test", "host_path", "/host/path"),
@@ -74,7 +75,7 @@ func TestAccMountResourceBind(t *testing.T) {
 			},
 			// Update testing - change host_path
 			{
-				Config: testAccMountResourceBindConfig("test-bind-mount-project", "test-bind-mount-env", "test-bind-mount-app", "/host/updated", "/container/path"),
+				Config: testAccMountResourceBindConfig("tf-acc-bind-mount-project", "test-bind-mount-env", "test-bind-mount-app", "/host/updated", "/container/path"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mount.test", "type", "bind"),
 					resource.TestCheckResourceAttr("dokploy_mount.test", "host_path", "/host/updated"),
@@ -176,7 +177,7 @@ func TestAccMountResourceFile(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing - file mount
 			{
-				Config: testAccMountResourceFileConfig("test-file-mount-project", "test-file-mount-env", "test-file-mount-app", "hello world", "/app/config.txt"),
+				Config: testAccMountResourceFileConfig("tf-acc-file-mount-project", "test-file-mount-env", "test-file-mount-app", "hello world", "/app/config.txt"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mount.test", "type", "file"),
 					resource.TestCheckResourceAttr("dokploy_mount.test", "mount_path", "/app/config.txt"),
@@ -186,19 +187,19 @@ func TestAccMountResourceFile(t *testing.T) {
 			},
 			// Update testing - change content
 			{
-				Config: testAccMountResourceFileConfig("test-file-mount-project", "test-file-mount-env", "test-file-mount-app", "updated content", "/app/config.txt"),
+				Config: testAccMountResourceFileConfig("tf-acc-file-mount-project", "test-file-mount-env", "test-file-mount-app", "updated content", "/app/config.txt"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mount.test", "type", "file"),
 					resource.TestCheckResourceAttr("dokploy_mount.test", "mount_path", "/app/config.txt"),
 					resource.TestCheckResourceAttr("dokploy_mount.test", "content", "updated content"),
+					resource.TestCheckResourceAttr("dokploy_mount.test", "content_sha256", "5c27d032a4fb58bbcf2271429b03b77e91876487da355ee2d406e8b30fb5076e"),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName:            "dokploy_mount.test",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content"}, // Content is sensitive and not returned by API
+				ResourceName:      "dokploy_mount.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
@@ -238,3 +239,96 @@ resource "dokploy_mount" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, content, mountPath)
 }
+
+// TestAccMountResourceSensitiveContent tests file mounts whose content is
+// hidden from plan output.
+func TestAccMountResourceSensitiveContent(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMountResourceSensitiveContentConfig("tf-acc-secret-mount-project", "test-secret-mount-env", "test-secret-mount-app", "API_KEY=secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_mount.test", "sensitive_content", "API_KEY=secret"),
+					resource.TestCheckNoResourceAttr("dokploy_mount.test", "content"),
+					resource.TestCheckResourceAttrSet("dokploy_mount.test", "content_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMountResourceSensitiveContentConfig(projectName, envName, appName, content string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for sensitive file mount tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "%s"
+  build_type     = "nixpacks"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_mount" "test" {
+  service_id        = dokploy_application.test.id
+  service_type      = "application"
+  type              = "file"
+  sensitive_content = "%s"
+  mount_path        = "/app/.env"
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, content)
+}
+
+func TestMountContentHash(t *testing.T) {
+	if got := mountContentHash(types.StringValue("updated content")); got.ValueString() != "5c27d032a4fb58bbcf2271429b03b77e91876487da355ee2d406e8b30fb5076e" {
+		t.Errorf("mountContentHash() = %s", got)
+	}
+	if got := mountContentHash(types.StringNull()); !got.IsNull() {
+		t.Errorf("mountContentHash(null) = %s, want null", got)
+	}
+	if got := mountContentHash(types.StringUnknown()); !got.IsUnknown() {
+		t.Errorf("mountContentHash(unknown) = %s, want unknown", got)
+	}
+}
+
+func TestMountSetContent(t *testing.T) {
+	m := MountResourceModel{Content: types.StringValue("old"), SensitiveContent: types.StringNull()}
+	m.setContent("edited in the UI")
+	if m.Content.ValueString() != "edited in the UI" || !m.SensitiveContent.IsNull() {
+		t.Errorf("content = %s, sensitive_content = %s", m.Content, m.SensitiveContent)
+	}
+
+	m = MountResourceModel{Content: types.StringNull(), SensitiveContent: types.StringValue("old")}
+	m.setContent("new")
+	if m.SensitiveContent.ValueString() != "new" || !m.Content.IsNull() {
+		t.Errorf("content = %s, sensitive_content = %s", m.Content, m.SensitiveContent)
+	}
+
+	m = MountResourceModel{Content: types.StringNull(), SensitiveContent: types.StringNull()}
+	m.setContent("")
+	if !m.Content.IsNull() || !m.ContentSHA256.IsNull() {
+		t.Errorf("content = %s, content_sha256 = %s, want null", m.Content, m.ContentSHA256)
+	}
+}
@@ -12,47 +12,62 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &MySQLResource{}
 var _ resource.ResourceWithImportState = &MySQLResource{}
+var _ resource.ResourceWithIdentity = &MySQLResource{}
 
 func NewMySQLResource() resource.Resource {
 	return &MySQLResource{}
 }
 
 type MySQLResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type MySQLResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	Name                 types.String `tfsdk:"name"`
-	AppName              types.String `tfsdk:"app_name"`
-	Description          types.String `tfsdk:"description"`
-	DatabaseName         types.String `tfsdk:"database_name"`
-	DatabaseUser         types.String `tfsdk:"database_user"`
-	DatabasePassword     types.String `tfsdk:"database_password"`
-	DatabaseRootPassword types.String `tfsdk:"database_root_password"`
-	DockerImage          types.String `tfsdk:"docker_image"`
-	Command              types.String `tfsdk:"command"`
-	Env                  types.String `tfsdk:"env"`
-	MemoryReservation    types.String `tfsdk:"memory_reservation"`
-	MemoryLimit          types.String `tfsdk:"memory_limit"`
-	CPUReservation       types.String `tfsdk:"cpu_reservation"`
-	CPULimit             types.String `tfsdk:"cpu_limit"`
-	ExternalPort         types.Int64  `tfsdk:"external_port"`
-	EnvironmentID        types.String `tfsdk:"environment_id"`
-	ApplicationStatus    types.String `tfsdk:"application_status"`
-	Replicas             types.Int64  `tfsdk:"replicas"`
-	ServerID             types.String `tfsdk:"server_id"`
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	AppName               types.String `tfsdk:"app_name"`
+	Description           types.String `tfsdk:"description"`
+	DatabaseName          types.String `tfsdk:"database_name"`
+	DatabaseUser          types.String `tfsdk:"database_user"`
+	DatabasePassword      types.String `tfsdk:"database_password"`
+	DatabaseRootPassword  types.String `tfsdk:"database_root_password"`
+	DockerImage           types.String `tfsdk:"docker_image"`
+	Command               types.String `tfsdk:"command"`
+	Env                   types.String `tfsdk:"env"`
+	MemoryReservation     types.String `tfsdk:"memory_reservation"`
+	MemoryLimit           types.String `tfsdk:"memory_limit"`
+	CPUReservation        types.String `tfsdk:"cpu_reservation"`
+	CPULimit              types.String `tfsdk:"cpu_limit"`
+	ExternalPort          types.Int64  `tfsdk:"external_port"`
+	EnvironmentID         types.String `tfsdk:"environment_id"`
+	ApplicationStatus     types.String `tfsdk:"application_status"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	DashboardURL          types.String `tfsdk:"dashboard_url"`
+	Replicas              types.Int64  `tfsdk:"replicas"`
+	ServerID              types.String `tfsdk:"server_id"`
+	DeletionProtection    types.Bool   `tfsdk:"deletion_protection"`
+	ApplyImmediately      types.Bool   `tfsdk:"apply_immediately"`
+	BackupBeforeUpgrade   types.Bool   `tfsdk:"backup_before_upgrade"`
+	SkipFinalBackup       types.Bool   `tfsdk:"skip_final_backup"`
+	InternalHost          types.String `tfsdk:"internal_host"`
+	InternalPort          types.Int64  `tfsdk:"internal_port"`
+	InternalConnectionURL types.String `tfsdk:"internal_connection_url"`
 }
 
 func (r *MySQLResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mysql"
 }
 
+func (r *MySQLResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	serviceIdentitySchema(resp)
+}
+
 func (r *MySQLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a MySQL database instance in Dokploy.",
@@ -118,6 +133,9 @@ func (r *MySQLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"env": schema.StringAttribute{
 				Optional:    true,
 				Description: "Environment variables for the container.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 			"memory_reservation": schema.StringAttribute{
 				Optional:    true,
@@ -153,6 +171,8 @@ func (r *MySQLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_at":    createdAtAttribute("MySQL database"),
+			"dashboard_url": dashboardURLAttribute("MySQL database"),
 			"replicas": schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
@@ -168,6 +188,13 @@ func (r *MySQLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"internal_host":           internalHostAttribute(),
+			"internal_port":           internalPortAttribute(),
+			"internal_connection_url": internalConnectionURLAttribute(),
+			"deletion_protection":     deletionProtectionAttribute(),
+			"apply_immediately":       applyImmediatelyAttribute(),
+			"backup_before_upgrade":   backupBeforeUpgradeAttribute(),
+			"skip_final_backup":       skipFinalBackupAttribute(),
 		},
 	}
 }
@@ -176,9 +203,9 @@ func (r *MySQLResource) Configure(_ context.Context, req resource.ConfigureReque
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.DokployClient)
+	c, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = c
@@ -250,8 +277,11 @@ func (r *MySQLResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Set state from created resource
 	r.mapMySQLToState(&plan, createdMySQL)
 
+	readDashboardURL(r.client, "mysql", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *MySQLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -280,8 +310,11 @@ func (r *MySQLResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.AppName = appNamePrefix
 	}
 
+	readDashboardURL(r.client, "mysql", state.ID.ValueString(), state.EnvironmentID.ValueString(), &state.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *MySQLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -292,6 +325,16 @@ func (r *MySQLResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var priorImage types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("docker_image"), &priorImage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	upgrade := imageUpgrade(priorImage, plan.DockerImage, plan.ApplyImmediately)
+	if upgrade && plan.BackupBeforeUpgrade.ValueBool() && !runUpgradeBackups(r.client, plan.ID.ValueString(), "mysql", &resp.Diagnostics) {
+		return
+	}
+
 	mysql := client.MySQL{
 		MySQLID:              plan.ID.ValueString(),
 		Name:                 plan.Name.ValueString(),
@@ -307,6 +350,12 @@ func (r *MySQLResource) Update(ctx context.Context, req resource.UpdateRequest,
 		CPULimit:             plan.CPULimit.ValueString(),
 		ExternalPort:         int(plan.ExternalPort.ValueInt64()),
 		Replicas:             int(plan.Replicas.ValueInt64()),
+		Clear: clearedDatabaseFields(
+			plan.Description, plan.Command, plan.Env,
+			plan.MemoryReservation, plan.MemoryLimit,
+			plan.CPUReservation, plan.CPULimit,
+			plan.ExternalPort,
+		),
 	}
 
 	_, err := r.client.UpdateMySQL(mysql)
@@ -315,6 +364,10 @@ func (r *MySQLResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if upgrade && redeployDatabase(ctx, r.client, plan.ID.ValueString(), "mysql", &resp.Diagnostics) == "" {
+		return
+	}
+
 	// Fetch updated state
 	updatedMySQL, err := r.client.GetMySQL(plan.ID.ValueString())
 	if err != nil {
@@ -327,8 +380,11 @@ func (r *MySQLResource) Update(ctx context.Context, req resource.UpdateRequest,
 	r.mapMySQLToState(&plan, updatedMySQL)
 	plan.AppName = appNamePrefix
 
+	readDashboardURL(r.client, "mysql", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *MySQLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -339,18 +395,26 @@ func (r *MySQLResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteMySQL(state.ID.ValueString())
+	if !checkDeletionProtection(state.DeletionProtection, "dokploy_mysql", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+	if !state.SkipFinalBackup.ValueBool() && !runFinalBackups(r.client, state.ID.ValueString(), "mysql", &resp.Diagnostics) {
+		return
+	}
+
+	err := deleteChild(ctx, func() error { return r.client.DeleteMySQL(state.ID.ValueString()) }, environmentParent(r.client, state.EnvironmentID.ValueString()))
 	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting MySQL instance", err.Error())
 		return
 	}
 }
 
 func (r *MySQLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("apply_immediately"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backup_before_upgrade"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_final_backup"), false)...)
 }
 
 func (r *MySQLResource) mapMySQLToState(state *MySQLResourceModel, mysql *client.MySQL) {
@@ -358,6 +422,7 @@ func (r *MySQLResource) mapMySQLToState(state *MySQLResourceModel, mysql *client
 	state.Name = types.StringValue(mysql.Name)
 	state.EnvironmentID = types.StringValue(mysql.EnvironmentID)
 	state.ApplicationStatus = types.StringValue(mysql.ApplicationStatus)
+	state.CreatedAt = optionalString(mysql.CreatedAt)
 	state.DatabaseName = types.StringValue(mysql.DatabaseName)
 	state.DatabaseUser = types.StringValue(mysql.DatabaseUser)
 
@@ -398,4 +463,6 @@ func (r *MySQLResource) mapMySQLToState(state *MySQLResourceModel, mysql *client
 	if !state.ServerID.IsNull() || mysql.ServerID != "" {
 		state.ServerID = types.StringValue(mysql.ServerID)
 	}
+
+	state.InternalHost, state.InternalPort, state.InternalConnectionURL = internalConnection(mysqlCredentials(mysql), state.DatabasePassword)
 }
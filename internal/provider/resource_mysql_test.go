@@ -22,10 +22,12 @@ func TestAccMySQLResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccMySQLResourceConfig("test-mysql-project", "test-mysql-env", "test-mysql", "testmysqlapp", "testdb", "testuser"),
+				Config: testAccMySQLResourceConfig("tf-acc-mysql-project", "test-mysql-env", "test-mysql", "testmysqlapp", "testdb", "testuser"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mysql.test", "name", "test-mysql"),
 					resource.TestCheckResourceAttrSet("dokploy_mysql.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_mysql.test", "internal_host"),
+					resource.TestCheckResourceAttr("dokploy_mysql.test", "internal_port", "3306"),
 					resource.TestCheckResourceAttrSet("dokploy_mysql.test", "environment_id"),
 					resource.TestCheckResourceAttr("dokploy_mysql.test", "database_name", "testdb"),
 					resource.TestCheckResourceAttr("dokploy_mysql.test", "database_user", "testuser"),
@@ -33,7 +35,7 @@ func TestAccMySQLResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccMySQLResourceConfigWithDescription("test-mysql-project", "test-mysql-env", "test-mysql-updated", "testmysqlapp", "testdb", "testuser", "Updated MySQL instance"),
+				Config: testAccMySQLResourceConfigWithDescription("tf-acc-mysql-project", "test-mysql-env", "test-mysql-updated", "testmysqlapp", "testdb", "testuser", "Updated MySQL instance"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_mysql.test", "name", "test-mysql-updated"),
 					resource.TestCheckResourceAttr("dokploy_mysql.test", "description", "Updated MySQL instance"),
@@ -44,7 +46,7 @@ func TestAccMySQLResource(t *testing.T) {
 				ResourceName:            "dokploy_mysql.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "database_root_password", "app_name"},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "database_root_password", "app_name", "deletion_protection"},
 			},
 		},
 	})
@@ -68,6 +70,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_mysql" "test" {
+  deletion_protection = false
+
   name                   = "%s"
   app_name               = "%s"
   database_name          = "%s"
@@ -97,6 +101,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_mysql" "test" {
+  deletion_protection = false
+
   name                   = "%s"
   app_name               = "%s"
   database_name          = "%s"
@@ -108,3 +114,67 @@ resource "dokploy_mysql" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, mysqlName, appName, dbName, dbUser, description)
 }
+
+// TestAccMySQLResourceClearSettings tests that removing optional settings from
+// the configuration clears them on the MySQL instance.
+func TestAccMySQLResourceClearSettings(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with every optional setting
+			{
+				Config: testAccMySQLResourceSettingsConfig("tf-acc-mysql-clear-project", "test-mysql-clear-env", testAccDatabaseSettings("mysqld --max-connections=200", 43306)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_mysql.test", "command", "mysqld --max-connections=200"),
+					resource.TestCheckResourceAttr("dokploy_mysql.test", "env", "TZ=UTC"),
+					resource.TestCheckResourceAttr("dokploy_mysql.test", "cpu_limit", "0.5"),
+					resource.TestCheckResourceAttr("dokploy_mysql.test", "external_port", "43306"),
+				),
+			},
+			// Remove the settings again
+			{
+				Config: testAccMySQLResourceSettingsConfig("tf-acc-mysql-clear-project", "test-mysql-clear-env", ""),
+				Check:  testAccCheckDatabaseSettingsCleared("dokploy_mysql.test"),
+			},
+		},
+	})
+}
+
+func testAccMySQLResourceSettingsConfig(projectName, envName, settings string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for MySQL settings tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_mysql" "test" {
+  deletion_protection = false
+
+  name                   = "test-mysql-clear"
+  app_name               = "testmysqlclear"
+  database_name          = "testdb"
+  database_user          = "testuser"
+  database_password      = "test_mysql_password_123"
+  database_root_password = "test_mysql_root_password_123"
+  environment_id         = dokploy_environment.test.id
+%s}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, settings)
+}
@@ -22,7 +22,7 @@ func NewOrganizationResource() resource.Resource {
 }
 
 type OrganizationResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type OrganizationResourceModel struct {
@@ -58,8 +58,9 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "URL or path to the organization logo.",
 			},
 			"slug": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "URL-friendly identifier for the organization.",
+				Description: "URL-friendly identifier for the organization. Generated by Dokploy if not set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -86,9 +87,9 @@ func (r *OrganizationResource) Configure(_ context.Context, req resource.Configu
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -102,13 +103,19 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	var logo *string
+	orgCreate := client.Organization{
+		Name: plan.Name.ValueString(),
+	}
 	if !plan.Logo.IsNull() && !plan.Logo.IsUnknown() {
 		logoVal := plan.Logo.ValueString()
-		logo = &logoVal
+		orgCreate.Logo = &logoVal
+	}
+	if !plan.Slug.IsNull() && !plan.Slug.IsUnknown() {
+		slugVal := plan.Slug.ValueString()
+		orgCreate.Slug = &slugVal
 	}
 
-	org, err := r.client.CreateOrganization(plan.Name.ValueString(), logo)
+	org, err := r.client.CreateOrganization(orgCreate)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating organization", err.Error())
 		return
@@ -163,7 +170,7 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		state.Slug = types.StringNull()
 	}
 
-	if org.Logo != nil {
+	if org.Logo != nil && *org.Logo != "" {
 		state.Logo = types.StringValue(*org.Logo)
 	} else {
 		state.Logo = types.StringNull()
@@ -196,6 +203,14 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	if !plan.Logo.IsNull() && !plan.Logo.IsUnknown() {
 		logoVal := plan.Logo.ValueString()
 		orgUpdate.Logo = &logoVal
+	} else if !state.Logo.IsNull() {
+		// Logo was removed from the configuration; clear it.
+		empty := ""
+		orgUpdate.Logo = &empty
+	}
+	if !plan.Slug.IsNull() && !plan.Slug.IsUnknown() {
+		slugVal := plan.Slug.ValueString()
+		orgUpdate.Slug = &slugVal
 	}
 
 	org, err := r.client.UpdateOrganization(orgUpdate)
@@ -211,14 +226,12 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 
 	if org.Slug != nil {
 		plan.Slug = types.StringValue(*org.Slug)
-	} else {
+	} else if plan.Slug.IsUnknown() {
 		plan.Slug = types.StringNull()
 	}
 
-	if org.Logo != nil {
+	if org.Logo != nil && *org.Logo != "" {
 		plan.Logo = types.StringValue(*org.Logo)
-	} else if plan.Logo.IsNull() {
-		plan.Logo = types.StringNull()
 	}
 
 	diags = resp.State.Set(ctx, plan)
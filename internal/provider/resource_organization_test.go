@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -75,6 +76,41 @@ func TestAccOrganizationResourceWithLogo(t *testing.T) {
 					resource.TestCheckResourceAttr("dokploy_organization.test", "logo", "https://example.com/new-logo.png"),
 				),
 			},
+			// Remove logo
+			{
+				Config: testAccOrganizationResourceConfig("test-terraform-org-logo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dokploy_organization.test", "logo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResourceWithSlug(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationResourceConfigWithSlug("test-terraform-org-slug", "tf-org-slug"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_organization.test", "slug", "tf-org-slug"),
+				),
+			},
+			{
+				Config: testAccOrganizationResourceConfigWithSlug("test-terraform-org-slug", "tf-org-slug-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_organization.test", "slug", "tf-org-slug-updated"),
+				),
+			},
 		},
 	})
 }
@@ -101,6 +137,51 @@ func TestAccOrganizationsDataSource(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationInvitationsDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+data "dokploy_organization_invitations" "pending" {}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dokploy_organization_invitations.pending", "invitations.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestInvitationExpired(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]bool{
+		"2025-05-31T12:00:00.000Z": true,
+		"2025-06-02T12:00:00Z":     false,
+		"not-a-timestamp":          false,
+		"":                         false,
+	}
+	for expiresAt, want := range cases {
+		if got := invitationExpired(expiresAt, now); got != want {
+			t.Errorf("invitationExpired(%q) = %t, want %t", expiresAt, got, want)
+		}
+	}
+}
+
 func testAccOrganizationResourceConfig(name string) string {
 	return fmt.Sprintf(`
 provider "dokploy" {
@@ -128,6 +209,20 @@ resource "dokploy_organization" "test" {
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), name, logo)
 }
 
+func testAccOrganizationResourceConfigWithSlug(name, slug string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_organization" "test" {
+  name = "%s"
+  slug = "%s"
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), name, slug)
+}
+
 func testAccOrganizationsDataSourceConfig() string {
 	return fmt.Sprintf(`
 provider "dokploy" {
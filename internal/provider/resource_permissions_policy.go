@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+var _ resource.Resource = &PermissionsPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &PermissionsPolicyResource{}
+
+func NewPermissionsPolicyResource() resource.Resource {
+	return &PermissionsPolicyResource{}
+}
+
+type PermissionsPolicyResource struct {
+	client client.Client
+}
+
+type PermissionsPolicyResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Policy          types.String `tfsdk:"policy"`
+	IncludeServices types.Bool   `tfsdk:"include_services"`
+	Grants          types.List   `tfsdk:"grants"`
+}
+
+// permissionsPolicyGrant is the access one member gets to one project.
+type permissionsPolicyGrant struct {
+	MemberID       string   `tfsdk:"member_id"`
+	ProjectID      string   `tfsdk:"project_id"`
+	EnvironmentIDs []string `tfsdk:"environment_ids"`
+	ServiceIDs     []string `tfsdk:"service_ids"`
+}
+
+var permissionsPolicyGrantAttrTypes = map[string]attr.Type{
+	"member_id":       types.StringType,
+	"project_id":      types.StringType,
+	"environment_ids": types.SetType{ElemType: types.StringType},
+	"service_ids":     types.SetType{ElemType: types.StringType},
+}
+
+// permissionsPolicy is the document in the policy attribute: roles list the
+// projects they grant, and members are given one or more roles.
+type permissionsPolicy struct {
+	Roles   map[string][]string         `yaml:"roles"`
+	Members map[string]permissionsRoles `yaml:"members"`
+}
+
+// permissionsRoles accepts a single role name as well as a list of them.
+type permissionsRoles []string
+
+func (p *permissionsRoles) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*p = permissionsRoles{node.Value}
+		return nil
+	}
+	var roles []string
+	if err := node.Decode(&roles); err != nil {
+		return err
+	}
+	*p = roles
+	return nil
+}
+
+func (r *PermissionsPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions_policy"
+}
+
+func (r *PermissionsPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants project access to many members from one policy document that maps roles to project names and members to roles. " +
+			"Project names and member emails are resolved to IDs on every plan, so projects, environments and services added later are granted on the next apply. " +
+			"Names that do not exist yet during plan, such as projects created in the same apply, are resolved on apply. " +
+			"Like dokploy_project_permissions, it only adds and removes the access it grants and leaves other permissions alone. " +
+			"Do not combine it with the accessed_* attributes of dokploy_user_permissions for the same member.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the policy, derived from its first version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Required: true,
+				Description: "The policy as YAML or JSON. `roles` maps each role to the names of the projects it grants, and `members` maps " +
+					"each member, by email or member ID, to a role or a list of roles.",
+			},
+			"include_services": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Grant access to every application, compose stack and database in the granted projects, and not only to their environments. Defaults to false.",
+			},
+			"grants": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The access granted by the policy, one entry per member and project, sorted by member ID and project ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The organization membership ID of the member.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the granted project.",
+						},
+						"environment_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The granted environment IDs of the project.",
+						},
+						"service_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The granted service IDs of the project. Empty unless include_services is true.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PermissionsPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan resolves the policy to grants, so the plan shows exactly which
+// members get which projects. Grants are left unknown, and resolved on apply,
+// while the policy is unknown or names a project or member that does not
+// exist yet, e.g. a project created in the same apply.
+func (r *PermissionsPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan PermissionsPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Grants = types.ListUnknown(types.ObjectType{AttrTypes: permissionsPolicyGrantAttrTypes})
+	if !plan.Policy.IsUnknown() && !plan.IncludeServices.IsUnknown() {
+		grants, err := resolvePermissionsPolicy(r.client, plan.Policy.ValueString(), plan.IncludeServices.ValueBool())
+		switch {
+		case errors.Is(err, errPolicyNameNotFound):
+		case err != nil:
+			resp.Diagnostics.AddAttributeError(path.Root("policy"), "Invalid Permissions Policy", err.Error())
+			return
+		default:
+			plan.Grants = grantsList(grants)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *PermissionsPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PermissionsPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grants, err := r.plannedGrants(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving permissions policy", err.Error())
+		return
+	}
+	if err := applyPermissionsPolicy(r.client, nil, grants); err != nil {
+		resp.Diagnostics.AddError("Error granting permissions policy", err.Error())
+		return
+	}
+
+	sum := sha256.Sum256([]byte(plan.Policy.ValueString()))
+	plan.ID = types.StringValue(hex.EncodeToString(sum[:8]))
+	plan.Grants = grantsList(grants)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PermissionsPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PermissionsPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var grants []permissionsPolicyGrant
+	resp.Diagnostics.Append(state.Grants.ElementsAs(ctx, &grants, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only report access that is still granted, so access revoked outside
+	// Terraform shows up as a change.
+	members := map[string]*client.OrganizationMember{}
+	refreshed := []permissionsPolicyGrant{}
+	for _, grant := range grants {
+		member, ok := members[grant.MemberID]
+		if !ok {
+			var err error
+			member, err = r.client.GetMemberByID(grant.MemberID)
+			if err != nil && !errors.Is(err, client.ErrNotFound) {
+				resp.Diagnostics.AddError("Error reading member", err.Error())
+				return
+			}
+			members[grant.MemberID] = member
+		}
+		if member == nil || !containsString(member.AccessedProjects, grant.ProjectID) {
+			continue
+		}
+		grant.EnvironmentIDs = intersectIDs(grant.EnvironmentIDs, member.AccessedEnvironments)
+		grant.ServiceIDs = intersectIDs(grant.ServiceIDs, member.AccessedServices)
+		refreshed = append(refreshed, grant)
+	}
+
+	state.Grants = grantsList(refreshed)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *PermissionsPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PermissionsPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior []permissionsPolicyGrant
+	resp.Diagnostics.Append(state.Grants.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	grants, err := r.plannedGrants(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving permissions policy", err.Error())
+		return
+	}
+	if err := applyPermissionsPolicy(r.client, prior, grants); err != nil {
+		resp.Diagnostics.AddError("Error updating permissions policy", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Grants = grantsList(grants)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PermissionsPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PermissionsPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior []permissionsPolicyGrant
+	resp.Diagnostics.Append(state.Grants.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := applyPermissionsPolicy(r.client, prior, nil); err != nil {
+		resp.Diagnostics.AddError("Error revoking permissions policy", err.Error())
+	}
+}
+
+// plannedGrants returns the grants resolved during the plan, resolving the
+// policy now if it was not known then.
+func (r *PermissionsPolicyResource) plannedGrants(ctx context.Context, plan *PermissionsPolicyResourceModel) ([]permissionsPolicyGrant, error) {
+	if plan.Grants.IsUnknown() || plan.Grants.IsNull() {
+		return resolvePermissionsPolicy(r.client, plan.Policy.ValueString(), plan.IncludeServices.ValueBool())
+	}
+	var grants []permissionsPolicyGrant
+	if diags := plan.Grants.ElementsAs(ctx, &grants, false); diags.HasError() {
+		return nil, fmt.Errorf("reading planned grants: %v", diags)
+	}
+	return grants, nil
+}
+
+// errPolicyNameNotFound is returned when a policy names a project or member
+// that does not exist (yet).
+var errPolicyNameNotFound = errors.New("not found")
+
+// parsePermissionsPolicy decodes a YAML or JSON policy document, rejecting
+// unknown keys so typos do not silently grant nothing.
+func parsePermissionsPolicy(document string) (*permissionsPolicy, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(document))
+	decoder.KnownFields(true)
+
+	var policy permissionsPolicy
+	if err := decoder.Decode(&policy); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("policy is empty")
+		}
+		return nil, fmt.Errorf("policy is not valid YAML or JSON: %w", err)
+	}
+
+	for member, roles := range policy.Members {
+		for _, role := range roles {
+			if _, ok := policy.Roles[role]; !ok {
+				return nil, fmt.Errorf("member %q has role %q, which is not defined under roles", member, role)
+			}
+		}
+	}
+	return &policy, nil
+}
+
+// resolvePermissionsPolicy expands a policy document into one grant per
+// member and project, resolving project names and member emails to IDs.
+func resolvePermissionsPolicy(c client.Client, document string, includeServices bool) ([]permissionsPolicyGrant, error) {
+	policy, err := parsePermissionsPolicy(document)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := c.ListProjects()
+	if err != nil {
+		return nil, fmt.Errorf("listing projects: %w", err)
+	}
+	members, err := c.ListMembers()
+	if err != nil {
+		return nil, fmt.Errorf("listing members: %w", err)
+	}
+
+	grants := []permissionsPolicyGrant{}
+	seen := map[string]bool{}
+	for key, roles := range policy.Members {
+		member, err := findPolicyMember(members, key)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			for _, name := range policy.Roles[role] {
+				project, err := findPolicyProject(projects, name)
+				if err != nil {
+					return nil, fmt.Errorf("role %q: %w", role, err)
+				}
+				if seen[member.ID+"/"+project.ID] {
+					continue
+				}
+				seen[member.ID+"/"+project.ID] = true
+
+				environmentIDs, serviceIDs := projectAccessIDs(project, true, includeServices)
+				grants = append(grants, permissionsPolicyGrant{
+					MemberID:       member.ID,
+					ProjectID:      project.ID,
+					EnvironmentIDs: environmentIDs,
+					ServiceIDs:     serviceIDs,
+				})
+			}
+		}
+	}
+
+	sort.Slice(grants, func(i, j int) bool {
+		if grants[i].MemberID != grants[j].MemberID {
+			return grants[i].MemberID < grants[j].MemberID
+		}
+		return grants[i].ProjectID < grants[j].ProjectID
+	})
+	return grants, nil
+}
+
+// findPolicyMember finds a member by member ID or, ignoring case, by email.
+// Owners are refused, since Dokploy rejects permission changes for them.
+func findPolicyMember(members []client.OrganizationMember, key string) (*client.OrganizationMember, error) {
+	for i := range members {
+		member := &members[i]
+		if member.ID != key && !strings.EqualFold(member.User.Email, key) {
+			continue
+		}
+		if member.Role == "owner" {
+			return nil, fmt.Errorf("member %q owns the organization; owners always have full access, and Dokploy rejects permission changes for them", key)
+		}
+		return member, nil
+	}
+	return nil, fmt.Errorf("no member with email or ID %q: %w", key, errPolicyNameNotFound)
+}
+
+// findPolicyProject finds a project by name, or by ID if no project has that
+// name. Names shared by several projects are refused.
+func findPolicyProject(projects []client.Project, name string) (*client.Project, error) {
+	var found *client.Project
+	for i := range projects {
+		if projects[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("several projects are named %q; use the project ID instead", name)
+		}
+		found = &projects[i]
+	}
+	if found != nil {
+		return found, nil
+	}
+	for i := range projects {
+		if projects[i].ID == name {
+			return &projects[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no project named %q: %w", name, errPolicyNameNotFound)
+}
+
+// applyPermissionsPolicy updates every member in prior or planned: the access
+// prior granted is dropped and the access planned grants is added, keeping
+// the rest of the member's permissions. Members that no longer exist are
+// skipped when they only have access to drop.
+func applyPermissionsPolicy(c client.Client, prior, planned []permissionsPolicyGrant) error {
+	type access struct {
+		projects, environments, services []string
+	}
+	collect := func(grants []permissionsPolicyGrant) map[string]*access {
+		byMember := map[string]*access{}
+		for _, grant := range grants {
+			a, ok := byMember[grant.MemberID]
+			if !ok {
+				a = &access{}
+				byMember[grant.MemberID] = a
+			}
+			a.projects = append(a.projects, grant.ProjectID)
+			a.environments = append(a.environments, grant.EnvironmentIDs...)
+			a.services = append(a.services, grant.ServiceIDs...)
+		}
+		return byMember
+	}
+	removed, added := collect(prior), collect(planned)
+
+	memberIDs := []string{}
+	for id := range removed {
+		memberIDs = append(memberIDs, id)
+	}
+	for id := range added {
+		if _, ok := removed[id]; !ok {
+			memberIDs = append(memberIDs, id)
+		}
+	}
+	sort.Strings(memberIDs)
+
+	for _, memberID := range memberIDs {
+		remove, add := &access{}, &access{}
+		if a, ok := removed[memberID]; ok {
+			remove = a
+		}
+		if a, ok := added[memberID]; ok {
+			add = a
+		}
+		err := modifyMemberPermissions(c, memberID, func(input *client.UserPermissionsInput) {
+			input.AccessedProjects = mergeIDs(input.AccessedProjects, remove.projects, add.projects)
+			input.AccessedEnvironments = mergeIDs(input.AccessedEnvironments, remove.environments, add.environments)
+			input.AccessedServices = mergeIDs(input.AccessedServices, remove.services, add.services)
+		})
+		if err != nil {
+			if _, ok := added[memberID]; !ok && errors.Is(err, client.ErrNotFound) {
+				continue
+			}
+			return fmt.Errorf("member %s: %w", memberID, err)
+		}
+	}
+	return nil
+}
+
+func grantsList(grants []permissionsPolicyGrant) types.List {
+	list, _ := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: permissionsPolicyGrantAttrTypes}, grants)
+	return list
+}
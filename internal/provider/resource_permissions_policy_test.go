@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResolvePermissionsPolicy(t *testing.T) {
+	mock := clientmock.New()
+	mock.ListProjectsFunc = func() ([]client.Project, error) {
+		return []client.Project{
+			{ID: "proj-shop", Name: "Shop", Environments: []client.Environment{
+				{ID: "env-shop", Applications: []client.Application{{ID: "app-shop"}}},
+			}},
+			{ID: "proj-blog", Name: "Blog", Environments: []client.Environment{{ID: "env-blog"}}},
+			{ID: "proj-a", Name: "Twin"},
+			{ID: "proj-b", Name: "Twin"},
+		}, nil
+	}
+	mock.ListMembersFunc = func() ([]client.OrganizationMember, error) {
+		return []client.OrganizationMember{
+			{ID: "mem-owner", Role: "owner", User: client.UserDetails{Email: "owner@example.com"}},
+			{ID: "mem-alice", Role: "member", User: client.UserDetails{Email: "alice@example.com"}},
+			{ID: "mem-bob", Role: "member", User: client.UserDetails{Email: "bob@example.com"}},
+		}, nil
+	}
+
+	policy := `
+roles:
+  developers: [Shop]
+  writers: [Blog, Shop]
+members:
+  Alice@Example.com: developers
+  mem-bob: [developers, writers]
+`
+	grants, err := resolvePermissionsPolicy(mock, policy, true)
+	if err != nil {
+		t.Fatalf("resolvePermissionsPolicy() error = %v", err)
+	}
+	want := []permissionsPolicyGrant{
+		{MemberID: "mem-alice", ProjectID: "proj-shop", EnvironmentIDs: []string{"env-shop"}, ServiceIDs: []string{"app-shop"}},
+		{MemberID: "mem-bob", ProjectID: "proj-blog", EnvironmentIDs: []string{"env-blog"}, ServiceIDs: []string{}},
+		{MemberID: "mem-bob", ProjectID: "proj-shop", EnvironmentIDs: []string{"env-shop"}, ServiceIDs: []string{"app-shop"}},
+	}
+	if !reflect.DeepEqual(grants, want) {
+		t.Errorf("grants = %+v, want %+v", grants, want)
+	}
+
+	// JSON is accepted as well.
+	grants, err = resolvePermissionsPolicy(mock, `{"roles": {"dev": ["Blog"]}, "members": {"alice@example.com": "dev"}}`, false)
+	if err != nil || len(grants) != 1 || grants[0].ProjectID != "proj-blog" {
+		t.Errorf("JSON policy: grants = %+v, error = %v", grants, err)
+	}
+
+	// Names that do not resolve yet are told apart from invalid policies, so
+	// the plan can leave grants unknown instead of failing.
+	errorTests := []struct {
+		name, policy, want string
+		notFound           bool
+	}{
+		{name: "empty", policy: "", want: "policy is empty"},
+		{name: "unknown key", policy: "role: {}", want: "not valid YAML or JSON"},
+		{name: "unknown role", policy: "roles: {}\nmembers: {alice@example.com: dev}", want: `role "dev"`},
+		{name: "unknown member", policy: "roles: {dev: [Shop]}\nmembers: {carol@example.com: dev}", want: `no member with email or ID "carol@example.com"`, notFound: true},
+		{name: "owner", policy: "roles: {dev: [Shop]}\nmembers: {owner@example.com: dev}", want: "owns the organization"},
+		{name: "unknown project", policy: "roles: {dev: [Wiki]}\nmembers: {alice@example.com: dev}", want: `no project named "Wiki"`, notFound: true},
+		{name: "ambiguous project", policy: "roles: {dev: [Twin]}\nmembers: {alice@example.com: dev}", want: `several projects are named "Twin"`},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolvePermissionsPolicy(mock, tt.policy, false)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.want)
+			}
+			if got := errors.Is(err, errPolicyNameNotFound); got != tt.notFound {
+				t.Errorf("errors.Is(err, errPolicyNameNotFound) = %v, want %v", got, tt.notFound)
+			}
+		})
+	}
+}
+
+func TestPermissionsPolicyPlanDefersUnresolvedNames(t *testing.T) {
+	ctx := context.Background()
+	mock := clientmock.New()
+	mock.ListProjectsFunc = func() ([]client.Project, error) {
+		return []client.Project{{ID: "proj-shop", Name: "Shop"}}, nil
+	}
+	mock.ListMembersFunc = func() ([]client.OrganizationMember, error) {
+		return []client.OrganizationMember{{ID: "mem-alice", Role: "member", User: client.UserDetails{Email: "alice@example.com"}}}, nil
+	}
+	r := &PermissionsPolicyResource{client: mock}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	plan := func(policy string) PermissionsPolicyResourceModel {
+		planned := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		planned.Set(ctx, PermissionsPolicyResourceModel{
+			ID:              types.StringUnknown(),
+			Policy:          types.StringValue(policy),
+			IncludeServices: types.BoolValue(false),
+			Grants:          types.ListUnknown(types.ObjectType{AttrTypes: permissionsPolicyGrantAttrTypes}),
+		})
+		resp := &fwresource.ModifyPlanResponse{Plan: planned}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+			Plan:  planned,
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("ModifyPlan(%q): %v", policy, resp.Diagnostics)
+		}
+		var model PermissionsPolicyResourceModel
+		resp.Plan.Get(ctx, &model)
+		return model
+	}
+
+	if got := plan("roles: {dev: [Shop]}\nmembers: {alice@example.com: dev}"); got.Grants.IsUnknown() || len(got.Grants.Elements()) != 1 {
+		t.Errorf("resolvable policy: grants = %v", got.Grants)
+	}
+	// Wiki is created in the same apply, so it is resolved then.
+	if got := plan("roles: {dev: [Shop, Wiki]}\nmembers: {alice@example.com: dev}"); !got.Grants.IsUnknown() {
+		t.Errorf("policy naming a new project: grants = %v, want unknown", got.Grants)
+	}
+}
+
+func TestApplyPermissionsPolicy(t *testing.T) {
+	members := map[string]*client.OrganizationMember{
+		"mem-alice": {
+			ID:                   "mem-alice",
+			AccessedProjects:     []string{"proj-other", "proj-blog"},
+			AccessedEnvironments: []string{"env-other", "env-blog"},
+			CanCreateServices:    true,
+		},
+		"mem-bob": {ID: "mem-bob"},
+	}
+	mock := clientmock.New()
+	mock.GetMemberByIDFunc = func(memberID string) (*client.OrganizationMember, error) {
+		if member, ok := members[memberID]; ok {
+			return member, nil
+		}
+		return nil, client.ErrNotFound
+	}
+	assigned := map[string]client.UserPermissionsInput{}
+	mock.AssignUserPermissionsFunc = func(input client.UserPermissionsInput) error {
+		assigned[input.MemberID] = input
+		return nil
+	}
+
+	prior := []permissionsPolicyGrant{
+		{MemberID: "mem-alice", ProjectID: "proj-blog", EnvironmentIDs: []string{"env-blog"}},
+		{MemberID: "mem-gone", ProjectID: "proj-blog", EnvironmentIDs: []string{"env-blog"}},
+	}
+	planned := []permissionsPolicyGrant{
+		{MemberID: "mem-alice", ProjectID: "proj-shop", EnvironmentIDs: []string{"env-shop"}, ServiceIDs: []string{"app-shop"}},
+		{MemberID: "mem-bob", ProjectID: "proj-blog", EnvironmentIDs: []string{"env-blog"}},
+	}
+	if err := applyPermissionsPolicy(mock, prior, planned); err != nil {
+		t.Fatalf("applyPermissionsPolicy() error = %v", err)
+	}
+
+	alice := assigned["mem-alice"]
+	if !reflect.DeepEqual(alice.AccessedProjects, []string{"proj-other", "proj-shop"}) ||
+		!reflect.DeepEqual(alice.AccessedEnvironments, []string{"env-other", "env-shop"}) ||
+		!reflect.DeepEqual(alice.AccessedServices, []string{"app-shop"}) {
+		t.Errorf("alice = %+v", alice)
+	}
+	if !alice.CanCreateServices {
+		t.Error("alice lost can_create_services")
+	}
+	if bob := assigned["mem-bob"]; !reflect.DeepEqual(bob.AccessedProjects, []string{"proj-blog"}) {
+		t.Errorf("bob projects = %v", bob.AccessedProjects)
+	}
+
+	// A removed member is an error when the policy still grants it access.
+	err := applyPermissionsPolicy(mock, nil, []permissionsPolicyGrant{{MemberID: "mem-gone", ProjectID: "proj-blog"}})
+	if err == nil {
+		t.Error("expected an error granting access to a missing member")
+	}
+}
@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,13 +21,66 @@ import (
 
 var _ resource.Resource = &PortResource{}
 var _ resource.ResourceWithImportState = &PortResource{}
+var _ resource.ResourceWithModifyPlan = &PortResource{}
+
+// plannedPortClaims records the published ports claimed by dokploy_port
+// resources during a plan, keyed by the configured client, which is recreated
+// whenever the provider is configured. Claims are released when the port is
+// created, updated or deleted, and a client's set is dropped once it is empty.
+var plannedPortClaims sync.Map
+
+// newPortClaimants numbers the claims of ports that do not exist yet, so two
+// new ports never share a claimant.
+var newPortClaimants atomic.Int64
+
+type portClaimSet struct {
+	mu     sync.Mutex
+	claims map[string]string
+}
+
+// claimPort registers key for claimant, the port ID or a token for a new port,
+// and reports whether another claimant already holds it.
+func claimPort(c client.Client, key, claimant string) bool {
+	set, _ := plannedPortClaims.LoadOrStore(c, &portClaimSet{claims: map[string]string{}})
+	claims := set.(*portClaimSet)
+	claims.mu.Lock()
+	defer claims.mu.Unlock()
+	if holder, ok := claims.claims[key]; ok && holder != claimant {
+		return true
+	}
+	claims.claims[key] = claimant
+	return false
+}
+
+// releasePorts drops the claims on keys once the plan that made them has been
+// applied.
+func releasePorts(c client.Client, keys ...string) {
+	set, ok := plannedPortClaims.Load(c)
+	if !ok {
+		return
+	}
+	claims := set.(*portClaimSet)
+	claims.mu.Lock()
+	defer claims.mu.Unlock()
+	for _, key := range keys {
+		delete(claims.claims, key)
+	}
+	if len(claims.claims) == 0 {
+		plannedPortClaims.CompareAndDelete(c, set)
+	}
+}
+
+// portClaimKey identifies a published port on an application.
+func portClaimKey(m *PortResourceModel) string {
+	return fmt.Sprintf("%s/%d/%s", m.ApplicationID.ValueString(), m.PublishedPort.ValueInt64(), m.Protocol.ValueString())
+}
 
 func NewPortResource() resource.Resource {
 	return &PortResource{}
 }
 
 type PortResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type PortResourceModel struct {
@@ -75,7 +132,7 @@ func (r *PortResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"application_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The ID of the application.",
+				Description: "The ID of the application. Dokploy does not support ports on compose stacks, so a compose stack ID fails at plan time; publish its ports in the compose file instead.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -88,17 +145,122 @@ func (r *PortResource) Configure(_ context.Context, req resource.ConfigureReques
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.DokployClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 	r.client = client
 }
 
+func (r *PortResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan PortResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ApplicationID.IsUnknown() {
+		return
+	}
+	var stateApplicationID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("application_id"), &stateApplicationID)...)
+	if !plan.ApplicationID.Equal(stateApplicationID) {
+		rejectComposeTarget(r.client, plan.ApplicationID.ValueString(), "Ports", "publish them in the ports section of the compose file", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.PublishedPort.IsUnknown() || plan.Protocol.IsUnknown() {
+		return
+	}
+
+	applicationID := plan.ApplicationID.ValueString()
+	publishedPort := plan.PublishedPort.ValueInt64()
+	protocol := plan.Protocol.ValueString()
+
+	var state *PortResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &PortResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Catch two dokploy_port resources in the same configuration publishing
+	// the same port on the same application. An existing port claims with its
+	// ID, so planning it again never conflicts with its own claim.
+	claimant := fmt.Sprintf("new-%d", newPortClaimants.Add(1))
+	if state != nil {
+		claimant = state.ID.ValueString()
+	}
+	if claimPort(r.client, portClaimKey(&plan), claimant) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("published_port"),
+			"Duplicate Published Port",
+			fmt.Sprintf("Another dokploy_port resource in this configuration already publishes %s port %d on application %s.", protocol, publishedPort, applicationID),
+		)
+		return
+	}
+
+	// Ports already in state were checked against Dokploy when they were
+	// first planned.
+	if state != nil && portClaimKey(state) == portClaimKey(&plan) {
+		return
+	}
+
+	// Catch ports that already exist on the application but are not managed
+	// by this resource (created in the UI or by another configuration). This is
+	// only a warning since the existing port may be destroyed in the same apply.
+	ports, err := r.client.GetPortsByApplication(applicationID)
+	if err != nil {
+		return
+	}
+	for _, p := range ports {
+		if state != nil && p.ID == state.ID.ValueString() {
+			continue
+		}
+		if p.PublishedPort == publishedPort && p.Protocol == protocol {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("published_port"),
+				"Published Port Already In Use",
+				fmt.Sprintf("Application %s already publishes %s port %d (port ID %s). Applying this change will fail unless that port is removed first.", applicationID, protocol, publishedPort, p.ID),
+			)
+			return
+		}
+	}
+}
+
+// rejectComposeTarget fails the plan when applicationID is a compose stack.
+// Dokploy only attaches ports and redirects to applications, and would
+// otherwise reject the apply with a bare 400. alternative tells users how to
+// get the same result for a compose stack.
+func rejectComposeTarget(c client.Client, applicationID, feature, alternative string, diags *diag.Diagnostics) {
+	if _, err := c.GetApplication(applicationID); !errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	comp, err := c.GetCompose(applicationID)
+	if err != nil {
+		return
+	}
+	diags.AddAttributeError(
+		path.Root("application_id"),
+		"Compose Stacks Are Not Supported",
+		fmt.Sprintf("%s is the compose stack %q. %s can only be attached to applications in Dokploy; for a compose stack, %s.", applicationID, comp.Name, feature, alternative),
+	)
+}
+
 func (r *PortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan PortResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -116,6 +278,7 @@ func (r *PortResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	createdPort, err := r.client.CreatePort(port)
+	releasePorts(r.client, portClaimKey(&plan))
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating port", err.Error())
 		return
@@ -154,12 +317,14 @@ func (r *PortResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *PortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan PortResourceModel
+	var plan, state PortResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	defer releasePorts(r.client, portClaimKey(&plan), portClaimKey(&state))
 
 	port := client.Port{
 		ID:            plan.ID.ValueString(),
@@ -192,7 +357,8 @@ func (r *PortResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeletePort(state.ID.ValueString())
+	releasePorts(r.client, portClaimKey(&state))
+	err := deleteChild(ctx, func() error { return r.client.DeletePort(state.ID.ValueString()) }, serviceParent(r.client, "application", state.ApplicationID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting port", err.Error())
 		return
@@ -3,8 +3,10 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -22,7 +24,7 @@ func TestAccPortResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccPortResourceConfig("test-port-project", "test-port-env", "test-port-app", 8080, 3000, "tcp"),
+				Config: testAccPortResourceConfig("tf-acc-port-project", "test-port-env", "test-port-app", 8080, 3000, "tcp"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_port.test", "published_port", "8080"),
 					resource.TestCheckResourceAttr("dokploy_port.test", "target_port", "3000"),
@@ -33,7 +35,7 @@ func TestAccPortResource(t *testing.T) {
 			},
 			// Update testing - change target_port (in-place update, not replace)
 			{
-				Config: testAccPortResourceConfig("test-port-project", "test-port-env", "test-port-app", 8080, 4000, "tcp"),
+				Config: testAccPortResourceConfig("tf-acc-port-project", "test-port-env", "test-port-app", 8080, 4000, "tcp"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_port.test", "published_port", "8080"),
 					resource.TestCheckResourceAttr("dokploy_port.test", "target_port", "4000"),
@@ -83,3 +85,61 @@ resource "dokploy_port" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, publishedPort, targetPort, protocol)
 }
+
+func TestAccPortResource_duplicatePublishedPort(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the application first so application_id is known at plan time
+			{
+				Config: testAccPortResourceConfig("tf-acc-port-dup-project", "test-port-dup-env", "test-port-dup-app", 8081, 3000, "tcp"),
+			},
+			// A second port publishing the same port must fail during plan
+			{
+				Config: testAccPortResourceConfig("tf-acc-port-dup-project", "test-port-dup-env", "test-port-dup-app", 8081, 3000, "tcp") + `
+resource "dokploy_port" "duplicate" {
+  application_id = dokploy_application.test.id
+  published_port = 8081
+  target_port    = 4000
+  protocol       = "tcp"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate Published Port"),
+			},
+		},
+	})
+}
+
+func TestPortClaims(t *testing.T) {
+	mock := clientmock.New()
+	defer plannedPortClaims.Delete(mock)
+
+	if claimPort(mock, "app/8080/tcp", "port-1") {
+		t.Fatal("first claim conflicted")
+	}
+	// Planning the same port again is not a conflict.
+	if claimPort(mock, "app/8080/tcp", "port-1") {
+		t.Error("re-claim by the same port conflicted")
+	}
+	if !claimPort(mock, "app/8080/tcp", "new-1") {
+		t.Error("claim by another port did not conflict")
+	}
+
+	// Claims end with the apply, and the set with its last claim.
+	releasePorts(mock, "app/8080/tcp")
+	if _, ok := plannedPortClaims.Load(mock); ok {
+		t.Error("claim set kept after its last claim was released")
+	}
+	if claimPort(mock, "app/8080/tcp", "new-2") {
+		t.Error("claim conflicted with a released claim")
+	}
+}
@@ -6,52 +6,71 @@ import (
 	"fmt"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &PostgresResource{}
 var _ resource.ResourceWithImportState = &PostgresResource{}
+var _ resource.ResourceWithIdentity = &PostgresResource{}
 
 func NewPostgresResource() resource.Resource {
 	return &PostgresResource{}
 }
 
 type PostgresResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type PostgresResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	AppName           types.String `tfsdk:"app_name"`
-	Description       types.String `tfsdk:"description"`
-	DatabaseName      types.String `tfsdk:"database_name"`
-	DatabaseUser      types.String `tfsdk:"database_user"`
-	DatabasePassword  types.String `tfsdk:"database_password"`
-	DockerImage       types.String `tfsdk:"docker_image"`
-	Command           types.String `tfsdk:"command"`
-	Env               types.String `tfsdk:"env"`
-	MemoryReservation types.String `tfsdk:"memory_reservation"`
-	MemoryLimit       types.String `tfsdk:"memory_limit"`
-	CPUReservation    types.String `tfsdk:"cpu_reservation"`
-	CPULimit          types.String `tfsdk:"cpu_limit"`
-	ExternalPort      types.Int64  `tfsdk:"external_port"`
-	EnvironmentID     types.String `tfsdk:"environment_id"`
-	ApplicationStatus types.String `tfsdk:"application_status"`
-	Replicas          types.Int64  `tfsdk:"replicas"`
-	ServerID          types.String `tfsdk:"server_id"`
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	AppName               types.String `tfsdk:"app_name"`
+	Description           types.String `tfsdk:"description"`
+	DatabaseName          types.String `tfsdk:"database_name"`
+	DatabaseUser          types.String `tfsdk:"database_user"`
+	DatabasePassword      types.String `tfsdk:"database_password"`
+	DockerImage           types.String `tfsdk:"docker_image"`
+	Command               types.String `tfsdk:"command"`
+	Env                   types.String `tfsdk:"env"`
+	MemoryReservation     types.String `tfsdk:"memory_reservation"`
+	MemoryLimit           types.String `tfsdk:"memory_limit"`
+	CPUReservation        types.String `tfsdk:"cpu_reservation"`
+	CPULimit              types.String `tfsdk:"cpu_limit"`
+	ExternalPort          types.Int64  `tfsdk:"external_port"`
+	EnvironmentID         types.String `tfsdk:"environment_id"`
+	ApplicationStatus     types.String `tfsdk:"application_status"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	DashboardURL          types.String `tfsdk:"dashboard_url"`
+	Replicas              types.Int64  `tfsdk:"replicas"`
+	ServerID              types.String `tfsdk:"server_id"`
+	DeletionProtection    types.Bool   `tfsdk:"deletion_protection"`
+	ApplyImmediately      types.Bool   `tfsdk:"apply_immediately"`
+	BackupBeforeUpgrade   types.Bool   `tfsdk:"backup_before_upgrade"`
+	SkipFinalBackup       types.Bool   `tfsdk:"skip_final_backup"`
+	InternalHost          types.String `tfsdk:"internal_host"`
+	InternalPort          types.Int64  `tfsdk:"internal_port"`
+	InternalConnectionURL types.String `tfsdk:"internal_connection_url"`
+	Extensions            types.Set    `tfsdk:"extensions"`
+	InitSQL               types.String `tfsdk:"init_sql"`
 }
 
 func (r *PostgresResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_postgres"
 }
 
+func (r *PostgresResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	serviceIdentitySchema(resp)
+}
+
 func (r *PostgresResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a PostgreSQL database instance in Dokploy.",
@@ -112,6 +131,9 @@ func (r *PostgresResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"env": schema.StringAttribute{
 				Optional:    true,
 				Description: "Environment variables for the container.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 			"memory_reservation": schema.StringAttribute{
 				Optional:    true,
@@ -147,6 +169,8 @@ func (r *PostgresResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_at":    createdAtAttribute("PostgreSQL database"),
+			"dashboard_url": dashboardURLAttribute("PostgreSQL database"),
 			"replicas": schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
@@ -162,6 +186,25 @@ func (r *PostgresResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"extensions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "PostgreSQL extensions to create in the database when it is first initialised, such as 'vector' or 'postgis'. The docker image must ship the extensions.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(postgresExtensionName, "must be a PostgreSQL extension name")),
+				},
+			},
+			"init_sql": schema.StringAttribute{
+				Optional:    true,
+				Description: "SQL run when the database is first initialised, after extensions are created. It is mounted into /docker-entrypoint-initdb.d, so later changes only take effect on an empty data volume.",
+			},
+			"internal_host":           internalHostAttribute(),
+			"internal_port":           internalPortAttribute(),
+			"internal_connection_url": internalConnectionURLAttribute(),
+			"deletion_protection":     deletionProtectionAttribute(),
+			"apply_immediately":       applyImmediatelyAttribute(),
+			"backup_before_upgrade":   backupBeforeUpgradeAttribute(),
+			"skip_final_backup":       skipFinalBackupAttribute(),
 		},
 	}
 }
@@ -170,9 +213,9 @@ func (r *PostgresResource) Configure(_ context.Context, req resource.ConfigureRe
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.DokployClient)
+	c, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = c
@@ -240,11 +283,23 @@ func (r *PostgresResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
+	// The init script must be mounted before the first deployment, which is
+	// the only time the image runs it.
+	if script := postgresInitScript(ctx, plan.Extensions, plan.InitSQL, &resp.Diagnostics); script != "" {
+		if err := syncPostgresInitMount(r.client, createdPostgres.PostgresID, script); err != nil {
+			resp.Diagnostics.AddError("Error mounting PostgreSQL init script", err.Error())
+			return
+		}
+	}
+
 	// Set state from created resource
 	r.mapPostgresToState(&plan, createdPostgres)
 
+	readDashboardURL(r.client, "postgres", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *PostgresResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -272,9 +327,13 @@ func (r *PostgresResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if !appNamePrefix.IsNull() && !appNamePrefix.IsUnknown() {
 		state.AppName = appNamePrefix
 	}
+	refreshPostgresInit(ctx, r.client, &state, &resp.Diagnostics)
+
+	readDashboardURL(r.client, "postgres", state.ID.ValueString(), state.EnvironmentID.ValueString(), &state.DashboardURL, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *PostgresResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -285,6 +344,16 @@ func (r *PostgresResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	var priorImage types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("docker_image"), &priorImage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	upgrade := imageUpgrade(priorImage, plan.DockerImage, plan.ApplyImmediately)
+	if upgrade && plan.BackupBeforeUpgrade.ValueBool() && !runUpgradeBackups(r.client, plan.ID.ValueString(), "postgres", &resp.Diagnostics) {
+		return
+	}
+
 	postgres := client.Postgres{
 		PostgresID:        plan.ID.ValueString(),
 		Name:              plan.Name.ValueString(),
@@ -299,6 +368,12 @@ func (r *PostgresResource) Update(ctx context.Context, req resource.UpdateReques
 		CPULimit:          plan.CPULimit.ValueString(),
 		ExternalPort:      int(plan.ExternalPort.ValueInt64()),
 		Replicas:          int(plan.Replicas.ValueInt64()),
+		Clear: clearedDatabaseFields(
+			plan.Description, plan.Command, plan.Env,
+			plan.MemoryReservation, plan.MemoryLimit,
+			plan.CPUReservation, plan.CPULimit,
+			plan.ExternalPort,
+		),
 	}
 
 	_, err := r.client.UpdatePostgres(postgres)
@@ -307,6 +382,16 @@ func (r *PostgresResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	script := postgresInitScript(ctx, plan.Extensions, plan.InitSQL, &resp.Diagnostics)
+	if err := syncPostgresInitMount(r.client, plan.ID.ValueString(), script); err != nil {
+		resp.Diagnostics.AddError("Error updating PostgreSQL init script", err.Error())
+		return
+	}
+
+	if upgrade && redeployDatabase(ctx, r.client, plan.ID.ValueString(), "postgres", &resp.Diagnostics) == "" {
+		return
+	}
+
 	// Fetch updated state
 	updatedPostgres, err := r.client.GetPostgres(plan.ID.ValueString())
 	if err != nil {
@@ -319,8 +404,11 @@ func (r *PostgresResource) Update(ctx context.Context, req resource.UpdateReques
 	r.mapPostgresToState(&plan, updatedPostgres)
 	plan.AppName = appNamePrefix
 
+	readDashboardURL(r.client, "postgres", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *PostgresResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -331,18 +419,26 @@ func (r *PostgresResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeletePostgres(state.ID.ValueString())
+	if !checkDeletionProtection(state.DeletionProtection, "dokploy_postgres", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+	if !state.SkipFinalBackup.ValueBool() && !runFinalBackups(r.client, state.ID.ValueString(), "postgres", &resp.Diagnostics) {
+		return
+	}
+
+	err := deleteChild(ctx, func() error { return r.client.DeletePostgres(state.ID.ValueString()) }, environmentParent(r.client, state.EnvironmentID.ValueString()))
 	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting PostgreSQL instance", err.Error())
 		return
 	}
 }
 
 func (r *PostgresResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("apply_immediately"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backup_before_upgrade"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_final_backup"), false)...)
 }
 
 func (r *PostgresResource) mapPostgresToState(state *PostgresResourceModel, postgres *client.Postgres) {
@@ -350,6 +446,7 @@ func (r *PostgresResource) mapPostgresToState(state *PostgresResourceModel, post
 	state.Name = types.StringValue(postgres.Name)
 	state.EnvironmentID = types.StringValue(postgres.EnvironmentID)
 	state.ApplicationStatus = types.StringValue(postgres.ApplicationStatus)
+	state.CreatedAt = optionalString(postgres.CreatedAt)
 	state.DatabaseName = types.StringValue(postgres.DatabaseName)
 	state.DatabaseUser = types.StringValue(postgres.DatabaseUser)
 
@@ -390,4 +487,6 @@ func (r *PostgresResource) mapPostgresToState(state *PostgresResourceModel, post
 	if !state.ServerID.IsNull() || postgres.ServerID != "" {
 		state.ServerID = types.StringValue(postgres.ServerID)
 	}
+
+	state.InternalHost, state.InternalPort, state.InternalConnectionURL = internalConnection(postgresCredentials(postgres), state.DatabasePassword)
 }
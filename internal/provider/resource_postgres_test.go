@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -22,10 +27,12 @@ func TestAccPostgresResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccPostgresResourceConfig("test-postgres-project", "test-postgres-env", "test-postgres", "testpgapp", "testdb", "testuser"),
+				Config: testAccPostgresResourceConfig("tf-acc-postgres-project", "test-postgres-env", "test-postgres", "testpgapp", "testdb", "testuser"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "name", "test-postgres"),
 					resource.TestCheckResourceAttrSet("dokploy_postgres.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_postgres.test", "internal_host"),
+					resource.TestCheckResourceAttr("dokploy_postgres.test", "internal_port", "5432"),
 					resource.TestCheckResourceAttrSet("dokploy_postgres.test", "environment_id"),
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "database_name", "testdb"),
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "database_user", "testuser"),
@@ -33,7 +40,7 @@ func TestAccPostgresResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccPostgresResourceConfigWithDescription("test-postgres-project", "test-postgres-env", "test-postgres-updated", "testpgapp", "testdb", "testuser", "Updated PostgreSQL instance"),
+				Config: testAccPostgresResourceConfigWithDescription("tf-acc-postgres-project", "test-postgres-env", "test-postgres-updated", "testpgapp", "testdb", "testuser", "Updated PostgreSQL instance"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "name", "test-postgres-updated"),
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "description", "Updated PostgreSQL instance"),
@@ -44,7 +51,7 @@ func TestAccPostgresResource(t *testing.T) {
 				ResourceName:            "dokploy_postgres.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "app_name"},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "app_name", "deletion_protection"},
 			},
 		},
 	})
@@ -68,6 +75,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_name     = "%s"
@@ -96,6 +105,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_name     = "%s"
@@ -122,7 +133,7 @@ func TestAccPostgresResourceExtended(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with extended settings
 			{
-				Config: testAccPostgresResourceExtendedConfig("test-pg-ext-project", "test-pg-ext-env", "test-pg-ext", "testpgext", "testdb", "testuser", "128", "256"),
+				Config: testAccPostgresResourceExtendedConfig("tf-acc-pg-ext-project", "test-pg-ext-env", "test-pg-ext", "testpgext", "testdb", "testuser", "128", "256"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "name", "test-pg-ext"),
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "memory_reservation", "128"),
@@ -132,7 +143,7 @@ func TestAccPostgresResourceExtended(t *testing.T) {
 			},
 			// Update extended settings
 			{
-				Config: testAccPostgresResourceExtendedConfig("test-pg-ext-project", "test-pg-ext-env", "test-pg-ext-updated", "testpgext", "testdb", "testuser", "256", "512"),
+				Config: testAccPostgresResourceExtendedConfig("tf-acc-pg-ext-project", "test-pg-ext-env", "test-pg-ext-updated", "testpgext", "testdb", "testuser", "256", "512"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "name", "test-pg-ext-updated"),
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "memory_reservation", "256"),
@@ -161,6 +172,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
   name               = "%s"
   app_name           = "%s"
   database_name      = "%s"
@@ -172,3 +185,130 @@ resource "dokploy_postgres" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, pgName, appName, dbName, dbUser, memReserve, memLimit)
 }
+
+func TestCheckDeletionProtection(t *testing.T) {
+	var diags diag.Diagnostics
+	if checkDeletionProtection(types.BoolValue(true), "dokploy_postgres", "pg-1", &diags) || !diags.HasError() {
+		t.Fatalf("expected protected delete to be blocked, diags %v", diags)
+	}
+
+	diags = nil
+	if !checkDeletionProtection(types.BoolValue(false), "dokploy_postgres", "pg-1", &diags) || diags.HasError() {
+		t.Fatalf("expected unprotected delete to proceed, diags %v", diags)
+	}
+}
+
+func TestRunFinalBackups(t *testing.T) {
+	t.Run("runs every backup", func(t *testing.T) {
+		mock := clientmock.New()
+		mock.GetBackupsByDatabaseIDFunc = func(databaseID, databaseType string) ([]client.Backup, error) {
+			return []client.Backup{{BackupID: "b1"}, {BackupID: "b2"}}, nil
+		}
+		var ran []string
+		mock.RunBackupFunc = func(backupID, backupType string) error {
+			if backupType != "postgres" {
+				t.Errorf("backupType = %q, want postgres", backupType)
+			}
+			ran = append(ran, backupID)
+			return nil
+		}
+
+		var diags diag.Diagnostics
+		if !runFinalBackups(mock, "pg-1", "postgres", &diags) || diags.HasError() {
+			t.Fatalf("runFinalBackups failed: %v", diags)
+		}
+		if len(ran) != 2 {
+			t.Fatalf("ran %v, want both backups", ran)
+		}
+	})
+
+	t.Run("warns without backups", func(t *testing.T) {
+		mock := clientmock.New()
+		mock.GetBackupsByDatabaseIDFunc = func(databaseID, databaseType string) ([]client.Backup, error) {
+			return nil, nil
+		}
+
+		var diags diag.Diagnostics
+		if !runFinalBackups(mock, "pg-1", "postgres", &diags) || diags.WarningsCount() != 1 {
+			t.Fatalf("expected a warning, diags %v", diags)
+		}
+	})
+
+	t.Run("keeps the database when a backup fails", func(t *testing.T) {
+		mock := clientmock.New()
+		mock.GetBackupsByDatabaseIDFunc = func(databaseID, databaseType string) ([]client.Backup, error) {
+			return []client.Backup{{BackupID: "b1"}}, nil
+		}
+		mock.RunBackupFunc = func(backupID, backupType string) error {
+			return errors.New("destination unreachable")
+		}
+
+		var diags diag.Diagnostics
+		if runFinalBackups(mock, "pg-1", "postgres", &diags) || !diags.HasError() {
+			t.Fatalf("expected failure, diags %v", diags)
+		}
+	})
+}
+
+// TestAccPostgresResourceClearSettings tests that removing optional settings from
+// the configuration clears them on the PostgreSQL instance.
+func TestAccPostgresResourceClearSettings(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with every optional setting
+			{
+				Config: testAccPostgresResourceSettingsConfig("tf-acc-pg-clear-project", "test-pg-clear-env", testAccDatabaseSettings("postgres -c max_connections=200", 45432)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_postgres.test", "command", "postgres -c max_connections=200"),
+					resource.TestCheckResourceAttr("dokploy_postgres.test", "env", "TZ=UTC"),
+					resource.TestCheckResourceAttr("dokploy_postgres.test", "cpu_limit", "0.5"),
+					resource.TestCheckResourceAttr("dokploy_postgres.test", "external_port", "45432"),
+				),
+			},
+			// Remove the settings again
+			{
+				Config: testAccPostgresResourceSettingsConfig("tf-acc-pg-clear-project", "test-pg-clear-env", ""),
+				Check:  testAccCheckDatabaseSettingsCleared("dokploy_postgres.test"),
+			},
+		},
+	})
+}
+
+func testAccPostgresResourceSettingsConfig(projectName, envName, settings string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for PostgreSQL settings tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
+  name              = "test-pg-clear"
+  app_name          = "testpgclear"
+  database_name     = "testdb"
+  database_user     = "testuser"
+  database_password = "test_postgres_password_123"
+  environment_id    = dokploy_environment.test.id
+%s}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, settings)
+}
@@ -3,12 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,13 +21,15 @@ func NewProjectResource() resource.Resource {
 }
 
 type ProjectResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ProjectResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	OrganizationID types.String `tfsdk:"organization_id"`
 }
 
 func (r *ProjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,6 +48,20 @@ func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"description": schema.StringAttribute{
 				Optional: true,
 			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the project was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The organization ID this project belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -53,9 +70,9 @@ func (r *ProjectResource) Configure(_ context.Context, req resource.ConfigureReq
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -80,6 +97,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.ID = types.StringValue(project.ID)
 	plan.Name = types.StringValue(project.Name)
 	plan.Description = types.StringValue(project.Description)
+	readProjectAudit(&plan, project)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -101,6 +119,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	state.Name = types.StringValue(project.Name)
 	state.Description = types.StringValue(project.Description)
+	readProjectAudit(&state, project)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -133,6 +152,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	plan.ID = types.StringValue(project.ID)
 	plan.Name = types.StringValue(project.Name)
 	plan.Description = types.StringValue(project.Description)
+	readProjectAudit(&plan, project)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -143,11 +163,8 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 
-	err := r.client.DeleteProject(state.ID.ValueString())
+	err := deleteChild(ctx, func() error { return r.client.DeleteProject(state.ID.ValueString()) }, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting project", err.Error())
 		return
 	}
@@ -156,3 +173,18 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// readProjectAudit sets created_at and organization_id, keeping known values
+// when the API response omits them and nulling them when they were never read.
+func readProjectAudit(m *ProjectResourceModel, project *client.Project) {
+	if project.CreatedAt != "" {
+		m.CreatedAt = types.StringValue(project.CreatedAt)
+	} else if m.CreatedAt.IsUnknown() {
+		m.CreatedAt = types.StringNull()
+	}
+	if project.OrganizationID != "" {
+		m.OrganizationID = types.StringValue(project.OrganizationID)
+	} else if m.OrganizationID.IsUnknown() {
+		m.OrganizationID = types.StringNull()
+	}
+}
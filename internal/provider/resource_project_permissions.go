@@ -0,0 +1,459 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ProjectPermissionsResource{}
+var _ resource.ResourceWithImportState = &ProjectPermissionsResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectPermissionsResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectPermissionsResource{}
+
+// memberPermissionLocks serializes read-modify-write cycles on a member's
+// permissions, since several project grants can target the same member.
+var memberPermissionLocks sync.Map
+
+func NewProjectPermissionsResource() resource.Resource {
+	return &ProjectPermissionsResource{}
+}
+
+type ProjectPermissionsResource struct {
+	client client.Client
+}
+
+type ProjectPermissionsResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	MemberID            types.String `tfsdk:"member_id"`
+	ProjectID           types.String `tfsdk:"project_id"`
+	IncludeEnvironments types.Bool   `tfsdk:"include_environments"`
+	IncludeServices     types.Bool   `tfsdk:"include_services"`
+	EnvironmentIDs      types.Set    `tfsdk:"environment_ids"`
+	ServiceIDs          types.Set    `tfsdk:"service_ids"`
+}
+
+func (r *ProjectPermissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_permissions"
+}
+
+func (r *ProjectPermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants an organization member access to a project and, optionally, to all of its environments and services. " +
+			"The environment and service IDs are looked up from the project on every plan, so services added later are granted on the next apply. " +
+			"Other entries in the member's accessed lists are left alone, so several of these resources can target the same member. " +
+			"Do not combine it with the accessed_* attributes of dokploy_user_permissions for the same member.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier in the form member_id/project_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization membership ID of the user. Use the 'member_id' from dokploy_user or dokploy_users data sources.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project to grant access to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_environments": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Grant access to every environment of the project. Defaults to true.",
+			},
+			"include_services": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Grant access to every application, compose stack and database in the project. Requires include_environments. Defaults to false.",
+			},
+			"environment_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The environment IDs granted by this resource.",
+			},
+			"service_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The service IDs granted by this resource.",
+			},
+		},
+	}
+}
+
+func (r *ProjectPermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *ProjectPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectPermissionsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IncludeServices.ValueBool() && !config.IncludeEnvironments.IsNull() && !config.IncludeEnvironments.IsUnknown() && !config.IncludeEnvironments.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("include_services"),
+			"Invalid Attribute Combination",
+			"include_services requires include_environments, since members only see services in environments they can access.",
+		)
+	}
+}
+
+// ModifyPlan refuses grants to owners, whose permissions Dokploy does not let
+// change, and marks the granted IDs as unknown when the project's
+// environments or services changed since the last refresh, so the next apply
+// grants them.
+func (r *ProjectPermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ProjectPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		// Granting project access never touches API access.
+		if !plan.MemberID.IsUnknown() {
+			checkMemberPermissionChange(r.client, plan.MemberID.ValueString(), true, &resp.Diagnostics)
+		}
+		return
+	}
+
+	var state ProjectPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.ProjectID.IsUnknown() {
+		return
+	}
+
+	project, err := r.client.GetProject(plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading project", err.Error())
+		return
+	}
+	environmentIDs, serviceIDs := projectAccessIDs(project, plan.IncludeEnvironments.ValueBool(), plan.IncludeServices.ValueBool())
+
+	if sameIDs(state.EnvironmentIDs, environmentIDs) && sameIDs(state.ServiceIDs, serviceIDs) {
+		plan.EnvironmentIDs = state.EnvironmentIDs
+		plan.ServiceIDs = state.ServiceIDs
+	} else {
+		plan.EnvironmentIDs = types.SetUnknown(types.StringType)
+		plan.ServiceIDs = types.SetUnknown(types.StringType)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ProjectPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.grant(&plan, nil); err != nil {
+		resp.Diagnostics.AddError("Error granting project permissions", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.MemberID.ValueString() + "/" + plan.ProjectID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ProjectPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetMemberByID(state.MemberID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading member", err.Error())
+		return
+	}
+
+	project, err := r.client.GetProject(state.ProjectID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project", err.Error())
+		return
+	}
+
+	// Access to the project itself was revoked outside Terraform.
+	if !containsString(member.AccessedProjects, project.ID) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only report IDs that are both granted and still part of the project, so
+	// revoked access and new services show up as a change.
+	environmentIDs, serviceIDs := projectAccessIDs(project, state.IncludeEnvironments.ValueBool(), state.IncludeServices.ValueBool())
+	state.EnvironmentIDs = stringSet(intersectIDs(environmentIDs, member.AccessedEnvironments))
+	state.ServiceIDs = stringSet(intersectIDs(serviceIDs, member.AccessedServices))
+	state.ID = types.StringValue(state.MemberID.ValueString() + "/" + state.ProjectID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ProjectPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.grant(&plan, &state); err != nil {
+		resp.Diagnostics.AddError("Error updating project permissions", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ProjectPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := modifyMemberPermissions(r.client, state.MemberID.ValueString(), func(input *client.UserPermissionsInput) {
+		input.AccessedProjects = mergeIDs(input.AccessedProjects, []string{state.ProjectID.ValueString()}, nil)
+		input.AccessedEnvironments = mergeIDs(input.AccessedEnvironments, setStrings(state.EnvironmentIDs), nil)
+		input.AccessedServices = mergeIDs(input.AccessedServices, setStrings(state.ServiceIDs), nil)
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError("Error revoking project permissions", err.Error())
+	}
+}
+
+func (r *ProjectPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	memberID, projectID, ok := strings.Cut(req.ID, "/")
+	if !ok || memberID == "" || projectID == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected \"member_id/project_id\", got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), memberID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("include_environments"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("include_services"), false)...)
+}
+
+// grant adds the project's current IDs to the member and drops the IDs the
+// prior state granted that no longer apply.
+func (r *ProjectPermissionsResource) grant(plan, prior *ProjectPermissionsResourceModel) error {
+	project, err := r.client.GetProject(plan.ProjectID.ValueString())
+	if err != nil {
+		return err
+	}
+	environmentIDs, serviceIDs := projectAccessIDs(project, plan.IncludeEnvironments.ValueBool(), plan.IncludeServices.ValueBool())
+
+	var staleEnvironments, staleServices []string
+	if prior != nil {
+		staleEnvironments = setStrings(prior.EnvironmentIDs)
+		staleServices = setStrings(prior.ServiceIDs)
+	}
+
+	err = modifyMemberPermissions(r.client, plan.MemberID.ValueString(), func(input *client.UserPermissionsInput) {
+		input.AccessedProjects = mergeIDs(input.AccessedProjects, nil, []string{project.ID})
+		input.AccessedEnvironments = mergeIDs(input.AccessedEnvironments, staleEnvironments, environmentIDs)
+		input.AccessedServices = mergeIDs(input.AccessedServices, staleServices, serviceIDs)
+	})
+	if err != nil {
+		return err
+	}
+
+	plan.EnvironmentIDs = stringSet(environmentIDs)
+	plan.ServiceIDs = stringSet(serviceIDs)
+	return nil
+}
+
+// modifyMemberPermissions applies fn to the member's current permissions and
+// writes them back, keeping every other permission as it is.
+func modifyMemberPermissions(c client.Client, memberID string, fn func(input *client.UserPermissionsInput)) error {
+	lock, _ := memberPermissionLocks.LoadOrStore(memberID, &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+	defer mu.Unlock()
+
+	member, err := c.GetMemberByID(memberID)
+	if err != nil {
+		return err
+	}
+
+	input := permissionsInputFromMember(member)
+	fn(&input)
+	return c.AssignUserPermissions(input)
+}
+
+func permissionsInputFromMember(member *client.OrganizationMember) client.UserPermissionsInput {
+	return client.UserPermissionsInput{
+		MemberID:                member.ID,
+		AccessedProjects:        append([]string{}, member.AccessedProjects...),
+		AccessedEnvironments:    append([]string{}, member.AccessedEnvironments...),
+		AccessedServices:        append([]string{}, member.AccessedServices...),
+		CanCreateProjects:       member.CanCreateProjects,
+		CanCreateServices:       member.CanCreateServices,
+		CanDeleteProjects:       member.CanDeleteProjects,
+		CanDeleteServices:       member.CanDeleteServices,
+		CanAccessToDocker:       member.CanAccessToDocker,
+		CanAccessToTraefikFiles: member.CanAccessToTraefikFiles,
+		CanAccessToAPI:          member.CanAccessToAPI,
+		CanAccessToSSHKeys:      member.CanAccessToSSHKeys,
+		CanAccessToGitProviders: member.CanAccessToGitProviders,
+		CanDeleteEnvironments:   member.CanDeleteEnvironments,
+		CanCreateEnvironments:   member.CanCreateEnvironments,
+	}
+}
+
+// projectAccessIDs returns the sorted environment and service IDs of the
+// project to grant.
+func projectAccessIDs(project *client.Project, includeEnvironments, includeServices bool) ([]string, []string) {
+	environmentIDs := []string{}
+	serviceIDs := []string{}
+	for _, env := range project.Environments {
+		if includeEnvironments {
+			environmentIDs = append(environmentIDs, env.ID)
+		}
+		if !includeServices {
+			continue
+		}
+		for _, app := range env.Applications {
+			serviceIDs = append(serviceIDs, app.ID)
+		}
+		for _, comp := range env.Compose {
+			serviceIDs = append(serviceIDs, comp.ID)
+		}
+		for _, dbs := range [][]client.Database{env.Postgres, env.Mysql, env.Mariadb, env.Mongo, env.Redis} {
+			for _, db := range dbs {
+				if id := databaseServiceID(db); id != "" {
+					serviceIDs = append(serviceIDs, id)
+				}
+			}
+		}
+	}
+	sort.Strings(environmentIDs)
+	sort.Strings(serviceIDs)
+	return environmentIDs, serviceIDs
+}
+
+// databaseServiceID returns the type-specific ID of a database listed in a
+// project environment.
+func databaseServiceID(db client.Database) string {
+	for _, id := range []string{db.PostgresID, db.MysqlID, db.MariadbID, db.MongoID, db.RedisID, db.ID} {
+		if id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// mergeIDs removes the IDs in remove from current and appends the IDs in add
+// that are missing, keeping the order of current.
+func mergeIDs(current, remove, add []string) []string {
+	drop := make(map[string]bool, len(remove))
+	for _, id := range remove {
+		drop[id] = true
+	}
+	for _, id := range add {
+		delete(drop, id)
+	}
+
+	result := []string{}
+	seen := make(map[string]bool, len(current)+len(add))
+	for _, id := range append(append([]string{}, current...), add...) {
+		if drop[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
+
+func intersectIDs(ids, granted []string) []string {
+	result := []string{}
+	for _, id := range ids {
+		if containsString(granted, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func sameIDs(set types.Set, ids []string) bool {
+	if set.IsNull() || set.IsUnknown() {
+		return false
+	}
+	return set.Equal(stringSet(ids))
+}
+
+func stringSet(ids []string) types.Set {
+	set, _ := types.SetValueFrom(context.Background(), types.StringType, ids)
+	return set
+}
+
+func setStrings(set types.Set) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	var ids []string
+	set.ElementsAs(context.Background(), &ids, false)
+	return ids
+}
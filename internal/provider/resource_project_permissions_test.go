@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestProjectAccessIDs(t *testing.T) {
+	project := &client.Project{
+		ID: "proj",
+		Environments: []client.Environment{
+			{
+				ID:           "env-b",
+				Applications: []client.Application{{ID: "app-1"}},
+				Postgres:     []client.Database{{PostgresID: "pg-1"}},
+			},
+			{
+				ID:      "env-a",
+				Compose: []client.Compose{{ID: "compose-1"}},
+				Redis:   []client.Database{{RedisID: "redis-1"}},
+			},
+		},
+	}
+
+	envs, services := projectAccessIDs(project, true, true)
+	if !reflect.DeepEqual(envs, []string{"env-a", "env-b"}) {
+		t.Errorf("environments = %v", envs)
+	}
+	if !reflect.DeepEqual(services, []string{"app-1", "compose-1", "pg-1", "redis-1"}) {
+		t.Errorf("services = %v", services)
+	}
+
+	envs, services = projectAccessIDs(project, true, false)
+	if len(envs) != 2 || len(services) != 0 {
+		t.Errorf("without services: environments = %v, services = %v", envs, services)
+	}
+}
+
+func TestMergeIDs(t *testing.T) {
+	tests := []struct {
+		name                 string
+		current, remove, add []string
+		want                 []string
+	}{
+		{name: "add", current: []string{"a"}, add: []string{"b", "a"}, want: []string{"a", "b"}},
+		{name: "remove", current: []string{"a", "b", "c"}, remove: []string{"b"}, want: []string{"a", "c"}},
+		{name: "remove and re-add", current: []string{"a", "b"}, remove: []string{"a", "b"}, add: []string{"b"}, want: []string{"b"}},
+		{name: "empty", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeIDs(tt.current, tt.remove, tt.add); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAccProjectPermissionsResource grants a member access to a project. The
+// owner's permissions cannot change, so set TEST_MEMBER_ID to a regular
+// member to run it.
+func TestAccProjectPermissionsResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+	memberID := os.Getenv("TEST_MEMBER_ID")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	if memberID == "" {
+		t.Skip("TEST_MEMBER_ID must be set to a non-owner member for permission acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPermissionsResourceConfig(memberID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_project_permissions.test", "environment_ids.#", "1"),
+					resource.TestCheckResourceAttr("dokploy_project_permissions.test", "service_ids.#", "1"),
+				),
+			},
+			// A second application shows up as a pending change and is
+			// granted on the next apply.
+			{
+				Config: testAccProjectPermissionsResourceConfig(memberID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_project_permissions.test", "service_ids.#", "1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProjectPermissionsResourceConfig(memberID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_project_permissions.test", "service_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "dokploy_project_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"include_services", "service_ids",
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectPermissionsResourceConfig(memberID string, secondApp bool) string {
+	second := ""
+	if secondApp {
+		second = `
+resource "dokploy_application" "second" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-project-permissions-app-2"
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+}
+`
+	}
+
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name = "tf-acc-project-permissions"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-project-permissions-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-project-permissions-app"
+  source_type    = "docker"
+  docker_image   = "nginx:alpine"
+}
+%s
+resource "dokploy_project_permissions" "test" {
+  member_id        = "%s"
+  project_id       = dokploy_project.test.id
+  include_services = true
+
+  depends_on = [dokploy_application.test]
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), second, memberID)
+}
@@ -16,13 +16,14 @@ import (
 
 var _ resource.Resource = &RedirectResource{}
 var _ resource.ResourceWithImportState = &RedirectResource{}
+var _ resource.ResourceWithModifyPlan = &RedirectResource{}
 
 func NewRedirectResource() resource.Resource {
 	return &RedirectResource{}
 }
 
 type RedirectResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type RedirectResourceModel struct {
@@ -39,7 +40,7 @@ func (r *RedirectResource) Metadata(_ context.Context, req resource.MetadataRequ
 
 func (r *RedirectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages URL redirects for a Dokploy application.",
+		Description: "Manages URL redirects for a Dokploy application. Dokploy has no redirect priority and applies the redirects of an application in the order they were added, so when several redirects can match the same URL, use depends_on to create them in the order they should apply.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -64,7 +65,7 @@ func (r *RedirectResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"application_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The ID of the application.",
+				Description: "The ID of the application. Dokploy does not support redirects on compose stacks, so a compose stack ID fails at plan time; use a Traefik redirect middleware in the compose file instead.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -77,17 +78,33 @@ func (r *RedirectResource) Configure(_ context.Context, req resource.ConfigureRe
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.DokployClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 	r.client = client
 }
 
+func (r *RedirectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planApplicationID, stateApplicationID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("application_id"), &planApplicationID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("application_id"), &stateApplicationID)...)
+	if resp.Diagnostics.HasError() || planApplicationID.IsUnknown() || planApplicationID.Equal(stateApplicationID) {
+		return
+	}
+
+	rejectComposeTarget(r.client, planApplicationID.ValueString(), "Redirects", "add a Traefik redirect middleware to the service labels in the compose file", &resp.Diagnostics)
+}
+
 func (r *RedirectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan RedirectResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -176,7 +193,7 @@ func (r *RedirectResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeleteRedirect(state.ID.ValueString())
+	err := deleteChild(ctx, func() error { return r.client.DeleteRedirect(state.ID.ValueString()) }, serviceParent(r.client, "application", state.ApplicationID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting redirect", err.Error())
 		return
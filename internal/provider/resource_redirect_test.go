@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -22,7 +30,7 @@ func TestAccRedirectResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccRedirectResourceConfig("test-redirect-project", "test-redirect-env", "test-redirect-app", "/old-path", "/new-path", false),
+				Config: testAccRedirectResourceConfig("tf-acc-redirect-project", "test-redirect-env", "test-redirect-app", "/old-path", "/new-path", false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_redirect.test", "regex", "/old-path"),
 					resource.TestCheckResourceAttr("dokploy_redirect.test", "replacement", "/new-path"),
@@ -33,7 +41,7 @@ func TestAccRedirectResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccRedirectResourceConfig("test-redirect-project", "test-redirect-env", "test-redirect-app", "/old-updated", "/new-updated", true),
+				Config: testAccRedirectResourceConfig("tf-acc-redirect-project", "test-redirect-env", "test-redirect-app", "/old-updated", "/new-updated", true),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_redirect.test", "regex", "/old-updated"),
 					resource.TestCheckResourceAttr("dokploy_redirect.test", "replacement", "/new-updated"),
@@ -83,3 +91,44 @@ resource "dokploy_redirect" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, regex, replacement, permanent)
 }
+
+func TestRedirectRejectsComposeTarget(t *testing.T) {
+	ctx := context.Background()
+	mock := clientmock.New()
+	mock.GetApplicationFunc = func(id string) (*client.Application, error) {
+		if id == "app-1" {
+			return &client.Application{ID: id}, nil
+		}
+		return nil, fmt.Errorf("%w: application", client.ErrNotFound)
+	}
+	mock.GetComposeFunc = func(id string) (*client.Compose, error) {
+		return &client.Compose{ID: id, Name: "stack"}, nil
+	}
+	r := &RedirectResource{client: mock}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for applicationID, wantError := range map[string]bool{"app-1": false, "compose-1": true} {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		plan.Set(ctx, RedirectResourceModel{
+			ID:            types.StringUnknown(),
+			Regex:         types.StringValue("^/old"),
+			Replacement:   types.StringValue("/new"),
+			Permanent:     types.BoolValue(true),
+			ApplicationID: types.StringValue(applicationID),
+		})
+		req := fwresource.ModifyPlanRequest{
+			Plan:  plan,
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		}
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() != wantError {
+			t.Errorf("application_id %s: diagnostics = %v, want error %v", applicationID, resp.Diagnostics, wantError)
+		}
+		if wantError && !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), `compose stack "stack"`) {
+			t.Errorf("error = %s, want it to name the compose stack", resp.Diagnostics.Errors()[0].Detail())
+		}
+	}
+}
@@ -12,45 +12,58 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &RedisResource{}
 var _ resource.ResourceWithImportState = &RedisResource{}
+var _ resource.ResourceWithIdentity = &RedisResource{}
 
 func NewRedisResource() resource.Resource {
 	return &RedisResource{}
 }
 
 type RedisResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type RedisResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	AppNamePrefix     types.String `tfsdk:"app_name_prefix"`
-	AppName           types.String `tfsdk:"app_name"`
-	Description       types.String `tfsdk:"description"`
-	DatabasePassword  types.String `tfsdk:"database_password"`
-	DockerImage       types.String `tfsdk:"docker_image"`
-	Command           types.String `tfsdk:"command"`
-	Env               types.String `tfsdk:"env"`
-	MemoryReservation types.String `tfsdk:"memory_reservation"`
-	MemoryLimit       types.String `tfsdk:"memory_limit"`
-	CPUReservation    types.String `tfsdk:"cpu_reservation"`
-	CPULimit          types.String `tfsdk:"cpu_limit"`
-	ExternalPort      types.Int64  `tfsdk:"external_port"`
-	EnvironmentID     types.String `tfsdk:"environment_id"`
-	ApplicationStatus types.String `tfsdk:"application_status"`
-	Replicas          types.Int64  `tfsdk:"replicas"`
-	ServerID          types.String `tfsdk:"server_id"`
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	AppNamePrefix         types.String `tfsdk:"app_name_prefix"`
+	AppName               types.String `tfsdk:"app_name"`
+	Description           types.String `tfsdk:"description"`
+	DatabasePassword      types.String `tfsdk:"database_password"`
+	DockerImage           types.String `tfsdk:"docker_image"`
+	Command               types.String `tfsdk:"command"`
+	Env                   types.String `tfsdk:"env"`
+	MemoryReservation     types.String `tfsdk:"memory_reservation"`
+	MemoryLimit           types.String `tfsdk:"memory_limit"`
+	CPUReservation        types.String `tfsdk:"cpu_reservation"`
+	CPULimit              types.String `tfsdk:"cpu_limit"`
+	ExternalPort          types.Int64  `tfsdk:"external_port"`
+	EnvironmentID         types.String `tfsdk:"environment_id"`
+	ApplicationStatus     types.String `tfsdk:"application_status"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	DashboardURL          types.String `tfsdk:"dashboard_url"`
+	Replicas              types.Int64  `tfsdk:"replicas"`
+	ServerID              types.String `tfsdk:"server_id"`
+	DeletionProtection    types.Bool   `tfsdk:"deletion_protection"`
+	ApplyImmediately      types.Bool   `tfsdk:"apply_immediately"`
+	InternalHost          types.String `tfsdk:"internal_host"`
+	InternalPort          types.Int64  `tfsdk:"internal_port"`
+	InternalConnectionURL types.String `tfsdk:"internal_connection_url"`
 }
 
 func (r *RedisResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_redis"
 }
 
+func (r *RedisResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	serviceIdentitySchema(resp)
+}
+
 func (r *RedisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Redis database instance in Dokploy.",
@@ -104,6 +117,9 @@ func (r *RedisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"env": schema.StringAttribute{
 				Optional:    true,
 				Description: "Environment variables for the Redis container.",
+				Validators: []validator.String{
+					envValidator{},
+				},
 			},
 			"memory_reservation": schema.StringAttribute{
 				Optional:    true,
@@ -139,6 +155,8 @@ func (r *RedisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_at":    createdAtAttribute("Redis database"),
+			"dashboard_url": dashboardURLAttribute("Redis database"),
 			"replicas": schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
@@ -154,6 +172,11 @@ func (r *RedisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"internal_host":           internalHostAttribute(),
+			"internal_port":           internalPortAttribute(),
+			"internal_connection_url": internalConnectionURLAttribute(),
+			"deletion_protection":     deletionProtectionAttribute(),
+			"apply_immediately":       applyImmediatelyAttribute(),
 		},
 	}
 }
@@ -162,9 +185,9 @@ func (r *RedisResource) Configure(_ context.Context, req resource.ConfigureReque
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.DokployClient)
+	c, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = c
@@ -240,6 +263,7 @@ func (r *RedisResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.AppName = types.StringValue(createdRedis.AppName)
 	plan.EnvironmentID = types.StringValue(createdRedis.EnvironmentID)
 	plan.ApplicationStatus = types.StringValue(createdRedis.ApplicationStatus)
+	plan.CreatedAt = optionalString(createdRedis.CreatedAt)
 
 	// Set computed fields that have defaults.
 	if createdRedis.DockerImage != "" {
@@ -279,9 +303,13 @@ func (r *RedisResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !plan.ServerID.IsNull() || createdRedis.ServerID != "" {
 		plan.ServerID = types.StringValue(createdRedis.ServerID)
 	}
+	plan.InternalHost, plan.InternalPort, plan.InternalConnectionURL = internalConnection(redisCredentials(createdRedis), plan.DatabasePassword)
+
+	readDashboardURL(r.client, "redis", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *RedisResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -308,6 +336,7 @@ func (r *RedisResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.AppName = types.StringValue(redis.AppName)
 	state.EnvironmentID = types.StringValue(redis.EnvironmentID)
 	state.ApplicationStatus = types.StringValue(redis.ApplicationStatus)
+	state.CreatedAt = optionalString(redis.CreatedAt)
 
 	// Update computed fields.
 	if redis.DockerImage != "" {
@@ -345,9 +374,13 @@ func (r *RedisResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if !state.ServerID.IsNull() || redis.ServerID != "" {
 		state.ServerID = types.StringValue(redis.ServerID)
 	}
+	state.InternalHost, state.InternalPort, state.InternalConnectionURL = internalConnection(redisCredentials(redis), state.DatabasePassword)
+
+	readDashboardURL(r.client, "redis", state.ID.ValueString(), state.EnvironmentID.ValueString(), &state.DashboardURL, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *RedisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -358,6 +391,13 @@ func (r *RedisResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var priorImage types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("docker_image"), &priorImage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	upgrade := imageUpgrade(priorImage, plan.DockerImage, plan.ApplyImmediately)
+
 	redis := client.Redis{
 		RedisID:           plan.ID.ValueString(),
 		Name:              plan.Name.ValueString(),
@@ -373,6 +413,12 @@ func (r *RedisResource) Update(ctx context.Context, req resource.UpdateRequest,
 		CPULimit:          plan.CPULimit.ValueString(),
 		ExternalPort:      int(plan.ExternalPort.ValueInt64()),
 		Replicas:          int(plan.Replicas.ValueInt64()),
+		Clear: clearedDatabaseFields(
+			plan.Description, plan.Command, plan.Env,
+			plan.MemoryReservation, plan.MemoryLimit,
+			plan.CPUReservation, plan.CPULimit,
+			plan.ExternalPort,
+		),
 	}
 
 	updatedRedis, err := r.client.UpdateRedis(redis)
@@ -381,12 +427,21 @@ func (r *RedisResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if upgrade {
+		status := redeployDatabase(ctx, r.client, plan.ID.ValueString(), "redis", &resp.Diagnostics)
+		if status == "" {
+			return
+		}
+		updatedRedis.ApplicationStatus = status
+	}
+
 	// Update required and computed fields.
 	// Note: AppNamePrefix is not updated - it's user-provided config that triggers replace.
 	plan.Name = types.StringValue(updatedRedis.Name)
 	// Update the computed app_name from server.
 	plan.AppName = types.StringValue(updatedRedis.AppName)
 	plan.ApplicationStatus = types.StringValue(updatedRedis.ApplicationStatus)
+	plan.CreatedAt = optionalString(updatedRedis.CreatedAt)
 
 	// Update computed fields.
 	if updatedRedis.DockerImage != "" {
@@ -421,9 +476,13 @@ func (r *RedisResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !plan.ExternalPort.IsNull() || updatedRedis.ExternalPort > 0 {
 		plan.ExternalPort = types.Int64Value(int64(updatedRedis.ExternalPort))
 	}
+	plan.InternalHost, plan.InternalPort, plan.InternalConnectionURL = internalConnection(redisCredentials(updatedRedis), plan.DatabasePassword)
+
+	readDashboardURL(r.client, "redis", plan.ID.ValueString(), plan.EnvironmentID.ValueString(), &plan.DashboardURL, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setServiceIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *RedisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -434,16 +493,19 @@ func (r *RedisResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteRedis(state.ID.ValueString())
+	if !checkDeletionProtection(state.DeletionProtection, "dokploy_redis", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	err := deleteChild(ctx, func() error { return r.client.DeleteRedis(state.ID.ValueString()) }, environmentParent(r.client, state.EnvironmentID.ValueString()))
 	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting Redis instance", err.Error())
 		return
 	}
 }
 
 func (r *RedisResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("apply_immediately"), false)...)
 }
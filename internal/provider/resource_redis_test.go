@@ -22,10 +22,12 @@ func TestAccRedisResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccRedisResourceConfig("test-redis-project", "test-redis-env", "test-redis", "testredisapp"),
+				Config: testAccRedisResourceConfig("tf-acc-redis-project", "test-redis-env", "test-redis", "testredisapp"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_redis.test", "name", "test-redis"),
 					resource.TestCheckResourceAttrSet("dokploy_redis.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_redis.test", "internal_host"),
+					resource.TestCheckResourceAttr("dokploy_redis.test", "internal_port", "6379"),
 					resource.TestCheckResourceAttrSet("dokploy_redis.test", "environment_id"),
 					resource.TestCheckResourceAttr("dokploy_redis.test", "app_name_prefix", "testredisapp"),
 					resource.TestCheckResourceAttrSet("dokploy_redis.test", "app_name"),
@@ -33,7 +35,7 @@ func TestAccRedisResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccRedisResourceConfigWithDescription("test-redis-project", "test-redis-env", "test-redis-updated", "testredisapp", "Updated Redis instance"),
+				Config: testAccRedisResourceConfigWithDescription("tf-acc-redis-project", "test-redis-env", "test-redis-updated", "testredisapp", "Updated Redis instance"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_redis.test", "name", "test-redis-updated"),
 					resource.TestCheckResourceAttr("dokploy_redis.test", "description", "Updated Redis instance"),
@@ -44,7 +46,7 @@ func TestAccRedisResource(t *testing.T) {
 				ResourceName:            "dokploy_redis.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "app_name_prefix"}, // Password not returned by API, prefix is config-only.
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "app_name_prefix", "deletion_protection"}, // Password not returned by API, prefix is config-only.
 			},
 		},
 	})
@@ -68,6 +70,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_redis" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name_prefix   = "%s"
   database_password = "test_redis_password_123"
@@ -94,6 +98,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_redis" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name_prefix   = "%s"
   database_password = "test_redis_password_123"
@@ -118,7 +124,7 @@ func TestAccRedisResourceExtended(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with extended settings
 			{
-				Config: testAccRedisResourceExtendedConfig("test-redis-ext-project", "test-redis-ext-env", "test-redis-ext", "testredisext", "128", "256", "REDIS_ENV=test"),
+				Config: testAccRedisResourceExtendedConfig("tf-acc-redis-ext-project", "test-redis-ext-env", "test-redis-ext", "testredisext", "128", "256", "REDIS_ENV=test"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_redis.test", "name", "test-redis-ext"),
 					resource.TestCheckResourceAttr("dokploy_redis.test", "memory_reservation", "128"),
@@ -129,7 +135,7 @@ func TestAccRedisResourceExtended(t *testing.T) {
 			},
 			// Update extended settings
 			{
-				Config: testAccRedisResourceExtendedConfig("test-redis-ext-project", "test-redis-ext-env", "test-redis-ext-updated", "testredisext", "256", "512", "REDIS_ENV=production"),
+				Config: testAccRedisResourceExtendedConfig("tf-acc-redis-ext-project", "test-redis-ext-env", "test-redis-ext-updated", "testredisext", "256", "512", "REDIS_ENV=production"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_redis.test", "name", "test-redis-ext-updated"),
 					resource.TestCheckResourceAttr("dokploy_redis.test", "memory_reservation", "256"),
@@ -159,6 +165,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_redis" "test" {
+  deletion_protection = false
+
   name               = "%s"
   app_name_prefix    = "%s"
   database_password  = "test_redis_password_123"
@@ -169,3 +177,64 @@ resource "dokploy_redis" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, redisName, appNamePrefix, memReserve, memLimit, env)
 }
+
+// TestAccRedisResourceClearSettings tests that removing optional settings from
+// the configuration clears them on the Redis instance.
+func TestAccRedisResourceClearSettings(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with every optional setting
+			{
+				Config: testAccRedisResourceSettingsConfig("tf-acc-redis-clear-project", "test-redis-clear-env", testAccDatabaseSettings("redis-server --appendonly yes", 46379)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_redis.test", "command", "redis-server --appendonly yes"),
+					resource.TestCheckResourceAttr("dokploy_redis.test", "env", "TZ=UTC"),
+					resource.TestCheckResourceAttr("dokploy_redis.test", "cpu_limit", "0.5"),
+					resource.TestCheckResourceAttr("dokploy_redis.test", "external_port", "46379"),
+				),
+			},
+			// Remove the settings again
+			{
+				Config: testAccRedisResourceSettingsConfig("tf-acc-redis-clear-project", "test-redis-clear-env", ""),
+				Check:  testAccCheckDatabaseSettingsCleared("dokploy_redis.test"),
+			},
+		},
+	})
+}
+
+func testAccRedisResourceSettingsConfig(projectName, envName, settings string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for Redis settings tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_redis" "test" {
+  deletion_protection = false
+
+  name              = "test-redis-clear"
+  app_name_prefix   = "testredisclear"
+  database_password = "test_redis_password_123"
+  environment_id    = dokploy_environment.test.id
+%s}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, settings)
+}
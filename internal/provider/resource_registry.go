@@ -5,12 +5,15 @@ import (
 	"fmt"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,7 +25,7 @@ func NewRegistryResource() resource.Resource {
 }
 
 type RegistryResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type RegistryResourceModel struct {
@@ -34,6 +37,9 @@ type RegistryResourceModel struct {
 	RegistryType types.String `tfsdk:"registry_type"`
 	ImagePrefix  types.String `tfsdk:"image_prefix"`
 	ServerID     types.String `tfsdk:"server_id"`
+
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 func (r *RegistryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,9 +66,25 @@ func (r *RegistryResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Username for the registry.",
 			},
 			"password": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password for the registry. Exactly one of password or password_wo must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Password for the registry.",
+				WriteOnly:   true,
+				Description: "Password for the registry. Write-only: it is never stored in state, so changing it alone causes no diff; bump password_wo_version to send the new password. Requires Terraform 1.11 or later.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Arbitrary number to change whenever password_wo changes. The new password is tested against the registry and then saved, so a rejected password leaves the old one in place.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"registry_url": schema.StringAttribute{
 				Required:    true,
@@ -90,11 +112,11 @@ func (r *RegistryResource) Configure(_ context.Context, req resource.ConfigureRe
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.DokployClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -109,10 +131,22 @@ func (r *RegistryResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// A write-only password is only available from the configuration.
+	var passwordWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password := plan.Password.ValueString()
+	if !passwordWO.IsNull() {
+		password = passwordWO.ValueString()
+	}
+
 	registry := client.Registry{
 		RegistryName: plan.RegistryName.ValueString(),
 		Username:     plan.Username.ValueString(),
-		Password:     plan.Password.ValueString(),
+		Password:     password,
 		RegistryUrl:  plan.RegistryUrl.ValueString(),
 		RegistryType: plan.RegistryType.ValueString(),
 		ImagePrefix:  plan.ImagePrefix.ValueString(),
@@ -161,9 +195,12 @@ func (r *RegistryResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *RegistryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan RegistryResourceModel
+	var plan, state RegistryResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var passwordWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -179,6 +216,18 @@ func (r *RegistryResource) Update(ctx context.Context, req resource.UpdateReques
 		ServerID:     plan.ServerID.ValueString(),
 	}
 
+	// The write-only password cannot be diffed, so it is only sent when
+	// password_wo_version changes. Rotated credentials are tested first so a
+	// bad password never replaces a working one.
+	if !passwordWO.IsNull() && !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		registry.Password = passwordWO.ValueString()
+		if err := r.client.TestRegistry(registry); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Registry Credentials Rejected",
+				fmt.Sprintf("The new password was not saved because logging in to %s failed: %s", registry.RegistryUrl, err))
+			return
+		}
+	}
+
 	updatedRegistry, err := r.client.UpdateRegistry(registry)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating registry", err.Error())
@@ -1,10 +1,19 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -28,21 +37,21 @@ func TestAccRegistryResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccRegistryResourceConfig("test-registry-project", "test-registry-env", "test-registry-app", "test-registry", "docker.io", dockerUsername, dockerPassword),
+				Config: testAccRegistryResourceConfig("tf-acc-registry-project", "test-registry-env", "test-registry-app", "tf-acc-registry", "docker.io", dockerUsername, dockerPassword),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_registry.test", "registry_url", "docker.io"),
 					resource.TestCheckResourceAttr("dokploy_registry.test", "username", dockerUsername),
-					resource.TestCheckResourceAttr("dokploy_registry.test", "registry_name", "test-registry"),
+					resource.TestCheckResourceAttr("dokploy_registry.test", "registry_name", "tf-acc-registry"),
 					resource.TestCheckResourceAttrSet("dokploy_registry.test", "id"),
 				),
 			},
 			// Update and Read testing - change registry name
 			{
-				Config: testAccRegistryResourceConfig("test-registry-project", "test-registry-env", "test-registry-app", "updated-registry", "docker.io", dockerUsername, dockerPassword),
+				Config: testAccRegistryResourceConfig("tf-acc-registry-project", "test-registry-env", "test-registry-app", "tf-acc-registry-updated", "docker.io", dockerUsername, dockerPassword),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_registry.test", "registry_url", "docker.io"),
 					resource.TestCheckResourceAttr("dokploy_registry.test", "username", dockerUsername),
-					resource.TestCheckResourceAttr("dokploy_registry.test", "registry_name", "updated-registry"),
+					resource.TestCheckResourceAttr("dokploy_registry.test", "registry_name", "tf-acc-registry-updated"),
 				),
 			},
 			// ImportState testing
@@ -90,3 +99,66 @@ resource "dokploy_registry" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, registryName, registryURL, username, password, registryURL)
 }
+
+func TestRegistryUpdateRotatesWriteOnlyPassword(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp fwresource.SchemaResponse
+	(&RegistryResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := func(version int64) RegistryResourceModel {
+		return RegistryResourceModel{
+			ID:                types.StringValue("reg-1"),
+			RegistryName:      types.StringValue("ghcr"),
+			Username:          types.StringValue("bot"),
+			Password:          types.StringNull(),
+			RegistryUrl:       types.StringValue("ghcr.io"),
+			RegistryType:      types.StringValue("cloud"),
+			ImagePrefix:       types.StringValue("ghcr.io/acme"),
+			ServerID:          types.StringNull(),
+			PasswordWO:        types.StringNull(),
+			PasswordWOVersion: types.Int64Value(version),
+		}
+	}
+
+	run := func(version int64, loginErr error) (sent []string, diags diag.Diagnostics) {
+		mock := clientmock.New()
+		mock.TestRegistryFunc = func(registry client.Registry) error {
+			sent = append(sent, "test:"+registry.Password)
+			return loginErr
+		}
+		mock.UpdateRegistryFunc = func(registry client.Registry) (*client.Registry, error) {
+			sent = append(sent, "update:"+registry.Password)
+			return &registry, nil
+		}
+
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		config := model(version)
+		config.PasswordWO = types.StringValue("new-token")
+		diags.Append(state.Set(ctx, model(1))...)
+		diags.Append(plan.Set(ctx, model(version))...)
+		configPlan := tfsdk.Plan{Schema: schemaResp.Schema}
+		diags.Append(configPlan.Set(ctx, config)...)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		resp := &fwresource.UpdateResponse{State: state}
+		(&RegistryResource{client: mock}).Update(ctx, fwresource.UpdateRequest{
+			Plan:   plan,
+			State:  state,
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configPlan.Raw},
+		}, resp)
+		return sent, resp.Diagnostics
+	}
+
+	if sent, diags := run(1, nil); diags.HasError() || strings.Join(sent, ",") != "update:" {
+		t.Errorf("same version: sent %v, diags %v; want the password left alone", sent, diags)
+	}
+	if sent, diags := run(2, nil); diags.HasError() || strings.Join(sent, ",") != "test:new-token,update:new-token" {
+		t.Errorf("bumped version: sent %v, diags %v; want the new password tested then saved", sent, diags)
+	}
+	if sent, diags := run(2, errors.New("unauthorized")); !diags.HasError() || strings.Join(sent, ",") != "test:new-token" {
+		t.Errorf("rejected password: sent %v, diags %v; want an error and no update", sent, diags)
+	}
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ScheduledTaskResource{}
+var _ resource.ResourceWithImportState = &ScheduledTaskResource{}
+
+func NewScheduledTaskResource() resource.Resource {
+	return &ScheduledTaskResource{}
+}
+
+type ScheduledTaskResource struct {
+	client client.Client
+}
+
+type ScheduledTaskResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ServerID       types.String `tfsdk:"server_id"`
+	CronExpression types.String `tfsdk:"cron_expression"`
+	Script         types.String `tfsdk:"script"`
+	ShellType      types.String `tfsdk:"shell_type"`
+	Timezone       types.String `tfsdk:"timezone"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	AppName        types.String `tfsdk:"app_name"`
+	LastRunStatus  types.String `tfsdk:"last_run_status"`
+	LastRunAt      types.String `tfsdk:"last_run_at"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *ScheduledTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_task"
+}
+
+func (r *ScheduledTaskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a server-level scheduled task in Dokploy. The task runs a shell script on a remote server, or on the Dokploy host when no server is set, according to a cron schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for the scheduled task.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the scheduled task.",
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the server to run the task on. If not set, the task runs on the Dokploy host.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cron_expression": schema.StringAttribute{
+				Required:    true,
+				Description: "Cron schedule for the task (e.g., '0 4 * * 0' for weekly on Sunday at 4 AM).",
+				Validators: []validator.String{
+					cronValidator{},
+				},
+			},
+			"script": schema.StringAttribute{
+				Required:    true,
+				Description: "Shell script to run on the server.",
+			},
+			"shell_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("bash"),
+				Description: "Shell used to run the script: bash or sh. Default: bash.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("bash", "sh"),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Description: "IANA timezone the cron expression is evaluated in (e.g., 'Europe/Lisbon'). Defaults to the server timezone.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the task is enabled. Default: true.",
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal name Dokploy assigns to the task.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_run_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the most recent run: running, done, or error. Null if the task has never run.",
+			},
+			"last_run_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the most recent run. Null if the task has never run.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the scheduled task was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ScheduledTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *ScheduledTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ScheduledTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule := scheduleFromModel(&plan)
+
+	// Tasks without a server run on the Dokploy host and are owned by the
+	// current user.
+	if schedule.ServerID != nil {
+		schedule.ScheduleType = "server"
+	} else {
+		user, err := r.client.GetUser()
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading current user", err.Error())
+			return
+		}
+		schedule.ScheduleType = "dokploy-server"
+		schedule.UserID = &user.ID
+	}
+
+	created, err := r.client.CreateSchedule(schedule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating scheduled task", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ScheduleID)
+	plan.AppName = types.StringValue(created.AppName)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+	plan.LastRunStatus = types.StringNull()
+	plan.LastRunAt = types.StringNull()
+
+	// Save the task before reading its runs, so it is tracked even if that
+	// fails.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readLastRun(&plan); err != nil {
+		resp.Diagnostics.AddWarning("Unable to Read Scheduled Task Runs", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduledTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ScheduledTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetSchedule(state.ID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading scheduled task", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(schedule.Name)
+	state.CronExpression = types.StringValue(schedule.CronExpression)
+	state.ShellType = types.StringValue(schedule.ShellType)
+	state.Enabled = types.BoolValue(schedule.Enabled)
+	state.AppName = types.StringValue(schedule.AppName)
+	state.CreatedAt = types.StringValue(schedule.CreatedAt)
+
+	if schedule.Script != nil {
+		state.Script = types.StringValue(*schedule.Script)
+	}
+	if schedule.ServerID != nil && *schedule.ServerID != "" {
+		state.ServerID = types.StringValue(*schedule.ServerID)
+	} else {
+		state.ServerID = types.StringNull()
+	}
+	if schedule.Timezone != nil && *schedule.Timezone != "" {
+		state.Timezone = types.StringValue(*schedule.Timezone)
+	} else {
+		state.Timezone = types.StringNull()
+	}
+
+	// The runs are informational, so an unavailable deployment list does not
+	// fail the refresh; last_run_* stay null instead.
+	if err := r.readLastRun(&state); err != nil {
+		resp.Diagnostics.AddWarning("Unable to Read Scheduled Task Runs", err.Error())
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduledTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ScheduledTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ScheduledTaskResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.GetSchedule(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading scheduled task", err.Error())
+		return
+	}
+
+	schedule := scheduleFromModel(&plan)
+	schedule.ScheduleID = state.ID.ValueString()
+	schedule.ScheduleType = existing.ScheduleType
+	schedule.UserID = existing.UserID
+	schedule.AppName = existing.AppName
+
+	if _, err := r.client.UpdateSchedule(schedule); err != nil {
+		resp.Diagnostics.AddError("Error updating scheduled task", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.AppName = state.AppName
+	plan.CreatedAt = state.CreatedAt
+
+	if err := r.readLastRun(&plan); err != nil {
+		resp.Diagnostics.AddWarning("Unable to Read Scheduled Task Runs", err.Error())
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduledTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ScheduledTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSchedule(state.ID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting scheduled task", err.Error())
+		return
+	}
+}
+
+func (r *ScheduledTaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func scheduleFromModel(m *ScheduledTaskResourceModel) client.Schedule {
+	script := m.Script.ValueString()
+	schedule := client.Schedule{
+		Name:           m.Name.ValueString(),
+		CronExpression: m.CronExpression.ValueString(),
+		ShellType:      m.ShellType.ValueString(),
+		Script:         &script,
+		Enabled:        m.Enabled.ValueBool(),
+	}
+	if !m.ServerID.IsNull() && !m.ServerID.IsUnknown() {
+		serverID := m.ServerID.ValueString()
+		schedule.ServerID = &serverID
+	}
+	if !m.Timezone.IsNull() && !m.Timezone.IsUnknown() {
+		timezone := m.Timezone.ValueString()
+		schedule.Timezone = &timezone
+	}
+	return schedule
+}
+
+// readLastRun sets the last run fields from the most recent deployment of the
+// task. They are left null when the deployments cannot be read.
+func (r *ScheduledTaskResource) readLastRun(m *ScheduledTaskResourceModel) error {
+	m.LastRunStatus = types.StringNull()
+	m.LastRunAt = types.StringNull()
+
+	deployments, err := r.client.ListDeploymentsByType(m.ID.ValueString(), "schedule")
+	if err != nil {
+		return err
+	}
+	if len(deployments) == 0 {
+		return nil
+	}
+
+	last := deployments[0]
+	m.LastRunStatus = types.StringValue(last.Status)
+	if last.StartedAt != nil && *last.StartedAt != "" {
+		m.LastRunAt = types.StringValue(*last.StartedAt)
+	} else {
+		m.LastRunAt = types.StringValue(last.CreatedAt)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduledTaskResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccScheduledTaskResourceConfig("test-scheduled-task", "0 4 * * 0", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_scheduled_task.test", "name", "test-scheduled-task"),
+					resource.TestCheckResourceAttr("dokploy_scheduled_task.test", "cron_expression", "0 4 * * 0"),
+					resource.TestCheckResourceAttr("dokploy_scheduled_task.test", "shell_type", "bash"),
+					resource.TestCheckResourceAttr("dokploy_scheduled_task.test", "enabled", "true"),
+					resource.TestCheckNoResourceAttr("dokploy_scheduled_task.test", "server_id"),
+					resource.TestCheckNoResourceAttr("dokploy_scheduled_task.test", "last_run_status"),
+					resource.TestCheckResourceAttrSet("dokploy_scheduled_task.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_scheduled_task.test", "app_name"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccScheduledTaskResourceConfig("test-scheduled-task-updated", "0 5 * * 0", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_scheduled_task.test", "name", "test-scheduled-task-updated"),
+					resource.TestCheckResourceAttr("dokploy_scheduled_task.test", "cron_expression", "0 5 * * 0"),
+					resource.TestCheckResourceAttr("dokploy_scheduled_task.test", "enabled", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dokploy_scheduled_task.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccScheduledTaskResourceConfig(name, cron string, enabled bool) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_scheduled_task" "test" {
+  name            = "%s"
+  cron_expression = "%s"
+  script          = "docker image prune --force"
+  enabled         = %t
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), name, cron, enabled)
+}
+
+func TestScheduledTaskReadWithoutRuns(t *testing.T) {
+	ctx := context.Background()
+	mock := clientmock.New()
+	mock.GetScheduleFunc = func(id string) (*client.Schedule, error) {
+		return &client.Schedule{ScheduleID: id, Name: "cleanup", CronExpression: "0 3 * * *", ShellType: "bash", Enabled: true}, nil
+	}
+	mock.ListDeploymentsByTypeFunc = func(string, string) ([]client.Deployment, error) {
+		return nil, errors.New("deployment.allByType: 500 Internal Server Error")
+	}
+	r := &ScheduledTaskResource{client: mock}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	state.Set(ctx, ScheduledTaskResourceModel{
+		ID:            types.StringValue("sched-1"),
+		Name:          types.StringValue("cleanup"),
+		LastRunStatus: types.StringValue("done"),
+		LastRunAt:     types.StringValue("2026-01-01T03:00:00Z"),
+	})
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	// An unavailable run list must not fail the refresh.
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("diagnostics = %v, want one warning", resp.Diagnostics)
+	}
+	var got ScheduledTaskResourceModel
+	resp.State.Get(ctx, &got)
+	if got.Name.ValueString() != "cleanup" || !got.LastRunStatus.IsNull() || !got.LastRunAt.IsNull() {
+		t.Errorf("state = %+v, want the schedule with null last_run_*", got)
+	}
+}
@@ -2,16 +2,25 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,26 +28,54 @@ import (
 
 var _ resource.Resource = &ServerResource{}
 var _ resource.ResourceWithImportState = &ServerResource{}
+var _ resource.ResourceWithValidateConfig = &ServerResource{}
+var _ resource.ResourceWithModifyPlan = &ServerResource{}
 
 func NewServerResource() resource.Resource {
 	return &ServerResource{}
 }
 
 type ServerResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type ServerResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	IPAddress    types.String `tfsdk:"ip_address"`
-	Port         types.Int64  `tfsdk:"port"`
-	Username     types.String `tfsdk:"username"`
-	SSHKeyID     types.String `tfsdk:"ssh_key_id"`
-	ServerType   types.String `tfsdk:"server_type"`
-	ServerStatus types.String `tfsdk:"server_status"`
-	Command      types.String `tfsdk:"command"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	IPAddress           types.String `tfsdk:"ip_address"`
+	Port                types.Int64  `tfsdk:"port"`
+	Username            types.String `tfsdk:"username"`
+	SSHKeyID            types.String `tfsdk:"ssh_key_id"`
+	PreviousSSHKeyID    types.String `tfsdk:"previous_ssh_key_id"`
+	ServerType          types.String `tfsdk:"server_type"`
+	ServerStatus        types.String `tfsdk:"server_status"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	Command             types.String `tfsdk:"command"`
+	EnableDockerCleanup types.Bool   `tfsdk:"enable_docker_cleanup"`
+	ValidateConnection  types.Bool   `tfsdk:"validate_connection"`
+	Validation          types.Object `tfsdk:"validation"`
+	SetupOnCreate       types.Bool   `tfsdk:"setup_on_create"`
+	TraefikPorts        types.List   `tfsdk:"traefik_ports"`
+	MetricsPort         types.Int64  `tfsdk:"metrics_port"`
+	MetricsToken        types.String `tfsdk:"metrics_token"`
+}
+
+// serverTraefikPortAttrTypes are the attribute types of a traefik_ports entry.
+var serverTraefikPortAttrTypes = map[string]attr.Type{
+	"target_port":    types.Int64Type,
+	"published_port": types.Int64Type,
+	"protocol":       types.StringType,
+}
+
+// serverValidationAttrTypes are the attribute types of the validation object.
+var serverValidationAttrTypes = map[string]attr.Type{
+	"ready":                     types.BoolType,
+	"docker_installed":          types.BoolType,
+	"docker_version":            types.StringType,
+	"swarm_installed":           types.BoolType,
+	"dokploy_network_installed": types.BoolType,
+	"main_directory_installed":  types.BoolType,
 }
 
 func (r *ServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -81,7 +118,14 @@ func (r *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"ssh_key_id": schema.StringAttribute{
 				Required:    true,
-				Description: "ID of the SSH key to use for authentication.",
+				Description: "ID of the SSH key to use for authentication. When it changes, Dokploy must be able to connect with the new key, otherwise the server is switched back to the previous key and apply fails.",
+			},
+			"previous_ssh_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the SSH key used before ssh_key_id last changed. Keep that key, and its public key authorized on the server, until the rotation has been applied, so Dokploy can fall back to it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"server_type": schema.StringAttribute{
 				Required:    true,
@@ -94,10 +138,102 @@ func (r *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:    true,
 				Description: "Current status of the server.",
 			},
+			"created_at": createdAtAttribute("server"),
 			"command": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Custom command to run on the server.",
+				Description: "Custom setup script. When set, Dokploy runs it instead of its default script when the server is set up, e.g. to install Docker from a mirror or a pinned version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_docker_cleanup": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Periodically prune unused Docker images, containers and build cache on the server. When not set, the setting made in the Dokploy UI is kept.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validate_connection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Connect to the server over SSH after create and update and check what is installed. Apply fails when the server cannot be reached, and warns when Docker, Swarm, the dokploy-network or the Dokploy directory are missing. Defaults to false.",
+			},
+			"validation": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Result of the last connection check. Null unless validate_connection is true.",
+				Attributes: map[string]schema.Attribute{
+					"ready": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether Docker, Swarm, the dokploy-network and the Dokploy directory are all set up.",
+					},
+					"docker_installed": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether Docker is installed.",
+					},
+					"docker_version": schema.StringAttribute{
+						Computed:    true,
+						Description: "Installed Docker version.",
+					},
+					"swarm_installed": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether Docker Swarm is initialized.",
+					},
+					"dokploy_network_installed": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the dokploy-network overlay network exists.",
+					},
+					"main_directory_installed": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the Dokploy directory exists.",
+					},
+				},
+			},
+			"setup_on_create": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Run Dokploy's server setup over SSH after creating the server, installing Docker, Swarm, the dokploy-network and, on deploy servers, Traefik. Build servers never get Traefik. Defaults to false.",
+			},
+			"traefik_ports": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Extra ports Traefik publishes on the server besides 80 and 443, e.g. for TCP routers. Only valid for deploy servers, and Traefik must be running, so combine it with setup_on_create for new servers.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"target_port": schema.Int64Attribute{
+							Required:    true,
+							Description: "Port inside the Traefik container.",
+						},
+						"published_port": schema.Int64Attribute{
+							Required:    true,
+							Description: "Port published on the server.",
+						},
+						"protocol": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("tcp"),
+							Description: "Protocol: 'tcp' or 'udp'. Defaults to 'tcp'.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("tcp", "udp"),
+							},
+						},
+					},
+				},
+			},
+			"metrics_port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Port of the monitoring agent. When set, the agent is installed on the server so its CPU and memory usage show up in Dokploy.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"metrics_token": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Token the monitoring agent requires from Dokploy. Generated when metrics_port is set and no token is given.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -110,9 +246,9 @@ func (r *ServerResource) Configure(_ context.Context, req resource.ConfigureRequ
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.DokployClient)
+	c, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = c
@@ -122,12 +258,14 @@ func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest,
 	var plan ServerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	var dockerCleanup types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enable_docker_cleanup"), &dockerCleanup)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create with only the fields supported by the create API.
-	// Note: command is NOT accepted by server.create, only by server.update.
+	// Note: command and enable_docker_cleanup are NOT accepted by server.create, only by server.update.
 	server := client.Server{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -144,23 +282,25 @@ func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Check if we need to update with command field (not supported by create API).
-	if !plan.Command.IsNull() && !plan.Command.IsUnknown() && plan.Command.ValueString() != "" {
+	// Check if we need to update with fields not supported by the create API.
+	hasCommand := !plan.Command.IsNull() && !plan.Command.IsUnknown() && plan.Command.ValueString() != ""
+	if hasCommand || dockerCleanup.ValueBool() {
 		updateServer := client.Server{
-			ID:          createdServer.ID,
-			Name:        createdServer.Name,
-			Description: createdServer.Description,
-			IPAddress:   createdServer.IPAddress,
-			Port:        createdServer.Port,
-			Username:    createdServer.Username,
-			SSHKeyID:    createdServer.SSHKeyID,
-			ServerType:  createdServer.ServerType,
-			Command:     plan.Command.ValueString(),
+			ID:                  createdServer.ID,
+			Name:                createdServer.Name,
+			Description:         createdServer.Description,
+			IPAddress:           createdServer.IPAddress,
+			Port:                createdServer.Port,
+			Username:            createdServer.Username,
+			SSHKeyID:            createdServer.SSHKeyID,
+			ServerType:          createdServer.ServerType,
+			Command:             plan.Command.ValueString(),
+			EnableDockerCleanup: dockerCleanup.ValueBoolPointer(),
 		}
 
 		updatedServer, err := r.client.UpdateServer(updateServer)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating server command after creation", err.Error())
+			resp.Diagnostics.AddError("Error updating server settings after creation", err.Error())
 			return
 		}
 		createdServer = updatedServer
@@ -173,9 +313,26 @@ func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.Port = types.Int64Value(int64(createdServer.Port))
 	plan.Username = types.StringValue(createdServer.Username)
 	plan.SSHKeyID = types.StringValue(createdServer.SSHKeyID)
+	plan.PreviousSSHKeyID = types.StringNull()
 	plan.ServerType = types.StringValue(createdServer.ServerType)
 	plan.ServerStatus = types.StringValue(createdServer.ServerStatus)
+	plan.CreatedAt = optionalString(createdServer.CreatedAt)
 	plan.Command = types.StringValue(createdServer.Command)
+	plan.EnableDockerCleanup = dockerCleanupValue(createdServer)
+	// The server exists now, so save it even if it turns out to be
+	// unreachable or its setup fails.
+	if plan.SetupOnCreate.ValueBool() {
+		if err := r.client.SetupServer(createdServer.ID); err != nil {
+			resp.Diagnostics.AddError("Error setting up server", err.Error())
+		}
+	}
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.bootstrap(&plan, nil)...)
+	}
+	if plan.MetricsToken.IsUnknown() {
+		plan.MetricsToken = types.StringNull()
+	}
+	resp.Diagnostics.Append(r.validate(&plan)...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -207,30 +364,60 @@ func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.SSHKeyID = types.StringValue(server.SSHKeyID)
 	state.ServerType = types.StringValue(server.ServerType)
 	state.ServerStatus = types.StringValue(server.ServerStatus)
+	state.CreatedAt = optionalString(server.CreatedAt)
 	state.Command = types.StringValue(server.Command)
+	state.EnableDockerCleanup = dockerCleanupValue(server)
+	if state.ValidateConnection.IsNull() {
+		state.ValidateConnection = types.BoolValue(false)
+	}
+	if state.Validation.IsNull() || state.Validation.IsUnknown() {
+		state.Validation = types.ObjectNull(serverValidationAttrTypes)
+	}
+	if state.SetupOnCreate.IsNull() {
+		state.SetupOnCreate = types.BoolValue(false)
+	}
+	if !state.MetricsPort.IsNull() && server.MetricsConfig != nil {
+		state.MetricsPort = types.Int64Value(int64(server.MetricsConfig.Server.Port))
+		state.MetricsToken = types.StringValue(server.MetricsConfig.Server.Token)
+	}
+	// Reading the ports needs an SSH connection to the server, so a server
+	// that is briefly unreachable keeps the ports from the last apply.
+	if !state.TraefikPorts.IsNull() {
+		ports, err := r.client.GetTraefikPorts(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to Read Traefik Ports", err.Error())
+		} else {
+			state.TraefikPorts = traefikPortsValue(ports)
+		}
+	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ServerResourceModel
+	var plan, state ServerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	var dockerCleanup types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enable_docker_cleanup"), &dockerCleanup)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	server := client.Server{
-		ID:          plan.ID.ValueString(),
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
-		IPAddress:   plan.IPAddress.ValueString(),
-		Port:        int(plan.Port.ValueInt64()),
-		Username:    plan.Username.ValueString(),
-		SSHKeyID:    plan.SSHKeyID.ValueString(),
-		ServerType:  plan.ServerType.ValueString(),
-		Command:     plan.Command.ValueString(),
+		ID:                  plan.ID.ValueString(),
+		Name:                plan.Name.ValueString(),
+		Description:         plan.Description.ValueString(),
+		IPAddress:           plan.IPAddress.ValueString(),
+		Port:                int(plan.Port.ValueInt64()),
+		Username:            plan.Username.ValueString(),
+		SSHKeyID:            plan.SSHKeyID.ValueString(),
+		ServerType:          plan.ServerType.ValueString(),
+		Command:             plan.Command.ValueString(),
+		EnableDockerCleanup: dockerCleanup.ValueBoolPointer(),
 	}
 
 	updatedServer, err := r.client.UpdateServer(server)
@@ -238,6 +425,17 @@ func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.AddError("Error updating server", err.Error())
 		return
 	}
+	if !plan.SSHKeyID.Equal(state.SSHKeyID) {
+		// Dokploy only checks a key by connecting with it, so the new key is
+		// saved first and replaced by the previous one if it is rejected.
+		if _, err := r.client.ValidateServer(plan.ID.ValueString()); err != nil {
+			resp.Diagnostics.Append(r.revertSSHKey(server, state.SSHKeyID.ValueString(), err)...)
+			return
+		}
+		plan.PreviousSSHKeyID = state.SSHKeyID
+	} else if plan.PreviousSSHKeyID.IsUnknown() {
+		plan.PreviousSSHKeyID = state.PreviousSSHKeyID
+	}
 
 	plan.Name = types.StringValue(updatedServer.Name)
 	plan.Description = types.StringValue(updatedServer.Description)
@@ -247,7 +445,14 @@ func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.SSHKeyID = types.StringValue(updatedServer.SSHKeyID)
 	plan.ServerType = types.StringValue(updatedServer.ServerType)
 	plan.ServerStatus = types.StringValue(updatedServer.ServerStatus)
+	plan.CreatedAt = optionalString(updatedServer.CreatedAt)
 	plan.Command = types.StringValue(updatedServer.Command)
+	plan.EnableDockerCleanup = dockerCleanupValue(updatedServer)
+	resp.Diagnostics.Append(r.bootstrap(&plan, &state)...)
+	if plan.MetricsToken.IsUnknown() {
+		plan.MetricsToken = types.StringNull()
+	}
+	resp.Diagnostics.Append(r.validate(&plan)...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -274,3 +479,234 @@ func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *ServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *ServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state ServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.SSHKeyID.IsUnknown():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_ssh_key_id"), types.StringUnknown())...)
+	case !plan.SSHKeyID.Equal(state.SSHKeyID):
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_ssh_key_id"), state.SSHKeyID)...)
+	}
+}
+
+func (r *ServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ServerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ServerType.ValueString() == "build" && !config.TraefikPorts.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("traefik_ports"),
+			"Traefik Ports on Build Server",
+			"Dokploy does not run Traefik on build servers, so traefik_ports can only be set when server_type is 'deploy'.",
+		)
+	}
+	if !config.MetricsToken.IsNull() && config.MetricsPort.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("metrics_token"),
+			"Missing Metrics Port",
+			"metrics_token only applies when metrics_port is set.",
+		)
+	}
+}
+
+// bootstrap applies the Traefik ports and monitoring settings that changed
+// since state, or all configured ones when state is nil.
+func (r *ServerResource) bootstrap(plan, state *ServerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	serverID := plan.ID.ValueString()
+
+	if !plan.TraefikPorts.IsUnknown() && (state == nil && !plan.TraefikPorts.IsNull() || state != nil && !plan.TraefikPorts.Equal(state.TraefikPorts)) {
+		if err := r.client.UpdateTraefikPorts(serverID, traefikPortsFromList(plan.TraefikPorts)); err != nil {
+			diags.AddAttributeError(path.Root("traefik_ports"), "Error updating Traefik ports", err.Error())
+			return diags
+		}
+	}
+
+	if plan.MetricsPort.IsNull() {
+		return diags
+	}
+	if plan.MetricsToken.IsNull() || plan.MetricsToken.IsUnknown() {
+		token, err := generateMetricsToken()
+		if err != nil {
+			diags.AddError("Error generating metrics token", err.Error())
+			return diags
+		}
+		plan.MetricsToken = types.StringValue(token)
+	}
+	if state != nil && plan.MetricsPort.Equal(state.MetricsPort) && plan.MetricsToken.Equal(state.MetricsToken) {
+		return diags
+	}
+	if err := r.client.SetupServerMonitoring(serverID, int(plan.MetricsPort.ValueInt64()), plan.MetricsToken.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("metrics_port"), "Error setting up server monitoring", err.Error())
+	}
+	return diags
+}
+
+// traefikPortsFromList converts the traefik_ports attribute to API ports.
+func traefikPortsFromList(list types.List) []client.TraefikPort {
+	ports := []client.TraefikPort{}
+	for _, el := range list.Elements() {
+		obj, ok := el.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		ports = append(ports, client.TraefikPort{
+			TargetPort:    int(attrs["target_port"].(types.Int64).ValueInt64()),
+			PublishedPort: int(attrs["published_port"].(types.Int64).ValueInt64()),
+			Protocol:      attrs["protocol"].(types.String).ValueString(),
+		})
+	}
+	return ports
+}
+
+// traefikPortsValue converts API ports to the traefik_ports attribute.
+func traefikPortsValue(ports []client.TraefikPort) types.List {
+	elemType := types.ObjectType{AttrTypes: serverTraefikPortAttrTypes}
+	elems := make([]attr.Value, 0, len(ports))
+	for _, p := range ports {
+		protocol := p.Protocol
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		elems = append(elems, types.ObjectValueMust(serverTraefikPortAttrTypes, map[string]attr.Value{
+			"target_port":    types.Int64Value(int64(p.TargetPort)),
+			"published_port": types.Int64Value(int64(p.PublishedPort)),
+			"protocol":       types.StringValue(protocol),
+		}))
+	}
+	return types.ListValueMust(elemType, elems)
+}
+
+// generateMetricsToken returns a random token for the monitoring agent.
+func generateMetricsToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// dockerCleanupValue returns the enable_docker_cleanup value of server.
+// Servers that never had the setting saved report it as off.
+func dockerCleanupValue(server *client.Server) types.Bool {
+	return types.BoolValue(server.EnableDockerCleanup != nil && *server.EnableDockerCleanup)
+}
+
+// revertSSHKey switches server back to previousKeyID after Dokploy could
+// not connect with its new SSH key, so the server stays reachable.
+func (r *ServerResource) revertSSHKey(server client.Server, previousKeyID string, connectErr error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	detail := fmt.Sprintf("Dokploy could not connect to %s@%s:%d with SSH key %s: %s\n\n",
+		server.Username, server.IPAddress, server.Port, server.SSHKeyID, connectErr)
+
+	server.SSHKeyID = previousKeyID
+	if _, err := r.client.UpdateServer(server); err != nil {
+		detail += fmt.Sprintf("Switching the server back to SSH key %s failed as well: %s. Set ssh_key_id to a key authorized on the server in the Dokploy UI.", previousKeyID, err)
+	} else {
+		detail += fmt.Sprintf("The server was switched back to SSH key %s. Authorize the public key of the new key for the user on the server and apply again.", previousKeyID)
+	}
+	diags.AddAttributeError(path.Root("ssh_key_id"), "SSH Key Rotation Failed", detail)
+	return diags
+}
+
+// validate runs Dokploy's connection check against the server when
+// validate_connection is set and stores the result in m.Validation.
+func (r *ServerResource) validate(m *ServerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Validation = types.ObjectNull(serverValidationAttrTypes)
+	if !m.ValidateConnection.ValueBool() {
+		return diags
+	}
+
+	result, err := r.client.ValidateServer(m.ID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("validate_connection"),
+			"Server Connection Failed",
+			fmt.Sprintf("Dokploy could not connect to %s@%s:%d over SSH: %s\n\nCheck that the server is reachable from Dokploy and that the public key of ssh_key_id is authorized for the user.",
+				m.Username.ValueString(), m.IPAddress.ValueString(), m.Port.ValueInt64(), err),
+		)
+		return diags
+	}
+
+	missing := missingServerComponents(result)
+	if len(missing) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("validate_connection"),
+			"Server Not Set Up",
+			fmt.Sprintf("Server %s is reachable but is missing: %s. Run the script from the dokploy_server_setup_script data source on it, or set it up from the Dokploy UI, before deploying to it.",
+				m.Name.ValueString(), strings.Join(missing, ", ")),
+		)
+	}
+
+	validation, d := types.ObjectValue(serverValidationAttrTypes, map[string]attr.Value{
+		"ready":                     types.BoolValue(len(missing) == 0),
+		"docker_installed":          types.BoolValue(result.Docker.Enabled),
+		"docker_version":            types.StringValue(result.Docker.Version),
+		"swarm_installed":           types.BoolValue(result.IsSwarmInstalled),
+		"dokploy_network_installed": types.BoolValue(result.IsDokployNetworkInstalled),
+		"main_directory_installed":  types.BoolValue(result.IsMainDirectoryInstalled),
+	})
+	diags.Append(d...)
+	m.Validation = validation
+	return diags
+}
+
+// missingServerComponents lists what a server needs before Dokploy can
+// deploy to it.
+func missingServerComponents(v *client.ServerValidation) []string {
+	var missing []string
+	if !v.Docker.Enabled {
+		missing = append(missing, "Docker")
+	}
+	if !v.IsSwarmInstalled {
+		missing = append(missing, "Docker Swarm")
+	}
+	if !v.IsDokployNetworkInstalled {
+		missing = append(missing, "the dokploy-network")
+	}
+	if !v.IsMainDirectoryInstalled {
+		missing = append(missing, "the Dokploy directory")
+	}
+	return missing
+}
+
+// checkServerType reports an error on attr when the server it refers to is
+// not of the wanted server_type: Dokploy only builds on build servers and
+// only deploys to deploy servers, but accepts either ID and fails at deploy
+// time. Servers created before build servers existed have no type and count
+// as deploy servers. Unknown, empty and missing IDs are left alone.
+func checkServerType(c client.Client, attr path.Path, serverID types.String, want string, diags *diag.Diagnostics) {
+	if serverID.IsUnknown() || serverID.ValueString() == "" {
+		return
+	}
+	server, err := c.GetServer(serverID.ValueString())
+	if err != nil {
+		return
+	}
+	serverType := server.ServerType
+	if serverType == "" {
+		serverType = "deploy"
+	}
+	if serverType != want {
+		diags.AddAttributeError(
+			attr,
+			"Wrong Server Type",
+			fmt.Sprintf("Server %q (%s) is a %s server, but %s must refer to a %s server.", server.Name, serverID.ValueString(), serverType, attr, want),
+		)
+	}
+}
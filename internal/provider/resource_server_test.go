@@ -1,10 +1,17 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -31,9 +38,9 @@ func TestAccServerResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccServerResourceConfig("test-server", serverIP, 22, "root", sshKeyID, "deploy"),
+				Config: testAccServerResourceConfig("tf-acc-server", serverIP, 22, "root", sshKeyID, "deploy"),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("dokploy_server.test", "name", "test-server"),
+					resource.TestCheckResourceAttr("dokploy_server.test", "name", "tf-acc-server"),
 					resource.TestCheckResourceAttr("dokploy_server.test", "ip_address", serverIP),
 					resource.TestCheckResourceAttr("dokploy_server.test", "port", "22"),
 					resource.TestCheckResourceAttr("dokploy_server.test", "username", "root"),
@@ -44,9 +51,9 @@ func TestAccServerResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccServerResourceConfigWithDescription("test-server-updated", "Updated test server", serverIP, 22, "root", sshKeyID, "deploy"),
+				Config: testAccServerResourceConfigWithDescription("tf-acc-server-updated", "Updated test server", serverIP, 22, "root", sshKeyID, "deploy"),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("dokploy_server.test", "name", "test-server-updated"),
+					resource.TestCheckResourceAttr("dokploy_server.test", "name", "tf-acc-server-updated"),
 					resource.TestCheckResourceAttr("dokploy_server.test", "description", "Updated test server"),
 				),
 			},
@@ -96,3 +103,273 @@ resource "dokploy_server" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), name, description, ipAddress, port, username, sshKeyID, serverType)
 }
+
+// TestAccServerResourceDockerCleanup tests toggling docker cleanup on a server.
+func TestAccServerResourceDockerCleanup(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+	serverIP := os.Getenv("TEST_SERVER_IP")
+	sshKeyID := os.Getenv("TEST_SSH_KEY_ID")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	if serverIP == "" || sshKeyID == "" {
+		t.Skip("TEST_SERVER_IP and TEST_SSH_KEY_ID must be set for server acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with docker cleanup enabled
+			{
+				Config: testAccServerResourceDockerCleanupConfig("tf-acc-server-cleanup", serverIP, sshKeyID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_server.test", "enable_docker_cleanup", "true"),
+				),
+			},
+			// Disable docker cleanup
+			{
+				Config: testAccServerResourceDockerCleanupConfig("tf-acc-server-cleanup", serverIP, sshKeyID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_server.test", "enable_docker_cleanup", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dokploy_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServerResourceDockerCleanupConfig(name, ipAddress, sshKeyID string, enableDockerCleanup bool) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_server" "test" {
+  name                  = "%s"
+  ip_address            = "%s"
+  port                  = 22
+  username              = "root"
+  ssh_key_id            = "%s"
+  server_type           = "deploy"
+  enable_docker_cleanup = %t
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), name, ipAddress, sshKeyID, enableDockerCleanup)
+}
+
+func TestAccServerResourceValidateConnection(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+	serverIP := os.Getenv("TEST_SERVER_IP")
+	sshKeyID := os.Getenv("TEST_SSH_KEY_ID")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	if serverIP == "" || sshKeyID == "" {
+		t.Skip("TEST_SERVER_IP and TEST_SSH_KEY_ID must be set for server acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_server" "test" {
+  name                = "tf-acc-server-validate"
+  ip_address          = "%s"
+  port                = 22
+  username            = "root"
+  ssh_key_id          = "%s"
+  server_type         = "deploy"
+  validate_connection = true
+}
+`, host, apiKey, serverIP, sshKeyID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_server.test", "validate_connection", "true"),
+					resource.TestCheckResourceAttrSet("dokploy_server.test", "validation.ready"),
+					resource.TestCheckResourceAttrSet("dokploy_server.test", "validation.docker_installed"),
+				),
+			},
+		},
+	})
+}
+
+func TestServerValidate(t *testing.T) {
+	mock := clientmock.New()
+	r := &ServerResource{client: mock}
+	m := ServerResourceModel{
+		ID:                 types.StringValue("srv-1"),
+		Name:               types.StringValue("worker"),
+		IPAddress:          types.StringValue("10.0.0.5"),
+		Port:               types.Int64Value(22),
+		Username:           types.StringValue("root"),
+		ValidateConnection: types.BoolValue(false),
+	}
+
+	// Nothing is checked unless validate_connection is set.
+	if diags := r.validate(&m); diags.HasError() || !m.Validation.IsNull() {
+		t.Fatalf("validate_connection = false: diags %v, validation %v", diags, m.Validation)
+	}
+
+	m.ValidateConnection = types.BoolValue(true)
+	mock.ValidateServerFunc = func(serverID string) (*client.ServerValidation, error) {
+		return &client.ServerValidation{
+			Docker:                    client.ServerTool{Enabled: true, Version: "27.3.1"},
+			IsSwarmInstalled:          true,
+			IsDokployNetworkInstalled: true,
+			IsMainDirectoryInstalled:  true,
+		}, nil
+	}
+	if diags := r.validate(&m); diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("ready server: unexpected diagnostics %v", diags)
+	}
+	if got := m.Validation.Attributes()["ready"]; !got.Equal(types.BoolValue(true)) {
+		t.Errorf("ready = %v, want true", got)
+	}
+
+	mock.ValidateServerFunc = func(serverID string) (*client.ServerValidation, error) {
+		return &client.ServerValidation{Docker: client.ServerTool{Enabled: true}}, nil
+	}
+	if diags := r.validate(&m); diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("server not set up: want one warning, got %v", diags)
+	}
+	if got := m.Validation.Attributes()["ready"]; !got.Equal(types.BoolValue(false)) {
+		t.Errorf("ready = %v, want false", got)
+	}
+
+	mock.ValidateServerFunc = func(serverID string) (*client.ServerValidation, error) {
+		return nil, errors.New("connect ECONNREFUSED 10.0.0.5:22")
+	}
+	if diags := r.validate(&m); !diags.HasError() || !m.Validation.IsNull() {
+		t.Fatalf("unreachable server: want error and null validation, got %v, %v", diags, m.Validation)
+	}
+}
+
+func TestServerBootstrap(t *testing.T) {
+	mock := clientmock.New()
+	var portUpdates [][]client.TraefikPort
+	mock.UpdateTraefikPortsFunc = func(serverID string, ports []client.TraefikPort) error {
+		portUpdates = append(portUpdates, ports)
+		return nil
+	}
+	var monitoring []string
+	mock.SetupServerMonitoringFunc = func(serverID string, port int, token string) error {
+		monitoring = append(monitoring, fmt.Sprintf("%s:%d:%s", serverID, port, token))
+		return nil
+	}
+	r := &ServerResource{client: mock}
+
+	ports := traefikPortsValue([]client.TraefikPort{{TargetPort: 5432, PublishedPort: 5432}})
+	plan := ServerResourceModel{
+		ID:           types.StringValue("srv-1"),
+		TraefikPorts: ports,
+		MetricsPort:  types.Int64Value(4500),
+		MetricsToken: types.StringUnknown(),
+	}
+
+	// On create every configured setting is applied and a token generated.
+	if diags := r.bootstrap(&plan, nil); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(portUpdates) != 1 || portUpdates[0][0].Protocol != "tcp" || portUpdates[0][0].TargetPort != 5432 {
+		t.Errorf("Traefik port updates = %+v", portUpdates)
+	}
+	token := plan.MetricsToken.ValueString()
+	if len(token) != 48 || len(monitoring) != 1 || monitoring[0] != "srv-1:4500:"+token {
+		t.Errorf("monitoring setups = %v, token %q", monitoring, token)
+	}
+
+	// An update with nothing changed applies nothing.
+	state := plan
+	if diags := r.bootstrap(&plan, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(portUpdates) != 1 || len(monitoring) != 1 {
+		t.Errorf("unchanged settings were reapplied: ports %d, monitoring %d", len(portUpdates), len(monitoring))
+	}
+
+	// Removing traefik_ports clears the extra ports.
+	plan.TraefikPorts = types.ListNull(types.ObjectType{AttrTypes: serverTraefikPortAttrTypes})
+	if diags := r.bootstrap(&plan, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(portUpdates) != 2 || len(portUpdates[1]) != 0 {
+		t.Errorf("Traefik port updates after removal = %+v", portUpdates)
+	}
+}
+
+func TestCheckServerType(t *testing.T) {
+	servers := map[string]*client.Server{
+		"deploy": {ID: "deploy", Name: "edge", ServerType: "deploy"},
+		"build":  {ID: "build", Name: "builder", ServerType: "build"},
+		"legacy": {ID: "legacy", Name: "old"},
+	}
+	mock := clientmock.New()
+	mock.GetServerFunc = func(id string) (*client.Server, error) {
+		if s, ok := servers[id]; ok {
+			return s, nil
+		}
+		return nil, fmt.Errorf("%w: server", client.ErrNotFound)
+	}
+
+	tests := []struct {
+		serverID  types.String
+		want      string
+		wantError bool
+	}{
+		{serverID: types.StringValue("build"), want: "build"},
+		{serverID: types.StringValue("deploy"), want: "build", wantError: true},
+		{serverID: types.StringValue("legacy"), want: "build", wantError: true},
+		{serverID: types.StringValue("legacy"), want: "deploy"},
+		{serverID: types.StringValue("build"), want: "deploy", wantError: true},
+		{serverID: types.StringValue("missing"), want: "build"},
+		{serverID: types.StringUnknown(), want: "build"},
+		{serverID: types.StringNull(), want: "build"},
+	}
+	for _, tt := range tests {
+		var diags diag.Diagnostics
+		checkServerType(mock, path.Root("build_server_id"), tt.serverID, tt.want, &diags)
+		if diags.HasError() != tt.wantError {
+			t.Errorf("server %s wanting %s: error %v, want %v", tt.serverID, tt.want, diags.HasError(), tt.wantError)
+		}
+	}
+}
+
+func TestServerRevertSSHKey(t *testing.T) {
+	mock := clientmock.New()
+	var keys []string
+	mock.UpdateServerFunc = func(server client.Server) (*client.Server, error) {
+		keys = append(keys, server.SSHKeyID)
+		return &server, nil
+	}
+	r := &ServerResource{client: mock}
+	server := client.Server{ID: "srv-1", Username: "root", IPAddress: "10.0.0.5", Port: 22, SSHKeyID: "key-new"}
+
+	diags := r.revertSSHKey(server, "key-old", errors.New("All configured authentication methods failed"))
+	if !diags.HasError() {
+		t.Fatal("expected a rotation error")
+	}
+	if len(keys) != 1 || keys[0] != "key-old" {
+		t.Errorf("server updated with keys %v, want the previous key", keys)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "key-new") || !strings.Contains(detail, "switched back to SSH key key-old") {
+		t.Errorf("detail = %q", detail)
+	}
+}
@@ -22,7 +22,7 @@ func NewSSHKeyResource() resource.Resource {
 }
 
 type SSHKeyResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type SSHKeyResourceModel struct {
@@ -73,9 +73,9 @@ func (r *SSHKeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
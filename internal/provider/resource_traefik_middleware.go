@@ -0,0 +1,635 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+var _ resource.Resource = &TraefikMiddlewareResource{}
+var _ resource.ResourceWithImportState = &TraefikMiddlewareResource{}
+
+// traefikConfigLocks serializes read-modify-write cycles on a single Traefik
+// config file, since several middleware resources can share one file.
+var traefikConfigLocks sync.Map
+
+func NewTraefikMiddlewareResource() resource.Resource {
+	return &TraefikMiddlewareResource{}
+}
+
+type TraefikMiddlewareResource struct {
+	client client.Client
+}
+
+type TraefikMiddlewareResourceModel struct {
+	ID            types.String             `tfsdk:"id"`
+	Name          types.String             `tfsdk:"name"`
+	ApplicationID types.String             `tfsdk:"application_id"`
+	ServerID      types.String             `tfsdk:"server_id"`
+	RateLimit     *traefikRateLimitModel   `tfsdk:"rate_limit"`
+	IPAllowList   *traefikIPAllowListModel `tfsdk:"ip_allow_list"`
+	Compress      *traefikCompressModel    `tfsdk:"compress"`
+	Headers       *traefikHeadersModel     `tfsdk:"headers"`
+	Reference     types.String             `tfsdk:"reference"`
+}
+
+type traefikRateLimitModel struct {
+	Average types.Int64  `tfsdk:"average"`
+	Burst   types.Int64  `tfsdk:"burst"`
+	Period  types.String `tfsdk:"period"`
+}
+
+type traefikIPAllowListModel struct {
+	SourceRange     []types.String `tfsdk:"source_range"`
+	IPStrategyDepth types.Int64    `tfsdk:"ip_strategy_depth"`
+}
+
+type traefikCompressModel struct {
+	ExcludedContentTypes []types.String `tfsdk:"excluded_content_types"`
+	MinResponseBodyBytes types.Int64    `tfsdk:"min_response_body_bytes"`
+}
+
+type traefikHeadersModel struct {
+	CustomRequestHeaders  types.Map   `tfsdk:"custom_request_headers"`
+	CustomResponseHeaders types.Map   `tfsdk:"custom_response_headers"`
+	STSSeconds            types.Int64 `tfsdk:"sts_seconds"`
+	STSIncludeSubdomains  types.Bool  `tfsdk:"sts_include_subdomains"`
+	FrameDeny             types.Bool  `tfsdk:"frame_deny"`
+	ContentTypeNosniff    types.Bool  `tfsdk:"content_type_nosniff"`
+	BrowserXSSFilter      types.Bool  `tfsdk:"browser_xss_filter"`
+}
+
+// traefikMiddleware is the YAML form of a middleware in Traefik's dynamic
+// configuration.
+type traefikMiddleware struct {
+	RateLimit   *traefikRateLimit   `yaml:"rateLimit,omitempty"`
+	IPAllowList *traefikIPAllowList `yaml:"ipAllowList,omitempty"`
+	Compress    *traefikCompress    `yaml:"compress,omitempty"`
+	Headers     *traefikHeaders     `yaml:"headers,omitempty"`
+}
+
+type traefikRateLimit struct {
+	Average int64  `yaml:"average"`
+	Burst   *int64 `yaml:"burst,omitempty"`
+	Period  string `yaml:"period,omitempty"`
+}
+
+type traefikIPAllowList struct {
+	SourceRange []string           `yaml:"sourceRange"`
+	IPStrategy  *traefikIPStrategy `yaml:"ipStrategy,omitempty"`
+}
+
+type traefikIPStrategy struct {
+	Depth int64 `yaml:"depth"`
+}
+
+type traefikCompress struct {
+	ExcludedContentTypes []string `yaml:"excludedContentTypes,omitempty"`
+	MinResponseBodyBytes *int64   `yaml:"minResponseBodyBytes,omitempty"`
+}
+
+type traefikHeaders struct {
+	CustomRequestHeaders  map[string]string `yaml:"customRequestHeaders,omitempty"`
+	CustomResponseHeaders map[string]string `yaml:"customResponseHeaders,omitempty"`
+	STSSeconds            *int64            `yaml:"stsSeconds,omitempty"`
+	STSIncludeSubdomains  *bool             `yaml:"stsIncludeSubdomains,omitempty"`
+	FrameDeny             *bool             `yaml:"frameDeny,omitempty"`
+	ContentTypeNosniff    *bool             `yaml:"contentTypeNosniff,omitempty"`
+	BrowserXSSFilter      *bool             `yaml:"browserXssFilter,omitempty"`
+}
+
+func (r *TraefikMiddlewareResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traefik_middleware"
+}
+
+func (r *TraefikMiddlewareResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	middlewareTypes := path.Expressions{
+		path.MatchRoot("rate_limit"),
+		path.MatchRoot("ip_allow_list"),
+		path.MatchRoot("compress"),
+		path.MatchRoot("headers"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Traefik middleware definition. The middleware is written into an application's Traefik config, or into the global middlewares file when no application is set. Exactly one of rate_limit, ip_allow_list, compress or headers must be configured.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the middleware in the form <target>/<name>, where target is application:<id>, server:<id> or dokploy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the middleware.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Application whose Traefik config the middleware is written to. Do not combine with traefik_config on the same dokploy_application.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("server_id")),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Server whose global middlewares file the middleware is written to. If neither application_id nor server_id is set, the Dokploy host is used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Limits the number of requests per client.",
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(middlewareTypes...),
+				},
+				Attributes: map[string]schema.Attribute{
+					"average": schema.Int64Attribute{
+						Required:    true,
+						Description: "Average number of requests allowed per period.",
+					},
+					"burst": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Maximum number of requests allowed in a burst.",
+					},
+					"period": schema.StringAttribute{
+						Optional:    true,
+						Description: "Period the average is measured over (e.g., '1s', '1m'). Traefik defaults to 1s.",
+					},
+				},
+			},
+			"ip_allow_list": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Only accepts requests from the given IP ranges.",
+				Attributes: map[string]schema.Attribute{
+					"source_range": schema.ListAttribute{
+						Required:    true,
+						ElementType: types.StringType,
+						Description: "Allowed IPs or CIDR ranges.",
+					},
+					"ip_strategy_depth": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Use the X-Forwarded-For entry at this depth as the client IP, for requests behind other proxies.",
+					},
+				},
+			},
+			"compress": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Compresses responses. Set to {} for Traefik defaults.",
+				Attributes: map[string]schema.Attribute{
+					"excluded_content_types": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Content types that are never compressed.",
+					},
+					"min_response_body_bytes": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Minimum response body size in bytes before compression applies.",
+					},
+				},
+			},
+			"headers": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Adds custom and security headers to requests and responses.",
+				Attributes: map[string]schema.Attribute{
+					"custom_request_headers": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Headers added to the request before it is forwarded.",
+					},
+					"custom_response_headers": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Headers added to the response.",
+					},
+					"sts_seconds": schema.Int64Attribute{
+						Optional:    true,
+						Description: "max-age of the Strict-Transport-Security header.",
+					},
+					"sts_include_subdomains": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Adds includeSubDomains to the Strict-Transport-Security header.",
+					},
+					"frame_deny": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Sets X-Frame-Options to DENY.",
+					},
+					"content_type_nosniff": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Sets X-Content-Type-Options to nosniff.",
+					},
+					"browser_xss_filter": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Sets X-XSS-Protection to 1; mode=block.",
+					},
+				},
+			},
+			"reference": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name to use when attaching the middleware to a router (e.g., in a domain's middlewares), in the form <name>@file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TraefikMiddlewareResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *TraefikMiddlewareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TraefikMiddlewareResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := traefikTargetFromModel(&plan)
+	name := plan.Name.ValueString()
+
+	err := r.modifyConfig(target, func(middlewares map[string]interface{}) error {
+		if _, exists := middlewares[name]; exists {
+			return fmt.Errorf("middleware %q already exists in the Traefik config; import it instead", name)
+		}
+		middlewares[name] = middlewareFromModel(ctx, &plan)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Traefik middleware", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(target.String() + "/" + name)
+	plan.Reference = types.StringValue(name + "@file")
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TraefikMiddlewareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TraefikMiddlewareResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := traefikTargetFromModel(&state)
+	doc, err := r.readConfig(target)
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Traefik middleware", err.Error())
+		return
+	}
+
+	raw, ok := traefikMiddlewares(doc, false)[state.Name.ValueString()]
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var middleware traefikMiddleware
+	if err := remarshalYAML(raw, &middleware); err != nil {
+		resp.Diagnostics.AddError("Error parsing Traefik middleware", err.Error())
+		return
+	}
+	middlewareIntoModel(&middleware, &state)
+	state.Reference = types.StringValue(state.Name.ValueString() + "@file")
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TraefikMiddlewareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TraefikMiddlewareResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TraefikMiddlewareResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.modifyConfig(traefikTargetFromModel(&plan), func(middlewares map[string]interface{}) error {
+		middlewares[name] = middlewareFromModel(ctx, &plan)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Traefik middleware", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Reference = state.Reference
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TraefikMiddlewareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TraefikMiddlewareResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.modifyConfig(traefikTargetFromModel(&state), func(middlewares map[string]interface{}) error {
+		delete(middlewares, name)
+		return nil
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting Traefik middleware", err.Error())
+		return
+	}
+}
+
+func (r *TraefikMiddlewareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Format: application:<id>/<name>, server:<id>/<name> or dokploy/<name>
+	targetPart, name, ok := strings.Cut(req.ID, "/")
+	if !ok || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected application:<id>/<name>, server:<id>/<name> or dokploy/<name>, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	switch {
+	case strings.HasPrefix(targetPart, "application:"):
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), strings.TrimPrefix(targetPart, "application:"))...)
+	case strings.HasPrefix(targetPart, "server:"):
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), strings.TrimPrefix(targetPart, "server:"))...)
+	case targetPart == "dokploy":
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unknown middleware target %q. Expected application:<id>, server:<id> or dokploy.", targetPart),
+		)
+	}
+}
+
+// traefikTarget identifies the Traefik config file a middleware lives in.
+type traefikTarget struct {
+	applicationID string
+	serverID      string
+}
+
+func traefikTargetFromModel(m *TraefikMiddlewareResourceModel) traefikTarget {
+	return traefikTarget{
+		applicationID: m.ApplicationID.ValueString(),
+		serverID:      m.ServerID.ValueString(),
+	}
+}
+
+func (t traefikTarget) String() string {
+	switch {
+	case t.applicationID != "":
+		return "application:" + t.applicationID
+	case t.serverID != "":
+		return "server:" + t.serverID
+	}
+	return "dokploy"
+}
+
+func (r *TraefikMiddlewareResource) readConfig(target traefikTarget) (map[string]interface{}, error) {
+	var content string
+	var err error
+	if target.applicationID != "" {
+		content, err = r.client.ReadTraefikConfig(target.applicationID)
+	} else {
+		content, err = r.client.ReadMiddlewareTraefikConfig(target.serverID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse Traefik config: %w", err)
+	}
+	if doc == nil {
+		doc = map[string]interface{}{}
+	}
+	return doc, nil
+}
+
+// modifyConfig applies fn to the http.middlewares section of the target's
+// Traefik config and writes the result back.
+func (r *TraefikMiddlewareResource) modifyConfig(target traefikTarget, fn func(middlewares map[string]interface{}) error) error {
+	lock, _ := traefikConfigLocks.LoadOrStore(target.String(), &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+	defer mu.Unlock()
+
+	doc, err := r.readConfig(target)
+	if err != nil {
+		return err
+	}
+	if err := fn(traefikMiddlewares(doc, true)); err != nil {
+		return err
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if target.applicationID != "" {
+		return r.client.UpdateTraefikConfig(target.applicationID, string(out))
+	}
+	return r.client.UpdateMiddlewareTraefikConfig(target.serverID, string(out))
+}
+
+// traefikMiddlewares returns the http.middlewares map of a Traefik config,
+// creating the enclosing sections when create is set.
+func traefikMiddlewares(doc map[string]interface{}, create bool) map[string]interface{} {
+	httpSection, ok := doc["http"].(map[string]interface{})
+	if !ok {
+		if !create {
+			return map[string]interface{}{}
+		}
+		httpSection = map[string]interface{}{}
+		doc["http"] = httpSection
+	}
+	middlewares, ok := httpSection["middlewares"].(map[string]interface{})
+	if !ok {
+		if !create {
+			return map[string]interface{}{}
+		}
+		middlewares = map[string]interface{}{}
+		httpSection["middlewares"] = middlewares
+	}
+	return middlewares
+}
+
+func remarshalYAML(in interface{}, out interface{}) error {
+	b, err := yaml.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(b, out)
+}
+
+func middlewareFromModel(ctx context.Context, m *TraefikMiddlewareResourceModel) traefikMiddleware {
+	var middleware traefikMiddleware
+
+	if m.RateLimit != nil {
+		middleware.RateLimit = &traefikRateLimit{
+			Average: m.RateLimit.Average.ValueInt64(),
+			Burst:   m.RateLimit.Burst.ValueInt64Pointer(),
+			Period:  m.RateLimit.Period.ValueString(),
+		}
+	}
+	if m.IPAllowList != nil {
+		middleware.IPAllowList = &traefikIPAllowList{
+			SourceRange: stringsFromValues(m.IPAllowList.SourceRange),
+		}
+		if !m.IPAllowList.IPStrategyDepth.IsNull() {
+			middleware.IPAllowList.IPStrategy = &traefikIPStrategy{Depth: m.IPAllowList.IPStrategyDepth.ValueInt64()}
+		}
+	}
+	if m.Compress != nil {
+		middleware.Compress = &traefikCompress{
+			ExcludedContentTypes: stringsFromValues(m.Compress.ExcludedContentTypes),
+			MinResponseBodyBytes: m.Compress.MinResponseBodyBytes.ValueInt64Pointer(),
+		}
+	}
+	if m.Headers != nil {
+		headers := &traefikHeaders{
+			STSSeconds:           m.Headers.STSSeconds.ValueInt64Pointer(),
+			STSIncludeSubdomains: m.Headers.STSIncludeSubdomains.ValueBoolPointer(),
+			FrameDeny:            m.Headers.FrameDeny.ValueBoolPointer(),
+			ContentTypeNosniff:   m.Headers.ContentTypeNosniff.ValueBoolPointer(),
+			BrowserXSSFilter:     m.Headers.BrowserXSSFilter.ValueBoolPointer(),
+		}
+		if !m.Headers.CustomRequestHeaders.IsNull() {
+			m.Headers.CustomRequestHeaders.ElementsAs(ctx, &headers.CustomRequestHeaders, false)
+		}
+		if !m.Headers.CustomResponseHeaders.IsNull() {
+			m.Headers.CustomResponseHeaders.ElementsAs(ctx, &headers.CustomResponseHeaders, false)
+		}
+		middleware.Headers = headers
+	}
+
+	return middleware
+}
+
+func middlewareIntoModel(middleware *traefikMiddleware, m *TraefikMiddlewareResourceModel) {
+	m.RateLimit = nil
+	m.IPAllowList = nil
+	m.Compress = nil
+	m.Headers = nil
+
+	if rl := middleware.RateLimit; rl != nil {
+		m.RateLimit = &traefikRateLimitModel{
+			Average: types.Int64Value(rl.Average),
+			Burst:   types.Int64PointerValue(rl.Burst),
+			Period:  types.StringNull(),
+		}
+		if rl.Period != "" {
+			m.RateLimit.Period = types.StringValue(rl.Period)
+		}
+	}
+	if al := middleware.IPAllowList; al != nil {
+		m.IPAllowList = &traefikIPAllowListModel{
+			SourceRange:     valuesFromStrings(al.SourceRange),
+			IPStrategyDepth: types.Int64Null(),
+		}
+		if al.IPStrategy != nil {
+			m.IPAllowList.IPStrategyDepth = types.Int64Value(al.IPStrategy.Depth)
+		}
+	}
+	if c := middleware.Compress; c != nil {
+		m.Compress = &traefikCompressModel{
+			ExcludedContentTypes: valuesFromStrings(c.ExcludedContentTypes),
+			MinResponseBodyBytes: types.Int64PointerValue(c.MinResponseBodyBytes),
+		}
+	}
+	if h := middleware.Headers; h != nil {
+		m.Headers = &traefikHeadersModel{
+			CustomRequestHeaders:  stringMapValue(h.CustomRequestHeaders),
+			CustomResponseHeaders: stringMapValue(h.CustomResponseHeaders),
+			STSSeconds:            types.Int64PointerValue(h.STSSeconds),
+			STSIncludeSubdomains:  types.BoolPointerValue(h.STSIncludeSubdomains),
+			FrameDeny:             types.BoolPointerValue(h.FrameDeny),
+			ContentTypeNosniff:    types.BoolPointerValue(h.ContentTypeNosniff),
+			BrowserXSSFilter:      types.BoolPointerValue(h.BrowserXSSFilter),
+		}
+	}
+}
+
+func stringsFromValues(values []types.String) []string {
+	if values == nil {
+		return nil
+	}
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = v.ValueString()
+	}
+	return out
+}
+
+func valuesFromStrings(values []string) []types.String {
+	if values == nil {
+		return nil
+	}
+	out := make([]types.String, len(values))
+	for i, v := range values {
+		out[i] = types.StringValue(v)
+	}
+	return out
+}
+
+func stringMapValue(m map[string]string) types.Map {
+	if m == nil {
+		return types.MapNull(types.StringType)
+	}
+	value, _ := types.MapValueFrom(context.Background(), types.StringType, m)
+	return value
+}
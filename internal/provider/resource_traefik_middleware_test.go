@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"gopkg.in/yaml.v3"
+)
+
+func TestAccTraefikMiddlewareResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTraefikMiddlewareResourceConfig(100, 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_traefik_middleware.rate_limit", "id", "dokploy/tf-acc-rate-limit"),
+					resource.TestCheckResourceAttr("dokploy_traefik_middleware.rate_limit", "reference", "tf-acc-rate-limit@file"),
+					resource.TestCheckResourceAttr("dokploy_traefik_middleware.rate_limit", "rate_limit.average", "100"),
+					resource.TestCheckResourceAttr("dokploy_traefik_middleware.rate_limit", "rate_limit.burst", "50"),
+					resource.TestCheckResourceAttr("dokploy_traefik_middleware.allow_list", "ip_allow_list.source_range.#", "2"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTraefikMiddlewareResourceConfig(200, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_traefik_middleware.rate_limit", "rate_limit.average", "200"),
+					resource.TestCheckResourceAttr("dokploy_traefik_middleware.rate_limit", "rate_limit.burst", "100"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dokploy_traefik_middleware.rate_limit",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTraefikMiddlewareResourceConfig(average, burst int) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_traefik_middleware" "rate_limit" {
+  name = "tf-acc-rate-limit"
+  rate_limit = {
+    average = %d
+    burst   = %d
+  }
+}
+
+resource "dokploy_traefik_middleware" "allow_list" {
+  name = "tf-acc-allow-list"
+  ip_allow_list = {
+    source_range = ["10.0.0.0/8", "192.168.0.0/16"]
+  }
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), average, burst)
+}
+
+func TestTraefikMiddlewaresPreservesConfig(t *testing.T) {
+	doc := map[string]interface{}{}
+	content := `
+http:
+  routers:
+    app-router:
+      rule: Host(` + "`example.com`" + `)
+      middlewares:
+        - existing
+  middlewares:
+    existing:
+      compress: {}
+`
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	burst := int64(10)
+	traefikMiddlewares(doc, true)["limit"] = traefikMiddleware{
+		RateLimit: &traefikRateLimit{Average: 5, Burst: &burst},
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reparsed := map[string]interface{}{}
+	if err := yaml.Unmarshal(out, &reparsed); err != nil {
+		t.Fatal(err)
+	}
+
+	middlewares := traefikMiddlewares(reparsed, false)
+	if _, ok := middlewares["existing"]; !ok {
+		t.Error("existing middleware was dropped")
+	}
+	if _, ok := reparsed["http"].(map[string]interface{})["routers"]; !ok {
+		t.Error("routers section was dropped")
+	}
+
+	var got traefikMiddleware
+	if err := remarshalYAML(middlewares["limit"], &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.RateLimit == nil || got.RateLimit.Average != 5 || got.RateLimit.Burst == nil || *got.RateLimit.Burst != 10 {
+		t.Errorf("unexpected rate limit after round trip: %+v", got.RateLimit)
+	}
+}
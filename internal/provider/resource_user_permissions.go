@@ -17,13 +17,14 @@ import (
 
 var _ resource.Resource = &UserPermissionsResource{}
 var _ resource.ResourceWithImportState = &UserPermissionsResource{}
+var _ resource.ResourceWithModifyPlan = &UserPermissionsResource{}
 
 func NewUserPermissionsResource() resource.Resource {
 	return &UserPermissionsResource{}
 }
 
 type UserPermissionsResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type UserPermissionsResourceModel struct {
@@ -51,7 +52,7 @@ func (r *UserPermissionsResource) Metadata(_ context.Context, req resource.Metad
 
 func (r *UserPermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages user permissions for an organization member in Dokploy. Note: Owner permissions cannot be modified.",
+		Description: "Manages user permissions for an organization member in Dokploy. Note: Owner permissions cannot be modified, so plans for an owner fail, as do plans that would revoke API access from the member the provider's API key belongs to.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -159,14 +160,38 @@ func (r *UserPermissionsResource) Configure(_ context.Context, req resource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
 }
 
+// ModifyPlan refuses permission changes that Dokploy rejects or that would
+// lock Terraform out, including the reset applied on destroy.
+func (r *UserPermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var memberID types.String
+	keepsAPIAccess := false
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("member_id"), &memberID)...)
+	} else {
+		var plan UserPermissionsResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		memberID = plan.MemberID
+		keepsAPIAccess = plan.CanAccessToAPI.IsUnknown() || plan.CanAccessToAPI.ValueBool()
+	}
+	if resp.Diagnostics.HasError() || memberID.IsUnknown() {
+		return
+	}
+
+	checkMemberPermissionChange(r.client, memberID.ValueString(), keepsAPIAccess, &resp.Diagnostics)
+}
+
 func (r *UserPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan UserPermissionsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -5,6 +5,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -76,3 +79,46 @@ resource "dokploy_user_permissions" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
 }
+
+func TestCheckMemberPermissionChange(t *testing.T) {
+	members := map[string]*client.OrganizationMember{
+		"owner":  {ID: "owner", Role: "owner"},
+		"admin":  {ID: "admin", Role: "admin"},
+		"self":   {ID: "self", Role: "member"},
+		"member": {ID: "member", Role: "member"},
+	}
+	mock := clientmock.New()
+	mock.GetMemberByIDFunc = func(memberID string) (*client.OrganizationMember, error) {
+		if m, ok := members[memberID]; ok {
+			return m, nil
+		}
+		return nil, fmt.Errorf("%w: member", client.ErrNotFound)
+	}
+	mock.GetCurrentMemberFunc = func() (*client.OrganizationMember, error) {
+		return members["self"], nil
+	}
+
+	tests := []struct {
+		memberID       string
+		keepsAPIAccess bool
+		wantError      string
+	}{
+		{memberID: "owner", keepsAPIAccess: true, wantError: "Owner Permissions Cannot Be Changed"},
+		{memberID: "admin"},
+		{memberID: "self", wantError: "Provider Would Lose API Access"},
+		{memberID: "self", keepsAPIAccess: true},
+		{memberID: "member"},
+		{memberID: "missing"},
+	}
+	for _, tt := range tests {
+		var diags diag.Diagnostics
+		checkMemberPermissionChange(mock, tt.memberID, tt.keepsAPIAccess, &diags)
+		got := ""
+		if diags.HasError() {
+			got = diags.Errors()[0].Summary()
+		}
+		if got != tt.wantError {
+			t.Errorf("member %s keeping API access %v: error %q, want %q", tt.memberID, tt.keepsAPIAccess, got, tt.wantError)
+		}
+	}
+}
@@ -26,7 +26,7 @@ func NewVolumeBackupResource() resource.Resource {
 }
 
 type VolumeBackupResource struct {
-	client *client.DokployClient
+	client client.Client
 }
 
 type VolumeBackupResourceModel struct {
@@ -80,6 +80,9 @@ func (r *VolumeBackupResource) Schema(_ context.Context, _ resource.SchemaReques
 			"cron_expression": schema.StringAttribute{
 				Required:    true,
 				Description: "Cron schedule for backups (e.g., '0 3 * * *' for daily at 3 AM).",
+				Validators: []validator.String{
+					cronValidator{},
+				},
 			},
 			"service_type": schema.StringAttribute{
 				Required:    true,
@@ -139,9 +142,9 @@ func (r *VolumeBackupResource) Configure(_ context.Context, req resource.Configu
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*client.DokployClient)
+	client, ok := req.ProviderData.(client.Client)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected client.Client, got: %T", req.ProviderData))
 		return
 	}
 	r.client = client
@@ -357,11 +360,8 @@ func (r *VolumeBackupResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.client.DeleteVolumeBackup(state.ID.ValueString())
+	err := deleteChild(ctx, func() error { return r.client.DeleteVolumeBackup(state.ID.ValueString()) }, serviceParent(r.client, state.ServiceType.ValueString(), state.ServiceID.ValueString()))
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
-			return
-		}
 		resp.Diagnostics.AddError("Error deleting volume backup", err.Error())
 		return
 	}
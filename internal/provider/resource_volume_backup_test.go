@@ -22,7 +22,7 @@ func TestAccVolumeBackupResource_Postgres(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccVolumeBackupResourceConfig_Postgres("test-volbk-project", "test-volbk-env", "test-volbk-pg", "testvbpg", "testdb", "testuser", "test-volbk-dest", "pg-vol-backup", "0 3 * * *"),
+				Config: testAccVolumeBackupResourceConfig_Postgres("tf-acc-volbk-project", "test-volbk-env", "test-volbk-pg", "testvbpg", "testdb", "testuser", "tf-acc-volbk-dest", "pg-vol-backup", "0 3 * * *"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_volume_backup.test", "name", "pg-vol-backup"),
 					resource.TestCheckResourceAttr("dokploy_volume_backup.test", "volume_name", "postgres_data"),
@@ -40,7 +40,7 @@ func TestAccVolumeBackupResource_Postgres(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccVolumeBackupResourceConfig_PostgresUpdated("test-volbk-project", "test-volbk-env", "test-volbk-pg", "testvbpg", "testdb", "testuser", "test-volbk-dest", "pg-vol-backup-updated", "0 4 * * *"),
+				Config: testAccVolumeBackupResourceConfig_PostgresUpdated("tf-acc-volbk-project", "test-volbk-env", "test-volbk-pg", "testvbpg", "testdb", "testuser", "tf-acc-volbk-dest", "pg-vol-backup-updated", "0 4 * * *"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_volume_backup.test", "name", "pg-vol-backup-updated"),
 					resource.TestCheckResourceAttr("dokploy_volume_backup.test", "cron_expression", "0 4 * * *"),
@@ -72,7 +72,7 @@ func TestAccVolumeBackupResource_Redis(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccVolumeBackupResourceConfig_Redis("test-volbk-redis-project", "test-volbk-redis-env", "test-volbk-redis", "testvbredis", "test-volbk-redis-dest"),
+				Config: testAccVolumeBackupResourceConfig_Redis("tf-acc-volbk-redis-project", "test-volbk-redis-env", "test-volbk-redis", "testvbredis", "tf-acc-volbk-redis-dest"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dokploy_volume_backup.test_redis", "name", "redis-vol-backup"),
 					resource.TestCheckResourceAttr("dokploy_volume_backup.test_redis", "volume_name", "redis_data"),
@@ -98,7 +98,7 @@ func TestAccVolumeBackupsDataSource(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccVolumeBackupsDataSourceConfig("test-volbk-ds-project", "test-volbk-ds-env", "test-volbk-ds-pg", "testvbdspg", "testdb", "testuser", "test-volbk-ds-dest"),
+				Config: testAccVolumeBackupsDataSourceConfig("tf-acc-volbk-ds-project", "test-volbk-ds-env", "test-volbk-ds-pg", "testvbdspg", "testdb", "testuser", "tf-acc-volbk-ds-dest"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.dokploy_volume_backups.test", "volume_backups.#"),
 				),
@@ -125,6 +125,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_name     = "%s"
@@ -176,6 +178,8 @@ resource "dokploy_environment" "test" {
 }
 
 resource "dokploy_postgres" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_name     = "%s"
@@ -227,6 +231,8 @@ resource "dokploy_environment" "test_redis" {
 }
 
 resource "dokploy_redis" "test" {
+  deletion_protection = false
+
   name              = "%s"
   app_name_prefix   = "%s"
   database_password = "test_redis_password_123"
@@ -276,6 +282,8 @@ resource "dokploy_environment" "test_ds" {
 }
 
 resource "dokploy_postgres" "test_ds" {
+  deletion_protection = false
+
   name              = "%s"
   app_name          = "%s"
   database_name     = "%s"
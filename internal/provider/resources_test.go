@@ -21,9 +21,9 @@ func TestAccResources(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourcesConfig("TestProjectFull", "staging"),
+				Config: testAccResourcesConfig("tf-acc-project-full", "staging"),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("dokploy_project.full", "name", "TestProjectFull"),
+					resource.TestCheckResourceAttr("dokploy_project.full", "name", "tf-acc-project-full"),
 					resource.TestCheckResourceAttr("dokploy_environment.staging", "name", "staging"),
 					resource.TestCheckResourceAttr("dokploy_application.app", "name", "test-app"),
 					resource.TestCheckResourceAttr("dokploy_postgres.db", "name", "test-db"),
@@ -97,6 +97,8 @@ resource "dokploy_application" "app" {
 }
 
 resource "dokploy_postgres" "db" {
+  deletion_protection = false
+
   environment_id    = dokploy_environment.staging.id
   name              = "test-db"
   app_name          = "testfulldb"
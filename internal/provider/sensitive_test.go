@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// secretAttributeName matches attribute names that hold credentials.
+var secretAttributeName = regexp.MustCompile(`(^|_)(password|secret|secrets|token|private_key|api_key|secret_key|secret_access_key|connection_url)$`)
+
+// knownSecretAttributes lists credentials whose names do not follow the
+// pattern above.
+var knownSecretAttributes = []string{
+	"dokploy_api_key.key",
+	"dokploy_application.build_secrets_map",
+	"dokploy_certificate.certificate_data",
+	"dokploy_compose.webhook_url",
+	"dokploy_environment.env",
+	"dokploy_environment.env_map",
+	"dokploy_environment_variables.variables",
+	"dokploy_mount.sensitive_content",
+}
+
+// notSecretAttributes are names that match the pattern but hold no secret.
+var notSecretAttributes = map[string]bool{
+	// An arbitrary value whose change rotates refresh_token.
+	"dokploy_application.rotate_token": true,
+	"dokploy_compose.rotate_token":     true,
+}
+
+// TestSensitiveAttributes fails when a credential attribute of any resource,
+// data source, ephemeral resource or the provider itself is not marked
+// Sensitive, so secrets are redacted from plan output.
+func TestSensitiveAttributes(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs := map[string]*tfprotov6.SchemaAttribute{}
+	var collect func(prefix string, schema []*tfprotov6.SchemaAttribute)
+	collect = func(prefix string, schema []*tfprotov6.SchemaAttribute) {
+		for _, a := range schema {
+			name := prefix + "." + a.Name
+			attrs[name] = a
+			if a.NestedType != nil {
+				collect(name, a.NestedType.Attributes)
+			}
+		}
+	}
+	collect("provider", resp.Provider.Block.Attributes)
+	for name, s := range resp.ResourceSchemas {
+		collect(name, s.Block.Attributes)
+	}
+	for name, s := range resp.DataSourceSchemas {
+		collect("data."+name, s.Block.Attributes)
+	}
+	for name, s := range resp.EphemeralResourceSchemas {
+		collect("ephemeral."+name, s.Block.Attributes)
+	}
+
+	for name, a := range attrs {
+		if notSecretAttributes[name] || !secretAttributeName.MatchString(a.Name) {
+			continue
+		}
+		if !a.Sensitive {
+			t.Errorf("%s holds a credential but is not marked Sensitive", name)
+		}
+	}
+
+	for _, name := range knownSecretAttributes {
+		a, ok := attrs[name]
+		if !ok {
+			t.Errorf("%s not found in the provider schema", name)
+			continue
+		}
+		if !a.Sensitive {
+			t.Errorf("%s holds a credential but is not marked Sensitive", name)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The audit attributes below let reports such as "services not deployed in
+// 90 days" be built from Terraform outputs. Dokploy does not record when a
+// service was last modified, so there is no updated_at.
+
+// createdAtAttribute returns the created_at attribute of a service.
+func createdAtAttribute(service string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Description: "Timestamp when the " + service + " was created.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// deploymentCountAttribute returns the deployment_count attribute of a
+// service. It has no plan modifier: an apply may deploy, so the count is
+// unknown until it is read back.
+func deploymentCountAttribute(service string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Computed:    true,
+		Description: "Number of deployments of the " + service + " in the history Dokploy keeps, which only holds the most recent ones.",
+	}
+}
+
+// lastDeployedAtAttribute returns the last_deployed_at attribute of a service.
+func lastDeployedAtAttribute(service string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Description: "Timestamp when the most recent successful deployment of the " + service + " started, or null when it has none.",
+	}
+}
+
+// readDeploymentActivity sets the deployment counters of a service from its
+// deployment history. A history that cannot be read leaves them null with a
+// warning, so a failing lookup never blocks an apply.
+func readDeploymentActivity(c client.Client, id, deploymentType string, count *types.Int64, lastDeployedAt *types.String, diags *diag.Diagnostics) {
+	*count = types.Int64Null()
+	*lastDeployedAt = types.StringNull()
+
+	deployments, err := c.ListDeploymentsByType(id, deploymentType)
+	if err != nil {
+		diags.AddWarning("Unable to Read Deployment History", err.Error())
+		return
+	}
+
+	*count = types.Int64Value(int64(len(deployments)))
+	var last string
+	for _, d := range deployments {
+		// Timestamps are ISO 8601 in UTC, so they sort as strings.
+		if d.Status == "done" && d.CreatedAt > last {
+			last = d.CreatedAt
+		}
+	}
+	*lastDeployedAt = optionalString(last)
+}
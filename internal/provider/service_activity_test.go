@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client/clientmock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReadDeploymentActivity(t *testing.T) {
+	mock := clientmock.New()
+	mock.ListDeploymentsByTypeFunc = func(id, deploymentType string) ([]client.Deployment, error) {
+		if id != "app-1" || deploymentType != "application" {
+			t.Errorf("listed deployments of %s %s", deploymentType, id)
+		}
+		return []client.Deployment{
+			{Status: "error", CreatedAt: "2026-03-02T10:00:00.000Z"},
+			{Status: "done", CreatedAt: "2026-03-01T10:00:00.000Z"},
+			{Status: "done", CreatedAt: "2026-02-01T10:00:00.000Z"},
+		}, nil
+	}
+
+	var diags diag.Diagnostics
+	var count types.Int64
+	var last types.String
+	readDeploymentActivity(mock, "app-1", "application", &count, &last, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if count.ValueInt64() != 3 {
+		t.Errorf("deployment_count = %v, want 3", count)
+	}
+	if last.ValueString() != "2026-03-01T10:00:00.000Z" {
+		t.Errorf("last_deployed_at = %v, want the latest successful deployment", last)
+	}
+
+	mock.ListDeploymentsByTypeFunc = func(id, deploymentType string) ([]client.Deployment, error) {
+		return nil, errors.New("unavailable")
+	}
+	readDeploymentActivity(mock, "app-1", "application", &count, &last, &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("diags = %v, want a single warning", diags)
+	}
+	if !count.IsNull() || !last.IsNull() {
+		t.Errorf("counters = %v, %v, want null when the history cannot be read", count, last)
+	}
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// requireReplaceOnRename marks a naming attribute such as app_name or suffix
+// as requiring replacement when it changes. Dokploy derives Docker service,
+// volume and network names from these, and renaming them in place leaves the
+// running service and its data behind under the old names.
+func requireReplaceOnRename(ctx context.Context, kind, attribute string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var from, to types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attribute), &from)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &to)...)
+	if resp.Diagnostics.HasError() || to.IsUnknown() || from.ValueString() == to.ValueString() {
+		return
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root(attribute))
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root(attribute),
+		"Renaming Requires Replacement",
+		fmt.Sprintf("Changing %s from %q to %q changes the Docker names of the %s, so it will be destroyed and recreated. "+
+			"Data in its named volumes is not carried over, and other services that reach it by hostname must be updated.",
+			attribute, from.ValueString(), to.ValueString(), kind),
+	)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequireReplaceOnRename(t *testing.T) {
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"suffix": schema.StringAttribute{Optional: true},
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"suffix": tftypes.String}}
+	value := func(suffix interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"suffix": tftypes.NewValue(tftypes.String, suffix)})
+	}
+
+	run := func(state tftypes.Value, plan tftypes.Value) *resource.ModifyPlanResponse {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: s, Raw: state},
+			Plan:  tfsdk.Plan{Schema: s, Raw: plan},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		requireReplaceOnRename(context.Background(), "compose stack", "suffix", req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+		}
+		return resp
+	}
+
+	if resp := run(value("a"), value("a")); len(resp.RequiresReplace) != 0 || resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("unchanged: RequiresReplace = %v, want none", resp.RequiresReplace)
+	}
+	if resp := run(tftypes.NewValue(objectType, nil), value("a")); len(resp.RequiresReplace) != 0 {
+		t.Errorf("create: RequiresReplace = %v, want none", resp.RequiresReplace)
+	}
+	if resp := run(value("a"), value(tftypes.UnknownValue)); len(resp.RequiresReplace) != 0 {
+		t.Errorf("unknown: RequiresReplace = %v, want none", resp.RequiresReplace)
+	}
+	if resp := run(value("a"), value("b")); len(resp.RequiresReplace) != 1 || resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("renamed: RequiresReplace = %v, warnings %d, want suffix and a warning", resp.RequiresReplace, resp.Diagnostics.WarningsCount())
+	}
+	if resp := run(value("a"), value(nil)); len(resp.RequiresReplace) != 1 {
+		t.Errorf("removed: RequiresReplace = %v, want suffix", resp.RequiresReplace)
+	}
+}
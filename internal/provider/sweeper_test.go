@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// sweepPrefix marks resources created by acceptance tests. Sweepers delete
+// anything whose name starts with it, so never use it for real resources.
+const sweepPrefix = "tf-acc-"
+
+// TestMain runs the sweepers when invoked with -sweep, e.g.
+//
+//	go test ./internal/provider -v -sweep=all
+//
+// and the tests otherwise.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	// Projects go first: deleting them removes the services that still
+	// reference servers, registries and destinations.
+	resource.AddTestSweepers("dokploy_project", &resource.Sweeper{
+		Name: "dokploy_project",
+		F:    sweepProjects,
+	})
+	resource.AddTestSweepers("dokploy_server", &resource.Sweeper{
+		Name:         "dokploy_server",
+		Dependencies: []string{"dokploy_project"},
+		F:            sweepServers,
+	})
+	resource.AddTestSweepers("dokploy_registry", &resource.Sweeper{
+		Name:         "dokploy_registry",
+		Dependencies: []string{"dokploy_project"},
+		F:            sweepRegistries,
+	})
+	resource.AddTestSweepers("dokploy_destination", &resource.Sweeper{
+		Name:         "dokploy_destination",
+		Dependencies: []string{"dokploy_project"},
+		F:            sweepDestinations,
+	})
+}
+
+// sweeperClient builds a client from the same environment variables the
+// acceptance tests use. The region argument of sweepers has no meaning for
+// Dokploy and is ignored.
+func sweeperClient() (client.Client, error) {
+	host, apiKey := os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY")
+	if host == "" || apiKey == "" {
+		return nil, fmt.Errorf("DOKPLOY_HOST and DOKPLOY_API_KEY must be set to run sweepers")
+	}
+	return client.NewDokployClient(host, apiKey), nil
+}
+
+// sweep deletes every item named with sweepPrefix and returns the failures
+// together, so one stuck resource does not leave the others behind.
+func sweep[T any](kind string, list func() ([]T, error), name, id func(T) string, remove func(string) error) error {
+	items, err := list()
+	if err != nil {
+		return fmt.Errorf("listing %s: %w", kind, err)
+	}
+
+	var failed []string
+	for _, item := range items {
+		if !strings.HasPrefix(name(item), sweepPrefix) {
+			continue
+		}
+		if err := remove(id(item)); err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%s): %s", name(item), id(item), err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("deleting %s:\n%s", kind, strings.Join(failed, "\n"))
+	}
+	return nil
+}
+
+func sweepProjects(string) error {
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	return sweep("projects", c.ListProjects,
+		func(p client.Project) string { return p.Name },
+		func(p client.Project) string { return p.ID },
+		c.DeleteProject)
+}
+
+func sweepServers(string) error {
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	return sweep("servers", c.ListServers,
+		func(s client.Server) string { return s.Name },
+		func(s client.Server) string { return s.ID },
+		c.DeleteServer)
+}
+
+func sweepRegistries(string) error {
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	return sweep("registries", c.ListRegistries,
+		func(r client.Registry) string { return r.RegistryName },
+		func(r client.Registry) string { return r.ID },
+		c.DeleteRegistry)
+}
+
+func sweepDestinations(string) error {
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	return sweep("destinations", c.ListDestinations,
+		func(d client.Destination) string { return d.Name },
+		func(d client.Destination) string { return d.DestinationID },
+		c.DeleteDestination)
+}
+
+func TestSweep(t *testing.T) {
+	type item struct{ name, id string }
+	items := []item{{"tf-acc-web", "1"}, {"production", "2"}, {"tf-acc-db", "3"}}
+
+	var deleted []string
+	err := sweep("items", func() ([]item, error) { return items, nil },
+		func(i item) string { return i.name },
+		func(i item) string { return i.id },
+		func(id string) error {
+			deleted = append(deleted, id)
+			if id == "3" {
+				return fmt.Errorf("in use")
+			}
+			return nil
+		})
+
+	if strings.Join(deleted, ",") != "1,3" {
+		t.Errorf("deleted %v, want only the prefixed items", deleted)
+	}
+	if err == nil || !strings.Contains(err.Error(), "tf-acc-db (3): in use") {
+		t.Errorf("err = %v, want the failed item reported", err)
+	}
+}
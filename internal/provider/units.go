@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// unitKind selects how a unitValue is parsed.
+type unitKind int
+
+const (
+	// unitMemory is a size in bytes, e.g. "512Mi", "1g" or "536870912".
+	unitMemory unitKind = iota
+	// unitCPU is a CPU count in nanocores, e.g. "1.5", "500m" or "1500000000".
+	unitCPU
+	// unitDuration is a duration in nanoseconds, e.g. "30s" or "30000000000".
+	unitDuration
+)
+
+var memorySuffixes = map[string]float64{
+	"":   1,
+	"b":  1,
+	"k":  1 << 10,
+	"kb": 1 << 10,
+	"ki": 1 << 10,
+	"m":  1 << 20,
+	"mb": 1 << 20,
+	"mi": 1 << 20,
+	"g":  1 << 30,
+	"gb": 1 << 30,
+	"gi": 1 << 30,
+	"t":  1 << 40,
+	"tb": 1 << 40,
+	"ti": 1 << 40,
+}
+
+// cpuNanocoreThreshold separates plain CPU counts from nanocore values, so
+// configurations written in nanocores keep working.
+const cpuNanocoreThreshold = 1000
+
+// parseUnit converts a human readable value into the integer the Dokploy API
+// expects for the kind.
+func parseUnit(kind unitKind, s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("value is empty")
+	}
+
+	switch kind {
+	case unitMemory:
+		i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		number, suffix := s, ""
+		if i >= 0 {
+			number, suffix = s[:i], strings.ToLower(strings.TrimSpace(s[i:]))
+		}
+		multiplier, ok := memorySuffixes[suffix]
+		if !ok {
+			return 0, fmt.Errorf("unknown memory unit %q, expected one of b, k, m, g, t, Ki, Mi, Gi or Ti", s[i:])
+		}
+		return scaleUnit(number, multiplier)
+	case unitCPU:
+		if strings.HasSuffix(s, "m") {
+			return scaleUnit(strings.TrimSuffix(s, "m"), 1e6)
+		}
+		cpus, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid CPU value %q", s)
+		}
+		if cpus >= cpuNanocoreThreshold {
+			return scaleUnit(s, 1)
+		}
+		return scaleUnit(s, 1e9)
+	case unitDuration:
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, nil
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return int64(d), nil
+	}
+	return 0, fmt.Errorf("unsupported unit kind %d", kind)
+}
+
+func scaleUnit(number string, multiplier float64) (int64, error) {
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid number %q", number)
+	}
+	scaled := math.Round(f * multiplier)
+	if scaled > math.MaxInt64 {
+		return 0, fmt.Errorf("value %q is too large", number)
+	}
+	return int64(scaled), nil
+}
+
+var (
+	_ basetypes.StringTypable                    = unitType{}
+	_ basetypes.StringValuableWithSemanticEquals = unitValue{}
+	_ xattr.ValidateableAttribute                = unitValue{}
+)
+
+// unitType is a string type holding a memory size, CPU count or duration.
+// Values compare equal when they normalize to the same number, so the
+// canonical value read back from Dokploy does not show up as a diff.
+type unitType struct {
+	basetypes.StringType
+	kind unitKind
+}
+
+func (t unitType) Equal(o attr.Type) bool {
+	other, ok := o.(unitType)
+	return ok && other.kind == t.kind
+}
+
+func (t unitType) String() string {
+	return fmt.Sprintf("unitType(%d)", t.kind)
+}
+
+func (t unitType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return unitValue{StringValue: in, kind: t.kind}, nil
+}
+
+func (t unitType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	value, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to unitValue: %v", diags)
+	}
+	return value, nil
+}
+
+func (t unitType) ValueType(_ context.Context) attr.Value {
+	return unitValue{kind: t.kind}
+}
+
+// unitValue is the value of a unitType attribute.
+type unitValue struct {
+	basetypes.StringValue
+	kind unitKind
+}
+
+func (v unitValue) Equal(o attr.Value) bool {
+	other, ok := o.(unitValue)
+	return ok && other.kind == v.kind && v.StringValue.Equal(other.StringValue)
+}
+
+func (v unitValue) Type(_ context.Context) attr.Type {
+	return unitType{kind: v.kind}
+}
+
+func (v unitValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	other, ok := newValuable.(unitValue)
+	if !ok {
+		return false, nil
+	}
+	a, err := parseUnit(v.kind, v.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	b, err := parseUnit(other.kind, other.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	return a == b, nil
+}
+
+func (v unitValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if _, err := parseUnit(v.kind, v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Value", err.Error())
+	}
+}
+
+// Int64 returns the normalized value sent to the Dokploy API.
+func (v unitValue) Int64() (int64, error) {
+	return parseUnit(v.kind, v.ValueString())
+}
+
+// unitInt64Value returns a known unitValue holding the canonical number.
+func unitInt64Value(kind unitKind, n int64) unitValue {
+	return unitValue{StringValue: basetypes.NewStringValue(strconv.FormatInt(n, 10)), kind: kind}
+}
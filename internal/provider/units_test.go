@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestParseUnit(t *testing.T) {
+	cases := []struct {
+		kind    unitKind
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{unitMemory, "536870912", 536870912, false},
+		{unitMemory, "512Mi", 536870912, false},
+		{unitMemory, "512m", 536870912, false},
+		{unitMemory, "1.5Gi", 1610612736, false},
+		{unitMemory, "1 GB", 1073741824, false},
+		{unitMemory, "2x", 0, true},
+		{unitMemory, "", 0, true},
+		{unitCPU, "1.5", 1500000000, false},
+		{unitCPU, "500m", 500000000, false},
+		{unitCPU, "1000000000", 1000000000, false},
+		{unitCPU, "-1", 0, true},
+		{unitCPU, "one", 0, true},
+		{unitDuration, "30s", 30000000000, false},
+		{unitDuration, "1m30s", 90000000000, false},
+		{unitDuration, "30000000000", 30000000000, false},
+		{unitDuration, "soon", 0, true},
+	}
+
+	for _, tc := range cases {
+		got, err := parseUnit(tc.kind, tc.in)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("parseUnit(%d, %q) error = %v, wantErr %v", tc.kind, tc.in, err, tc.wantErr)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("parseUnit(%d, %q) = %d, want %d", tc.kind, tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestUnitValueSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	configured := unitValue{StringValue: types.StringValue("512Mi"), kind: unitMemory}
+
+	equal, diags := configured.StringSemanticEquals(ctx, unitInt64Value(unitMemory, 536870912))
+	if diags.HasError() || !equal {
+		t.Errorf("512Mi should equal 536870912, got %v %v", equal, diags)
+	}
+
+	equal, _ = configured.StringSemanticEquals(ctx, unitInt64Value(unitMemory, 268435456))
+	if equal {
+		t.Error("512Mi should not equal 268435456")
+	}
+}
+
+func TestUpgradeApplicationStateV0(t *testing.T) {
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{"id":"app-1","memory_limit":536870912,"cpu_limit":null,"stop_grace_period_swarm":30000000000}`)},
+	}
+	var resp resource.UpgradeStateResponse
+	upgradeApplicationStateV0(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(resp.DynamicValue.JSON, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["memory_limit"] != "536870912" || got["stop_grace_period_swarm"] != "30000000000" || got["cpu_limit"] != nil {
+		t.Errorf("unexpected upgraded state: %v", got)
+	}
+}
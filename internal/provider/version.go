@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Minimum Dokploy versions for features that older servers lack.
+const (
+	minVersionEnvironments = "v0.23.0"
+)
+
+// requireDokployVersion reports whether the server is at least minimum,
+// adding a "requires Dokploy >= X" error when it is not. When the version
+// cannot be determined the check passes, so older or locked-down servers are
+// never blocked by detection alone.
+func requireDokployVersion(c client.Client, minimum, feature string, diags *diag.Diagnostics) bool {
+	required, err := client.ParseVersion(minimum)
+	if err != nil {
+		return true
+	}
+
+	raw, err := c.GetVersion()
+	if err != nil {
+		return true
+	}
+	current, err := client.ParseVersion(raw)
+	if err != nil {
+		return true
+	}
+
+	if !current.AtLeast(required) {
+		diags.AddError(
+			"Unsupported Dokploy Version",
+			fmt.Sprintf("%s requires Dokploy >= %s, but the server is running %s. Upgrade Dokploy to use this feature.", feature, required, current),
+		)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// normalizeWatchPath returns the canonical form of a watch path glob, which
+// Dokploy matches against paths relative to the repository root: leading
+// "./" prefixes, duplicate slashes and "." segments are removed.
+func normalizeWatchPath(p string) string {
+	for strings.HasPrefix(p, "./") {
+		p = p[2:]
+	}
+	return path.Clean(p)
+}
+
+// normalizeWatchPaths normalizes every watch path.
+func normalizeWatchPaths(paths []string) []string {
+	normalized := make([]string, len(paths))
+	for i, p := range paths {
+		normalized[i] = normalizeWatchPath(p)
+	}
+	return normalized
+}
+
+// watchPathsValidator rejects watch paths Dokploy can never match: empty and
+// absolute paths, paths leaving the repository, and malformed globs.
+type watchPathsValidator struct{}
+
+func (v watchPathsValidator) Description(_ context.Context) string {
+	return "watch paths must be globs relative to the repository root"
+}
+
+func (v watchPathsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v watchPathsValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for i, elem := range req.ConfigValue.Elements() {
+		s, ok := elem.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		if msg := watchPathProblem(s.ValueString()); msg != "" {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Invalid Watch Path", fmt.Sprintf("%q %s.", s.ValueString(), msg))
+		}
+	}
+}
+
+// watchPathProblem describes why p is not a valid watch path, or returns "".
+func watchPathProblem(p string) string {
+	normalized := normalizeWatchPath(p)
+	switch {
+	case strings.TrimSpace(p) == "" || normalized == ".":
+		return "is empty; use \"**\" to watch the whole repository"
+	case strings.HasPrefix(p, "/"):
+		return "is absolute; watch paths are relative to the repository root, e.g. \"src/**\""
+	case normalized == ".." || strings.HasPrefix(normalized, "../"):
+		return "points outside the repository"
+	}
+	if _, err := path.Match(normalized, ""); err != nil {
+		return "is not a valid glob: " + err.Error()
+	}
+	return ""
+}
+
+// watchPathsFromPlan returns the watch paths to send to Dokploy, normalized.
+// A null list becomes an empty slice so that removed paths are cleared.
+func watchPathsFromPlan(list types.List) []string {
+	paths := []string{}
+	if list.IsNull() || list.IsUnknown() {
+		return paths
+	}
+	for _, elem := range list.Elements() {
+		if s, ok := elem.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			paths = append(paths, normalizeWatchPath(s.ValueString()))
+		}
+	}
+	return paths
+}
+
+// watchPathsValue converts the watch paths read from Dokploy into state.
+// No paths keep a configured empty list and are null otherwise. Paths that
+// only differ from prior in normalization keep prior, so configurations
+// written as "./src/**" show no diff.
+func watchPathsValue(prior types.List, paths []string) types.List {
+	if len(paths) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			return prior
+		}
+		return types.ListNull(types.StringType)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if planned := watchPathsFromPlan(prior); slices.Equal(planned, normalizeWatchPaths(paths)) {
+			return prior
+		}
+	}
+	elems := make([]attr.Value, 0, len(paths))
+	for _, p := range paths {
+		elems = append(elems, types.StringValue(p))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWatchPaths(t *testing.T) {
+	empty := types.ListValueMust(types.StringType, []attr.Value{})
+	paths := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a/**"), types.StringValue("b")})
+
+	if got := watchPathsFromPlan(types.ListNull(types.StringType)); got == nil || len(got) != 0 {
+		t.Errorf("watchPathsFromPlan(null) = %#v, want empty slice", got)
+	}
+	if got := watchPathsFromPlan(paths); !reflect.DeepEqual(got, []string{"a/**", "b"}) {
+		t.Errorf("watchPathsFromPlan(paths) = %#v", got)
+	}
+
+	if got := watchPathsValue(types.ListNull(types.StringType), nil); !got.IsNull() {
+		t.Errorf("watchPathsValue(null, nil) = %s, want null", got)
+	}
+	if got := watchPathsValue(empty, nil); !got.Equal(empty) {
+		t.Errorf("watchPathsValue(empty, nil) = %s, want empty list", got)
+	}
+	if got := watchPathsValue(types.ListNull(types.StringType), []string{"a/**", "b"}); !got.Equal(paths) {
+		t.Errorf("watchPathsValue(null, paths) = %s, want %s", got, paths)
+	}
+
+	dotted := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("./a/**"), types.StringValue("b")})
+	if got := watchPathsFromPlan(dotted); !reflect.DeepEqual(got, []string{"a/**", "b"}) {
+		t.Errorf("watchPathsFromPlan(dotted) = %#v, want normalized paths", got)
+	}
+	if got := watchPathsValue(dotted, []string{"a/**", "b"}); !got.Equal(dotted) {
+		t.Errorf("watchPathsValue(dotted, paths) = %s, want the configured %s", got, dotted)
+	}
+	if got := watchPathsValue(dotted, []string{"c/**"}); !got.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("c/**")})) {
+		t.Errorf("watchPathsValue(dotted, changed) = %s, want the paths from Dokploy", got)
+	}
+}
+
+func TestWatchPathProblem(t *testing.T) {
+	tests := map[string]bool{
+		"src/**":          true,
+		"./src/**":        true,
+		"**/*.{ts,tsx}":   true,
+		"package.json":    true,
+		"src/../lib/**":   true,
+		"":                false,
+		"./":              false,
+		"/src/**":         false,
+		"../shared/**":    false,
+		"src/[a-z.go":     false,
+		"./src/../../etc": false,
+	}
+	for p, valid := range tests {
+		if got := watchPathProblem(p) == ""; got != valid {
+			t.Errorf("watchPathProblem(%q) = %q, want valid %v", p, watchPathProblem(p), valid)
+		}
+	}
+	if got := normalizeWatchPath("././src//lib/./**"); got != "src/lib/**" {
+		t.Errorf("normalizeWatchPath() = %q, want src/lib/**", got)
+	}
+}
@@ -0,0 +1,134 @@
+// Package waiter polls the Dokploy API until an object reaches a terminal
+// state. It is shared by every wait-for behaviour in the provider so that
+// intervals, backoff, jitter, timeouts and cancelation behave the same way.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// ErrTimeout is returned, wrapped, when the timeout or attempt limit is
+// reached before the object reaches a terminal state.
+var ErrTimeout = errors.New("timed out waiting")
+
+// Config controls how often and for how long Wait polls.
+type Config struct {
+	// Interval is the delay before the second poll.
+	Interval time.Duration
+	// MaxInterval caps the delay when backing off. Zero means no cap.
+	MaxInterval time.Duration
+	// Multiplier grows the delay after each poll. Values of 1 or less keep
+	// the delay constant.
+	Multiplier float64
+	// Jitter randomizes each delay by up to this fraction of it, in either
+	// direction, so concurrent waiters do not poll in lockstep.
+	Jitter float64
+	// Timeout bounds the whole wait. Zero means only the context bounds it.
+	Timeout time.Duration
+	// MaxAttempts bounds the number of polls. Zero means unlimited.
+	MaxAttempts int
+}
+
+// retryableError marks an error that should not stop polling.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+// Retryable marks err as transient: Wait keeps polling instead of returning
+// it. If the wait times out, the last retryable error is included in the
+// timeout error.
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+// IsRetryable reports whether err was marked with Retryable.
+func IsRetryable(err error) bool {
+	var r *retryableError
+	return errors.As(err, &r)
+}
+
+// Wait calls refresh until done reports a terminal state, refresh returns a
+// non-retryable error, the context is canceled, or the timeout or attempt
+// limit is reached. It always returns the last value refresh produced
+// without an error, so callers can fall back to it when the wait fails.
+func Wait[T any](ctx context.Context, cfg Config, refresh func(context.Context) (T, error), done func(T) bool) (T, error) {
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	var last T
+	var lastErr error
+	delay := cfg.Interval
+	for attempt := 1; ; attempt++ {
+		result, err := refresh(ctx)
+		switch {
+		case err == nil:
+			last, lastErr = result, nil
+			if done(result) {
+				return result, nil
+			}
+		case IsRetryable(err):
+			lastErr = errors.Unwrap(err)
+		default:
+			return last, err
+		}
+
+		if cfg.MaxAttempts > 0 && attempt >= cfg.MaxAttempts {
+			return last, timeoutError(fmt.Sprintf("after %d attempts", attempt), lastErr)
+		}
+
+		timer := time.NewTimer(jitter(delay, cfg.Jitter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && cfg.Timeout > 0 {
+				return last, timeoutError(fmt.Sprintf("after %s", cfg.Timeout), lastErr)
+			}
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+		delay = next(delay, cfg)
+	}
+}
+
+// next returns the delay that follows d under cfg's backoff.
+func next(d time.Duration, cfg Config) time.Duration {
+	if cfg.Multiplier > 1 {
+		d = time.Duration(float64(d) * cfg.Multiplier)
+	}
+	if cfg.MaxInterval > 0 && d > cfg.MaxInterval {
+		d = cfg.MaxInterval
+	}
+	return d
+}
+
+// jitter randomizes d by up to fraction of it in either direction.
+func jitter(d time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 || d <= 0 {
+		return d
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	spread := float64(d) * fraction
+	return time.Duration(float64(d) - spread + rand.Float64()*2*spread)
+}
+
+func timeoutError(detail string, lastErr error) error {
+	if lastErr != nil {
+		return fmt.Errorf("%w %s: %w", ErrTimeout, detail, lastErr)
+	}
+	return fmt.Errorf("%w %s", ErrTimeout, detail)
+}
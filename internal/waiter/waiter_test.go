@@ -0,0 +1,106 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitUntilDone(t *testing.T) {
+	calls := 0
+	got, err := Wait(context.Background(), Config{Interval: time.Millisecond}, func(context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, Retryable(errors.New("404 not found"))
+		}
+		return calls, nil
+	}, func(n int) bool { return n >= 3 })
+	if err != nil || got != 3 || calls != 3 {
+		t.Fatalf("got %d, %v after %d calls", got, err, calls)
+	}
+}
+
+func TestWaitStopsOnError(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	got, err := Wait(context.Background(), Config{Interval: time.Millisecond}, func(context.Context) (string, error) {
+		calls++
+		if calls == 1 {
+			return "partial", nil
+		}
+		return "", boom
+	}, func(s string) bool { return false })
+	if !errors.Is(err, boom) || calls != 2 {
+		t.Fatalf("err = %v after %d calls, want boom after 2", err, calls)
+	}
+	if got != "partial" {
+		t.Errorf("got %q, want last successful value", got)
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	transient := errors.New("502 bad gateway")
+	_, err := Wait(context.Background(), Config{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}, func(context.Context) (int, error) {
+		return 0, Retryable(transient)
+	}, func(int) bool { return false })
+	if !errors.Is(err, ErrTimeout) || !errors.Is(err, transient) {
+		t.Fatalf("err = %v, want timeout wrapping the last error", err)
+	}
+}
+
+func TestWaitMaxAttempts(t *testing.T) {
+	calls := 0
+	_, err := Wait(context.Background(), Config{Interval: time.Millisecond, MaxAttempts: 4}, func(context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}, func(int) bool { return false })
+	if !errors.Is(err, ErrTimeout) || calls != 4 {
+		t.Fatalf("err = %v after %d calls, want timeout after 4", err, calls)
+	}
+}
+
+func TestWaitContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	_, err := Wait(ctx, Config{Interval: time.Hour}, func(context.Context) (int, error) {
+		calls++
+		cancel()
+		return 0, nil
+	}, func(int) bool { return false })
+	if !errors.Is(err, context.Canceled) || errors.Is(err, ErrTimeout) || calls != 1 {
+		t.Fatalf("err = %v after %d calls, want context.Canceled after 1", err, calls)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := Config{Interval: 100 * time.Millisecond, Multiplier: 2, MaxInterval: 300 * time.Millisecond}
+	d := cfg.Interval
+	var got []time.Duration
+	for i := 0; i < 4; i++ {
+		d = next(d, cfg)
+		got = append(got, d)
+	}
+	want := []time.Duration{200 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("delays = %v, want %v", got, want)
+		}
+	}
+
+	if d := next(time.Second, Config{}); d != time.Second {
+		t.Errorf("constant delay = %v, want 1s", d)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		d := jitter(time.Second, 0.2)
+		if d < 800*time.Millisecond || d > 1200*time.Millisecond {
+			t.Fatalf("jitter(1s, 0.2) = %v, want within 20%%", d)
+		}
+	}
+	if d := jitter(time.Second, 0); d != time.Second {
+		t.Errorf("jitter(1s, 0) = %v, want 1s", d)
+	}
+}
@@ -36,6 +36,7 @@ func main() {
 	}
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	provider.LogMetricsSummaries()
 
 	if err != nil {
 		log.Fatal(err.Error())